@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/infrastructure"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/config"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+const usage = `Uso: outbox <comando> [opciones]
+
+Comandos:
+  stats    muestra las entradas pendientes, agotadas y el retraso del outbox
+  replay   vuelve a dejar pendientes entradas ya entregadas o agotadas para que el relay las entregue otra vez
+
+Opciones de replay:
+`
+
+func main() {
+	replayFlags := flag.NewFlagSet("replay", flag.ExitOnError)
+	deadOnly := replayFlags.Bool("dead", false, "solo las entradas que agotaron los reintentos")
+	fromID := replayFlags.Int64("from", 0, "ID mínimo de las entradas (0 = sin límite)")
+	toID := replayFlags.Int64("to", 0, "ID máximo de las entradas (0 = sin límite)")
+	since := replayFlags.String("since", "", "solo las entradas creadas desde esta fecha (RFC 3339)")
+	printUsage := func() {
+		fmt.Fprint(os.Stderr, usage)
+		replayFlags.PrintDefaults()
+	}
+
+	if len(os.Args) < 2 || (os.Args[1] != "stats" && os.Args[1] != "replay") {
+		printUsage()
+		os.Exit(2)
+	}
+	if os.Args[1] == "replay" {
+		_ = replayFlags.Parse(os.Args[2:])
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatal("Error cargando configuración:", err)
+	}
+	gormDB, err := database.NewGormDB(cfg)
+	if err != nil {
+		log.Fatal("Error conectando a la base de datos:", err)
+	}
+	defer gormDB.Close()
+
+	ctx := context.Background()
+	outbox := infrastructure.NewGormOutboxRepository(gormDB.GetDB())
+
+	switch os.Args[1] {
+	case "stats":
+		stats, err := outbox.Stats(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("pendientes: %d\nagotadas: %d\nretraso: %s\n", stats.Pending, stats.Dead, stats.Lag(time.Now()).Round(time.Second))
+	case "replay":
+		filter := infrastructure.OutboxReplayFilter{FromID: *fromID, ToID: *toID, DeadOnly: *deadOnly}
+		if *since != "" {
+			if filter.Since, err = time.Parse(time.RFC3339, *since); err != nil {
+				log.Fatalf("Fecha inválida en -since: %v", err)
+			}
+		}
+		replayed, err := outbox.Replay(ctx, filter)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%d entradas reprogramadas\n", replayed)
+	}
+}
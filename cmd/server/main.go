@@ -1,11 +1,28 @@
 package main
 
 import (
+	"context"
 	"log"
+	"time"
 
+	graphqlapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/application"
+	graphqldomain "github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	graphqlpresentation "github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/presentation"
+	idempotencyapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/application"
+	idempotencyinfrastructure "github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/infrastructure"
+	idempotencypresentation "github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/presentation"
+	realtimeapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application"
+	realtimepresentation "github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/presentation"
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/infrastructure"
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	userapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/user/application"
+	userinfrastructure "github.com/YerkoTenorio/api-go-hexagonal/modules/user/infrastructure"
+	webhookapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/application"
+	webhookdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	webhookinfrastructure "github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/infrastructure"
+	webhookpresentation "github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/presentation"
 	"github.com/YerkoTenorio/api-go-hexagonal/shared/config"
 	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
 	"github.com/gofiber/fiber/v2"
@@ -31,14 +48,166 @@ func main() {
 	// Crear repositorio con GORM
 	taskRepository := infrastructure.NewGormTaskRepository(gormDB.GetDB())
 
+	dependencyRepository := infrastructure.NewGormDependencyRepository(gormDB.GetDB())
+	commentRepository := infrastructure.NewGormCommentRepository(gormDB.GetDB())
+	assignmentRepository := infrastructure.NewGormAssignmentRepository(gormDB.GetDB())
+	userRepository := userinfrastructure.NewGormUserRepository(gormDB.GetDB())
+	historyRepository := infrastructure.NewGormTaskHistoryRepository(gormDB.GetDB())
+	customFieldRepository := infrastructure.NewGormCustomFieldRepository(gormDB.GetDB())
+
+	// Bus de eventos de tareas: entrega asíncrona ordenada por tarea (síncrona con TASK_EVENT_WORKERS=0)
+	var busOptions []infrastructure.EventBusOption
+	if cfg.Task.EventWorkers > 0 {
+		busOptions = append(busOptions, infrastructure.WithAsyncDelivery(cfg.Task.EventWorkers, cfg.Task.EventBuffer))
+	}
+	eventBus := infrastructure.NewEventBus(busOptions...)
+	defer eventBus.Close()
+	if cfg.Log.Level == "debug" {
+		eventBus.Subscribe("log", func(_ context.Context, event domain.Event) error {
+			log.Printf("Evento %s de la tarea %d (usuario %s)", event.Type, event.TaskID, event.Actor)
+			return nil
+		})
+	}
+
+	// Stream SSE de cambios de tareas: guarda los últimos eventos del bus para reanudar con Last-Event-ID
+	eventLog := infrastructure.NewEventLog(cfg.Task.StreamLogSize)
+	eventBus.Subscribe("stream", eventLog.Append)
+
+	// Webhooks salientes: los eventos de tareas generan entregas firmadas que el despachador envía con reintentos
+	webhookService := webhookapplication.NewWebhookService(
+		webhookinfrastructure.NewGormRepository(gormDB.GetDB()),
+		webhookinfrastructure.NewHTTPSender(cfg.Webhook.Timeout),
+		webhookdomain.RetryPolicy{MaxAttempts: cfg.Webhook.MaxAttempts, BackoffBase: cfg.Webhook.BackoffBase, BackoffMax: cfg.Webhook.BackoffMax},
+	)
+	go webhookService.RunDispatcher(context.Background(), cfg.Webhook.DispatchInterval, cfg.Webhook.BatchSize)
+
+	// Outbox de eventos (OUTBOX_ENABLED): los eventos se guardan en la transacción de cada cambio y el
+	// relay los entrega al bus con reintentos. Sin outbox se publican en el bus después de confirmar
+	outboxRepository := infrastructure.NewGormOutboxRepository(gormDB.GetDB())
+	eventOption := application.WithEventPublisher(eventBus)
+	if cfg.Outbox.Enabled {
+		eventOption = application.WithEventOutbox(outboxRepository)
+		outboxRelay := infrastructure.NewOutboxRelay(outboxRepository, infrastructure.OutboxRelayConfig{
+			PollInterval: cfg.Outbox.PollInterval,
+			BatchSize:    cfg.Outbox.BatchSize,
+			Lease:        cfg.Outbox.Lease,
+			MaxAttempts:  cfg.Outbox.MaxAttempts,
+			BackoffBase:  cfg.Outbox.BackoffBase,
+			BackoffMax:   cfg.Outbox.BackoffMax,
+			Retention:    cfg.Outbox.Retention,
+		})
+		outboxRelay.AddSink("bus", eventBus)
+		outboxRelay.AddSink("webhooks", webhookService)
+		go outboxRelay.Run(context.Background())
+	} else {
+		eventBus.Subscribe("webhooks", func(ctx context.Context, event domain.Event) error {
+			return webhookService.Publish(ctx, []domain.Event{event})
+		})
+	}
+
+	// Almacenamiento de adjuntos: sistema de archivos local o servicio compatible con S3
+	var blobStore domain.BlobStore
+	if cfg.Attachments.Storage == "s3" {
+		blobStore, err = infrastructure.NewS3BlobStore(infrastructure.S3Config{
+			Endpoint:  cfg.Attachments.S3Endpoint,
+			Bucket:    cfg.Attachments.S3Bucket,
+			Region:    cfg.Attachments.S3Region,
+			AccessKey: cfg.Attachments.S3AccessKey,
+			SecretKey: cfg.Attachments.S3SecretKey,
+		}, nil)
+	} else {
+		blobStore, err = infrastructure.NewLocalBlobStore(cfg.Attachments.Dir)
+	}
+	if err != nil {
+		log.Fatal("Error configurando almacenamiento de adjuntos:", err)
+	}
+	attachmentRepository := infrastructure.NewGormAttachmentRepository(gormDB.GetDB())
+
 	// Crear servicio de aplicación
-	taskService := application.NewTaskService(taskRepository)
+	taskService := application.NewTaskService(taskRepository,
+		application.WithDependencyRepository(dependencyRepository),
+		application.WithBlockerEnforcement(cfg.Task.EnforceBlockers),
+		application.WithCommentRepository(commentRepository, domain.CommentDeletePolicy(cfg.Task.CommentsOnDelete)),
+		application.WithAttachments(attachmentRepository, blobStore),
+		application.WithAssignments(assignmentRepository, userRepository),
+		application.WithHistory(historyRepository),
+		application.WithCustomFields(customFieldRepository, userRepository),
+		application.WithTxManager(database.NewGormTxManager(gormDB.GetDB())),
+		eventOption,
+	)
+
+	// Al desactivar un usuario se le quitan sus tareas (configurable).
+	// El módulo user todavía no expone rutas HTTP; el socket lo usa para verificar credenciales.
+	var userOptions []userapplication.UserServiceOption
+	if cfg.Task.UnassignOnDeactivate {
+		userOptions = append(userOptions, userapplication.WithDeactivationHook(taskService.UnassignUser))
+	}
+	userService := userapplication.NewUserService(userRepository, userOptions...)
+
+	// Socket de edición colaborativa: los eventos del bus llegan a las conexiones suscritas a cada tarea
+	realtimeService := realtimeapplication.NewRealtimeService(taskService, userRepository, userService, realtimeapplication.RealtimeConfig{
+		TicketSecret:     []byte(cfg.Realtime.TicketSecret),
+		TicketTTL:        cfg.Realtime.TicketTTL,
+		SendBuffer:       cfg.Realtime.SendBuffer,
+		MaxSubscriptions: cfg.Realtime.MaxSubscriptions,
+	})
+	eventBus.Subscribe("realtime", realtimeService.HandleEvent)
+
+	// Endpoint GraphQL sobre los casos de uso de tareas; las suscripciones leen el stream de eventos
+	graphqlService, err := graphqlapplication.NewGraphQLService(taskService, userRepository, eventLog, graphqldomain.Limits{
+		MaxDepth:      cfg.GraphQL.MaxDepth,
+		MaxComplexity: cfg.GraphQL.MaxComplexity,
+		ListFactor:    cfg.GraphQL.ListFactor,
+	})
+	if err != nil {
+		log.Fatal("Error construyendo el esquema GraphQL:", err)
+	}
+
+	// Purga periódica de la papelera: elimina definitivamente las tareas vencidas
+	go taskService.RunTrashPurger(context.Background(), cfg.Task.TrashRetention, cfg.Task.TrashPurgeInterval)
+
+	// Archivado automático de tareas completadas (desactivado con TASK_AUTO_ARCHIVE_DAYS=0)
+	if cfg.Task.AutoArchiveDays > 0 {
+		go taskService.RunAutoArchiver(context.Background(), cfg.Task.AutoArchiveDays, cfg.Task.AutoArchiveInterval)
+	}
+
+	commentService := application.NewCommentService(commentRepository, taskRepository)
+	boardRepository := infrastructure.NewGormBoardRepository(gormDB.GetDB())
+	boardService := application.NewBoardService(boardRepository, taskService)
+	timeService := application.NewTimeTrackingService(infrastructure.NewGormTimeEntryRepository(gormDB.GetDB()), taskRepository, boardRepository)
+	estimateReportService := application.NewEstimateReportService(infrastructure.NewGormEstimateRepository(gormDB.GetDB()), boardRepository)
+	customFieldService := application.NewCustomFieldService(customFieldRepository)
+	templateService := application.NewTemplateService(infrastructure.NewGormTemplateRepository(gormDB.GetDB()), customFieldRepository)
+
+	attachmentService := application.NewAttachmentService(attachmentRepository, taskRepository, blobStore, application.AttachmentLimits{
+		MaxSize:      int64(cfg.Attachments.MaxSize),
+		AllowedTypes: cfg.Attachments.AllowedTypes,
+	})
+
+	// Claves de idempotencia: las respuestas se guardan durante IDEMPOTENCY_TTL y se purgan al vencer
+	idempotencyService := idempotencyapplication.NewIdempotencyService(idempotencyinfrastructure.NewGormRepository(gormDB.GetDB()), cfg.Idempotency.TTL)
+	go idempotencyService.RunPurger(context.Background(), cfg.Idempotency.PurgeInterval)
 
 	// Crear handler con Fiber
 	taskHandler := presentation.NewFiberTaskHandler(taskService)
+	commentHandler := presentation.NewFiberCommentHandler(commentService)
+	attachmentHandler := presentation.NewFiberAttachmentHandler(attachmentService)
+	boardHandler := presentation.NewFiberBoardHandler(boardService)
+	timeHandler := presentation.NewFiberTimeHandler(timeService)
+	estimateHandler := presentation.NewFiberEstimateHandler(taskService, estimateReportService)
+	customFieldHandler := presentation.NewFiberCustomFieldHandler(taskService, customFieldService)
+	templateHandler := presentation.NewFiberTemplateHandler(templateService)
+	streamHandler := presentation.NewFiberTaskStreamHandler(eventLog, cfg.Task.StreamHeartbeat)
+	webhookHandler := webhookpresentation.NewFiberWebhookHandler(webhookService)
+	realtimeHandler := realtimepresentation.NewFiberRealtimeHandler(realtimeService, cfg.Realtime.AllowedOrigins)
+	graphqlHandler := graphqlpresentation.NewFiberGraphQLHandler(graphqlService, cfg.Task.StreamHeartbeat)
 
 	// Crear aplicación Fiber
 	app := fiber.New(fiber.Config{
+		// Los cuerpos que superan BodyLimit se entregan en streaming: la subida de adjuntos lee el
+		// formulario sin cargarlo en memoria y NewFiberBodyLimitMiddleware rechaza los demás
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			code := fiber.StatusInternalServerError
 			if e, ok := err.(*fiber.Error); ok {
@@ -52,8 +221,10 @@ func main() {
 
 	// Middleware
 	app.Use(recover.New())
+	app.Use(presentation.NewFiberBodyLimitMiddleware(fiber.DefaultBodyLimit))
 	app.Use(logger.New())
 	app.Use(cors.New())
+	app.Use(idempotencypresentation.NewFiberIdempotencyMiddleware(idempotencyService))
 
 	// Rutas de salud
 	app.Get("/health", func(c *fiber.Ctx) error {
@@ -64,8 +235,29 @@ func main() {
 		})
 	})
 
+	// Métricas en formato Prometheus: retraso y tamaño del outbox de eventos
+	app.Get("/metrics", func(c *fiber.Ctx) error {
+		stats, err := outboxRepository.Stats(c.Context())
+		if err != nil {
+			return err
+		}
+		c.Set(fiber.HeaderContentType, "text/plain; version=0.0.4; charset=utf-8")
+		return infrastructure.WriteOutboxMetrics(c, stats, time.Now())
+	})
+
 	// Configurar rutas de tareas
+	presentation.SetupTaskStreamRoutesFiber(app, streamHandler)
 	presentation.SetupTaskRoutesFiber(app, taskHandler)
+	presentation.SetupCommentRoutesFiber(app, commentHandler)
+	presentation.SetupAttachmentRoutesFiber(app, attachmentHandler)
+	presentation.SetupBoardRoutesFiber(app, boardHandler)
+	presentation.SetupTimeRoutesFiber(app, timeHandler)
+	presentation.SetupEstimateRoutesFiber(app, estimateHandler)
+	presentation.SetupCustomFieldRoutesFiber(app, customFieldHandler)
+	presentation.SetupTemplateRoutesFiber(app, templateHandler)
+	webhookpresentation.SetupWebhookRoutesFiber(app, webhookHandler)
+	realtimepresentation.SetupRealtimeRoutesFiber(app, realtimeHandler)
+	graphqlpresentation.SetupGraphQLRoutesFiber(app, graphqlHandler)
 
 	// Iniciar servidor
 	log.Printf("Servidor Fiber + GORM iniciado en %s:%s", cfg.Server.Host, cfg.Server.Port)
@@ -0,0 +1,24 @@
+package application
+
+import (
+	"context"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	"github.com/graphql-go/graphql"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=../presentation/mocks/mock_graphql_service.go -package=mocks
+
+// GraphQLServiceInterface define el contrato que usan los handlers de /graphql
+type GraphQLServiceInterface interface {
+	// Do valida y ejecuta una operación
+	Do(ctx context.Context, req domain.Request) Response
+}
+
+// Response es el resultado de una operación: Result para consultas y mutaciones, Stream para suscripciones
+type Response struct {
+	Result *graphql.Result
+	Stream <-chan *graphql.Result
+	// Invalid indica que la operación se rechazó antes de ejecutarse
+	Invalid bool
+}
@@ -0,0 +1,154 @@
+package application
+
+import (
+	"math"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// queryCost es la profundidad y el costo estimado de una operación
+type queryCost struct {
+	depth      int
+	complexity int
+}
+
+// checkLimits mide la operación antes de ejecutarla y la rechaza si supera los límites
+func checkLimits(schema *graphql.Schema, operation *ast.OperationDefinition, fragments map[string]*ast.FragmentDefinition, limits domain.Limits) error {
+	var root *graphql.Object
+	switch operation.Operation {
+	case ast.OperationTypeMutation:
+		root = schema.MutationType()
+	case ast.OperationTypeSubscription:
+		root = schema.SubscriptionType()
+	default:
+		root = schema.QueryType()
+	}
+
+	listFactor := limits.ListFactor
+	if listFactor < 1 {
+		listFactor = 1
+	}
+	measurer := costMeasurer{schema: schema, fragments: fragments, listFactor: listFactor}
+	cost := measurer.selectionSet(root, operation.SelectionSet, map[string]bool{})
+
+	if limits.MaxDepth > 0 && cost.depth > limits.MaxDepth {
+		return domain.ErrQueryTooDeep
+	}
+	if limits.MaxComplexity > 0 && cost.complexity > limits.MaxComplexity {
+		return domain.ErrQueryTooComplex
+	}
+	return nil
+}
+
+// costMeasurer recorre las selecciones con los tipos del esquema
+type costMeasurer struct {
+	schema     *graphql.Schema
+	fragments  map[string]*ast.FragmentDefinition
+	listFactor int
+}
+
+// selectionSet suma el costo de los campos de una selección; visiting evita los ciclos de fragmentos,
+// que la validación ya rechaza
+func (m *costMeasurer) selectionSet(parent graphql.Type, set *ast.SelectionSet, visiting map[string]bool) queryCost {
+	cost := queryCost{}
+	if set == nil {
+		return cost
+	}
+	for _, selection := range set.Selections {
+		var child queryCost
+		switch selection := selection.(type) {
+		case *ast.Field:
+			child = m.field(parent, selection, visiting)
+		case *ast.InlineFragment:
+			child = m.selectionSet(m.typeCondition(parent, selection.TypeCondition), selection.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			name := selection.Name.Value
+			fragment, ok := m.fragments[name]
+			if !ok || visiting[name] {
+				continue
+			}
+			visiting[name] = true
+			child = m.selectionSet(m.typeCondition(parent, fragment.TypeCondition), fragment.SelectionSet, visiting)
+			delete(visiting, name)
+		}
+		cost.depth = max(cost.depth, child.depth)
+		cost.complexity = saturatingAdd(cost.complexity, child.complexity)
+	}
+	return cost
+}
+
+// field mide un campo y lo que se pide dentro de él; los campos de introspección cuentan igual que
+// los demás, para que una consulta a __schema no evite los límites
+func (m *costMeasurer) field(parent graphql.Type, field *ast.Field, visiting map[string]bool) queryCost {
+	var fieldType graphql.Type
+	if definition := fieldDefinition(parent, field.Name.Value); definition != nil {
+		fieldType = definition.Type
+	}
+
+	multiplier := 1
+	named := fieldType
+	for {
+		switch typ := named.(type) {
+		case *graphql.NonNull:
+			named = typ.OfType
+			continue
+		case *graphql.List:
+			multiplier = saturatingMul(multiplier, m.listFactor)
+			named = typ.OfType
+			continue
+		}
+		break
+	}
+
+	children := m.selectionSet(named, field.SelectionSet, visiting)
+	return queryCost{
+		depth:      children.depth + 1,
+		complexity: saturatingAdd(1, saturatingMul(children.complexity, multiplier)),
+	}
+}
+
+// fieldDefinition busca la definición de un campo en el tipo padre, incluidos los campos de
+// introspección, que no forman parte de los campos del objeto
+func fieldDefinition(parent graphql.Type, name string) *graphql.FieldDefinition {
+	switch name {
+	case graphql.SchemaMetaFieldDef.Name:
+		return graphql.SchemaMetaFieldDef
+	case graphql.TypeMetaFieldDef.Name:
+		return graphql.TypeMetaFieldDef
+	case graphql.TypeNameMetaFieldDef.Name:
+		return graphql.TypeNameMetaFieldDef
+	}
+	if object, ok := parent.(*graphql.Object); ok {
+		return object.Fields()[name]
+	}
+	return nil
+}
+
+// typeCondition retorna el tipo de un fragmento; sin condición es el tipo del padre
+func (m *costMeasurer) typeCondition(parent graphql.Type, condition *ast.Named) graphql.Type {
+	if condition == nil || condition.Name == nil {
+		return parent
+	}
+	if typ, ok := m.schema.TypeMap()[condition.Name.Value]; ok {
+		return typ
+	}
+	return parent
+}
+
+// saturatingAdd suma sin desbordar, para que una consulta enorme no parezca barata
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+// saturatingMul multiplica sin desbordar
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
@@ -0,0 +1,93 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+)
+
+// userLoader agrupa las búsquedas de usuarios de una operación. El ejecutor resuelve primero todos
+// los campos de un nivel y después las funciones diferidas, así que los responsables de todas las
+// tareas de una lista se piden al repositorio en una sola consulta en lugar de una por tarea
+type userLoader struct {
+	users UserReader
+
+	mu sync.Mutex
+	// batch junta los IDs pedidos hasta que se resuelve la primera función diferida
+	batch *userBatch
+}
+
+// userBatch es un lote de IDs que se busca una sola vez
+type userBatch struct {
+	ids   []int
+	once  sync.Once
+	users map[int]*userdomain.User
+	err   error
+}
+
+func newUserLoader(users UserReader) *userLoader {
+	return &userLoader{users: users}
+}
+
+// load agrega los IDs al lote en curso y retorna una función diferida con los usuarios en el mismo
+// orden; los usuarios que ya no existen se omiten
+func (l *userLoader) load(ctx context.Context, ids []int) func() (any, error) {
+	l.mu.Lock()
+	if l.batch == nil {
+		l.batch = &userBatch{}
+	}
+	batch := l.batch
+	batch.ids = append(batch.ids, ids...)
+	l.mu.Unlock()
+
+	return func() (any, error) {
+		batch.once.Do(func() { l.dispatch(ctx, batch) })
+		if batch.err != nil {
+			return nil, batch.err
+		}
+		users := make([]*userdomain.User, 0, len(ids))
+		for _, id := range ids {
+			if user, ok := batch.users[id]; ok {
+				users = append(users, user)
+			}
+		}
+		return users, nil
+	}
+}
+
+// dispatch cierra el lote y busca sus usuarios; los IDs pedidos después van a un lote nuevo
+func (l *userLoader) dispatch(ctx context.Context, batch *userBatch) {
+	l.mu.Lock()
+	if l.batch == batch {
+		l.batch = nil
+	}
+	ids := uniqueIDs(batch.ids)
+	l.mu.Unlock()
+
+	batch.users = make(map[int]*userdomain.User, len(ids))
+	if len(ids) == 0 {
+		return
+	}
+	users, err := l.users.GetByIDs(ctx, ids)
+	if err != nil {
+		batch.err = err
+		return
+	}
+	for _, user := range users {
+		batch.users[user.ID] = user
+	}
+}
+
+// uniqueIDs retorna los IDs sin repetir, en el orden en que se pidieron
+func uniqueIDs(ids []int) []int {
+	seen := make(map[int]struct{}, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,18 @@
+package application
+
+import (
+	"context"
+
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+)
+
+//go:generate mockgen -source=ports.go -destination=mocks/mock_ports.go -package=mocks
+
+// UserReader obtiene los usuarios que se exponen en el esquema; lo implementa el repositorio de usuarios
+type UserReader interface {
+	// GetByIDs obtiene los usuarios de una lista de IDs en una sola consulta
+	GetByIDs(ctx context.Context, ids []int) ([]*userdomain.User, error)
+
+	// GetAll obtiene todos los usuarios
+	GetAll(ctx context.Context) ([]*userdomain.User, error)
+}
@@ -0,0 +1,673 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	taskapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Códigos de error que se envían en extensions.code
+const (
+	CodeBadUserInput       = "BAD_USER_INPUT"
+	CodePreconditionFailed = "PRECONDITION_FAILED"
+	CodeConflict           = "CONFLICT"
+	CodeTaskArchived       = "TASK_ARCHIVED"
+	CodeTaskBlocked        = "TASK_BLOCKED"
+	CodeQueryTooDeep       = "QUERY_TOO_DEEP"
+	CodeQueryTooComplex    = "QUERY_TOO_COMPLEX"
+)
+
+// codedError es un error con un código en extensions, para que el cliente no dependa del mensaje
+type codedError struct {
+	err  error
+	code string
+}
+
+func (e *codedError) Error() string { return e.err.Error() }
+
+func (e *codedError) Unwrap() error { return e.err }
+
+// Extensions implementa gqlerrors.ExtendedError
+func (e *codedError) Extensions() map[string]any {
+	return map[string]any{"code": e.code}
+}
+
+// withCode agrega el código del error de dominio; los demás errores se envían sin código
+func withCode(err error) error {
+	code := ""
+	switch {
+	case errors.Is(err, taskdomain.ErrPreconditionFailed):
+		code = CodePreconditionFailed
+	case errors.Is(err, taskdomain.ErrVersionConflict):
+		code = CodeConflict
+	case errors.Is(err, taskdomain.ErrTaskArchived):
+		code = CodeTaskArchived
+	case errors.Is(err, taskdomain.ErrTaskBlocked):
+		code = CodeTaskBlocked
+	case errors.Is(err, taskdomain.ErrInvalidPatch), errors.Is(err, taskdomain.ErrSelfDependency),
+		errors.Is(err, taskdomain.ErrDependencyCycle), errors.Is(err, taskdomain.ErrInvalidRecurrence),
+		errors.Is(err, taskdomain.ErrInvalidEstimate), errors.Is(err, taskdomain.ErrInvalidMove),
+		errors.Is(err, taskdomain.ErrInvalidCustomFieldValue), errors.Is(err, taskdomain.ErrAssigneeNotFound),
+		errors.Is(err, taskdomain.ErrAssigneeInactive), errors.Is(err, taskdomain.ErrArchiveNotCompleted),
+		errors.Is(err, taskdomain.ErrTaskNotArchived), errors.Is(err, taskdomain.ErrRevisionNotFound),
+		errors.Is(err, taskdomain.ErrTaskNotInTrash):
+		code = CodeBadUserInput
+	}
+	if code == "" {
+		return err
+	}
+	return &codedError{err: err, code: code}
+}
+
+// jsonScalar representa valores JSON arbitrarios, como los campos personalizados
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "JSON",
+	Description:  "Valor JSON arbitrario: objeto, lista, texto, número o booleano",
+	Serialize:    func(value any) any { return value },
+	ParseValue:   func(value any) any { return value },
+	ParseLiteral: parseJSONLiteral,
+})
+
+// parseJSONLiteral convierte un literal de la consulta al mismo valor que daría el JSON de las
+// variables; los números son float64 en ambos casos
+func parseJSONLiteral(value ast.Value) any {
+	switch value := value.(type) {
+	case *ast.StringValue:
+		return value.Value
+	case *ast.EnumValue:
+		return value.Value
+	case *ast.BooleanValue:
+		return value.Value
+	case *ast.IntValue:
+		number, err := strconv.ParseFloat(value.Value, 64)
+		if err != nil {
+			return nil
+		}
+		return number
+	case *ast.FloatValue:
+		number, err := strconv.ParseFloat(value.Value, 64)
+		if err != nil {
+			return nil
+		}
+		return number
+	case *ast.ListValue:
+		list := make([]any, 0, len(value.Values))
+		for _, item := range value.Values {
+			list = append(list, parseJSONLiteral(item))
+		}
+		return list
+	case *ast.ObjectValue:
+		object := make(map[string]any, len(value.Fields))
+		for _, field := range value.Fields {
+			object[field.Name.Value] = parseJSONLiteral(field.Value)
+		}
+		return object
+	}
+	return nil
+}
+
+// schemaResolver resuelve los campos del esquema con los casos de uso de tareas
+type schemaResolver struct {
+	tasks  taskapplication.TaskServiceInterface
+	users  UserReader
+	stream taskapplication.EventStream
+}
+
+type userLoaderKey struct{}
+
+// withUserLoader guarda en el contexto el loader de usuarios de la operación
+func withUserLoader(ctx context.Context, loader *userLoader) context.Context {
+	return context.WithValue(ctx, userLoaderKey{}, loader)
+}
+
+// loader retorna el loader de usuarios de la operación
+func (r *schemaResolver) loader(ctx context.Context) *userLoader {
+	if loader, ok := ctx.Value(userLoaderKey{}).(*userLoader); ok {
+		return loader
+	}
+	return newUserLoader(r.users)
+}
+
+// taskField expone un valor de la tarea
+func taskField(typ graphql.Output, description string, value func(*taskdomain.Task) any) *graphql.Field {
+	return &graphql.Field{
+		Type:        typ,
+		Description: description,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			task, _ := p.Source.(*taskdomain.Task)
+			if task == nil {
+				return nil, nil
+			}
+			return value(task), nil
+		},
+	}
+}
+
+// userField expone un valor del usuario
+func userField(typ graphql.Output, value func(*userdomain.User) any) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			user, _ := p.Source.(*userdomain.User)
+			if user == nil {
+				return nil, nil
+			}
+			return value(user), nil
+		},
+	}
+}
+
+// eventField expone un valor del evento
+func eventField(typ graphql.Output, value func(*taskdomain.Event) any) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			event, _ := p.Source.(*taskdomain.Event)
+			if event == nil {
+				return nil, nil
+			}
+			return value(event), nil
+		},
+	}
+}
+
+// taskResult adapta el resultado de un caso de uso que retorna una tarea
+func taskResult(task *taskdomain.Task, err error) (any, error) {
+	if err != nil {
+		return nil, withCode(err)
+	}
+	return task, nil
+}
+
+// tasksResult adapta el resultado de un caso de uso que retorna tareas
+func tasksResult(tasks []*taskdomain.Task, err error) (any, error) {
+	if err != nil {
+		return nil, withCode(err)
+	}
+	if tasks == nil {
+		tasks = []*taskdomain.Task{}
+	}
+	return tasks, nil
+}
+
+// doneResult adapta el resultado de un caso de uso que no retorna datos
+func doneResult(err error) (any, error) {
+	if err != nil {
+		return nil, withCode(err)
+	}
+	return true, nil
+}
+
+// mutationContext agrega la versión esperada del argumento version, como If-Match
+func mutationContext(p graphql.ResolveParams) context.Context {
+	if version, ok := p.Args["version"].(int); ok {
+		return taskapplication.WithExpectedVersion(p.Context, version)
+	}
+	return p.Context
+}
+
+// intArg retorna un argumento entero; 0 si no se envió
+func intArg(p graphql.ResolveParams, name string) int {
+	value, _ := p.Args[name].(int)
+	return value
+}
+
+// stringArg retorna un argumento de texto opcional
+func stringArg(p graphql.ResolveParams, name string) *string {
+	if value, ok := p.Args[name].(string); ok {
+		return &value
+	}
+	return nil
+}
+
+// boolArg retorna un argumento booleano opcional
+func boolArg(p graphql.ResolveParams, name string) *bool {
+	if value, ok := p.Args[name].(bool); ok {
+		return &value
+	}
+	return nil
+}
+
+// optionalTime evita exponer una fecha vacía
+func optionalTime(value *time.Time) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+// newSchema construye el esquema de tareas y usuarios
+func newSchema(r *schemaResolver) (graphql.Schema, error) {
+	nonNullInt := graphql.NewNonNull(graphql.Int)
+	nonNullString := graphql.NewNonNull(graphql.String)
+	nonNullBoolean := graphql.NewNonNull(graphql.Boolean)
+	nonNullFloat := graphql.NewNonNull(graphql.Float)
+	nonNullDateTime := graphql.NewNonNull(graphql.DateTime)
+
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "User",
+		Description: "Usuario del sistema",
+		Fields: graphql.Fields{
+			"id":        userField(nonNullInt, func(u *userdomain.User) any { return u.ID }),
+			"username":  userField(nonNullString, func(u *userdomain.User) any { return u.Username }),
+			"email":     userField(nonNullString, func(u *userdomain.User) any { return u.Email }),
+			"firstName": userField(nonNullString, func(u *userdomain.User) any { return u.FirstName }),
+			"lastName":  userField(nonNullString, func(u *userdomain.User) any { return u.LastName }),
+			"active":    userField(nonNullBoolean, func(u *userdomain.User) any { return u.Active }),
+		},
+	})
+
+	taskType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Task",
+		Description: "Tarea",
+		Fields: graphql.Fields{
+			"id":          taskField(nonNullInt, "", func(t *taskdomain.Task) any { return t.ID }),
+			"title":       taskField(nonNullString, "", func(t *taskdomain.Task) any { return t.Title }),
+			"description": taskField(nonNullString, "", func(t *taskdomain.Task) any { return t.Description }),
+			"completed":   taskField(nonNullBoolean, "", func(t *taskdomain.Task) any { return t.Completed }),
+			"blocked":     taskField(nonNullBoolean, "Tiene bloqueadores sin completar", func(t *taskdomain.Task) any { return t.Blocked }),
+			"dueDate":     taskField(graphql.DateTime, "", func(t *taskdomain.Task) any { return optionalTime(t.DueDate) }),
+			"recurrence":  taskField(nonNullString, "Regla RRULE; vacía si no se repite", func(t *taskdomain.Task) any { return t.Recurrence }),
+			"occurrence":  taskField(nonNullInt, "Número de ocurrencia de una tarea recurrente", func(t *taskdomain.Task) any { return t.Occurrence }),
+			"assigneeIds": taskField(graphql.NewNonNull(graphql.NewList(nonNullInt)), "", func(t *taskdomain.Task) any {
+				if t.Assignees == nil {
+					return []int{}
+				}
+				return t.Assignees
+			}),
+			"assignees": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+				Description: "Responsables; se buscan en una sola consulta para todas las tareas del mismo nivel",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					task, _ := p.Source.(*taskdomain.Task)
+					if task == nil || len(task.Assignees) == 0 {
+						return []*userdomain.User{}, nil
+					}
+					return r.loader(p.Context).load(p.Context, task.Assignees), nil
+				},
+			},
+			"version":        taskField(nonNullInt, "Se incrementa en cada escritura; se usa como versión esperada", func(t *taskdomain.Task) any { return t.Version }),
+			"rank":           taskField(nonNullString, "Posición en el orden manual", func(t *taskdomain.Task) any { return t.Rank }),
+			"storyPoints":    taskField(nonNullInt, "", func(t *taskdomain.Task) any { return t.StoryPoints }),
+			"estimatedHours": taskField(nonNullFloat, "", func(t *taskdomain.Task) any { return t.EstimatedHours }),
+			"remainingHours": taskField(nonNullFloat, "", func(t *taskdomain.Task) any { return t.RemainingHours }),
+			"customFields": taskField(graphql.NewNonNull(jsonScalar), "Valores de campos personalizados por clave", func(t *taskdomain.Task) any {
+				if t.CustomFields == nil {
+					return map[string]any{}
+				}
+				return t.CustomFields
+			}),
+			"completedAt": taskField(graphql.DateTime, "", func(t *taskdomain.Task) any { return optionalTime(t.CompletedAt) }),
+			"archivedAt":  taskField(graphql.DateTime, "", func(t *taskdomain.Task) any { return optionalTime(t.ArchivedAt) }),
+			"createdAt":   taskField(nonNullDateTime, "", func(t *taskdomain.Task) any { return t.CreatedAt }),
+			"updatedAt":   taskField(nonNullDateTime, "", func(t *taskdomain.Task) any { return t.UpdatedAt }),
+			"deletedAt":   taskField(graphql.DateTime, "En la papelera desde esta fecha", func(t *taskdomain.Task) any { return optionalTime(t.DeletedAt) }),
+		},
+	})
+
+	taskEventType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "TaskEvent",
+		Description: "Cambio de una tarea",
+		Fields: graphql.Fields{
+			"type":       eventField(nonNullString, func(e *taskdomain.Event) any { return string(e.Type) }),
+			"taskId":     eventField(nonNullInt, func(e *taskdomain.Event) any { return e.TaskID }),
+			"actor":      eventField(nonNullString, func(e *taskdomain.Event) any { return e.Actor }),
+			"occurredAt": eventField(nonNullDateTime, func(e *taskdomain.Event) any { return e.OccurredAt }),
+			"task":       eventField(taskType, func(e *taskdomain.Event) any { return e.Task }),
+		},
+	})
+
+	taskList := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(taskType)))
+	idArgs := graphql.FieldConfigArgument{"id": {Type: nonNullInt}}
+	versionedIDArgs := graphql.FieldConfigArgument{
+		"id":      {Type: nonNullInt},
+		"version": {Type: graphql.Int, Description: "Versión esperada de la tarea, como If-Match"},
+	}
+	// withVersion agrega el argumento version a los argumentos de una mutación
+	withVersion := func(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+		args["id"] = &graphql.ArgumentConfig{Type: nonNullInt}
+		args["version"] = versionedIDArgs["version"]
+		return args
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"task": &graphql.Field{
+				Type: taskType,
+				Args: idArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.GetTaskByID(p.Context, intArg(p, "id")))
+				},
+			},
+			"tasks": &graphql.Field{
+				Type:        taskList,
+				Description: "Tareas en el orden manual; completed filtra por estado",
+				Args: graphql.FieldConfigArgument{
+					"completed":       {Type: graphql.Boolean},
+					"includeArchived": {Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					opts := taskdomain.TaskQueryOptions{IncludeArchived: *boolArg(p, "includeArchived")}
+					if completed := boolArg(p, "completed"); completed != nil {
+						return tasksResult(r.tasks.GetTasksByStatus(p.Context, *completed, opts))
+					}
+					return tasksResult(r.tasks.GetAllTasks(p.Context, opts))
+				},
+			},
+			"tasksByAssignee": &graphql.Field{
+				Type: taskList,
+				Args: graphql.FieldConfigArgument{"userId": {Type: nonNullInt}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return tasksResult(r.tasks.GetTasksByAssignee(p.Context, intArg(p, "userId")))
+				},
+			},
+			"trash": &graphql.Field{
+				Type: taskList,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return tasksResult(r.tasks.GetTrash(p.Context))
+				},
+			},
+			"user": &graphql.Field{
+				Type: userType,
+				Args: idArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					load := r.loader(p.Context).load(p.Context, []int{intArg(p, "id")})
+					return func() (any, error) {
+						value, err := load()
+						if err != nil {
+							return nil, err
+						}
+						if users := value.([]*userdomain.User); len(users) > 0 {
+							return users[0], nil
+						}
+						return nil, nil
+					}, nil
+				},
+			},
+			"users": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(userType))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					users, err := r.users.GetAll(p.Context)
+					if err != nil {
+						return nil, err
+					}
+					return users, nil
+				},
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createTask": &graphql.Field{
+				Type: taskType,
+				Args: graphql.FieldConfigArgument{
+					"title":       {Type: nonNullString},
+					"description": {Type: graphql.String, DefaultValue: ""},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.CreateTask(p.Context, *stringArg(p, "title"), *stringArg(p, "description")))
+				},
+			},
+			"updateTask": &graphql.Field{
+				Type:        taskType,
+				Description: "Cambia solo los campos enviados",
+				Args: withVersion(graphql.FieldConfigArgument{
+					"title":       {Type: graphql.String},
+					"description": {Type: graphql.String},
+					"completed":   {Type: graphql.Boolean},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.PatchTask(mutationContext(p), intArg(p, "id"), taskdomain.TaskPatch{
+						Title:       stringArg(p, "title"),
+						Description: stringArg(p, "description"),
+						Completed:   boolArg(p, "completed"),
+					}))
+				},
+			},
+			"deleteTask": &graphql.Field{
+				Type:        nonNullBoolean,
+				Description: "Mueve la tarea a la papelera",
+				Args:        versionedIDArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return doneResult(r.tasks.DeleteTask(mutationContext(p), intArg(p, "id")))
+				},
+			},
+			"completeTask": &graphql.Field{
+				Type: taskType,
+				Args: versionedIDArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.MarkTaskAsCompleted(mutationContext(p), intArg(p, "id")))
+				},
+			},
+			"reopenTask": &graphql.Field{
+				Type: taskType,
+				Args: versionedIDArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.MarkTaskAsUncompleted(mutationContext(p), intArg(p, "id")))
+				},
+			},
+			"setTaskSchedule": &graphql.Field{
+				Type:        taskType,
+				Description: "Sin dueDate se quita la fecha de vencimiento",
+				Args: withVersion(graphql.FieldConfigArgument{
+					"dueDate":    {Type: graphql.DateTime},
+					"recurrence": {Type: graphql.String, DefaultValue: ""},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					var dueDate *time.Time
+					if value, ok := p.Args["dueDate"].(time.Time); ok {
+						dueDate = &value
+					}
+					return taskResult(r.tasks.SetTaskSchedule(mutationContext(p), intArg(p, "id"), dueDate, *stringArg(p, "recurrence")))
+				},
+			},
+			"assignTask": &graphql.Field{
+				Type: taskType,
+				Args: withVersion(graphql.FieldConfigArgument{
+					"userIds": {Type: graphql.NewNonNull(graphql.NewList(nonNullInt))},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					values, _ := p.Args["userIds"].([]any)
+					userIDs := make([]int, 0, len(values))
+					for _, value := range values {
+						if id, ok := value.(int); ok {
+							userIDs = append(userIDs, id)
+						}
+					}
+					return taskResult(r.tasks.AssignTask(mutationContext(p), intArg(p, "id"), userIDs))
+				},
+			},
+			"unassignTask": &graphql.Field{
+				Type: nonNullBoolean,
+				Args: withVersion(graphql.FieldConfigArgument{"userId": {Type: nonNullInt}}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return doneResult(r.tasks.UnassignTask(mutationContext(p), intArg(p, "id"), intArg(p, "userId")))
+				},
+			},
+			"addDependency": &graphql.Field{
+				Type:        nonNullBoolean,
+				Description: "Registra que la tarea id está bloqueada por blockerId",
+				Args:        withVersion(graphql.FieldConfigArgument{"blockerId": {Type: nonNullInt}}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return doneResult(r.tasks.AddDependency(mutationContext(p), intArg(p, "id"), intArg(p, "blockerId")))
+				},
+			},
+			"removeDependency": &graphql.Field{
+				Type: nonNullBoolean,
+				Args: withVersion(graphql.FieldConfigArgument{"blockerId": {Type: nonNullInt}}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return doneResult(r.tasks.RemoveDependency(mutationContext(p), intArg(p, "id"), intArg(p, "blockerId")))
+				},
+			},
+			"restoreTask": &graphql.Field{
+				Type:        taskType,
+				Description: "Saca la tarea de la papelera",
+				Args:        idArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.RestoreTask(p.Context, intArg(p, "id")))
+				},
+			},
+			"permanentlyDeleteTask": &graphql.Field{
+				Type:        nonNullBoolean,
+				Description: "Elimina definitivamente una tarea de la papelera",
+				Args:        idArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return doneResult(r.tasks.PermanentlyDeleteTask(p.Context, intArg(p, "id")))
+				},
+			},
+			"archiveTask": &graphql.Field{
+				Type: taskType,
+				Args: versionedIDArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.ArchiveTask(mutationContext(p), intArg(p, "id")))
+				},
+			},
+			"unarchiveTask": &graphql.Field{
+				Type: taskType,
+				Args: idArgs,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.UnarchiveTask(p.Context, intArg(p, "id")))
+				},
+			},
+			"archiveCompletedTasks": &graphql.Field{
+				Type:        nonNullInt,
+				Description: "Archiva las tareas completadas hace más de days días y retorna cuántas",
+				Args:        graphql.FieldConfigArgument{"days": {Type: nonNullInt}},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					count, err := r.tasks.ArchiveCompletedTasks(p.Context, intArg(p, "days"))
+					if err != nil {
+						return nil, withCode(err)
+					}
+					return count, nil
+				},
+			},
+			"revertTask": &graphql.Field{
+				Type: taskType,
+				Args: withVersion(graphql.FieldConfigArgument{"revisionId": {Type: nonNullInt}}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.RevertTask(mutationContext(p), intArg(p, "id"), intArg(p, "revisionId")))
+				},
+			},
+			"moveTask": &graphql.Field{
+				Type:        taskType,
+				Description: "Ubica la tarea en el orden manual antes de beforeId y/o después de afterId",
+				Args: withVersion(graphql.FieldConfigArgument{
+					"beforeId": {Type: graphql.Int},
+					"afterId":  {Type: graphql.Int},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return taskResult(r.tasks.MoveTask(mutationContext(p), intArg(p, "id"), intArg(p, "beforeId"), intArg(p, "afterId")))
+				},
+			},
+			"setTaskEstimate": &graphql.Field{
+				Type: taskType,
+				Args: withVersion(graphql.FieldConfigArgument{
+					"storyPoints":    {Type: nonNullInt},
+					"estimatedHours": {Type: nonNullFloat},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					hours, _ := p.Args["estimatedHours"].(float64)
+					return taskResult(r.tasks.SetTaskEstimate(mutationContext(p), intArg(p, "id"), intArg(p, "storyPoints"), hours))
+				},
+			},
+			"updateRemainingWork": &graphql.Field{
+				Type: taskType,
+				Args: withVersion(graphql.FieldConfigArgument{"remainingHours": {Type: nonNullFloat}}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					hours, _ := p.Args["remainingHours"].(float64)
+					return taskResult(r.tasks.UpdateRemainingWork(mutationContext(p), intArg(p, "id"), hours))
+				},
+			},
+			"setTaskCustomFields": &graphql.Field{
+				Type:        taskType,
+				Description: "Guarda los valores por clave; un valor null quita el campo",
+				Args:        withVersion(graphql.FieldConfigArgument{"values": {Type: graphql.NewNonNull(jsonScalar)}}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					values, ok := p.Args["values"].(map[string]any)
+					if !ok {
+						return nil, &codedError{err: errors.New("values must be an object"), code: CodeBadUserInput}
+					}
+					return taskResult(r.tasks.SetTaskCustomFields(mutationContext(p), intArg(p, "id"), values))
+				},
+			},
+		},
+	})
+
+	subscription := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Subscription",
+		Fields: graphql.Fields{
+			"taskChanged": &graphql.Field{
+				Type:        graphql.NewNonNull(taskEventType),
+				Description: "Cambios de tareas desde que se suscribe; status y project filtran por el estado de la tarea después del cambio",
+				Args: graphql.FieldConfigArgument{
+					"taskId":  {Type: graphql.Int},
+					"status":  {Type: graphql.String, Description: "pending o completed"},
+					"project": {Type: graphql.String, Description: "Valor del campo personalizado project"},
+				},
+				Subscribe: r.subscribeTaskChanged,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:        query,
+		Mutation:     mutation,
+		Subscription: subscription,
+	})
+}
+
+// subscribeTaskChanged entrega los eventos del stream de tareas que pasan los filtros hasta que se
+// cancele el contexto de la operación o el stream corte la suscripción por lenta
+func (r *schemaResolver) subscribeTaskChanged(p graphql.ResolveParams) (any, error) {
+	filter := taskapplication.EventStreamFilter{}
+	if status := stringArg(p, "status"); status != nil {
+		if *status != "pending" && *status != "completed" {
+			return nil, &codedError{err: errors.New("status must be pending or completed"), code: CodeBadUserInput}
+		}
+		filter.Status = *status
+	}
+	if project := stringArg(p, "project"); project != nil {
+		filter.Project = *project
+	}
+	taskID := intArg(p, "taskId")
+
+	subscription := r.stream.Subscribe(0)
+	events := make(chan any)
+	go func() {
+		defer close(events)
+		defer subscription.Close()
+		for {
+			select {
+			case <-p.Context.Done():
+				return
+			case streamed, ok := <-subscription.Events:
+				if !ok {
+					return
+				}
+				event := streamed.Event
+				if taskID > 0 && event.TaskID != taskID || !filter.Matches(event) {
+					continue
+				}
+				select {
+				case events <- &event:
+				case <-p.Context.Done():
+					return
+				}
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"errors"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	taskapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// GraphQLService ejecuta operaciones GraphQL sobre los casos de uso de tareas y usuarios
+type GraphQLService struct {
+	schema graphql.Schema
+	users  UserReader
+	limits domain.Limits
+}
+
+// NewGraphQLService construye el esquema; falla solo si el esquema es inválido
+func NewGraphQLService(tasks taskapplication.TaskServiceInterface, users UserReader, stream taskapplication.EventStream, limits domain.Limits) (*GraphQLService, error) {
+	schema, err := newSchema(&schemaResolver{tasks: tasks, users: users, stream: stream})
+	if err != nil {
+		return nil, err
+	}
+	return &GraphQLService{schema: schema, users: users, limits: limits}, nil
+}
+
+// Do valida y ejecuta una operación. Las suscripciones entregan un resultado por cada evento en
+// Stream hasta que se cancele el contexto; quien lo consume debe vaciar Stream después de cancelar
+func (s *GraphQLService) Do(ctx context.Context, req domain.Request) Response {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return invalidResponse(gqlerrors.FormatErrors(err))
+	}
+	validation := graphql.ValidateDocument(&s.schema, document, nil)
+	if !validation.IsValid {
+		return invalidResponse(validation.Errors)
+	}
+
+	operation, fragments, err := selectOperation(document, req.OperationName)
+	if err != nil {
+		return invalidResponse(gqlerrors.FormatErrors(err))
+	}
+	if err := checkLimits(&s.schema, operation, fragments, s.limits); err != nil {
+		code := CodeQueryTooComplex
+		if errors.Is(err, domain.ErrQueryTooDeep) {
+			code = CodeQueryTooDeep
+		}
+		formatted := gqlerrors.NewFormattedError(err.Error())
+		formatted.Extensions = map[string]any{"code": code}
+		return invalidResponse([]gqlerrors.FormattedError{formatted})
+	}
+	if req.ReadOnly && operation.Operation == ast.OperationTypeMutation {
+		return invalidResponse(gqlerrors.FormatErrors(errors.New("mutations are only allowed with POST")))
+	}
+
+	ctx = withUserLoader(ctx, newUserLoader(s.users))
+	if req.Actor != "" {
+		ctx = taskapplication.WithActor(ctx, req.Actor)
+	}
+	params := graphql.ExecuteParams{
+		Schema:        s.schema,
+		AST:           document,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	}
+	if operation.Operation == ast.OperationTypeSubscription {
+		return Response{Stream: graphql.ExecuteSubscription(params)}
+	}
+	return Response{Result: graphql.Execute(params)}
+}
+
+// selectOperation busca la operación a ejecutar y los fragmentos del documento
+func selectOperation(document *ast.Document, name string) (*ast.OperationDefinition, map[string]*ast.FragmentDefinition, error) {
+	var operation *ast.OperationDefinition
+	fragments := map[string]*ast.FragmentDefinition{}
+	operations := 0
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.OperationDefinition:
+			operations++
+			if name == "" || definition.Name != nil && definition.Name.Value == name {
+				operation = definition
+			}
+		case *ast.FragmentDefinition:
+			fragments[definition.Name.Value] = definition
+		}
+	}
+	switch {
+	case name == "" && operations > 1:
+		return nil, nil, errors.New("operationName is required when the document has several operations")
+	case operation == nil && name != "":
+		return nil, nil, errors.New(`unknown operation named "` + name + `"`)
+	case operation == nil:
+		return nil, nil, errors.New("the document has no operations")
+	}
+	return operation, fragments, nil
+}
+
+// invalidResponse es una operación rechazada antes de ejecutarse
+func invalidResponse(errs []gqlerrors.FormattedError) Response {
+	return Response{Result: &graphql.Result{Errors: errs}, Invalid: true}
+}
@@ -0,0 +1,227 @@
+package application_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	taskapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	taskmocks "github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testLimits = domain.Limits{MaxDepth: 8, MaxComplexity: 1000, ListFactor: 10}
+
+// newTestService crea el servicio con los mocks de tareas, usuarios y stream
+func newTestService(t *testing.T, ctrl *gomock.Controller, limits domain.Limits) (*application.GraphQLService,
+	*taskmocks.MockTaskServiceInterface, *mocks.MockUserReader, *taskmocks.MockEventStream) {
+	t.Helper()
+	mockTasks := taskmocks.NewMockTaskServiceInterface(ctrl)
+	mockUsers := mocks.NewMockUserReader(ctrl)
+	mockStream := taskmocks.NewMockEventStream(ctrl)
+	service, err := application.NewGraphQLService(mockTasks, mockUsers, mockStream, limits)
+	require.NoError(t, err)
+	return service, mockTasks, mockUsers, mockStream
+}
+
+// decodeResult convierte el resultado a JSON genérico para comparar los datos como los ve el cliente
+func decodeResult(t *testing.T, result *graphql.Result) map[string]any {
+	t.Helper()
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	return decoded
+}
+
+// errorCode retorna el código del primer error del resultado
+func errorCode(result *graphql.Result) any {
+	if len(result.Errors) == 0 {
+		return nil
+	}
+	return result.Errors[0].Extensions["code"]
+}
+
+// TestGraphQLService_Tasks_BatchesAssignees verifica que los responsables de todas las tareas se
+// piden al repositorio en una sola consulta, sin repetir IDs
+func TestGraphQLService_Tasks_BatchesAssignees(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, mockTasks, mockUsers, _ := newTestService(t, ctrl, testLimits)
+
+	mockTasks.EXPECT().GetAllTasks(gomock.Any(), taskdomain.TaskQueryOptions{}).Return([]*taskdomain.Task{
+		{ID: 1, Title: "Primera", Assignees: []int{1, 2}},
+		{ID: 2, Title: "Segunda", Assignees: []int{2}},
+		{ID: 3, Title: "Tercera", Assignees: []int{3}},
+		{ID: 4, Title: "Sin responsables"},
+	}, nil)
+	mockUsers.EXPECT().GetByIDs(gomock.Any(), []int{1, 2, 3}).Return([]*userdomain.User{
+		{ID: 1, Username: "ana"},
+		{ID: 2, Username: "bob"},
+	}, nil).Times(1)
+
+	// Act
+	response := service.Do(context.Background(), domain.Request{
+		Query: `{ tasks { id title assignees { username } } }`,
+	})
+
+	// Assert
+	require.NotNil(t, response.Result)
+	assert.False(t, response.Invalid)
+	assert.Empty(t, response.Result.Errors)
+	decoded := decodeResult(t, response.Result)
+	tasks := decoded["data"].(map[string]any)["tasks"].([]any)
+	require.Len(t, tasks, 4)
+	assert.Equal(t, []any{map[string]any{"username": "ana"}, map[string]any{"username": "bob"}}, tasks[0].(map[string]any)["assignees"])
+	assert.Equal(t, []any{map[string]any{"username": "bob"}}, tasks[1].(map[string]any)["assignees"])
+	assert.Equal(t, []any{}, tasks[2].(map[string]any)["assignees"])
+	assert.Equal(t, []any{}, tasks[3].(map[string]any)["assignees"])
+}
+
+// TestGraphQLService_Limits verifica que las operaciones demasiado profundas o costosas se rechazan
+// sin llamar a los casos de uso, incluso si el costo está dentro de un fragmento o es introspección
+func TestGraphQLService_Limits(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	shallow, _, _, _ := newTestService(t, ctrl, domain.Limits{MaxDepth: 2, ListFactor: 10})
+	cheap, _, _, _ := newTestService(t, ctrl, domain.Limits{MaxComplexity: 100, ListFactor: 10})
+
+	// Act
+	tooDeep := shallow.Do(context.Background(), domain.Request{Query: `{ tasks { assignees { id } } }`})
+	// 1 + 10 * (id + title + (1 + 10 * id)) = 131
+	tooComplex := cheap.Do(context.Background(), domain.Request{
+		Query: `query { tasks { ...fields } } fragment fields on Task { id title assignees { id } }`,
+	})
+	introspection := shallow.Do(context.Background(), domain.Request{Query: `{ __schema { types { name fields { name } } } }`})
+	// 1 + 1 * (1 + 10 * (name + (1 + 10 * name))) = 122
+	costlyIntrospection := cheap.Do(context.Background(), domain.Request{Query: `{ __schema { types { name fields { name } } } }`})
+	typename := shallow.Do(context.Background(), domain.Request{Query: `{ __typename }`})
+
+	// Assert
+	assert.True(t, tooDeep.Invalid)
+	assert.Equal(t, application.CodeQueryTooDeep, errorCode(tooDeep.Result))
+	assert.True(t, tooComplex.Invalid)
+	assert.Equal(t, application.CodeQueryTooComplex, errorCode(tooComplex.Result))
+	assert.True(t, introspection.Invalid)
+	assert.Equal(t, application.CodeQueryTooDeep, errorCode(introspection.Result))
+	assert.True(t, costlyIntrospection.Invalid)
+	assert.Equal(t, application.CodeQueryTooComplex, errorCode(costlyIntrospection.Result))
+	assert.False(t, typename.Invalid)
+	assert.Empty(t, typename.Result.Errors)
+}
+
+// TestGraphQLService_Mutations verifica los argumentos de una mutación, el código de un error de
+// dominio y que una petición de solo lectura no puede ejecutar mutaciones
+func TestGraphQLService_Mutations(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, mockTasks, _, _ := newTestService(t, ctrl, testLimits)
+
+	title := "Nuevo título"
+	mockTasks.EXPECT().PatchTask(gomock.Any(), 7, taskdomain.TaskPatch{Title: &title}).
+		Return(&taskdomain.Task{ID: 7, Title: title, Version: 3}, nil)
+	mockTasks.EXPECT().MarkTaskAsCompleted(gomock.Any(), 7).Return(nil, taskdomain.ErrVersionConflict)
+
+	// Act
+	updated := service.Do(context.Background(), domain.Request{
+		Query:     `mutation Update($title: String) { updateTask(id: 7, version: 2, title: $title) { id title version } }`,
+		Variables: map[string]any{"title": title},
+		Actor:     "ana",
+	})
+	conflict := service.Do(context.Background(), domain.Request{Query: `mutation { completeTask(id: 7, version: 1) { id } }`})
+	readOnly := service.Do(context.Background(), domain.Request{Query: `mutation { deleteTask(id: 7) }`, ReadOnly: true})
+
+	// Assert
+	assert.Empty(t, updated.Result.Errors)
+	assert.Equal(t, map[string]any{"updateTask": map[string]any{"id": float64(7), "title": title, "version": float64(3)}},
+		decodeResult(t, updated.Result)["data"])
+	assert.False(t, conflict.Invalid)
+	assert.Equal(t, application.CodeConflict, errorCode(conflict.Result))
+	assert.True(t, readOnly.Invalid)
+}
+
+// TestGraphQLService_Do_RejectsInvalidDocuments verifica que los errores de sintaxis y de validación
+// se informan sin ejecutar la operación
+func TestGraphQLService_Do_RejectsInvalidDocuments(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, _, _, _ := newTestService(t, ctrl, testLimits)
+
+	// Act
+	syntax := service.Do(context.Background(), domain.Request{Query: `{ tasks { id }`})
+	unknownField := service.Do(context.Background(), domain.Request{Query: `{ tasks { owner } }`})
+	ambiguous := service.Do(context.Background(), domain.Request{Query: `query A { trash { id } } query B { users { id } }`})
+
+	// Assert
+	assert.True(t, syntax.Invalid)
+	assert.NotEmpty(t, syntax.Result.Errors)
+	assert.True(t, unknownField.Invalid)
+	assert.True(t, ambiguous.Invalid)
+}
+
+// TestGraphQLService_Subscription verifica que la suscripción entrega solo los eventos de la tarea
+// pedida y termina al cancelar el contexto
+func TestGraphQLService_Subscription(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, _, mockUsers, mockStream := newTestService(t, ctrl, testLimits)
+
+	events := make(chan taskapplication.StreamedEvent, 2)
+	closed := make(chan struct{})
+	mockStream.EXPECT().Subscribe(uint64(0)).Return(&taskapplication.EventSubscription{
+		Events: events,
+		Close:  func() { close(closed) },
+	})
+	mockUsers.EXPECT().GetByIDs(gomock.Any(), []int{1}).Return([]*userdomain.User{{ID: 1, Username: "ana"}}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Act
+	response := service.Do(ctx, domain.Request{
+		Query: `subscription { taskChanged(taskId: 7) { type actor task { id assignees { username } } } }`,
+	})
+	require.NotNil(t, response.Stream)
+	events <- taskapplication.StreamedEvent{ID: 1, Event: taskdomain.Event{Type: taskdomain.EventTaskCompleted, TaskID: 8}}
+	events <- taskapplication.StreamedEvent{ID: 2, Event: taskdomain.Event{
+		Type: taskdomain.EventTaskCompleted, TaskID: 7, Actor: "ana",
+		Task: &taskdomain.Task{ID: 7, Assignees: []int{1}},
+	}}
+
+	var result *graphql.Result
+	select {
+	case result = <-response.Stream:
+	case <-time.After(5 * time.Second):
+		t.Fatal("la suscripción no entregó el evento")
+	}
+	cancel()
+	for range response.Stream {
+	}
+
+	// Assert
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"taskChanged": map[string]any{
+		"type":  string(taskdomain.EventTaskCompleted),
+		"actor": "ana",
+		"task":  map[string]any{"id": float64(7), "assignees": []any{map[string]any{"username": "ana"}}},
+	}}, decodeResult(t, result)["data"])
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("la suscripción al stream no se cerró")
+	}
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrQueryTooDeep indica una operación que anida más campos que la profundidad máxima
+	ErrQueryTooDeep = errors.New("la consulta supera la profundidad máxima")
+	// ErrQueryTooComplex indica una operación cuyo costo estimado supera la complejidad máxima
+	ErrQueryTooComplex = errors.New("la consulta supera la complejidad máxima")
+)
+
+// Request es una operación GraphQL recibida por HTTP
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+
+	// Actor es el usuario que se registra en el historial de las mutaciones
+	Actor string `json:"-"`
+	// ReadOnly rechaza las mutaciones; lo usan las peticiones GET
+	ReadOnly bool `json:"-"`
+}
+
+// Limits acota el costo de las operaciones antes de ejecutarlas
+type Limits struct {
+	// MaxDepth es la cantidad máxima de niveles de campos anidados; 0 no limita
+	MaxDepth int
+	// MaxComplexity es el costo estimado máximo: cada campo cuesta 1 y lo que se pide dentro de una
+	// lista se multiplica por ListFactor; 0 no limita
+	MaxComplexity int
+	// ListFactor es la cantidad de elementos que se estima para cada lista
+	ListFactor int
+}
@@ -0,0 +1,195 @@
+package presentation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+)
+
+// CurrentUserHeader identifica al usuario que se registra en el historial de las mutaciones
+const CurrentUserHeader = "X-User-ID"
+
+// GraphQLHandler maneja el endpoint /graphql
+type GraphQLHandler struct {
+	graphqlService application.GraphQLServiceInterface
+	heartbeat      time.Duration
+}
+
+// NewGraphQLHandler crea una nueva instancia del handler de GraphQL; las suscripciones envían un
+// comentario cada heartbeat sin eventos para mantener la conexión abierta
+func NewGraphQLHandler(graphqlService application.GraphQLServiceInterface, heartbeat time.Duration) *GraphQLHandler {
+	return &GraphQLHandler{
+		graphqlService: graphqlService,
+		heartbeat:      heartbeat,
+	}
+}
+
+// parseQueryRequest interpreta una operación enviada en los parámetros de una petición GET; solo
+// puede ser una consulta o una suscripción
+func parseQueryRequest(query, operationName, variables string) (domain.Request, error) {
+	req := domain.Request{Query: query, OperationName: operationName, ReadOnly: true}
+	if variables != "" {
+		if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+			return domain.Request{}, errors.New("variables must be a JSON object")
+		}
+	}
+	return req, validateRequest(req)
+}
+
+// validateRequest verifica que la petición traiga una operación
+func validateRequest(req domain.Request) error {
+	if req.Query == "" {
+		return errors.New("query is required")
+	}
+	return nil
+}
+
+// responseStatus retorna 400 si la operación se rechazó antes de ejecutarse; los errores de los
+// resolvers van en errors con 200, como indica GraphQL sobre HTTP
+func responseStatus(response application.Response) int {
+	if response.Invalid {
+		return http.StatusBadRequest
+	}
+	return http.StatusOK
+}
+
+// writeResultStream escribe los resultados de una suscripción en formato SSE (evento next por
+// resultado y complete al terminar) hasta que se cierre done, falle la escritura o termine el stream
+func writeResultStream(done <-chan struct{}, w io.Writer, flush func() error, stream <-chan *graphql.Result, heartbeat time.Duration) error {
+	send := func(frame string) error {
+		if _, err := io.WriteString(w, frame); err != nil {
+			return err
+		}
+		return flush()
+	}
+
+	if err := send(": subscribed\n\n"); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return nil
+		case result, ok := <-stream:
+			if !ok {
+				return send("event: complete\ndata:\n\n")
+			}
+			data, err := json.Marshal(result)
+			if err != nil {
+				return err
+			}
+			if err := send(fmt.Sprintf("event: next\ndata: %s\n\n", data)); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := send(": heartbeat\n\n"); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// closeResultStream cancela la suscripción y descarta los resultados pendientes para que el
+// ejecutor no quede bloqueado enviando al canal
+func closeResultStream(cancel context.CancelFunc, stream <-chan *graphql.Result) {
+	cancel()
+	go func() {
+		for range stream {
+		}
+	}()
+}
+
+// setEventStreamHeaders define las cabeceras de una respuesta SSE
+func setEventStreamHeaders(set func(key, value string)) {
+	set("Content-Type", "text/event-stream")
+	set("Cache-Control", "no-cache")
+	set("Connection", "keep-alive")
+	// Evita que un proxy como nginx acumule los eventos
+	set("X-Accel-Buffering", "no")
+}
+
+// Execute ejecuta una operación enviada en el cuerpo JSON
+// @Summary Ejecuta una operación GraphQL
+// @Description Consultas y mutaciones responden JSON; las suscripciones responden Server-Sent Events con un evento next por resultado
+// @Tags graphql
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string false "Usuario que se registra en el historial"
+// @Param request body domain.Request true "Operación"
+// @Success 200 {object} graphql.Result
+// @Failure 400 {object} graphql.Result
+// @Router /graphql [post]
+func (h *GraphQLHandler) Execute(c *gin.Context) {
+	var req domain.Request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+		return
+	}
+	if err := validateRequest(req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+		return
+	}
+	req.Actor = c.GetHeader(CurrentUserHeader)
+	h.serve(c, req)
+}
+
+// ExecuteQuery ejecuta una operación enviada en los parámetros; rechaza las mutaciones
+// @Summary Ejecuta una consulta GraphQL por GET
+// @Description Solo consultas y suscripciones; las mutaciones requieren POST
+// @Tags graphql
+// @Produce json
+// @Param query query string true "Documento GraphQL"
+// @Param operationName query string false "Operación a ejecutar"
+// @Param variables query string false "Variables en JSON"
+// @Success 200 {object} graphql.Result
+// @Failure 400 {object} graphql.Result
+// @Router /graphql [get]
+func (h *GraphQLHandler) ExecuteQuery(c *gin.Context) {
+	req, err := parseQueryRequest(c.Query("query"), c.Query("operationName"), c.Query("variables"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+		return
+	}
+	req.Actor = c.GetHeader(CurrentUserHeader)
+	h.serve(c, req)
+}
+
+// serve ejecuta la operación y escribe el resultado o el stream de la suscripción
+func (h *GraphQLHandler) serve(c *gin.Context, req domain.Request) {
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	response := h.graphqlService.Do(ctx, req)
+	if response.Stream == nil {
+		c.JSON(responseStatus(response), response.Result)
+		return
+	}
+	defer closeResultStream(cancel, response.Stream)
+
+	setEventStreamHeaders(c.Header)
+	c.Status(http.StatusOK)
+	_ = writeResultStream(c.Request.Context().Done(), c.Writer, func() error {
+		c.Writer.Flush()
+		return nil
+	}, response.Stream, h.heartbeat)
+}
@@ -0,0 +1,77 @@
+package presentation
+
+import (
+	"bufio"
+	"context"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberGraphQLHandler maneja el endpoint /graphql con Fiber
+type FiberGraphQLHandler struct {
+	graphqlService application.GraphQLServiceInterface
+	heartbeat      time.Duration
+}
+
+// NewFiberGraphQLHandler crea una nueva instancia del handler de GraphQL con Fiber
+func NewFiberGraphQLHandler(graphqlService application.GraphQLServiceInterface, heartbeat time.Duration) *FiberGraphQLHandler {
+	return &FiberGraphQLHandler{
+		graphqlService: graphqlService,
+		heartbeat:      heartbeat,
+	}
+}
+
+// Execute ejecuta una operación enviada en el cuerpo JSON con Fiber
+func (h *FiberGraphQLHandler) Execute(c *fiber.Ctx) error {
+	var req domain.Request
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+	if err := validateRequest(req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"message": err.Error(),
+		})
+	}
+	req.Actor = c.Get(CurrentUserHeader)
+	return h.serve(c, req)
+}
+
+// ExecuteQuery ejecuta una operación enviada en los parámetros con Fiber; rechaza las mutaciones
+func (h *FiberGraphQLHandler) ExecuteQuery(c *fiber.Ctx) error {
+	req, err := parseQueryRequest(c.Query("query"), c.Query("operationName"), c.Query("variables"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid query parameters",
+			"message": err.Error(),
+		})
+	}
+	req.Actor = c.Get(CurrentUserHeader)
+	return h.serve(c, req)
+}
+
+// serve ejecuta la operación y escribe el resultado o el stream de la suscripción. El contexto de
+// fasthttp se reutiliza al terminar el handler, así que la suscripción usa uno propio que se cancela
+// cuando falla una escritura, a más tardar en el siguiente heartbeat
+func (h *FiberGraphQLHandler) serve(c *fiber.Ctx, req domain.Request) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	response := h.graphqlService.Do(ctx, req)
+	if response.Stream == nil {
+		cancel()
+		return c.Status(responseStatus(response)).JSON(response.Result)
+	}
+
+	setEventStreamHeaders(c.Set)
+	c.Status(fiber.StatusOK)
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer closeResultStream(cancel, response.Stream)
+		_ = writeResultStream(nil, w, w.Flush, response.Stream, h.heartbeat)
+	})
+	return nil
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=../presentation/mocks/mock_graphql_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	application "github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/application"
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGraphQLServiceInterface is a mock of GraphQLServiceInterface interface.
+type MockGraphQLServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGraphQLServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGraphQLServiceInterfaceMockRecorder is the mock recorder for MockGraphQLServiceInterface.
+type MockGraphQLServiceInterfaceMockRecorder struct {
+	mock *MockGraphQLServiceInterface
+}
+
+// NewMockGraphQLServiceInterface creates a new mock instance.
+func NewMockGraphQLServiceInterface(ctrl *gomock.Controller) *MockGraphQLServiceInterface {
+	mock := &MockGraphQLServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockGraphQLServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGraphQLServiceInterface) EXPECT() *MockGraphQLServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockGraphQLServiceInterface) Do(ctx context.Context, req domain.Request) application.Response {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, req)
+	ret0, _ := ret[0].(application.Response)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockGraphQLServiceInterfaceMockRecorder) Do(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockGraphQLServiceInterface)(nil).Do), ctx, req)
+}
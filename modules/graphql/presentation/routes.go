@@ -0,0 +1,17 @@
+package presentation
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// SetupGraphQLRoutes configura las rutas del endpoint GraphQL
+func SetupGraphQLRoutes(router *gin.Engine, graphqlHandler *GraphQLHandler) {
+	v1 := router.Group("/api/v1")
+	{
+		// POST /api/v1/graphql - Ejecutar una consulta, mutación o suscripción
+		v1.POST("/graphql", graphqlHandler.Execute)
+
+		// GET /api/v1/graphql?query= - Ejecutar una consulta o suscripción
+		v1.GET("/graphql", graphqlHandler.ExecuteQuery)
+	}
+}
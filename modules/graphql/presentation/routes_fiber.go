@@ -0,0 +1,11 @@
+package presentation
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupGraphQLRoutesFiber configura las rutas del endpoint GraphQL para Fiber
+func SetupGraphQLRoutesFiber(app *fiber.App, handler *FiberGraphQLHandler) {
+	app.Post("/graphql", handler.Execute)
+	app.Get("/graphql", handler.ExecuteQuery)
+}
@@ -0,0 +1,212 @@
+package presentation_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/graphql/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// setupGraphQLRouter crea un router de Gin con la ruta de GraphQL y el servicio indicado
+func setupGraphQLRouter(service application.GraphQLServiceInterface) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupGraphQLRoutes(router, presentation.NewGraphQLHandler(service, time.Minute))
+	return router
+}
+
+// dataResult es un resultado exitoso con los datos indicados
+func dataResult(data any) application.Response {
+	return application.Response{Result: &graphql.Result{Data: data}}
+}
+
+// TestGraphQLHandler_Execute verifica el cuerpo enviado al servicio, el actor de X-User-ID y el 400
+// de un cuerpo inválido o de una operación rechazada
+func TestGraphQLHandler_Execute(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mocks.NewMockGraphQLServiceInterface(ctrl)
+	router := setupGraphQLRouter(mockService)
+
+	mockService.EXPECT().Do(gomock.Any(), domain.Request{
+		Query:         `mutation Done($id: Int!) { completeTask(id: $id) { id } }`,
+		OperationName: "Done",
+		Variables:     map[string]any{"id": float64(7)},
+		Actor:         "ana",
+	}).Return(dataResult(map[string]any{"completeTask": map[string]any{"id": 7}}))
+	mockService.EXPECT().Do(gomock.Any(), domain.Request{Query: `{ tasks { owner } }`}).Return(application.Response{
+		Result:  &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(`Cannot query field "owner" on type "Task".`)}},
+		Invalid: true,
+	})
+
+	post := func(body string, actor string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/api/v1/graphql", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if actor != "" {
+			req.Header.Set(presentation.CurrentUserHeader, actor)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	ok := post(`{"query":"mutation Done($id: Int!) { completeTask(id: $id) { id } }","operationName":"Done","variables":{"id":7}}`, "ana")
+	invalid := post(`{"query":"{ tasks { owner } }"}`, "")
+	missingQuery := post(`{"variables":{}}`, "")
+	malformed := post(`{"query":`, "")
+
+	// Assert
+	assert.Equal(t, http.StatusOK, ok.Code)
+	assert.JSONEq(t, `{"data":{"completeTask":{"id":7}}}`, ok.Body.String())
+	assert.Equal(t, http.StatusBadRequest, invalid.Code)
+	assert.Contains(t, invalid.Body.String(), `Cannot query field`)
+	assert.Equal(t, http.StatusBadRequest, missingQuery.Code)
+	assert.Contains(t, missingQuery.Body.String(), "query is required")
+	assert.Equal(t, http.StatusBadRequest, malformed.Code)
+}
+
+// TestGraphQLHandler_ExecuteQuery verifica que GET envía una operación de solo lectura con las
+// variables decodificadas y rechaza variables que no son JSON
+func TestGraphQLHandler_ExecuteQuery(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mocks.NewMockGraphQLServiceInterface(ctrl)
+	router := setupGraphQLRouter(mockService)
+
+	mockService.EXPECT().Do(gomock.Any(), domain.Request{
+		Query:     `query($id: Int!) { task(id: $id) { title } }`,
+		Variables: map[string]any{"id": float64(3)},
+		ReadOnly:  true,
+	}).Return(dataResult(map[string]any{"task": map[string]any{"title": "Tarea"}}))
+
+	get := func(params url.Values) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("GET", "/api/v1/graphql?"+params.Encode(), nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	ok := get(url.Values{"query": {`query($id: Int!) { task(id: $id) { title } }`}, "variables": {`{"id":3}`}})
+	badVariables := get(url.Values{"query": {`{ trash { id } }`}, "variables": {`[1`}})
+
+	// Assert
+	assert.Equal(t, http.StatusOK, ok.Code)
+	assert.JSONEq(t, `{"data":{"task":{"title":"Tarea"}}}`, ok.Body.String())
+	assert.Equal(t, http.StatusBadRequest, badVariables.Code)
+	assert.Contains(t, badVariables.Body.String(), "variables must be a JSON object")
+}
+
+// TestGraphQLHandler_Subscription verifica que una suscripción responde Server-Sent Events con un
+// evento next por resultado y complete cuando termina el stream
+func TestGraphQLHandler_Subscription(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mocks.NewMockGraphQLServiceInterface(ctrl)
+	router := setupGraphQLRouter(mockService)
+
+	stream := make(chan *graphql.Result, 1)
+	stream <- &graphql.Result{Data: map[string]any{"taskChanged": map[string]any{"taskId": 7}}}
+	close(stream)
+	mockService.EXPECT().Do(gomock.Any(), gomock.Any()).Return(application.Response{Stream: stream})
+
+	req, _ := http.NewRequest("POST", "/api/v1/graphql", strings.NewReader(`{"query":"subscription { taskChanged { taskId } }"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "event: next\ndata: {\"data\":{\"taskChanged\":{\"taskId\":7}}}\n\n")
+	assert.True(t, strings.HasSuffix(w.Body.String(), "event: complete\ndata:\n\n"))
+}
+
+// TestFiberGraphQLHandler verifica con Fiber una consulta por POST y el stream de una suscripción,
+// que se cancela cuando el cliente se desconecta
+func TestFiberGraphQLHandler(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mocks.NewMockGraphQLServiceInterface(ctrl)
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	presentation.SetupGraphQLRoutesFiber(app, presentation.NewFiberGraphQLHandler(mockService, 20*time.Millisecond))
+
+	mockService.EXPECT().Do(gomock.Any(), domain.Request{Query: `{ trash { id } }`, Actor: "ana"}).
+		Return(dataResult(map[string]any{"trash": []any{}}))
+
+	stream := make(chan *graphql.Result)
+	canceled := make(chan struct{})
+	mockService.EXPECT().Do(gomock.Any(), domain.Request{Query: `subscription { taskChanged { taskId } }`, ReadOnly: true}).
+		DoAndReturn(func(ctx context.Context, _ domain.Request) application.Response {
+			go func() {
+				defer close(stream)
+				stream <- &graphql.Result{Data: map[string]any{"taskChanged": map[string]any{"taskId": 7}}}
+				<-ctx.Done()
+				close(canceled)
+			}()
+			return application.Response{Stream: stream}
+		})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = app.Listener(listener) }()
+	defer func() { _ = app.Shutdown() }()
+	baseURL := "http://" + listener.Addr().String() + "/graphql"
+	// Sin keep-alive, para que Shutdown no espere a una conexión inactiva
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	queryReq, _ := http.NewRequest("POST", baseURL, strings.NewReader(`{"query":"{ trash { id } }"}`))
+	queryReq.Header.Set("Content-Type", "application/json")
+	queryReq.Header.Set(presentation.CurrentUserHeader, "ana")
+
+	// Act
+	queryResp, err := client.Do(queryReq)
+	require.NoError(t, err)
+	_ = queryResp.Body.Close()
+	subscriptionResp, err := client.Get(baseURL + "?" + url.Values{"query": {`subscription { taskChanged { taskId } }`}}.Encode())
+	require.NoError(t, err)
+	reader := bufio.NewReader(subscriptionResp.Body)
+	var frames []string
+	for len(frames) < 2 {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		if strings.HasPrefix(line, "event:") || strings.HasPrefix(line, "data:") {
+			frames = append(frames, strings.TrimSpace(line))
+		}
+	}
+	_ = subscriptionResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusOK, queryResp.StatusCode)
+	assert.Equal(t, http.StatusOK, subscriptionResp.StatusCode)
+	assert.Equal(t, []string{"event: next", `data: {"data":{"taskChanged":{"taskId":7}}}`}, frames)
+	select {
+	case <-canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("la suscripción no se canceló al desconectarse el cliente")
+	}
+}
@@ -0,0 +1,21 @@
+package application
+
+import (
+	"context"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=../presentation/mocks/mock_idempotency_service.go -package=mocks
+
+// IdempotencyServiceInterface define el contrato que usan los middlewares de idempotencia
+type IdempotencyServiceInterface interface {
+	// Begin reserva la clave; retorna la respuesta guardada si la petición es un reintento
+	Begin(ctx context.Context, key, fingerprint string) (*domain.Record, error)
+
+	// Complete guarda la respuesta de la petición; un error del servidor libera la clave
+	Complete(ctx context.Context, key string, status int, contentType string, body []byte) error
+
+	// Release libera la clave de una petición que no terminó
+	Release(ctx context.Context, key string) error
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+)
+
+// IdempotencyService maneja las claves de idempotencia de las peticiones que modifican datos
+type IdempotencyService struct {
+	repo domain.Repository
+	ttl  time.Duration
+}
+
+// NewIdempotencyService crea una nueva instancia de IdempotencyService; las respuestas se guardan durante ttl
+func NewIdempotencyService(repo domain.Repository, ttl time.Duration) *IdempotencyService {
+	return &IdempotencyService{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+// Begin reserva la clave para una petición. Retorna nil si la petición debe ejecutarse, o la respuesta
+// guardada si es un reintento de una petición ya completada
+func (s *IdempotencyService) Begin(ctx context.Context, key, fingerprint string) (*domain.Record, error) {
+	if err := domain.ValidateKey(key); err != nil {
+		return nil, err
+	}
+
+	existing, reserved, err := s.repo.Reserve(ctx, domain.NewRecord(key, fingerprint, s.ttl))
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo reservar la clave de idempotencia: %w", err)
+	}
+	if reserved {
+		return nil, nil
+	}
+	if existing.Fingerprint != fingerprint {
+		return nil, domain.ErrKeyReused
+	}
+	if !existing.IsCompleted() {
+		return nil, domain.ErrRequestInProgress
+	}
+	return existing, nil
+}
+
+// Complete guarda la respuesta de la petición. Un error del servidor no se guarda: la clave se libera
+// para que el cliente pueda reintentar
+func (s *IdempotencyService) Complete(ctx context.Context, key string, status int, contentType string, body []byte) error {
+	if status >= http.StatusInternalServerError {
+		return s.Release(ctx, key)
+	}
+	if err := s.repo.Complete(ctx, key, status, contentType, body); err != nil {
+		return fmt.Errorf("no se pudo guardar la respuesta de la clave de idempotencia: %w", err)
+	}
+	return nil
+}
+
+// Release libera la clave de una petición que no terminó
+func (s *IdempotencyService) Release(ctx context.Context, key string) error {
+	if err := s.repo.Release(ctx, key); err != nil {
+		return fmt.Errorf("no se pudo liberar la clave de idempotencia: %w", err)
+	}
+	return nil
+}
+
+// PurgeExpired elimina las claves vencidas y retorna cuántas se eliminaron
+func (s *IdempotencyService) PurgeExpired(ctx context.Context) (int, error) {
+	purged, err := s.repo.DeleteExpired(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, fmt.Errorf("no se pudieron purgar las claves de idempotencia: %w", err)
+	}
+	return purged, nil
+}
+
+// RunPurger ejecuta PurgeExpired cada interval hasta que se cancele el contexto
+func (s *IdempotencyService) RunPurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.PurgeExpired(ctx)
+			if err != nil {
+				log.Printf("Error purgando claves de idempotencia: %v", err)
+				continue
+			}
+			if purged > 0 {
+				log.Printf("Claves de idempotencia purgadas: %d", purged)
+			}
+		}
+	}
+}
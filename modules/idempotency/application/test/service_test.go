@@ -0,0 +1,110 @@
+package application_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestIdempotencyService_Begin_Reserves verifica que una clave nueva se reserva con el TTL configurado
+func TestIdempotencyService_Begin_Reserves(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepository(ctrl)
+	service := application.NewIdempotencyService(mockRepo, time.Hour)
+
+	mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, record *domain.Record) (*domain.Record, bool, error) {
+			assert.Equal(t, "k1", record.Key)
+			assert.Equal(t, "fp", record.Fingerprint)
+			assert.Equal(t, time.Hour, record.ExpiresAt.Sub(record.CreatedAt))
+			return nil, true, nil
+		})
+
+	// Act
+	record, err := service.Begin(context.Background(), "k1", "fp")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Nil(t, record)
+}
+
+// TestIdempotencyService_Begin_Existing verifica la respuesta según el registro que ya tiene la clave
+func TestIdempotencyService_Begin_Existing(t *testing.T) {
+	completed := &domain.Record{Key: "k1", Fingerprint: "fp", Status: http.StatusCreated, Body: []byte(`{}`)}
+	tests := []struct {
+		name     string
+		existing *domain.Record
+		want     *domain.Record
+		wantErr  error
+	}{
+		{name: "reintento completado", existing: completed, want: completed},
+		{name: "otra petición", existing: &domain.Record{Key: "k1", Fingerprint: "otro", Status: http.StatusCreated}, wantErr: domain.ErrKeyReused},
+		{name: "en curso", existing: &domain.Record{Key: "k1", Fingerprint: "fp"}, wantErr: domain.ErrRequestInProgress},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockRepository(ctrl)
+			service := application.NewIdempotencyService(mockRepo, time.Hour)
+			mockRepo.EXPECT().Reserve(gomock.Any(), gomock.Any()).Return(tt.existing, false, nil)
+
+			// Act
+			record, err := service.Begin(context.Background(), "k1", "fp")
+
+			// Assert
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, record)
+		})
+	}
+}
+
+// TestIdempotencyService_Begin_InvalidKey verifica que una clave demasiado larga no llega al repositorio
+func TestIdempotencyService_Begin_InvalidKey(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := application.NewIdempotencyService(mocks.NewMockRepository(ctrl), time.Hour)
+	key := string(make([]byte, domain.MaxKeyLength+1))
+
+	// Act
+	_, err := service.Begin(context.Background(), key, "fp")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidKey)
+}
+
+// TestIdempotencyService_Complete verifica que se guarda la respuesta y que un error del servidor libera la clave
+func TestIdempotencyService_Complete(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepository(ctrl)
+	service := application.NewIdempotencyService(mockRepo, time.Hour)
+
+	mockRepo.EXPECT().Complete(gomock.Any(), "k1", http.StatusCreated, "application/json", []byte(`{}`)).Return(nil)
+	mockRepo.EXPECT().Release(gomock.Any(), "k2").Return(nil)
+
+	// Act
+	errCreated := service.Complete(context.Background(), "k1", http.StatusCreated, "application/json", []byte(`{}`))
+	errFailed := service.Complete(context.Background(), "k2", http.StatusInternalServerError, "application/json", []byte(`{}`))
+
+	// Assert
+	assert.NoError(t, errCreated)
+	assert.NoError(t, errFailed)
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+)
+
+// MaxKeyLength es el largo máximo de una clave de idempotencia
+const MaxKeyLength = 255
+
+var (
+	// ErrInvalidKey indica que la clave de idempotencia está vacía o es demasiado larga
+	ErrInvalidKey = errors.New("clave de idempotencia inválida")
+	// ErrKeyReused indica que la clave ya se usó con una petición distinta
+	ErrKeyReused = errors.New("la clave de idempotencia ya se usó con otra petición")
+	// ErrRequestInProgress indica que la petición original con la misma clave todavía no termina
+	ErrRequestInProgress = errors.New("hay una petición en curso con la misma clave de idempotencia")
+)
+
+// Record es la petición registrada con una clave de idempotencia y, una vez completada, su respuesta
+type Record struct {
+	Key         string
+	Fingerprint string
+	// Status es el código HTTP de la respuesta; 0 mientras la petición está en curso
+	Status      int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+// NewRecord crea el registro de una petición en curso que vence después de ttl
+func NewRecord(key, fingerprint string, ttl time.Duration) *Record {
+	now := time.Now().UTC()
+	return &Record{
+		Key:         key,
+		Fingerprint: fingerprint,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+}
+
+// IsCompleted indica si la respuesta de la petición ya está guardada
+func (r *Record) IsCompleted() bool {
+	return r.Status != 0
+}
+
+// ValidateKey valida la clave enviada por el cliente
+func ValidateKey(key string) error {
+	if strings.TrimSpace(key) == "" || len(key) > MaxKeyLength {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// ScopedKey separa las claves de cada usuario para que dos clientes no compartan respuestas. El
+// ámbito se guarda como su hash SHA-256, de largo fijo, para que ningún par de ámbito y clave se
+// confunda con otro ("a:b" y "c" frente a "a" y "b:c")
+func ScopedKey(scope, key string) string {
+	if scope == "" {
+		return key
+	}
+	hash := sha256.Sum256([]byte(scope))
+	return hex.EncodeToString(hash[:]) + ":" + key
+}
+
+// Fingerprint resume método, ruta y cuerpo de una petición; el mismo valor identifica un reintento
+func Fingerprint(method, uri string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(uri))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=repository.go -destination=../application/mocks/mock_repository.go -package=mocks
+
+// Repository define el puerto para persistir las claves de idempotencia
+type Repository interface {
+	// Reserve guarda el registro si la clave no existe o ya venció y retorna reserved=true;
+	// si hay un registro vigente con esa clave lo retorna sin modificarlo
+	Reserve(ctx context.Context, record *Record) (existing *Record, reserved bool, err error)
+	// Complete guarda la respuesta de una petición reservada
+	Complete(ctx context.Context, key string, status int, contentType string, body []byte) error
+	// Release elimina una reserva para que la petición pueda reintentarse
+	Release(ctx context.Context, key string) error
+	// DeleteExpired elimina los registros vencidos antes de before y retorna cuántos se eliminaron
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
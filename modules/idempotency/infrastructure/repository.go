@@ -0,0 +1,99 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// reserveQuery inserta la reserva o reemplaza un registro vencido con la misma clave; si la clave
+// sigue vigente no modifica ninguna fila. Es válida en SQLite y PostgreSQL
+const reserveQuery = `INSERT INTO idempotency_keys (key, fingerprint, status, content_type, body, created_at, expires_at)
+	VALUES (?, ?, 0, '', NULL, ?, ?)
+	ON CONFLICT (key) DO UPDATE SET fingerprint = excluded.fingerprint, status = 0, content_type = '', body = NULL,
+		created_at = excluded.created_at, expires_at = excluded.expires_at
+	WHERE idempotency_keys.expires_at <= excluded.created_at`
+
+// reserveAttempts es cuántas veces se intenta reservar si el registro vigente se libera entre
+// el INSERT y su lectura
+const reserveAttempts = 2
+
+// SQLiteRepository implementa Repository usando SQLite
+type SQLiteRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteRepository crea una nueva instancia del repositorio de claves de idempotencia
+func NewSQLiteRepository(db *database.SQLiteDB) domain.Repository {
+	return &SQLiteRepository{
+		db: db,
+	}
+}
+
+// Reserve guarda la reserva o retorna el registro vigente con la misma clave
+func (r *SQLiteRepository) Reserve(ctx context.Context, record *domain.Record) (*domain.Record, bool, error) {
+	for range reserveAttempts {
+		result, err := r.db.Conn(ctx).ExecContext(ctx, reserveQuery, record.Key, record.Fingerprint, record.CreatedAt, record.ExpiresAt)
+		if err != nil {
+			return nil, false, fmt.Errorf("error reservando clave de idempotencia: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return nil, false, fmt.Errorf("error obteniendo filas afectadas: %w", err)
+		}
+		if rows > 0 {
+			return nil, true, nil
+		}
+
+		existing := &domain.Record{}
+		var contentType sql.NullString
+		query := `SELECT key, fingerprint, status, content_type, body, created_at, expires_at FROM idempotency_keys WHERE key = ?`
+		err = r.db.Conn(ctx).QueryRowContext(ctx, query, record.Key).Scan(&existing.Key, &existing.Fingerprint, &existing.Status,
+			&contentType, &existing.Body, &existing.CreatedAt, &existing.ExpiresAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("error obteniendo clave de idempotencia: %w", err)
+		}
+		existing.ContentType = contentType.String
+		return existing, false, nil
+	}
+	return nil, false, domain.ErrRequestInProgress
+}
+
+// Complete guarda la respuesta de una petición reservada
+func (r *SQLiteRepository) Complete(ctx context.Context, key string, status int, contentType string, body []byte) error {
+	query := `UPDATE idempotency_keys SET status = ?, content_type = ?, body = ? WHERE key = ?`
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, status, contentType, body, key); err != nil {
+		return fmt.Errorf("error guardando respuesta de idempotencia: %w", err)
+	}
+	return nil
+}
+
+// Release elimina una reserva que no se completó
+func (r *SQLiteRepository) Release(ctx context.Context, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE key = ? AND status = 0`
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, key); err != nil {
+		return fmt.Errorf("error liberando clave de idempotencia: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired elimina los registros vencidos
+func (r *SQLiteRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.Conn(ctx).ExecContext(ctx, `DELETE FROM idempotency_keys WHERE expires_at <= ?`, before)
+	if err != nil {
+		return 0, fmt.Errorf("error eliminando claves de idempotencia vencidas: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("error obteniendo filas afectadas: %w", err)
+	}
+	return int(rows), nil
+}
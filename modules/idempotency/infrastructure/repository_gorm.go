@@ -0,0 +1,104 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormRecordModel es el modelo de GORM para la tabla idempotency_keys (PostgreSQL)
+type GormRecordModel struct {
+	Key         string    `gorm:"primaryKey;size:512"`
+	Fingerprint string    `gorm:"not null;size:64"`
+	Status      int       `gorm:"not null;default:0"`
+	ContentType string    `gorm:"not null;default:''"`
+	Body        []byte    `gorm:"type:bytea"`
+	CreatedAt   time.Time `gorm:"not null"`
+	ExpiresAt   time.Time `gorm:"not null;index"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormRecordModel) TableName() string {
+	return "idempotency_keys"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (m *GormRecordModel) ToDomain() *domain.Record {
+	return &domain.Record{
+		Key:         m.Key,
+		Fingerprint: m.Fingerprint,
+		Status:      m.Status,
+		ContentType: m.ContentType,
+		Body:        m.Body,
+		CreatedAt:   m.CreatedAt,
+		ExpiresAt:   m.ExpiresAt,
+	}
+}
+
+// GormRepository implementa Repository usando GORM
+type GormRepository struct {
+	db *gorm.DB
+}
+
+// NewGormRepository crea una nueva instancia del repositorio de claves de idempotencia con GORM
+func NewGormRepository(db *gorm.DB) domain.Repository {
+	return &GormRepository{
+		db: db,
+	}
+}
+
+// Reserve guarda la reserva o retorna el registro vigente con la misma clave
+func (r *GormRepository) Reserve(ctx context.Context, record *domain.Record) (*domain.Record, bool, error) {
+	for range reserveAttempts {
+		result := database.GormConn(ctx, r.db).Exec(reserveQuery, record.Key, record.Fingerprint, record.CreatedAt, record.ExpiresAt)
+		if result.Error != nil {
+			return nil, false, fmt.Errorf("error reservando clave de idempotencia con GORM: %w", result.Error)
+		}
+		if result.RowsAffected > 0 {
+			return nil, true, nil
+		}
+
+		var model GormRecordModel
+		err := database.GormConn(ctx, r.db).Where("key = ?", record.Key).First(&model).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, false, fmt.Errorf("error obteniendo clave de idempotencia con GORM: %w", err)
+		}
+		return model.ToDomain(), false, nil
+	}
+	return nil, false, domain.ErrRequestInProgress
+}
+
+// Complete guarda la respuesta de una petición reservada
+func (r *GormRepository) Complete(ctx context.Context, key string, status int, contentType string, body []byte) error {
+	err := database.GormConn(ctx, r.db).Model(&GormRecordModel{}).Where("key = ?", key).
+		Updates(map[string]any{"status": status, "content_type": contentType, "body": body}).Error
+	if err != nil {
+		return fmt.Errorf("error guardando respuesta de idempotencia con GORM: %w", err)
+	}
+	return nil
+}
+
+// Release elimina una reserva que no se completó
+func (r *GormRepository) Release(ctx context.Context, key string) error {
+	if err := database.GormConn(ctx, r.db).Where("key = ? AND status = 0", key).Delete(&GormRecordModel{}).Error; err != nil {
+		return fmt.Errorf("error liberando clave de idempotencia con GORM: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired elimina los registros vencidos
+func (r *GormRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	result := database.GormConn(ctx, r.db).Where("expires_at <= ?", before).Delete(&GormRecordModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("error eliminando claves de idempotencia vencidas con GORM: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
@@ -0,0 +1,89 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/config"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSQLiteDB(t *testing.T) *database.SQLiteDB {
+	t.Helper()
+	dbPath := filepath.Join(os.TempDir(), fmt.Sprintf("idempotency_test_%d.db", time.Now().UnixNano()))
+	cfg := &config.Config{Database: config.DatabaseConfig{Path: dbPath}}
+	sqliteDB, err := database.NewSQLiteDB(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = sqliteDB.Close()
+		_ = os.Remove(dbPath)
+	})
+	return sqliteDB
+}
+
+func TestSQLiteRepository_ReserveAndComplete(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+
+	existing, reserved, err := repo.Reserve(ctx, domain.NewRecord("k1", "fp", time.Hour))
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.Nil(t, existing)
+
+	// Mientras está en curso, otra reserva obtiene el registro sin respuesta
+	existing, reserved, err = repo.Reserve(ctx, domain.NewRecord("k1", "fp", time.Hour))
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.False(t, existing.IsCompleted())
+
+	require.NoError(t, repo.Complete(ctx, "k1", http.StatusCreated, "application/json", []byte(`{"id":1}`)))
+
+	existing, reserved, err = repo.Reserve(ctx, domain.NewRecord("k1", "otro", time.Hour))
+	require.NoError(t, err)
+	require.False(t, reserved)
+	require.Equal(t, "fp", existing.Fingerprint)
+	require.Equal(t, http.StatusCreated, existing.Status)
+	require.Equal(t, "application/json", existing.ContentType)
+	require.Equal(t, []byte(`{"id":1}`), existing.Body)
+}
+
+func TestSQLiteRepository_ReserveExpired(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+
+	_, reserved, err := repo.Reserve(ctx, domain.NewRecord("k1", "fp", -time.Minute))
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.NoError(t, repo.Complete(ctx, "k1", http.StatusOK, "application/json", []byte(`{}`)))
+
+	// Una clave vencida se vuelve a reservar aunque la petición sea distinta
+	existing, reserved, err := repo.Reserve(ctx, domain.NewRecord("k1", "otro", time.Hour))
+	require.NoError(t, err)
+	require.True(t, reserved)
+	require.Nil(t, existing)
+}
+
+func TestSQLiteRepository_ReleaseAndDeleteExpired(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+
+	_, _, err := repo.Reserve(ctx, domain.NewRecord("pending", "fp", time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, repo.Release(ctx, "pending"))
+	_, reserved, err := repo.Reserve(ctx, domain.NewRecord("pending", "fp", time.Hour))
+	require.NoError(t, err)
+	require.True(t, reserved)
+
+	_, _, err = repo.Reserve(ctx, domain.NewRecord("old", "fp", -time.Minute))
+	require.NoError(t, err)
+	purged, err := repo.DeleteExpired(ctx, time.Now().UTC())
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+}
@@ -0,0 +1,147 @@
+package presentation
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// KeyHeader es la cabecera con la que el cliente identifica una petición y sus reintentos
+	KeyHeader = "Idempotency-Key"
+	// ReplayedHeader marca las respuestas repetidas desde el registro guardado
+	ReplayedHeader = "Idempotent-Replayed"
+	// ScopeHeader identifica al usuario; las claves de usuarios distintos no se mezclan. La API no
+	// autentica esta cabecera, así que el ámbito solo evita choques accidentales entre clientes y no
+	// es un límite de seguridad: quien conoce la clave y el usuario de otro cliente recibe su respuesta
+	ScopeHeader = "X-User-ID"
+)
+
+// isMultipart indica si el cuerpo es multipart; su huella no lee el cuerpo para no cargar en
+// memoria los archivos subidos
+func isMultipart(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && strings.HasPrefix(mediaType, "multipart/")
+}
+
+// multipartFingerprintBody reemplaza el cuerpo multipart en la huella por su tipo y largo declarado;
+// el boundary queda fuera porque el cliente puede generar uno nuevo en cada reintento
+func multipartFingerprintBody(contentType string, contentLength int64) []byte {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return []byte(mediaType + "\x00" + strconv.FormatInt(contentLength, 10))
+}
+
+// isIdempotentMethod indica si el método modifica datos; solo esas peticiones usan la clave
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// idempotencyErrorStatus traduce los errores de la clave de idempotencia a un código HTTP
+func idempotencyErrorStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidKey):
+		return http.StatusBadRequest, "Invalid Idempotency-Key"
+	case errors.Is(err, domain.ErrKeyReused):
+		return http.StatusUnprocessableEntity, "Idempotency-Key was already used with a different request"
+	case errors.Is(err, domain.ErrRequestInProgress):
+		return http.StatusConflict, "A request with this Idempotency-Key is still being processed"
+	}
+	return http.StatusInternalServerError, "Error processing Idempotency-Key"
+}
+
+// bodyRecorder copia lo que el handler escribe para guardarlo como respuesta de la clave
+type bodyRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write escribe la respuesta y guarda una copia
+func (w *bodyRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString escribe la respuesta y guarda una copia
+func (w *bodyRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// NewIdempotencyMiddleware crea el middleware de Gin para la cabecera Idempotency-Key. La primera
+// petición con una clave se ejecuta y su respuesta se guarda; los reintentos con el mismo método,
+// ruta y cuerpo reciben la respuesta guardada y una clave reutilizada con otra petición responde 422.
+// De las subidas multipart solo se comparan el tipo y el largo declarado del cuerpo
+func NewIdempotencyMiddleware(service application.IdempotencyServiceInterface) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(KeyHeader)
+		if key == "" || !isIdempotentMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if contentType := c.GetHeader("Content-Type"); isMultipart(contentType) {
+			body = multipartFingerprintBody(contentType, c.Request.ContentLength)
+		} else {
+			var err error
+			if body, err = io.ReadAll(c.Request.Body); err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error":   "Invalid request body",
+					"message": err.Error(),
+				})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		ctx := c.Request.Context()
+		key = domain.ScopedKey(c.GetHeader(ScopeHeader), key)
+		record, err := service.Begin(ctx, key, domain.Fingerprint(c.Request.Method, c.Request.URL.RequestURI(), body))
+		if err != nil {
+			status, message := idempotencyErrorStatus(err)
+			c.AbortWithStatusJSON(status, gin.H{
+				"error":   message,
+				"message": err.Error(),
+			})
+			return
+		}
+		if record != nil {
+			c.Header(ReplayedHeader, "true")
+			c.Data(record.Status, record.ContentType, record.Body)
+			c.Abort()
+			return
+		}
+
+		// Si el handler entra en pánico la clave se libera para que el cliente pueda reintentar
+		completed := false
+		defer func() {
+			if !completed {
+				if err := service.Release(ctx, key); err != nil {
+					log.Printf("Error liberando la clave de idempotencia: %v", err)
+				}
+			}
+		}()
+
+		recorder := &bodyRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		completed = true
+		if err := service.Complete(ctx, key, recorder.Status(), recorder.Header().Get("Content-Type"), recorder.body.Bytes()); err != nil {
+			log.Printf("Error guardando la respuesta de la clave de idempotencia: %v", err)
+		}
+	}
+}
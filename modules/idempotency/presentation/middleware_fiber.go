@@ -0,0 +1,66 @@
+package presentation
+
+import (
+	"log"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewFiberIdempotencyMiddleware crea el middleware de Fiber para la cabecera Idempotency-Key,
+// con el mismo comportamiento que NewIdempotencyMiddleware
+func NewFiberIdempotencyMiddleware(service application.IdempotencyServiceInterface) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := c.Get(KeyHeader)
+		if key == "" || !isIdempotentMethod(c.Method()) {
+			return c.Next()
+		}
+
+		// c.Body() leería completo el stream de una subida multipart
+		var body []byte
+		if contentType := c.Get(fiber.HeaderContentType); isMultipart(contentType) {
+			body = multipartFingerprintBody(contentType, int64(c.Request().Header.ContentLength()))
+		} else {
+			body = c.Body()
+		}
+
+		ctx := c.Context()
+		key = domain.ScopedKey(c.Get(ScopeHeader), key)
+		record, err := service.Begin(ctx, key, domain.Fingerprint(c.Method(), c.OriginalURL(), body))
+		if err != nil {
+			status, message := idempotencyErrorStatus(err)
+			return c.Status(status).JSON(fiber.Map{
+				"error":   message,
+				"message": err.Error(),
+			})
+		}
+		if record != nil {
+			c.Set(ReplayedHeader, "true")
+			c.Set(fiber.HeaderContentType, record.ContentType)
+			return c.Status(record.Status).Send(record.Body)
+		}
+
+		// Si el handler falla o entra en pánico la clave se libera para que el cliente pueda reintentar
+		completed := false
+		defer func() {
+			if !completed {
+				if err := service.Release(ctx, key); err != nil {
+					log.Printf("Error liberando la clave de idempotencia: %v", err)
+				}
+			}
+		}()
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		completed = true
+		response := c.Response()
+		responseBody := append([]byte(nil), response.Body()...)
+		if err := service.Complete(ctx, key, response.StatusCode(), string(response.Header.ContentType()), responseBody); err != nil {
+			log.Printf("Error guardando la respuesta de la clave de idempotencia: %v", err)
+		}
+		return nil
+	}
+}
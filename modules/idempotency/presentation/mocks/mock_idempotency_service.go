@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=../presentation/mocks/mock_idempotency_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyServiceInterface is a mock of IdempotencyServiceInterface interface.
+type MockIdempotencyServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyServiceInterfaceMockRecorder is the mock recorder for MockIdempotencyServiceInterface.
+type MockIdempotencyServiceInterfaceMockRecorder struct {
+	mock *MockIdempotencyServiceInterface
+}
+
+// NewMockIdempotencyServiceInterface creates a new mock instance.
+func NewMockIdempotencyServiceInterface(ctrl *gomock.Controller) *MockIdempotencyServiceInterface {
+	mock := &MockIdempotencyServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyServiceInterface) EXPECT() *MockIdempotencyServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockIdempotencyServiceInterface) Begin(ctx context.Context, key, fingerprint string) (*domain.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, key, fingerprint)
+	ret0, _ := ret[0].(*domain.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyServiceInterfaceMockRecorder) Begin(ctx, key, fingerprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotencyServiceInterface)(nil).Begin), ctx, key, fingerprint)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyServiceInterface) Complete(ctx context.Context, key string, status int, contentType string, body []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, key, status, contentType, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyServiceInterfaceMockRecorder) Complete(ctx, key, status, contentType, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyServiceInterface)(nil).Complete), ctx, key, status, contentType, body)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyServiceInterface) Release(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyServiceInterfaceMockRecorder) Release(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyServiceInterface)(nil).Release), ctx, key)
+}
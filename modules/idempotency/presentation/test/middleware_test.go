@@ -0,0 +1,226 @@
+package presentation_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/idempotency/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupIdempotencyRouter crea un router de Gin con el middleware y un handler que registra cuántas veces se ejecuta
+func setupIdempotencyRouter(ctrl *gomock.Controller, calls *int) (*gin.Engine, *mocks.MockIdempotencyServiceInterface) {
+	mockService := mocks.NewMockIdempotencyServiceInterface(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(presentation.NewIdempotencyMiddleware(mockService))
+	router.POST("/tasks", func(c *gin.Context) {
+		*calls++
+		body, _ := io.ReadAll(c.Request.Body)
+		c.Data(http.StatusCreated, "application/json", body)
+	})
+	return router, mockService
+}
+
+// idempotentRequest crea una petición POST /tasks con la clave de idempotencia
+func idempotentRequest(key, body string) *http.Request {
+	req, _ := http.NewRequest("POST", "/tasks", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(presentation.KeyHeader, key)
+	req.Header.Set(presentation.ScopeHeader, "7")
+	return req
+}
+
+// TestIdempotencyMiddleware_StoresResponse verifica que la primera petición se ejecuta y su respuesta se guarda
+func TestIdempotencyMiddleware_StoresResponse(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	calls := 0
+	router, mockService := setupIdempotencyRouter(ctrl, &calls)
+
+	body := `{"title":"T"}`
+	fingerprint := domain.Fingerprint("POST", "/tasks", []byte(body))
+	mockService.EXPECT().Begin(gomock.Any(), domain.ScopedKey("7", "k1"), fingerprint).Return(nil, nil)
+	mockService.EXPECT().Complete(gomock.Any(), domain.ScopedKey("7", "k1"), http.StatusCreated, "application/json", []byte(body)).Return(nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, idempotentRequest("k1", body))
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, body, w.Body.String())
+	assert.Equal(t, 1, calls)
+}
+
+// TestIdempotencyMiddleware_Replay verifica que un reintento recibe la respuesta guardada sin ejecutar el handler
+func TestIdempotencyMiddleware_Replay(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	calls := 0
+	router, mockService := setupIdempotencyRouter(ctrl, &calls)
+
+	stored := &domain.Record{Status: http.StatusCreated, ContentType: "application/json", Body: []byte(`{"id":1}`)}
+	mockService.EXPECT().Begin(gomock.Any(), domain.ScopedKey("7", "k1"), gomock.Any()).Return(stored, nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, idempotentRequest("k1", `{"title":"T"}`))
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, `{"id":1}`, w.Body.String())
+	assert.Equal(t, "true", w.Header().Get(presentation.ReplayedHeader))
+	assert.Equal(t, 0, calls)
+}
+
+// TestIdempotencyMiddleware_Errors verifica el código HTTP de cada error de la clave
+func TestIdempotencyMiddleware_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "clave reutilizada", err: domain.ErrKeyReused, want: http.StatusUnprocessableEntity},
+		{name: "petición en curso", err: domain.ErrRequestInProgress, want: http.StatusConflict},
+		{name: "clave inválida", err: domain.ErrInvalidKey, want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			calls := 0
+			router, mockService := setupIdempotencyRouter(ctrl, &calls)
+			mockService.EXPECT().Begin(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, tt.err)
+			w := httptest.NewRecorder()
+
+			// Act
+			router.ServeHTTP(w, idempotentRequest("k1", `{"title":"T"}`))
+
+			// Assert
+			assert.Equal(t, tt.want, w.Code)
+			assert.Equal(t, 0, calls)
+		})
+	}
+}
+
+// TestIdempotencyMiddleware_WithoutKey verifica que sin cabecera la petición pasa sin usar el servicio
+func TestIdempotencyMiddleware_WithoutKey(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	calls := 0
+	router, _ := setupIdempotencyRouter(ctrl, &calls)
+	req := idempotentRequest("", `{"title":"T"}`)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, 1, calls)
+}
+
+// TestFiberIdempotencyMiddleware verifica que Fiber guarda la primera respuesta y repite la guardada en el reintento
+func TestFiberIdempotencyMiddleware(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mocks.NewMockIdempotencyServiceInterface(ctrl)
+
+	calls := 0
+	app := fiber.New()
+	app.Use(presentation.NewFiberIdempotencyMiddleware(mockService))
+	app.Post("/tasks", func(c *fiber.Ctx) error {
+		calls++
+		return c.Status(fiber.StatusCreated).JSON(fiber.Map{"id": 1})
+	})
+
+	body := `{"title":"T"}`
+	fingerprint := domain.Fingerprint("POST", "/tasks", []byte(body))
+	var stored *domain.Record
+	mockService.EXPECT().Begin(gomock.Any(), domain.ScopedKey("7", "k1"), fingerprint).Return(nil, nil)
+	mockService.EXPECT().Complete(gomock.Any(), domain.ScopedKey("7", "k1"), http.StatusCreated, "application/json", []byte(`{"id":1}`)).
+		DoAndReturn(func(_ context.Context, key string, status int, contentType string, body []byte) error {
+			stored = &domain.Record{Key: key, Status: status, ContentType: contentType, Body: body}
+			return nil
+		})
+	mockService.EXPECT().Begin(gomock.Any(), domain.ScopedKey("7", "k1"), fingerprint).DoAndReturn(func(context.Context, string, string) (*domain.Record, error) {
+		return stored, nil
+	})
+
+	// Act
+	first, err := app.Test(idempotentRequest("k1", body))
+	assert.NoError(t, err)
+	retry, err := app.Test(idempotentRequest("k1", body))
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, first.StatusCode)
+	assert.Equal(t, http.StatusCreated, retry.StatusCode)
+	assert.Equal(t, "true", retry.Header.Get(presentation.ReplayedHeader))
+	retryBody, _ := io.ReadAll(retry.Body)
+	assert.JSONEq(t, `{"id":1}`, string(retryBody))
+	assert.Equal(t, 1, calls)
+}
+
+// TestIdempotencyMiddleware_Multipart verifica que la huella de una subida multipart no depende del
+// boundary y que el handler recibe el cuerpo completo
+func TestIdempotencyMiddleware_Multipart(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	calls := 0
+	router, mockService := setupIdempotencyRouter(ctrl, &calls)
+
+	var fingerprints []string
+	mockService.EXPECT().Begin(gomock.Any(), domain.ScopedKey("7", "k1"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, fingerprint string) (*domain.Record, error) {
+			fingerprints = append(fingerprints, fingerprint)
+			return nil, nil
+		}).Times(2)
+	mockService.EXPECT().Complete(gomock.Any(), domain.ScopedKey("7", "k1"), http.StatusCreated, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	upload := func(boundary string) *httptest.ResponseRecorder {
+		body := "--" + boundary + "\r\nContent-Disposition: form-data; name=\"file\"; filename=\"a.txt\"\r\n\r\nhola\r\n--" + boundary + "--\r\n"
+		req := idempotentRequest("k1", body)
+		req.Header.Set("Content-Type", "multipart/form-data; boundary="+boundary)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	first := upload("AAAA")
+	retry := upload("BBBB")
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Contains(t, first.Body.String(), "hola")
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Len(t, fingerprints, 2)
+	assert.Equal(t, fingerprints[0], fingerprints[1])
+	assert.NotEqual(t, domain.Fingerprint("POST", "/tasks", first.Body.Bytes()), fingerprints[0])
+}
+
+// TestScopedKey verifica que el ámbito no se confunde con la clave aunque contengan el separador
+func TestScopedKey(t *testing.T) {
+	// Act & Assert
+	assert.NotEqual(t, domain.ScopedKey("a:b", "c"), domain.ScopedKey("a", "b:c"))
+	assert.Equal(t, "k1", domain.ScopedKey("", "k1"))
+}
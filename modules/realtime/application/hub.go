@@ -0,0 +1,222 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/domain"
+)
+
+var (
+	// errNotSubscribed indica una presencia en una tarea a la que la sesión no está suscrita
+	errNotSubscribed = errors.New("la conexión no está suscrita a la tarea")
+	// errTooManySubscriptions indica que la sesión alcanzó el máximo de suscripciones
+	errTooManySubscriptions = errors.New("la conexión alcanzó el máximo de suscripciones")
+)
+
+// Session es una conexión abierta de un participante. Los mensajes para el cliente se encolan en
+// Outbound; si la cola se llena, el cliente no está leyendo a tiempo y la sesión se corta (Done)
+type Session struct {
+	ID          uint64
+	Participant domain.Participant
+
+	queue chan []byte
+	done  chan struct{}
+	// tasks y closed los protege el mutex del hub
+	tasks  map[int]struct{}
+	closed bool
+}
+
+// Outbound entrega los mensajes codificados que hay que enviar al cliente
+func (s *Session) Outbound() <-chan []byte {
+	return s.queue
+}
+
+// Done se cierra cuando el hub corta la sesión porque el cliente no lee a tiempo
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// hub lleva las sesiones suscritas a cada tarea con su presencia. Nunca se bloquea esperando a un
+// cliente: el envío es no bloqueante y una sesión con la cola llena se corta
+type hub struct {
+	mu               sync.Mutex
+	nextID           uint64
+	queueSize        int
+	maxSubscriptions int
+	// subscribers tiene por tarea las sesiones suscritas y su estado de presencia ("" si no anunció ninguno)
+	subscribers map[int]map[*Session]string
+}
+
+// newHub crea un hub con colas de queueSize mensajes y hasta maxSubscriptions tareas por sesión
+func newHub(queueSize, maxSubscriptions int) *hub {
+	return &hub{
+		queueSize:        max(queueSize, 1),
+		maxSubscriptions: maxSubscriptions,
+		subscribers:      make(map[int]map[*Session]string),
+	}
+}
+
+// register crea una sesión para el participante
+func (h *hub) register(participant domain.Participant) *Session {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.nextID++
+	return &Session{
+		ID:          h.nextID,
+		Participant: participant,
+		queue:       make(chan []byte, h.queueSize),
+		done:        make(chan struct{}),
+		tasks:       make(map[int]struct{}),
+	}
+}
+
+// subscribe suscribe la sesión a la tarea y retorna la presencia de las demás sesiones
+func (h *hub) subscribe(session *Session, taskID int) ([]domain.Presence, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if session.closed {
+		return nil, nil
+	}
+	if _, ok := session.tasks[taskID]; !ok {
+		if h.maxSubscriptions > 0 && len(session.tasks) >= h.maxSubscriptions {
+			return nil, errTooManySubscriptions
+		}
+		session.tasks[taskID] = struct{}{}
+		if h.subscribers[taskID] == nil {
+			h.subscribers[taskID] = make(map[*Session]string)
+		}
+		h.subscribers[taskID][session] = ""
+	}
+
+	var presence []domain.Presence
+	for other, state := range h.subscribers[taskID] {
+		if other != session && state != "" {
+			presence = append(presence, domain.Presence{TaskID: taskID, SessionID: other.ID, User: other.Participant, State: state})
+		}
+	}
+	return presence, nil
+}
+
+// unsubscribe quita la suscripción de la sesión a la tarea y avisa si tenía presencia
+func (h *hub) unsubscribe(session *Session, taskID int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.unsubscribeLocked(session, taskID)
+}
+
+func (h *hub) unsubscribeLocked(session *Session, taskID int) {
+	state, ok := h.subscribers[taskID][session]
+	if !ok {
+		return
+	}
+	delete(h.subscribers[taskID], session)
+	if len(h.subscribers[taskID]) == 0 {
+		delete(h.subscribers, taskID)
+	}
+	delete(session.tasks, taskID)
+	if state != "" {
+		h.broadcastPresenceLocked(session, taskID, domain.PresenceLeft)
+	}
+}
+
+// setPresence guarda el estado de la sesión en la tarea y lo envía a las demás sesiones suscritas
+func (h *hub) setPresence(session *Session, taskID int, state string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	current, ok := h.subscribers[taskID][session]
+	if !ok {
+		return errNotSubscribed
+	}
+	if current == state {
+		return nil
+	}
+	h.subscribers[taskID][session] = state
+	h.broadcastPresenceLocked(session, taskID, state)
+	return nil
+}
+
+// broadcastPresenceLocked envía el estado de la sesión a las demás sesiones suscritas a la tarea
+func (h *hub) broadcastPresenceLocked(session *Session, taskID int, state string) {
+	participant := session.Participant
+	h.broadcastLocked(taskID, domain.ServerMessage{
+		Type: domain.MessagePresence, TaskID: taskID, SessionID: session.ID, User: &participant, State: state,
+	}, session)
+}
+
+// broadcast envía el mensaje a las sesiones suscritas a la tarea
+func (h *hub) broadcast(taskID int, message domain.ServerMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.broadcastLocked(taskID, message, nil)
+}
+
+func (h *hub) broadcastLocked(taskID int, message domain.ServerMessage, except *Session) {
+	if len(h.subscribers[taskID]) == 0 {
+		return
+	}
+	data, ok := encodeMessage(message)
+	if !ok {
+		return
+	}
+	for session := range h.subscribers[taskID] {
+		if session != except {
+			h.enqueueLocked(session, data)
+		}
+	}
+}
+
+// send envía el mensaje a una sesión
+func (h *hub) send(session *Session, message domain.ServerMessage) {
+	data, ok := encodeMessage(message)
+	if !ok {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.enqueueLocked(session, data)
+}
+
+// enqueueLocked encola sin bloquear; si la cola está llena corta la sesión
+func (h *hub) enqueueLocked(session *Session, data []byte) {
+	if session.closed {
+		return
+	}
+	select {
+	case session.queue <- data:
+	default:
+		log.Printf("Realtime: la sesión %d del usuario %d no lee a tiempo y se desconecta", session.ID, session.Participant.UserID)
+		h.closeLocked(session)
+	}
+}
+
+// remove quita la sesión de todas sus tareas
+func (h *hub) remove(session *Session) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closeLocked(session)
+}
+
+// closeLocked quita la sesión de sus tareas, avisa a las demás su salida y cierra Done
+func (h *hub) closeLocked(session *Session) {
+	if session.closed {
+		return
+	}
+	session.closed = true
+	close(session.done)
+	for taskID := range session.tasks {
+		h.unsubscribeLocked(session, taskID)
+	}
+}
+
+// encodeMessage codifica un mensaje del servidor
+func encodeMessage(message domain.ServerMessage) ([]byte, bool) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		log.Printf("Realtime: no se pudo codificar el mensaje %s: %v", message.Type, err)
+		return nil, false
+	}
+	return data, true
+}
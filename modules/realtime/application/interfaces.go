@@ -0,0 +1,28 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/domain"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=../presentation/mocks/mock_realtime_service.go -package=mocks
+
+// RealtimeServiceInterface define el contrato que usan los handlers del socket
+type RealtimeServiceInterface interface {
+	// IssueTicket verifica las credenciales de un usuario activo y le emite un ticket de conexión
+	IssueTicket(ctx context.Context, username, password string) (string, time.Time, error)
+
+	// Authenticate verifica el ticket de conexión y que el usuario siga activo
+	Authenticate(ctx context.Context, ticket string) (*domain.Participant, error)
+
+	// Connect abre una sesión para el participante
+	Connect(participant domain.Participant) *Session
+
+	// Disconnect cierra la sesión
+	Disconnect(session *Session)
+
+	// HandleMessage procesa un mensaje del cliente
+	HandleMessage(ctx context.Context, session *Session, data []byte)
+}
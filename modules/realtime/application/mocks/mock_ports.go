@@ -0,0 +1,195 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ports.go
+//
+// Generated by this command:
+//
+//	mockgen -source=ports.go -destination=mocks/mock_ports.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	domain0 "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskMutator is a mock of TaskMutator interface.
+type MockTaskMutator struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskMutatorMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskMutatorMockRecorder is the mock recorder for MockTaskMutator.
+type MockTaskMutatorMockRecorder struct {
+	mock *MockTaskMutator
+}
+
+// NewMockTaskMutator creates a new mock instance.
+func NewMockTaskMutator(ctrl *gomock.Controller) *MockTaskMutator {
+	mock := &MockTaskMutator{ctrl: ctrl}
+	mock.recorder = &MockTaskMutatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskMutator) EXPECT() *MockTaskMutatorMockRecorder {
+	return m.recorder
+}
+
+// DeleteTask mocks base method.
+func (m *MockTaskMutator) DeleteTask(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTask", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTask indicates an expected call of DeleteTask.
+func (mr *MockTaskMutatorMockRecorder) DeleteTask(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskMutator)(nil).DeleteTask), ctx, id)
+}
+
+// GetTaskByID mocks base method.
+func (m *MockTaskMutator) GetTaskByID(ctx context.Context, id int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskByID indicates an expected call of GetTaskByID.
+func (mr *MockTaskMutatorMockRecorder) GetTaskByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskByID", reflect.TypeOf((*MockTaskMutator)(nil).GetTaskByID), ctx, id)
+}
+
+// MarkTaskAsCompleted mocks base method.
+func (m *MockTaskMutator) MarkTaskAsCompleted(ctx context.Context, id int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTaskAsCompleted", ctx, id)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkTaskAsCompleted indicates an expected call of MarkTaskAsCompleted.
+func (mr *MockTaskMutatorMockRecorder) MarkTaskAsCompleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTaskAsCompleted", reflect.TypeOf((*MockTaskMutator)(nil).MarkTaskAsCompleted), ctx, id)
+}
+
+// MarkTaskAsUncompleted mocks base method.
+func (m *MockTaskMutator) MarkTaskAsUncompleted(ctx context.Context, id int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkTaskAsUncompleted", ctx, id)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkTaskAsUncompleted indicates an expected call of MarkTaskAsUncompleted.
+func (mr *MockTaskMutatorMockRecorder) MarkTaskAsUncompleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTaskAsUncompleted", reflect.TypeOf((*MockTaskMutator)(nil).MarkTaskAsUncompleted), ctx, id)
+}
+
+// PatchTask mocks base method.
+func (m *MockTaskMutator) PatchTask(ctx context.Context, id int, patch domain.TaskPatch) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchTask", ctx, id, patch)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchTask indicates an expected call of PatchTask.
+func (mr *MockTaskMutatorMockRecorder) PatchTask(ctx, id, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchTask", reflect.TypeOf((*MockTaskMutator)(nil).PatchTask), ctx, id, patch)
+}
+
+// MockUserDirectory is a mock of UserDirectory interface.
+type MockUserDirectory struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDirectoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserDirectoryMockRecorder is the mock recorder for MockUserDirectory.
+type MockUserDirectoryMockRecorder struct {
+	mock *MockUserDirectory
+}
+
+// NewMockUserDirectory creates a new mock instance.
+func NewMockUserDirectory(ctrl *gomock.Controller) *MockUserDirectory {
+	mock := &MockUserDirectory{ctrl: ctrl}
+	mock.recorder = &MockUserDirectoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDirectory) EXPECT() *MockUserDirectoryMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockUserDirectory) GetByID(ctx context.Context, id int) (*domain0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUserDirectoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUserDirectory)(nil).GetByID), ctx, id)
+}
+
+// MockUserAuthenticator is a mock of UserAuthenticator interface.
+type MockUserAuthenticator struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserAuthenticatorMockRecorder
+	isgomock struct{}
+}
+
+// MockUserAuthenticatorMockRecorder is the mock recorder for MockUserAuthenticator.
+type MockUserAuthenticatorMockRecorder struct {
+	mock *MockUserAuthenticator
+}
+
+// NewMockUserAuthenticator creates a new mock instance.
+func NewMockUserAuthenticator(ctrl *gomock.Controller) *MockUserAuthenticator {
+	mock := &MockUserAuthenticator{ctrl: ctrl}
+	mock.recorder = &MockUserAuthenticatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserAuthenticator) EXPECT() *MockUserAuthenticatorMockRecorder {
+	return m.recorder
+}
+
+// AuthenticateUser mocks base method.
+func (m *MockUserAuthenticator) AuthenticateUser(ctx context.Context, username, password string) (*domain0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateUser", ctx, username, password)
+	ret0, _ := ret[0].(*domain0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateUser indicates an expected call of AuthenticateUser.
+func (mr *MockUserAuthenticatorMockRecorder) AuthenticateUser(ctx, username, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateUser", reflect.TypeOf((*MockUserAuthenticator)(nil).AuthenticateUser), ctx, username, password)
+}
@@ -0,0 +1,40 @@
+package application
+
+import (
+	"context"
+
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+)
+
+//go:generate mockgen -source=ports.go -destination=mocks/mock_ports.go -package=mocks
+
+// TaskMutator son los casos de uso de tareas que se usan desde el socket; los implementa TaskService
+type TaskMutator interface {
+	// GetTaskByID obtiene una tarea por su ID
+	GetTaskByID(ctx context.Context, id int) (*taskdomain.Task, error)
+
+	// PatchTask aplica los cambios explícitos a una tarea
+	PatchTask(ctx context.Context, id int, patch taskdomain.TaskPatch) (*taskdomain.Task, error)
+
+	// MarkTaskAsCompleted marca una tarea como completada
+	MarkTaskAsCompleted(ctx context.Context, id int) (*taskdomain.Task, error)
+
+	// MarkTaskAsUncompleted marca una tarea como pendiente
+	MarkTaskAsUncompleted(ctx context.Context, id int) (*taskdomain.Task, error)
+
+	// DeleteTask mueve una tarea a la papelera
+	DeleteTask(ctx context.Context, id int) error
+}
+
+// UserDirectory obtiene los usuarios que se autentican en el socket; lo implementa el repositorio de usuarios
+type UserDirectory interface {
+	// GetByID obtiene un usuario por su ID
+	GetByID(ctx context.Context, id int) (*userdomain.User, error)
+}
+
+// UserAuthenticator verifica las credenciales de quien pide un ticket; lo implementa UserService
+type UserAuthenticator interface {
+	// AuthenticateUser verifica usuario y contraseña de un usuario activo
+	AuthenticateUser(ctx context.Context, username, password string) (*userdomain.User, error)
+}
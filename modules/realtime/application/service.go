@@ -0,0 +1,238 @@
+package application
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/domain"
+	taskapplication "github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// RealtimeConfig configura las conexiones en tiempo real
+type RealtimeConfig struct {
+	// TicketSecret firma los tickets de conexión; vacío genera uno aleatorio y los tickets solo
+	// valen en este proceso
+	TicketSecret []byte
+	// TicketTTL es cuánto vale un ticket de conexión
+	TicketTTL time.Duration
+	// SendBuffer es la cantidad de mensajes que puede tener pendientes un cliente antes de desconectarlo
+	SendBuffer int
+	// MaxSubscriptions es la cantidad máxima de tareas a las que se suscribe una conexión; 0 no limita
+	MaxSubscriptions int
+}
+
+// RealtimeService maneja las conexiones de edición colaborativa: suscripciones a tareas, presencia,
+// cambios en vivo y modificaciones enviadas por el mismo socket
+type RealtimeService struct {
+	tasks  TaskMutator
+	users  UserDirectory
+	auth   UserAuthenticator
+	hub    *hub
+	secret []byte
+	ttl    time.Duration
+}
+
+// NewRealtimeService crea una nueva instancia de RealtimeService; auth verifica las credenciales
+// con las que se piden los tickets de conexión
+func NewRealtimeService(tasks TaskMutator, users UserDirectory, auth UserAuthenticator, cfg RealtimeConfig) *RealtimeService {
+	secret := cfg.TicketSecret
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		_, _ = rand.Read(secret)
+	}
+	return &RealtimeService{
+		tasks:  tasks,
+		users:  users,
+		auth:   auth,
+		hub:    newHub(cfg.SendBuffer, cfg.MaxSubscriptions),
+		secret: secret,
+		ttl:    cfg.TicketTTL,
+	}
+}
+
+// IssueTicket verifica las credenciales de un usuario activo y le emite un ticket de conexión. El
+// motivo del rechazo no se informa para no revelar qué usuarios existen
+func (s *RealtimeService) IssueTicket(ctx context.Context, username, password string) (string, time.Time, error) {
+	user, err := s.auth.AuthenticateUser(ctx, username, password)
+	if err != nil || user == nil {
+		return "", time.Time{}, fmt.Errorf("%w: credenciales inválidas", domain.ErrUnauthorized)
+	}
+	expiresAt := time.Now().Add(s.ttl).Truncate(time.Second)
+	return domain.IssueTicket(s.secret, user.ID, expiresAt), expiresAt, nil
+}
+
+// Authenticate verifica el ticket de conexión y que el usuario siga activo
+func (s *RealtimeService) Authenticate(ctx context.Context, ticket string) (*domain.Participant, error) {
+	userID, err := domain.ParseTicket(s.secret, ticket, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return s.participant(ctx, userID)
+}
+
+// participant obtiene el participante de un usuario activo
+func (s *RealtimeService) participant(ctx context.Context, userID int) (*domain.Participant, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil || user == nil || !user.Active {
+		return nil, fmt.Errorf("%w: %d", domain.ErrUnauthorized, userID)
+	}
+	return &domain.Participant{UserID: user.ID, Username: user.Username}, nil
+}
+
+// Connect abre una sesión para el participante y le envía la bienvenida
+func (s *RealtimeService) Connect(participant domain.Participant) *Session {
+	session := s.hub.register(participant)
+	s.hub.send(session, domain.ServerMessage{Type: domain.MessageWelcome, SessionID: session.ID, User: &participant})
+	return session
+}
+
+// Disconnect cierra la sesión y avisa su salida a las tareas donde tenía presencia
+func (s *RealtimeService) Disconnect(session *Session) {
+	s.hub.remove(session)
+}
+
+// HandleMessage procesa un mensaje del cliente; las respuestas y los errores se encolan en la sesión
+func (s *RealtimeService) HandleMessage(ctx context.Context, session *Session, data []byte) {
+	var message domain.ClientMessage
+	if err := json.Unmarshal(data, &message); err != nil {
+		s.sendError(session, "", 0, domain.CodeInvalidMessage, "message must be a JSON object")
+		return
+	}
+
+	switch message.Type {
+	case domain.MessageSubscribe:
+		s.subscribe(ctx, session, message)
+	case domain.MessageUnsubscribe:
+		for _, taskID := range messageTaskIDs(message) {
+			s.hub.unsubscribe(session, taskID)
+			s.hub.send(session, domain.ServerMessage{Type: domain.MessageUnsubscribed, ID: message.ID, TaskID: taskID})
+		}
+	case domain.MessagePresence:
+		s.presence(session, message)
+	case domain.MessageMutate:
+		s.mutate(ctx, session, message)
+	case domain.MessagePing:
+		s.hub.send(session, domain.ServerMessage{Type: domain.MessagePong, ID: message.ID})
+	default:
+		s.sendError(session, message.ID, 0, domain.CodeInvalidMessage, fmt.Sprintf("unknown message type %q", message.Type))
+	}
+}
+
+// subscribe suscribe la sesión a cada tarea y responde con su estado y la presencia actual
+func (s *RealtimeService) subscribe(ctx context.Context, session *Session, message domain.ClientMessage) {
+	taskIDs := messageTaskIDs(message)
+	if len(taskIDs) == 0 {
+		s.sendError(session, message.ID, 0, domain.CodeInvalidMessage, "subscribe requires task_id or task_ids")
+		return
+	}
+	for _, taskID := range taskIDs {
+		task, err := s.tasks.GetTaskByID(ctx, taskID)
+		if err != nil {
+			s.sendError(session, message.ID, taskID, domain.CodeFailed, err.Error())
+			continue
+		}
+		presence, err := s.hub.subscribe(session, taskID)
+		if err != nil {
+			s.sendError(session, message.ID, taskID, domain.CodeTooManySubscriptions, err.Error())
+			return
+		}
+		s.hub.send(session, domain.ServerMessage{Type: domain.MessageSubscribed, ID: message.ID, TaskID: taskID, Task: task, Presence: presence})
+	}
+}
+
+// presence anuncia el estado de la sesión a las demás sesiones suscritas a la tarea
+func (s *RealtimeService) presence(session *Session, message domain.ClientMessage) {
+	if message.State != domain.PresenceViewing && message.State != domain.PresenceEditing {
+		s.sendError(session, message.ID, message.TaskID, domain.CodeInvalidMessage, "state must be viewing or editing")
+		return
+	}
+	if err := s.hub.setPresence(session, message.TaskID, message.State); err != nil {
+		s.sendError(session, message.ID, message.TaskID, domain.CodeNotSubscribed, err.Error())
+	}
+}
+
+// mutate modifica la tarea en nombre del participante; el cambio llega a los suscriptores como evento
+func (s *RealtimeService) mutate(ctx context.Context, session *Session, message domain.ClientMessage) {
+	if message.TaskID <= 0 {
+		s.sendError(session, message.ID, 0, domain.CodeInvalidMessage, "mutate requires task_id")
+		return
+	}
+	ctx = taskapplication.WithActor(ctx, session.Participant.Username)
+	if message.Version > 0 {
+		ctx = taskapplication.WithExpectedVersion(ctx, message.Version)
+	}
+
+	var task *taskdomain.Task
+	var err error
+	switch message.Op {
+	case domain.OpUpdate:
+		if message.Changes == nil {
+			s.sendError(session, message.ID, message.TaskID, domain.CodeInvalidMessage, "update requires changes")
+			return
+		}
+		task, err = s.tasks.PatchTask(ctx, message.TaskID, taskdomain.TaskPatch{
+			Title:       message.Changes.Title,
+			Description: message.Changes.Description,
+			Completed:   message.Changes.Completed,
+		})
+	case domain.OpComplete:
+		task, err = s.tasks.MarkTaskAsCompleted(ctx, message.TaskID)
+	case domain.OpReopen:
+		task, err = s.tasks.MarkTaskAsUncompleted(ctx, message.TaskID)
+	case domain.OpDelete:
+		err = s.tasks.DeleteTask(ctx, message.TaskID)
+	default:
+		s.sendError(session, message.ID, message.TaskID, domain.CodeInvalidMessage, "op must be update, complete, reopen or delete")
+		return
+	}
+	if err != nil {
+		s.sendError(session, message.ID, message.TaskID, mutationErrorCode(err), err.Error())
+		return
+	}
+	s.hub.send(session, domain.ServerMessage{Type: domain.MessageResult, ID: message.ID, TaskID: message.TaskID, Task: task})
+}
+
+// HandleEvent envía el evento a las sesiones suscritas a la tarea; tiene la firma de EventHandler
+// para suscribirse al bus de eventos de tareas
+func (s *RealtimeService) HandleEvent(_ context.Context, event taskdomain.Event) error {
+	s.hub.broadcast(event.TaskID, domain.ServerMessage{Type: domain.MessageChange, TaskID: event.TaskID, Event: &event})
+	return nil
+}
+
+// sendError envía un mensaje de error a la sesión
+func (s *RealtimeService) sendError(session *Session, id string, taskID int, code, message string) {
+	s.hub.send(session, domain.ServerMessage{Type: domain.MessageError, ID: id, TaskID: taskID, Code: code, Error: message})
+}
+
+// messageTaskIDs retorna las tareas de un mensaje, de task_ids o de task_id
+func messageTaskIDs(message domain.ClientMessage) []int {
+	taskIDs := message.TaskIDs
+	if message.TaskID > 0 {
+		taskIDs = append(taskIDs, message.TaskID)
+	}
+	valid := taskIDs[:0:0]
+	for _, taskID := range taskIDs {
+		if taskID > 0 {
+			valid = append(valid, taskID)
+		}
+	}
+	return valid
+}
+
+// mutationErrorCode traduce los errores de una modificación a códigos del protocolo
+func mutationErrorCode(err error) string {
+	switch {
+	case errors.Is(err, taskdomain.ErrPreconditionFailed):
+		return domain.CodePreconditionFailed
+	case errors.Is(err, taskdomain.ErrVersionConflict):
+		return domain.CodeConflict
+	case errors.Is(err, taskdomain.ErrInvalidPatch):
+		return domain.CodeInvalidMessage
+	}
+	return domain.CodeFailed
+}
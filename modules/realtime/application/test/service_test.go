@@ -0,0 +1,209 @@
+package application_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/domain"
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var testConfig = application.RealtimeConfig{TicketSecret: []byte("secret"), TicketTTL: time.Minute, SendBuffer: 16}
+
+// nextMessage lee el siguiente mensaje encolado en la sesión
+func nextMessage(t *testing.T, session *application.Session) domain.ServerMessage {
+	t.Helper()
+	select {
+	case data := <-session.Outbound():
+		var message domain.ServerMessage
+		require.NoError(t, json.Unmarshal(data, &message))
+		return message
+	default:
+		t.Fatal("la sesión no tiene mensajes pendientes")
+		return domain.ServerMessage{}
+	}
+}
+
+// TestRealtimeService_Authenticate verifica que un ticket emitido con credenciales válidas autentica
+// al usuario y que unas credenciales rechazadas no reciben ticket
+func TestRealtimeService_Authenticate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsers := mocks.NewMockUserDirectory(ctrl)
+	mockAuth := mocks.NewMockUserAuthenticator(ctrl)
+	service := application.NewRealtimeService(mocks.NewMockTaskMutator(ctrl), mockUsers, mockAuth, testConfig)
+
+	mockAuth.EXPECT().AuthenticateUser(gomock.Any(), "ana", "secreta").Return(&userdomain.User{ID: 1, Username: "ana", Active: true}, nil)
+	mockAuth.EXPECT().AuthenticateUser(gomock.Any(), "bob", "secreta").Return(nil, fmt.Errorf("usuario inactivo"))
+	mockUsers.EXPECT().GetByID(gomock.Any(), 1).Return(&userdomain.User{ID: 1, Username: "ana", Active: true}, nil)
+
+	// Act
+	ticket, expiresAt, err := service.IssueTicket(context.Background(), "ana", "secreta")
+	require.NoError(t, err)
+	participant, authErr := service.Authenticate(context.Background(), ticket)
+	_, _, inactiveErr := service.IssueTicket(context.Background(), "bob", "secreta")
+	_, invalidErr := service.Authenticate(context.Background(), ticket+"x")
+
+	// Assert
+	assert.NoError(t, authErr)
+	assert.Equal(t, domain.Participant{UserID: 1, Username: "ana"}, *participant)
+	assert.True(t, expiresAt.After(time.Now()))
+	assert.ErrorIs(t, inactiveErr, domain.ErrUnauthorized)
+	assert.ErrorIs(t, invalidErr, domain.ErrInvalidTicket)
+}
+
+// TestRealtimeService_SubscribeAndPresence verifica que la suscripción trae la presencia de los demás
+// y que los cambios de presencia y la desconexión llegan a las otras sesiones
+func TestRealtimeService_SubscribeAndPresence(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTasks := mocks.NewMockTaskMutator(ctrl)
+	service := application.NewRealtimeService(mockTasks, mocks.NewMockUserDirectory(ctrl), mocks.NewMockUserAuthenticator(ctrl), testConfig)
+	mockTasks.EXPECT().GetTaskByID(gomock.Any(), 7).Return(&taskdomain.Task{ID: 7, Title: "Tarea"}, nil).Times(2)
+
+	ana := service.Connect(domain.Participant{UserID: 1, Username: "ana"})
+	bob := service.Connect(domain.Participant{UserID: 2, Username: "bob"})
+	assert.Equal(t, domain.MessageWelcome, nextMessage(t, ana).Type)
+	assert.Equal(t, domain.MessageWelcome, nextMessage(t, bob).Type)
+
+	// Act
+	service.HandleMessage(context.Background(), ana, []byte(`{"type":"subscribe","id":"1","task_ids":[7]}`))
+	service.HandleMessage(context.Background(), ana, []byte(`{"type":"presence","task_id":7,"state":"editing"}`))
+	service.HandleMessage(context.Background(), bob, []byte(`{"type":"subscribe","task_id":7}`))
+	anaSubscribed := nextMessage(t, ana)
+	bobSubscribed := nextMessage(t, bob)
+
+	service.HandleMessage(context.Background(), bob, []byte(`{"type":"presence","task_id":7,"state":"viewing"}`))
+	bobViewing := nextMessage(t, ana)
+	service.Disconnect(ana)
+	anaLeft := nextMessage(t, bob)
+
+	// Assert
+	assert.Equal(t, domain.MessageSubscribed, anaSubscribed.Type)
+	assert.Equal(t, "1", anaSubscribed.ID)
+	assert.Equal(t, "Tarea", anaSubscribed.Task.Title)
+	assert.Empty(t, anaSubscribed.Presence)
+
+	require.Len(t, bobSubscribed.Presence, 1)
+	assert.Equal(t, ana.ID, bobSubscribed.Presence[0].SessionID)
+	assert.Equal(t, domain.PresenceEditing, bobSubscribed.Presence[0].State)
+
+	assert.Equal(t, domain.MessagePresence, bobViewing.Type)
+	assert.Equal(t, "bob", bobViewing.User.Username)
+	assert.Equal(t, domain.PresenceViewing, bobViewing.State)
+
+	assert.Equal(t, domain.MessagePresence, anaLeft.Type)
+	assert.Equal(t, ana.ID, anaLeft.SessionID)
+	assert.Equal(t, domain.PresenceLeft, anaLeft.State)
+}
+
+// TestRealtimeService_PresenceRequiresSubscription verifica el error de presencia sin suscripción
+func TestRealtimeService_PresenceRequiresSubscription(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := application.NewRealtimeService(mocks.NewMockTaskMutator(ctrl), mocks.NewMockUserDirectory(ctrl), mocks.NewMockUserAuthenticator(ctrl), testConfig)
+	session := service.Connect(domain.Participant{UserID: 1, Username: "ana"})
+	nextMessage(t, session)
+
+	// Act
+	service.HandleMessage(context.Background(), session, []byte(`{"type":"presence","id":"p","task_id":7,"state":"editing"}`))
+	service.HandleMessage(context.Background(), session, []byte(`not json`))
+	notSubscribed := nextMessage(t, session)
+	invalid := nextMessage(t, session)
+
+	// Assert
+	assert.Equal(t, domain.MessageError, notSubscribed.Type)
+	assert.Equal(t, "p", notSubscribed.ID)
+	assert.Equal(t, domain.CodeNotSubscribed, notSubscribed.Code)
+	assert.Equal(t, domain.CodeInvalidMessage, invalid.Code)
+}
+
+// TestRealtimeService_Mutate verifica que un mutate modifica la tarea y que una versión esperada
+// desactualizada se responde con su código
+func TestRealtimeService_Mutate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTasks := mocks.NewMockTaskMutator(ctrl)
+	service := application.NewRealtimeService(mockTasks, mocks.NewMockUserDirectory(ctrl), mocks.NewMockUserAuthenticator(ctrl), testConfig)
+	session := service.Connect(domain.Participant{UserID: 1, Username: "ana"})
+	nextMessage(t, session)
+
+	mockTasks.EXPECT().PatchTask(gomock.Any(), 7, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, patch taskdomain.TaskPatch) (*taskdomain.Task, error) {
+			assert.Equal(t, "Nuevo", *patch.Title)
+			return &taskdomain.Task{ID: 7, Title: "Nuevo", Version: 4}, nil
+		})
+	mockTasks.EXPECT().MarkTaskAsCompleted(gomock.Any(), 7).
+		Return(nil, fmt.Errorf("%w: versión 3", taskdomain.ErrPreconditionFailed))
+
+	// Act
+	service.HandleMessage(context.Background(), session, []byte(`{"type":"mutate","id":"m1","task_id":7,"op":"update","version":3,"changes":{"title":"Nuevo"}}`))
+	service.HandleMessage(context.Background(), session, []byte(`{"type":"mutate","id":"m2","task_id":7,"op":"complete","version":3}`))
+	result := nextMessage(t, session)
+	failed := nextMessage(t, session)
+
+	// Assert
+	assert.Equal(t, domain.MessageResult, result.Type)
+	assert.Equal(t, "m1", result.ID)
+	assert.Equal(t, 4, result.Task.Version)
+	assert.Equal(t, domain.MessageError, failed.Type)
+	assert.Equal(t, "m2", failed.ID)
+	assert.Equal(t, domain.CodePreconditionFailed, failed.Code)
+}
+
+// TestRealtimeService_HandleEvent_DropsSlowConsumer verifica que los eventos llegan a los suscriptores
+// y que una sesión que no lee a tiempo se corta sin bloquear a las demás
+func TestRealtimeService_HandleEvent_DropsSlowConsumer(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTasks := mocks.NewMockTaskMutator(ctrl)
+	service := application.NewRealtimeService(mockTasks, mocks.NewMockUserDirectory(ctrl), mocks.NewMockUserAuthenticator(ctrl),
+		application.RealtimeConfig{TicketTTL: time.Minute, SendBuffer: 2})
+	mockTasks.EXPECT().GetTaskByID(gomock.Any(), 7).Return(&taskdomain.Task{ID: 7}, nil).Times(2)
+
+	slow := service.Connect(domain.Participant{UserID: 1, Username: "ana"})
+	fast := service.Connect(domain.Participant{UserID: 2, Username: "bob"})
+	service.HandleMessage(context.Background(), slow, []byte(`{"type":"subscribe","task_id":7}`))
+	service.HandleMessage(context.Background(), fast, []byte(`{"type":"subscribe","task_id":7}`))
+	nextMessage(t, fast)
+	nextMessage(t, fast)
+
+	// Act
+	for range 3 {
+		assert.NoError(t, service.HandleEvent(context.Background(), taskdomain.Event{Type: taskdomain.EventTaskUpdated, TaskID: 7}))
+		change := nextMessage(t, fast)
+		assert.Equal(t, domain.MessageChange, change.Type)
+		assert.Equal(t, taskdomain.EventTaskUpdated, change.Event.Type)
+	}
+
+	// Assert
+	select {
+	case <-slow.Done():
+	default:
+		t.Fatal("la sesión lenta debió cortarse")
+	}
+	select {
+	case <-fast.Done():
+		t.Fatal("la sesión que lee a tiempo no debió cortarse")
+	default:
+	}
+}
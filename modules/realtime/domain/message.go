@@ -0,0 +1,125 @@
+package domain
+
+import (
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// Tipos de mensaje que envía el cliente
+const (
+	// MessageSubscribe suscribe la conexión a las tareas de TaskIDs
+	MessageSubscribe = "subscribe"
+	// MessageUnsubscribe quita la suscripción a las tareas de TaskIDs
+	MessageUnsubscribe = "unsubscribe"
+	// MessagePresence anuncia el estado del usuario en una tarea suscrita
+	MessagePresence = "presence"
+	// MessageMutate modifica una tarea
+	MessageMutate = "mutate"
+	// MessagePing pide un pong para comprobar la conexión
+	MessagePing = "ping"
+)
+
+// Tipos de mensaje que envía el servidor
+const (
+	// MessageWelcome se envía al conectarse, con el usuario autenticado
+	MessageWelcome = "welcome"
+	// MessageSubscribed confirma una suscripción con el estado de la tarea y la presencia actual
+	MessageSubscribed = "subscribed"
+	// MessageUnsubscribed confirma que se quitó una suscripción
+	MessageUnsubscribed = "unsubscribed"
+	// MessageChange es un evento de una tarea suscrita
+	MessageChange = "change"
+	// MessageResult es la tarea resultante de un mutate
+	MessageResult = "result"
+	// MessageError es el error de un mensaje del cliente
+	MessageError = "error"
+	// MessagePong responde a un ping
+	MessagePong = "pong"
+)
+
+// Estados de presencia
+const (
+	// PresenceViewing indica que el usuario tiene la tarea abierta
+	PresenceViewing = "viewing"
+	// PresenceEditing indica que el usuario está editando la tarea
+	PresenceEditing = "editing"
+	// PresenceLeft lo envía el servidor cuando la conexión deja la tarea o se cierra
+	PresenceLeft = "left"
+)
+
+// Operaciones de un mensaje mutate
+const (
+	// OpUpdate aplica los cambios de Changes
+	OpUpdate = "update"
+	// OpComplete completa la tarea
+	OpComplete = "complete"
+	// OpReopen vuelve a dejar pendiente la tarea
+	OpReopen = "reopen"
+	// OpDelete mueve la tarea a la papelera
+	OpDelete = "delete"
+)
+
+// Códigos de los mensajes de error
+const (
+	// CodeInvalidMessage indica un mensaje mal formado o de un tipo desconocido
+	CodeInvalidMessage = "invalid_message"
+	// CodeNotSubscribed indica una presencia en una tarea a la que la conexión no está suscrita
+	CodeNotSubscribed = "not_subscribed"
+	// CodeTooManySubscriptions indica que la conexión alcanzó el máximo de suscripciones
+	CodeTooManySubscriptions = "too_many_subscriptions"
+	// CodePreconditionFailed indica que la tarea no está en la versión indicada
+	CodePreconditionFailed = "precondition_failed"
+	// CodeConflict indica que otra escritura cambió la tarea al mismo tiempo
+	CodeConflict = "conflict"
+	// CodeFailed indica cualquier otro error al obtener o modificar la tarea
+	CodeFailed = "failed"
+)
+
+// Participant es el usuario autenticado de una conexión
+type Participant struct {
+	UserID   int    `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// Presence es el estado de una conexión en una tarea
+type Presence struct {
+	TaskID    int         `json:"task_id"`
+	SessionID uint64      `json:"session_id"`
+	User      Participant `json:"user"`
+	State     string      `json:"state"`
+}
+
+// TaskChanges son los campos que modifica un mutate update; un campo nil no se modifica
+type TaskChanges struct {
+	Title       *string `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Completed   *bool   `json:"completed,omitempty"`
+}
+
+// ClientMessage es un mensaje del cliente
+type ClientMessage struct {
+	Type string `json:"type"`
+	// ID lo elige el cliente para relacionar la respuesta con el mensaje
+	ID      string `json:"id,omitempty"`
+	TaskIDs []int  `json:"task_ids,omitempty"`
+	TaskID  int    `json:"task_id,omitempty"`
+	State   string `json:"state,omitempty"`
+	Op      string `json:"op,omitempty"`
+	// Version es la versión esperada de la tarea, como If-Match; 0 no la exige
+	Version int          `json:"version,omitempty"`
+	Changes *TaskChanges `json:"changes,omitempty"`
+}
+
+// ServerMessage es un mensaje del servidor
+type ServerMessage struct {
+	Type      string            `json:"type"`
+	ID        string            `json:"id,omitempty"`
+	SessionID uint64            `json:"session_id,omitempty"`
+	TaskID    int               `json:"task_id,omitempty"`
+	User      *Participant      `json:"user,omitempty"`
+	State     string            `json:"state,omitempty"`
+	Task      *taskdomain.Task  `json:"task,omitempty"`
+	Presence  []Presence        `json:"presence,omitempty"`
+	Event     *taskdomain.Event `json:"event,omitempty"`
+	Code      string            `json:"code,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
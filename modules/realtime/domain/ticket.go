@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidTicket indica un ticket de conexión mal formado o con una firma que no corresponde
+	ErrInvalidTicket = errors.New("el ticket de conexión no es válido")
+	// ErrTicketExpired indica un ticket de conexión vencido
+	ErrTicketExpired = errors.New("el ticket de conexión está vencido")
+	// ErrUnauthorized indica que el usuario no existe o está inactivo
+	ErrUnauthorized = errors.New("el usuario no puede conectarse")
+)
+
+// IssueTicket firma un ticket de conexión para el usuario que vence en expiresAt. El ticket es
+// "<user_id>.<vencimiento unix>.<firma>", con la firma HMAC-SHA256 en base64url
+func IssueTicket(secret []byte, userID int, expiresAt time.Time) string {
+	payload := fmt.Sprintf("%d.%d", userID, expiresAt.Unix())
+	return payload + "." + signTicket(secret, payload)
+}
+
+// ParseTicket verifica la firma y el vencimiento del ticket y retorna el ID del usuario
+func ParseTicket(secret []byte, ticket string, now time.Time) (int, error) {
+	parts := strings.Split(ticket, ".")
+	if len(parts) != 3 {
+		return 0, ErrInvalidTicket
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(signTicket(secret, payload))) {
+		return 0, ErrInvalidTicket
+	}
+
+	userID, err := strconv.Atoi(parts[0])
+	if err != nil || userID <= 0 {
+		return 0, ErrInvalidTicket
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, ErrInvalidTicket
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return 0, ErrTicketExpired
+	}
+	return userID, nil
+}
+
+// signTicket calcula la firma del contenido de un ticket
+func signTicket(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
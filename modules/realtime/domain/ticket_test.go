@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicket_RoundTrip(t *testing.T) {
+	secret := []byte("secreto")
+	now := time.Now()
+	ticket := IssueTicket(secret, 7, now.Add(time.Minute))
+
+	userID, err := ParseTicket(secret, ticket, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, userID)
+
+	_, err = ParseTicket(secret, ticket, now.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrTicketExpired)
+}
+
+func TestTicket_RejectsTampering(t *testing.T) {
+	secret := []byte("secreto")
+	now := time.Now()
+	ticket := IssueTicket(secret, 7, now.Add(time.Minute))
+
+	tests := []string{
+		"",
+		"7.123",
+		IssueTicket([]byte("otro"), 7, now.Add(time.Minute)),
+		"8" + ticket[1:],
+		IssueTicket(secret, 0, now.Add(time.Minute)),
+	}
+	for _, tampered := range tests {
+		_, err := ParseTicket(secret, tampered, now)
+		assert.ErrorIs(t, err, ErrInvalidTicket, tampered)
+	}
+}
@@ -0,0 +1,197 @@
+package presentation
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/domain"
+	"github.com/coder/websocket"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// basicAuthRealm es el realm que se anuncia en WWW-Authenticate al rechazar un pedido de ticket
+	basicAuthRealm = `Basic realm="realtime", charset="UTF-8"`
+	// maxMessageSize es el tamaño máximo de un mensaje del cliente
+	maxMessageSize = 64 << 10
+	// writeTimeout es el tiempo máximo para enviar un mensaje al cliente
+	writeTimeout = 10 * time.Second
+	// pingInterval es cada cuánto se comprueba que el cliente siga conectado
+	pingInterval = 30 * time.Second
+)
+
+// RealtimeHandler maneja el socket de edición colaborativa
+type RealtimeHandler struct {
+	realtimeService application.RealtimeServiceInterface
+	originPatterns  []string
+}
+
+// NewRealtimeHandler crea una nueva instancia del handler del socket; originPatterns son los
+// orígenes permitidos además del propio host (por ejemplo app.example.com o *.example.com)
+func NewRealtimeHandler(realtimeService application.RealtimeServiceInterface, originPatterns []string) *RealtimeHandler {
+	return &RealtimeHandler{
+		realtimeService: realtimeService,
+		originPatterns:  originPatterns,
+	}
+}
+
+// parseBasicAuth interpreta las credenciales de una cabecera Authorization con el esquema Basic
+func parseBasicAuth(header string) (username, password string, err error) {
+	scheme, encoded, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Basic") {
+		return "", "", errors.New("the Authorization header with Basic credentials is required")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return "", "", errors.New("the Basic credentials are not valid base64")
+	}
+	username, password, found = strings.Cut(string(decoded), ":")
+	if !found {
+		return "", "", errors.New("the Basic credentials must be username:password")
+	}
+	return username, password, nil
+}
+
+// serveSession atiende la conexión hasta que el cliente la cierre o el hub corte la sesión. Los
+// mensajes del cliente se procesan en orden; los del servidor los envía una goroutine aparte
+func serveSession(ctx context.Context, conn *websocket.Conn, service application.RealtimeServiceInterface, session *application.Session) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer conn.CloseNow()
+	defer service.Disconnect(session)
+
+	conn.SetReadLimit(maxMessageSize)
+	go writeSession(ctx, cancel, conn, session)
+
+	for {
+		messageType, data, err := conn.Read(ctx)
+		if err != nil {
+			return
+		}
+		if messageType != websocket.MessageText {
+			_ = conn.Close(websocket.StatusUnsupportedData, "messages must be JSON text")
+			return
+		}
+		service.HandleMessage(ctx, session, data)
+	}
+}
+
+// writeSession envía al cliente los mensajes de la sesión y los pings de control
+func writeSession(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, session *application.Session) {
+	defer cancel()
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-session.Done():
+			// El cliente no leyó a tiempo: puede reconectarse y volver a suscribirse
+			_ = conn.Close(websocket.StatusTryAgainLater, "client too slow")
+			return
+		case data := <-session.Outbound():
+			writeCtx, cancelWrite := context.WithTimeout(ctx, writeTimeout)
+			err := conn.Write(writeCtx, websocket.MessageText, data)
+			cancelWrite()
+			if err != nil {
+				return
+			}
+		case <-ticker.C:
+			pingCtx, cancelPing := context.WithTimeout(ctx, writeTimeout)
+			err := conn.Ping(pingCtx)
+			cancelPing()
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// IssueTicket emite un ticket de conexión para el usuario de las credenciales Basic
+// @Summary Emite un ticket de conexión al socket
+// @Description Requiere usuario y contraseña con HTTP Basic. El ticket se envía en ?ticket= al conectarse; vence a los pocos segundos
+// @Tags realtime
+// @Produce json
+// @Param Authorization header string true "Credenciales Basic del usuario"
+// @Success 201 {object} gin.H
+// @Failure 401 {object} gin.H
+// @Router /realtime/tickets [post]
+func (h *RealtimeHandler) IssueTicket(c *gin.Context) {
+	username, password, err := parseBasicAuth(c.GetHeader("Authorization"))
+	if err != nil {
+		c.Header("WWW-Authenticate", basicAuthRealm)
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error":   "Unauthorized",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ticket, expiresAt, err := h.realtimeService.IssueTicket(c.Request.Context(), username, password)
+	if err != nil {
+		if realtimeErrorStatus(err) == http.StatusUnauthorized {
+			c.Header("WWW-Authenticate", basicAuthRealm)
+		}
+		c.JSON(realtimeErrorStatus(err), gin.H{
+			"error":   "Error issuing ticket",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Ticket issued successfully",
+		"data":    gin.H{"ticket": ticket, "expires_at": expiresAt},
+	})
+}
+
+// Connect abre el socket de edición colaborativa
+// @Summary Socket de edición colaborativa
+// @Description WebSocket con mensajes JSON: subscribe, unsubscribe, presence, mutate y ping
+// @Tags realtime
+// @Param ticket query string true "Ticket de conexión"
+// @Success 101 {string} string
+// @Failure 401 {object} gin.H
+// @Router /realtime/ws [get]
+func (h *RealtimeHandler) Connect(c *gin.Context) {
+	participant, err := h.realtimeService.Authenticate(c.Request.Context(), c.Query("ticket"))
+	if err != nil {
+		c.JSON(realtimeErrorStatus(err), gin.H{
+			"error":   "Unauthorized",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	// Accept responde el error del handshake si la petición no es un upgrade válido
+	conn, err := websocket.Accept(upgradeWriter(c.Writer), c.Request, &websocket.AcceptOptions{OriginPatterns: h.originPatterns})
+	if err != nil {
+		return
+	}
+	session := h.realtimeService.Connect(*participant)
+	serveSession(c.Request.Context(), conn, h.realtimeService, session)
+}
+
+// upgradeWriter retorna el ResponseWriter de net/http que envuelve Gin: el de Gin no permite Hijack
+// después de enviar el 101 del handshake
+func upgradeWriter(w gin.ResponseWriter) http.ResponseWriter {
+	if unwrapper, ok := w.(interface{ Unwrap() http.ResponseWriter }); ok {
+		return unwrapper.Unwrap()
+	}
+	return w
+}
+
+// realtimeErrorStatus traduce los errores de autenticación a códigos HTTP
+func realtimeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTicket), errors.Is(err, domain.ErrTicketExpired), errors.Is(err, domain.ErrUnauthorized):
+		return http.StatusUnauthorized
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,140 @@
+package presentation
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application"
+	"github.com/coder/websocket"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberRealtimeHandler maneja el socket de edición colaborativa con Fiber
+type FiberRealtimeHandler struct {
+	realtimeService application.RealtimeServiceInterface
+	originPatterns  []string
+}
+
+// NewFiberRealtimeHandler crea una nueva instancia del handler del socket con Fiber
+func NewFiberRealtimeHandler(realtimeService application.RealtimeServiceInterface, originPatterns []string) *FiberRealtimeHandler {
+	return &FiberRealtimeHandler{
+		realtimeService: realtimeService,
+		originPatterns:  originPatterns,
+	}
+}
+
+// IssueTicket emite un ticket de conexión para el usuario de las credenciales Basic con Fiber
+func (h *FiberRealtimeHandler) IssueTicket(c *fiber.Ctx) error {
+	username, password, err := parseBasicAuth(c.Get(fiber.HeaderAuthorization))
+	if err != nil {
+		c.Set(fiber.HeaderWWWAuthenticate, basicAuthRealm)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error":   "Unauthorized",
+			"message": err.Error(),
+		})
+	}
+
+	ticket, expiresAt, err := h.realtimeService.IssueTicket(c.Context(), username, password)
+	if err != nil {
+		if realtimeErrorStatus(err) == fiber.StatusUnauthorized {
+			c.Set(fiber.HeaderWWWAuthenticate, basicAuthRealm)
+		}
+		return c.Status(realtimeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error issuing ticket",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Ticket issued successfully",
+		"data":    fiber.Map{"ticket": ticket, "expires_at": expiresAt},
+	})
+}
+
+// Connect abre el socket de edición colaborativa con Fiber. fasthttp no expone un
+// http.ResponseWriter, así que el handshake se hace sobre la conexión tomada con Hijack
+func (h *FiberRealtimeHandler) Connect(c *fiber.Ctx) error {
+	participant, err := h.realtimeService.Authenticate(c.Context(), c.Query("ticket"))
+	if err != nil {
+		return c.Status(realtimeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Unauthorized",
+			"message": err.Error(),
+		})
+	}
+
+	// Los valores del contexto de Fiber solo valen dentro del handler: la petición se copia antes
+	req, err := http.NewRequest(c.Method(), strings.Clone(c.OriginalURL()), nil)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	req.Host = string(c.Request().Host())
+	c.Request().Header.VisitAll(func(key, value []byte) {
+		req.Header.Add(string(key), string(value))
+	})
+
+	c.Context().HijackSetNoResponse(true)
+	c.Context().Hijack(func(netConn net.Conn) {
+		conn, err := websocket.Accept(newHijackedResponseWriter(netConn), req, &websocket.AcceptOptions{OriginPatterns: h.originPatterns})
+		if err != nil {
+			return
+		}
+		session := h.realtimeService.Connect(*participant)
+		serveSession(context.Background(), conn, h.realtimeService, session)
+	})
+	return nil
+}
+
+// hijackedResponseWriter es un http.ResponseWriter mínimo sobre una conexión tomada de fasthttp,
+// suficiente para el handshake de websocket.Accept y sus respuestas de error
+type hijackedResponseWriter struct {
+	conn        net.Conn
+	bw          *bufio.Writer
+	header      http.Header
+	wroteHeader bool
+}
+
+func newHijackedResponseWriter(conn net.Conn) *hijackedResponseWriter {
+	return &hijackedResponseWriter{conn: conn, bw: bufio.NewWriter(conn), header: make(http.Header)}
+}
+
+// Header retorna las cabeceras de la respuesta
+func (w *hijackedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+// WriteHeader escribe la línea de estado y las cabeceras; fuera del upgrade la conexión se cierra al terminar
+func (w *hijackedResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if status != http.StatusSwitchingProtocols {
+		w.header.Set("Connection", "close")
+	}
+	fmt.Fprintf(w.bw, "HTTP/1.1 %d %s\r\n", status, http.StatusText(status))
+	_ = w.header.Write(w.bw)
+	_, _ = w.bw.WriteString("\r\n")
+	_ = w.bw.Flush()
+}
+
+// Write escribe el cuerpo de una respuesta de error
+func (w *hijackedResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	n, err := w.bw.Write(b)
+	if err != nil {
+		return n, err
+	}
+	return n, w.bw.Flush()
+}
+
+// Hijack entrega la conexión a websocket.Accept
+func (w *hijackedResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return w.conn, bufio.NewReadWriter(bufio.NewReader(w.conn), w.bw), nil
+}
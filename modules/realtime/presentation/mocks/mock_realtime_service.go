@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=../presentation/mocks/mock_realtime_service.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	application "github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application"
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRealtimeServiceInterface is a mock of RealtimeServiceInterface interface.
+type MockRealtimeServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockRealtimeServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockRealtimeServiceInterfaceMockRecorder is the mock recorder for MockRealtimeServiceInterface.
+type MockRealtimeServiceInterfaceMockRecorder struct {
+	mock *MockRealtimeServiceInterface
+}
+
+// NewMockRealtimeServiceInterface creates a new mock instance.
+func NewMockRealtimeServiceInterface(ctrl *gomock.Controller) *MockRealtimeServiceInterface {
+	mock := &MockRealtimeServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockRealtimeServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRealtimeServiceInterface) EXPECT() *MockRealtimeServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockRealtimeServiceInterface) Authenticate(ctx context.Context, ticket string) (*domain.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, ticket)
+	ret0, _ := ret[0].(*domain.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockRealtimeServiceInterfaceMockRecorder) Authenticate(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockRealtimeServiceInterface)(nil).Authenticate), ctx, ticket)
+}
+
+// Connect mocks base method.
+func (m *MockRealtimeServiceInterface) Connect(participant domain.Participant) *application.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Connect", participant)
+	ret0, _ := ret[0].(*application.Session)
+	return ret0
+}
+
+// Connect indicates an expected call of Connect.
+func (mr *MockRealtimeServiceInterfaceMockRecorder) Connect(participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Connect", reflect.TypeOf((*MockRealtimeServiceInterface)(nil).Connect), participant)
+}
+
+// Disconnect mocks base method.
+func (m *MockRealtimeServiceInterface) Disconnect(session *application.Session) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Disconnect", session)
+}
+
+// Disconnect indicates an expected call of Disconnect.
+func (mr *MockRealtimeServiceInterfaceMockRecorder) Disconnect(session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockRealtimeServiceInterface)(nil).Disconnect), session)
+}
+
+// HandleMessage mocks base method.
+func (m *MockRealtimeServiceInterface) HandleMessage(ctx context.Context, session *application.Session, data []byte) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandleMessage", ctx, session, data)
+}
+
+// HandleMessage indicates an expected call of HandleMessage.
+func (mr *MockRealtimeServiceInterfaceMockRecorder) HandleMessage(ctx, session, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleMessage", reflect.TypeOf((*MockRealtimeServiceInterface)(nil).HandleMessage), ctx, session, data)
+}
+
+// IssueTicket mocks base method.
+func (m *MockRealtimeServiceInterface) IssueTicket(ctx context.Context, username, password string) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueTicket", ctx, username, password)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// IssueTicket indicates an expected call of IssueTicket.
+func (mr *MockRealtimeServiceInterfaceMockRecorder) IssueTicket(ctx, username, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueTicket", reflect.TypeOf((*MockRealtimeServiceInterface)(nil).IssueTicket), ctx, username, password)
+}
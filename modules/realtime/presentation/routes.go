@@ -0,0 +1,17 @@
+package presentation
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// SetupRealtimeRoutes configura las rutas del socket de edición colaborativa
+func SetupRealtimeRoutes(router *gin.Engine, realtimeHandler *RealtimeHandler) {
+	realtimeGroup := router.Group("/api/v1/realtime")
+	{
+		// POST /api/v1/realtime/tickets - Emitir un ticket de conexión
+		realtimeGroup.POST("/tickets", realtimeHandler.IssueTicket)
+
+		// GET /api/v1/realtime/ws?ticket= - Abrir el socket
+		realtimeGroup.GET("/ws", realtimeHandler.Connect)
+	}
+}
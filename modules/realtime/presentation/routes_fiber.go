@@ -0,0 +1,13 @@
+package presentation
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupRealtimeRoutesFiber configura las rutas del socket de edición colaborativa para Fiber
+func SetupRealtimeRoutesFiber(app *fiber.App, handler *FiberRealtimeHandler) {
+	realtime := app.Group("/realtime")
+
+	realtime.Post("/tickets", handler.IssueTicket)
+	realtime.Get("/ws", handler.Connect)
+}
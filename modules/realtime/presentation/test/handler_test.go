@@ -0,0 +1,225 @@
+package presentation_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application"
+	appmocks "github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/realtime/presentation/mocks"
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+	"github.com/coder/websocket"
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// setupRealtimeRouter crea un router de Gin con las rutas del socket y el servicio indicado
+func setupRealtimeRouter(service application.RealtimeServiceInterface) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupRealtimeRoutes(router, presentation.NewRealtimeHandler(service, nil))
+	return router
+}
+
+// TestRealtimeHandler_IssueTicket verifica el ticket emitido con credenciales Basic y el 401 sin
+// credenciales, con credenciales mal formadas o rechazadas
+func TestRealtimeHandler_IssueTicket(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mocks.NewMockRealtimeServiceInterface(ctrl)
+	router := setupRealtimeRouter(mockService)
+
+	expiresAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockService.EXPECT().IssueTicket(gomock.Any(), "ana", "secreta").Return("1.123.sig", expiresAt, nil)
+	mockService.EXPECT().IssueTicket(gomock.Any(), "bob", "otra").Return("", time.Time{}, domain.ErrUnauthorized)
+
+	issue := func(authorization string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("POST", "/api/v1/realtime/tickets", nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	ok := issue("Basic " + base64.StdEncoding.EncodeToString([]byte("ana:secreta")))
+	rejected := issue("Basic " + base64.StdEncoding.EncodeToString([]byte("bob:otra")))
+	missing := issue("")
+	malformed := issue("Basic " + base64.StdEncoding.EncodeToString([]byte("ana")))
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, ok.Code)
+	assert.Contains(t, ok.Body.String(), `"ticket":"1.123.sig"`)
+	assert.Contains(t, ok.Body.String(), `"expires_at":"2026-01-01T00:00:00Z"`)
+	assert.Equal(t, http.StatusUnauthorized, rejected.Code)
+	assert.Contains(t, rejected.Header().Get("WWW-Authenticate"), "Basic")
+	assert.Equal(t, http.StatusUnauthorized, missing.Code)
+	assert.Equal(t, http.StatusUnauthorized, malformed.Code)
+}
+
+// TestRealtimeHandler_Connect_RejectsInvalidTicket verifica que sin un ticket válido no se abre el socket
+func TestRealtimeHandler_Connect_RejectsInvalidTicket(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mocks.NewMockRealtimeServiceInterface(ctrl)
+	router := setupRealtimeRouter(mockService)
+
+	mockService.EXPECT().Authenticate(gomock.Any(), "bad").Return(nil, domain.ErrInvalidTicket)
+
+	req, _ := http.NewRequest("GET", "/api/v1/realtime/ws?ticket=bad", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// readMessage lee el siguiente mensaje del socket
+func readMessage(t *testing.T, ctx context.Context, conn *websocket.Conn) domain.ServerMessage {
+	t.Helper()
+	_, data, err := conn.Read(ctx)
+	require.NoError(t, err)
+	var message domain.ServerMessage
+	require.NoError(t, json.Unmarshal(data, &message))
+	return message
+}
+
+// TestRealtimeHandler_Collaboration verifica de punta a punta dos clientes en la misma tarea: la
+// presencia de uno llega al otro, un mutate responde con el resultado y los eventos llegan a ambos
+func TestRealtimeHandler_Collaboration(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockTasks := appmocks.NewMockTaskMutator(ctrl)
+	mockUsers := appmocks.NewMockUserDirectory(ctrl)
+	mockAuth := appmocks.NewMockUserAuthenticator(ctrl)
+	service := application.NewRealtimeService(mockTasks, mockUsers, mockAuth,
+		application.RealtimeConfig{TicketTTL: time.Minute, SendBuffer: 16})
+	server := httptest.NewServer(setupRealtimeRouter(service))
+	defer server.Close()
+
+	users := map[int]*userdomain.User{
+		1: {ID: 1, Username: "ana", Active: true},
+		2: {ID: 2, Username: "bob", Active: true},
+	}
+	for id, user := range users {
+		mockAuth.EXPECT().AuthenticateUser(gomock.Any(), user.Username, "secreta").Return(user, nil).AnyTimes()
+		mockUsers.EXPECT().GetByID(gomock.Any(), id).Return(user, nil).AnyTimes()
+	}
+	mockTasks.EXPECT().GetTaskByID(gomock.Any(), 7).Return(&taskdomain.Task{ID: 7, Title: "Tarea", Version: 1}, nil).Times(2)
+	mockTasks.EXPECT().MarkTaskAsCompleted(gomock.Any(), 7).Return(&taskdomain.Task{ID: 7, Completed: true, Version: 2}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	dial := func(userID int) *websocket.Conn {
+		ticket, _, err := service.IssueTicket(ctx, users[userID].Username, "secreta")
+		require.NoError(t, err)
+		url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/realtime/ws?ticket=" + ticket
+		conn, _, err := websocket.Dial(ctx, url, nil)
+		require.NoError(t, err)
+		welcome := readMessage(t, ctx, conn)
+		require.Equal(t, domain.MessageWelcome, welcome.Type)
+		require.Equal(t, userID, welcome.User.UserID)
+		return conn
+	}
+	ana := dial(1)
+	defer ana.CloseNow()
+	bob := dial(2)
+	defer bob.CloseNow()
+
+	// Act
+	require.NoError(t, ana.Write(ctx, websocket.MessageText, []byte(`{"type":"subscribe","task_id":7}`)))
+	anaSubscribed := readMessage(t, ctx, ana)
+	require.NoError(t, bob.Write(ctx, websocket.MessageText, []byte(`{"type":"subscribe","task_id":7}`)))
+	readMessage(t, ctx, bob)
+
+	require.NoError(t, bob.Write(ctx, websocket.MessageText, []byte(`{"type":"presence","task_id":7,"state":"editing"}`)))
+	bobEditing := readMessage(t, ctx, ana)
+
+	require.NoError(t, ana.Write(ctx, websocket.MessageText, []byte(`{"type":"mutate","id":"m1","task_id":7,"op":"complete","version":1}`)))
+	result := readMessage(t, ctx, ana)
+	require.NoError(t, service.HandleEvent(ctx, taskdomain.Event{Type: taskdomain.EventTaskCompleted, TaskID: 7, Actor: "ana"}))
+	anaChange := readMessage(t, ctx, ana)
+	bobChange := readMessage(t, ctx, bob)
+
+	require.NoError(t, bob.Close(websocket.StatusNormalClosure, ""))
+	bobLeft := readMessage(t, ctx, ana)
+
+	// Assert
+	assert.Equal(t, domain.MessageSubscribed, anaSubscribed.Type)
+	assert.Equal(t, "Tarea", anaSubscribed.Task.Title)
+	assert.Equal(t, domain.MessagePresence, bobEditing.Type)
+	assert.Equal(t, "bob", bobEditing.User.Username)
+	assert.Equal(t, domain.PresenceEditing, bobEditing.State)
+	assert.Equal(t, domain.MessageResult, result.Type)
+	assert.True(t, result.Task.Completed)
+	assert.Equal(t, domain.MessageChange, anaChange.Type)
+	assert.Equal(t, taskdomain.EventTaskCompleted, bobChange.Event.Type)
+	assert.Equal(t, "ana", bobChange.Event.Actor)
+	assert.Equal(t, domain.PresenceLeft, bobLeft.State)
+}
+
+// TestFiberRealtimeHandler_Connect verifica el handshake sobre la conexión tomada de fasthttp: el socket
+// responde a un ping y un ticket inválido se rechaza con 401
+func TestFiberRealtimeHandler_Connect(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUsers := appmocks.NewMockUserDirectory(ctrl)
+	mockAuth := appmocks.NewMockUserAuthenticator(ctrl)
+	service := application.NewRealtimeService(appmocks.NewMockTaskMutator(ctrl), mockUsers, mockAuth,
+		application.RealtimeConfig{TicketTTL: time.Minute, SendBuffer: 16})
+	ana := &userdomain.User{ID: 1, Username: "ana", Active: true}
+	mockAuth.EXPECT().AuthenticateUser(gomock.Any(), "ana", "secreta").Return(ana, nil)
+	mockUsers.EXPECT().GetByID(gomock.Any(), 1).Return(ana, nil).AnyTimes()
+
+	app := fiber.New(fiber.Config{DisableStartupMessage: true})
+	presentation.SetupRealtimeRoutesFiber(app, presentation.NewFiberRealtimeHandler(service, nil))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = app.Listener(listener) }()
+	defer func() { _ = app.Shutdown() }()
+	baseURL := "ws://" + listener.Addr().String() + "/realtime/ws?ticket="
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ticket, _, err := service.IssueTicket(ctx, "ana", "secreta")
+	require.NoError(t, err)
+
+	// Act
+	conn, _, err := websocket.Dial(ctx, baseURL+ticket, nil)
+	require.NoError(t, err)
+	defer conn.CloseNow()
+	welcome := readMessage(t, ctx, conn)
+	require.NoError(t, conn.Write(ctx, websocket.MessageText, []byte(`{"type":"ping","id":"p1"}`)))
+	pong := readMessage(t, ctx, conn)
+	_, rejected, rejectErr := websocket.Dial(ctx, baseURL+"bad", nil)
+
+	// Assert
+	assert.Equal(t, domain.MessageWelcome, welcome.Type)
+	assert.Equal(t, "ana", welcome.User.Username)
+	assert.Equal(t, domain.MessagePong, pong.Type)
+	assert.Equal(t, "p1", pong.ID)
+	assert.Error(t, rejectErr)
+	require.NotNil(t, rejected)
+	assert.Equal(t, http.StatusUnauthorized, rejected.StatusCode)
+}
@@ -0,0 +1,132 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// ArchiveTask archiva una tarea completada; queda de solo lectura hasta desarchivarla
+func (s *TaskService) ArchiveTask(ctx context.Context, id int) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	// La verificación y el archivado van en una transacción
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !task.Completed {
+			return nil, fmt.Errorf("no se pudo archivar la tarea %d: %w", id, domain.ErrArchiveNotCompleted)
+		}
+
+		if err := s.archiveTask(ctx, task); err != nil {
+			return nil, err
+		}
+		return s.taskRepo.GetByID(ctx, id)
+	})
+}
+
+// UnarchiveTask saca una tarea del archivo para que vuelva a ser editable
+func (s *TaskService) UnarchiveTask(ctx context.Context, id int) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	// El desarchivado se guarda como una actualización, con su revisión y su evento
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.taskRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", id, err)
+		}
+		return s.touchTask(ctx, task, func(ctx context.Context) error {
+			if err := s.taskRepo.Unarchive(ctx, id); err != nil {
+				return fmt.Errorf("no se pudo desarchivar la tarea %d: %w", id, err)
+			}
+			return nil
+		})
+	})
+}
+
+// ArchiveCompletedTasks archiva las tareas completadas hace más de days días y retorna cuántas archivó
+func (s *TaskService) ArchiveCompletedTasks(ctx context.Context, days int) (int, error) {
+	if days < 0 {
+		return 0, fmt.Errorf("la cantidad de días no puede ser negativa")
+	}
+
+	before := time.Now().UTC().AddDate(0, 0, -days)
+
+	// Cada tarea se archiva con su revisión y su evento, todas en una transacción
+	return inTx(ctx, s, func(ctx context.Context) (int, error) {
+		tasks, err := s.taskRepo.GetCompletedBefore(ctx, before)
+		if err != nil {
+			return 0, fmt.Errorf("no se pudieron obtener las tareas completadas: %w", err)
+		}
+		for _, task := range tasks {
+			if err := s.archiveTask(ctx, task); err != nil {
+				return 0, err
+			}
+		}
+		return len(tasks), nil
+	})
+}
+
+// archiveTask archiva una tarea completada y lo registra como una actualización, con su revisión y
+// su evento. La actualización se guarda antes de archivar, porque las tareas archivadas no se modifican
+func (s *TaskService) archiveTask(ctx context.Context, task *domain.Task) error {
+	before := *task
+	now := time.Now().UTC()
+	task.ArchivedAt = &now
+	task.RecordEvent(domain.EventTaskUpdated)
+
+	if _, err := s.saveTask(ctx, &before, task, domain.RevisionUpdate); err != nil {
+		return fmt.Errorf("no se pudo archivar la tarea %d: %w", task.ID, err)
+	}
+	if err := s.taskRepo.Archive(ctx, task.ID); err != nil {
+		return fmt.Errorf("no se pudo archivar la tarea %d: %w", task.ID, err)
+	}
+	return nil
+}
+
+// RunAutoArchiver archiva cada interval las tareas completadas hace más de days días
+// hasta que se cancele ctx
+func (s *TaskService) RunAutoArchiver(ctx context.Context, days int, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			archived, err := s.ArchiveCompletedTasks(ctx, days)
+			if err != nil {
+				log.Printf("Error archivando tareas completadas: %v", err)
+				continue
+			}
+			if archived > 0 {
+				log.Printf("Archivado automático: %d tareas archivadas", archived)
+			}
+		}
+	}
+}
+
+// getWritableTask obtiene una tarea y verifica que no esté archivada
+func (s *TaskService) getWritableTask(ctx context.Context, id int) (*domain.Task, error) {
+	task, err := s.taskRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", id, err)
+	}
+	if err := task.EnsureWritable(); err != nil {
+		return nil, fmt.Errorf("no se puede modificar la tarea %d: %w", id, err)
+	}
+	if err := checkExpectedVersion(ctx, task); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
@@ -0,0 +1,116 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+)
+
+// WithAssignments habilita la asignación de tareas a usuarios, que se validan con userRepo
+func WithAssignments(repo domain.AssignmentRepository, userRepo userdomain.UserRepository) TaskServiceOption {
+	return func(s *TaskService) {
+		s.assignmentRepo = repo
+		s.userRepo = userRepo
+	}
+}
+
+// AssignTask asigna uno o más usuarios activos a una tarea
+func (s *TaskService) AssignTask(ctx context.Context, taskID int, userIDs []int) (*domain.Task, error) {
+	if s.assignmentRepo == nil {
+		return nil, fmt.Errorf("la asignación de tareas no está habilitada")
+	}
+	if taskID == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+	if len(userIDs) == 0 {
+		return nil, fmt.Errorf("se requiere al menos un usuario")
+	}
+
+	// La verificación de la tarea y la asignación van en una transacción
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, userID := range userIDs {
+			if err := s.ensureActiveUser(ctx, userID); err != nil {
+				return nil, err
+			}
+		}
+
+		return s.touchTask(ctx, task, func(ctx context.Context) error {
+			if err := s.assignmentRepo.Assign(ctx, taskID, userIDs); err != nil {
+				return fmt.Errorf("no se pudo asignar la tarea %d: %w", taskID, err)
+			}
+			return nil
+		})
+	})
+}
+
+// UnassignTask quita a un usuario de los responsables de una tarea
+func (s *TaskService) UnassignTask(ctx context.Context, taskID, userID int) error {
+	if s.assignmentRepo == nil {
+		return fmt.Errorf("la asignación de tareas no está habilitada")
+	}
+	if taskID == 0 || userID == 0 {
+		return fmt.Errorf("los IDs de la tarea y del usuario son requeridos")
+	}
+
+	return s.withinTx(ctx, func(ctx context.Context) error {
+		task, err := s.getWritableTask(ctx, taskID)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.touchTask(ctx, task, func(ctx context.Context) error {
+			if err := s.assignmentRepo.Unassign(ctx, taskID, userID); err != nil {
+				return fmt.Errorf("no se pudo quitar la asignación: %w", err)
+			}
+			return nil
+		})
+		return err
+	})
+}
+
+// GetTasksByAssignee obtiene las tareas asignadas a un usuario
+func (s *TaskService) GetTasksByAssignee(ctx context.Context, userID int) ([]*domain.Task, error) {
+	if s.assignmentRepo == nil {
+		return nil, fmt.Errorf("la asignación de tareas no está habilitada")
+	}
+	if userID == 0 {
+		return nil, fmt.Errorf("el ID del usuario es requerido")
+	}
+
+	tasks, err := s.assignmentRepo.GetTasksByAssignee(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener las tareas del usuario %d: %w", userID, err)
+	}
+	return tasks, nil
+}
+
+// UnassignUser quita al usuario de todas sus tareas; se usa al desactivarlo
+func (s *TaskService) UnassignUser(ctx context.Context, userID int) error {
+	if s.assignmentRepo == nil {
+		return nil
+	}
+
+	if _, err := s.assignmentRepo.UnassignUser(ctx, userID); err != nil {
+		return fmt.Errorf("no se pudieron quitar las asignaciones del usuario %d: %w", userID, err)
+	}
+	return nil
+}
+
+// ensureActiveUser verifica que el usuario exista y esté activo
+func (s *TaskService) ensureActiveUser(ctx context.Context, userID int) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil || user == nil {
+		return fmt.Errorf("%w: ID %d", domain.ErrAssigneeNotFound, userID)
+	}
+	if !user.Active {
+		return fmt.Errorf("%w: ID %d", domain.ErrAssigneeInactive, userID)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// sniffLen es la cantidad de bytes que se usan para detectar el tipo MIME
+const sniffLen = 512
+
+// AttachmentLimits define las restricciones de los archivos adjuntos
+type AttachmentLimits struct {
+	// MaxSize es el tamaño máximo en bytes
+	MaxSize int64
+	// AllowedTypes son los tipos MIME permitidos; admite comodines como "image/*"
+	AllowedTypes []string
+}
+
+// AttachmentService maneja los casos de uso de archivos adjuntos
+type AttachmentService struct {
+	attachmentRepo domain.AttachmentRepository
+	taskRepo       domain.TaskRepository
+	blobStore      domain.BlobStore
+	limits         AttachmentLimits
+}
+
+// NewAttachmentService crea una nueva instancia de AttachmentService
+func NewAttachmentService(attachmentRepo domain.AttachmentRepository, taskRepo domain.TaskRepository, blobStore domain.BlobStore, limits AttachmentLimits) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepo: attachmentRepo,
+		taskRepo:       taskRepo,
+		blobStore:      blobStore,
+		limits:         limits,
+	}
+}
+
+// UploadAttachment guarda un archivo adjunto a una tarea.
+// El contenido se copia a un archivo temporal mientras se calcula su hash,
+// de modo que nunca se mantiene completo en memoria; si ya existe un blob
+// con el mismo hash se reutiliza.
+func (s *AttachmentService) UploadAttachment(ctx context.Context, taskID int, fileName string, content io.Reader) (*domain.Attachment, error) {
+	if taskID == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+	fileName = domain.SanitizeFileName(fileName)
+	if fileName == "" {
+		return nil, domain.ErrInvalidAttachment
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", taskID, err)
+	}
+	if err := task.EnsureWritable(); err != nil {
+		return nil, fmt.Errorf("no se pueden adjuntar archivos a la tarea %d: %w", taskID, err)
+	}
+
+	// Detectar el tipo a partir del contenido, no del nombre ni de la cabecera del cliente
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(content, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, fmt.Errorf("error leyendo el archivo: %w", err)
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	if !s.isAllowedType(contentType) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrAttachmentTypeNotAllowed, contentType)
+	}
+
+	tmp, err := os.CreateTemp("", "attachment-*")
+	if err != nil {
+		return nil, fmt.Errorf("error creando archivo temporal: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hasher := sha256.New()
+	limited := io.LimitReader(io.MultiReader(bytes.NewReader(head), content), s.limits.MaxSize+1)
+	size, err := io.Copy(io.MultiWriter(tmp, hasher), limited)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo el archivo: %w", err)
+	}
+	if size > s.limits.MaxSize {
+		return nil, fmt.Errorf("%w: máximo %d bytes", domain.ErrAttachmentTooLarge, s.limits.MaxSize)
+	}
+
+	sum := hex.EncodeToString(hasher.Sum(nil))
+	key := domain.AttachmentStorageKey(sum)
+
+	exists, err := s.blobStore.Exists(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("error verificando el blob: %w", err)
+	}
+	if !exists {
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("error leyendo archivo temporal: %w", err)
+		}
+		if err := s.blobStore.Put(ctx, key, tmp, size, contentType); err != nil {
+			return nil, fmt.Errorf("error guardando el archivo: %w", err)
+		}
+	}
+
+	attachment := &domain.Attachment{
+		TaskID:      taskID,
+		FileName:    fileName,
+		ContentType: contentType,
+		Size:        size,
+		SHA256:      sum,
+		StorageKey:  key,
+	}
+	return s.attachmentRepo.Create(ctx, attachment)
+}
+
+// ListAttachments obtiene los adjuntos de una tarea
+func (s *AttachmentService) ListAttachments(ctx context.Context, taskID int) ([]*domain.Attachment, error) {
+	if taskID == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	attachments, err := s.attachmentRepo.ListByTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener los adjuntos de la tarea %d: %w", taskID, err)
+	}
+	return attachments, nil
+}
+
+// OpenAttachment obtiene los metadatos y el contenido de un adjunto; quien llama debe cerrar el contenido
+func (s *AttachmentService) OpenAttachment(ctx context.Context, taskID, attachmentID int) (*domain.Attachment, io.ReadCloser, error) {
+	attachment, err := s.getTaskAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobStore.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("no se pudo abrir el adjunto %d: %w", attachmentID, err)
+	}
+	return attachment, content, nil
+}
+
+// DeleteAttachment elimina un adjunto y su blob si ningún otro adjunto lo comparte
+func (s *AttachmentService) DeleteAttachment(ctx context.Context, taskID, attachmentID int) error {
+	attachment, err := s.getTaskAttachment(ctx, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, attachmentID); err != nil {
+		return fmt.Errorf("no se pudo eliminar el adjunto %d: %w", attachmentID, err)
+	}
+
+	remaining, err := s.attachmentRepo.CountByStorageKey(ctx, attachment.StorageKey)
+	if err != nil {
+		return fmt.Errorf("error verificando referencias del blob: %w", err)
+	}
+	if remaining == 0 {
+		if err := s.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+			return fmt.Errorf("error eliminando el blob del adjunto %d: %w", attachmentID, err)
+		}
+	}
+	return nil
+}
+
+// getTaskAttachment obtiene un adjunto verificando que pertenece a la tarea
+func (s *AttachmentService) getTaskAttachment(ctx context.Context, taskID, attachmentID int) (*domain.Attachment, error) {
+	if taskID == 0 || attachmentID == 0 {
+		return nil, fmt.Errorf("los IDs de la tarea y del adjunto son requeridos")
+	}
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo encontrar el adjunto con ID %d: %w", attachmentID, err)
+	}
+	if attachment.TaskID != taskID {
+		return nil, fmt.Errorf("el adjunto %d no pertenece a la tarea %d", attachmentID, taskID)
+	}
+	return attachment, nil
+}
+
+// isAllowedType verifica el tipo MIME contra la lista permitida; una lista vacía permite todo
+func (s *AttachmentService) isAllowedType(contentType string) bool {
+	if len(s.limits.AllowedTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range s.limits.AllowedTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// BoardService maneja los casos de uso de tableros kanban
+type BoardService struct {
+	boardRepo domain.BoardRepository
+	tasks     TaskServiceInterface
+}
+
+// transactor ejecuta fn en una transacción y publica los eventos de las tareas solo si se
+// confirma; lo implementa TaskService
+type transactor interface {
+	withinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// NewBoardService crea una nueva instancia de BoardService; los cambios de estado de las
+// tareas pasan por el servicio de tareas para respetar bloqueadores, recurrencia e historial.
+// Si tasks es un TaskService, los movimientos usan su TxManager
+func NewBoardService(boardRepo domain.BoardRepository, tasks TaskServiceInterface) *BoardService {
+	return &BoardService{
+		boardRepo: boardRepo,
+		tasks:     tasks,
+	}
+}
+
+// CreateBoard crea un tablero con sus columnas en el orden recibido
+func (s *BoardService) CreateBoard(ctx context.Context, name string, swimlaneBy domain.SwimlaneMode, columns []domain.BoardColumn) (*domain.Board, error) {
+	board, err := domain.NewBoard(name, swimlaneBy, columns)
+	if err != nil {
+		return nil, err
+	}
+	return s.boardRepo.Create(ctx, board)
+}
+
+// GetBoard obtiene la vista completa del tablero agrupada por filas y columnas
+func (s *BoardService) GetBoard(ctx context.Context, id int) (*domain.BoardSnapshot, error) {
+	board, cards, err := s.boardRepo.GetSnapshot(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener el tablero %d: %w", id, err)
+	}
+	return domain.BuildBoardSnapshot(board, cards), nil
+}
+
+// MoveCard ubica la tarea al final de la columna indicada, agregándola al tablero si no estaba.
+// Respeta el límite WIP de la columna de destino y sincroniza el estado de la tarea con la columna.
+// Todo ocurre en una transacción que bloquea la columna al contarla, para que dos movimientos
+// concurrentes no superen el límite y la tarea no cambie de estado si la tarjeta no se guarda
+func (s *BoardService) MoveCard(ctx context.Context, boardID, taskID, columnID int) (*domain.BoardCard, error) {
+	board, err := s.boardRepo.GetByID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener el tablero %d: %w", boardID, err)
+	}
+	column, ok := board.Column(columnID)
+	if !ok {
+		return nil, fmt.Errorf("columna %d: %w", columnID, domain.ErrBoardColumnNotFound)
+	}
+
+	var card *domain.BoardCard
+	err = s.withinTx(ctx, func(ctx context.Context) error {
+		task, err := s.tasks.GetTaskByID(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", taskID, err)
+		}
+
+		current, err := s.boardRepo.GetCard(ctx, boardID, taskID)
+		if err != nil && !errors.Is(err, domain.ErrBoardCardNotFound) {
+			return err
+		}
+		if current != nil && current.ColumnID == columnID {
+			card = domain.NewBoardCard(boardID, columnID, current.Rank, task)
+			return nil
+		}
+
+		count, err := s.boardRepo.CountCards(ctx, columnID)
+		if err != nil {
+			return err
+		}
+		if !column.AllowsCard(count) {
+			return fmt.Errorf("columna %q (límite %d): %w", column.Name, column.WIPLimit, domain.ErrWIPLimitExceeded)
+		}
+
+		task, err = s.syncTaskState(ctx, task, column.State)
+		if err != nil {
+			return err
+		}
+
+		last, err := s.boardRepo.LastCardRank(ctx, columnID)
+		if err != nil {
+			return err
+		}
+		card = domain.NewBoardCard(boardID, columnID, domain.RankAfter(last), task)
+		return s.boardRepo.PutCard(ctx, card)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// withinTx ejecuta fn en la transacción del servicio de tareas; con otra implementación de
+// TaskServiceInterface fn se ejecuta sin transacción
+func (s *BoardService) withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := s.tasks.(transactor); ok {
+		return tx.withinTx(ctx, fn)
+	}
+	return fn(ctx)
+}
+
+// RemoveCard quita la tarea del tablero sin modificarla
+func (s *BoardService) RemoveCard(ctx context.Context, boardID, taskID int) error {
+	if err := s.boardRepo.RemoveCard(ctx, boardID, taskID); err != nil {
+		return fmt.Errorf("no se pudo quitar la tarea %d del tablero %d: %w", taskID, boardID, err)
+	}
+	return nil
+}
+
+// syncTaskState completa o reabre la tarea según el estado de la columna de destino
+func (s *BoardService) syncTaskState(ctx context.Context, task *domain.Task, state domain.ColumnState) (*domain.Task, error) {
+	switch {
+	case state == domain.ColumnStateCompleted && !task.Completed:
+		return s.tasks.MarkTaskAsCompleted(ctx, task.ID)
+	case state == domain.ColumnStatePending && task.Completed:
+		return s.tasks.MarkTaskAsUncompleted(ctx, task.ID)
+	default:
+		return task, nil
+	}
+}
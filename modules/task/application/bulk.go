@@ -0,0 +1,226 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// bulkWrite es la escritura que prepara una operación del lote
+type bulkWrite struct {
+	action domain.BulkAction
+	write  domain.BatchWrite
+	// next es la siguiente ocurrencia de una tarea recurrente que la operación completa
+	next *domain.BatchWrite
+	// unchanged indica un update sin cambios, que no se escribe
+	unchanged bool
+}
+
+// BulkTasks aplica un lote de operaciones sobre tareas y retorna un resultado por operación, en el
+// mismo orden. En modo atómico, si una operación falla no se aplica ninguna y las demás quedan con
+// ErrBulkAborted; en best_effort se aplican las válidas. Las escrituras se envían al repositorio
+// como un solo lote
+func (s *TaskService) BulkTasks(ctx context.Context, mode domain.BulkMode, ops []domain.BulkOperation) ([]*domain.BulkResult, error) {
+	if !mode.IsValid() || len(ops) == 0 || len(ops) > domain.MaxBulkOperations {
+		return nil, domain.ErrInvalidBulkRequest
+	}
+
+	// Validar y preparar cada operación sobre la versión actual de su tarea
+	results := make([]*domain.BulkResult, len(ops))
+	writes := make([]*bulkWrite, len(ops))
+	seen := make(map[int]bool, len(ops))
+	for i, op := range ops {
+		results[i] = &domain.BulkResult{Index: i, Action: op.Action, TaskID: op.TaskID}
+		write, err := s.prepareBulkOperation(ctx, op, seen)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		writes[i] = write
+	}
+
+	for {
+		if mode == domain.BulkAtomic && slices.ContainsFunc(results, func(r *domain.BulkResult) bool { return r.Err != nil }) {
+			abortBulk(results)
+			return results, nil
+		}
+
+		err := s.applyBatch(ctx, writes)
+		if err == nil {
+			break
+		}
+		var conflict *domain.BatchConflictError
+		if !errors.As(err, &conflict) {
+			return nil, fmt.Errorf("no se pudo aplicar el lote: %w", err)
+		}
+
+		// Otra escritura ganó la carrera: esas operaciones fallan y el resto se reintenta
+		for i, write := range writes {
+			if write != nil && write.action != domain.BulkCreate && slices.Contains(conflict.TaskIDs, write.write.Task.ID) {
+				results[i].Err = fmt.Errorf("la tarea %d cambió durante el lote: %w", write.write.Task.ID, domain.ErrVersionConflict)
+				writes[i] = nil
+			}
+		}
+	}
+
+	for i, write := range writes {
+		if write == nil {
+			continue
+		}
+		results[i].Task = write.write.Task
+		results[i].TaskID = write.write.Task.ID
+	}
+	return results, nil
+}
+
+// applyBatch envía las escrituras al repositorio como un solo lote, asigna los responsables de las
+// siguientes ocurrencias y registra los eventos de sus tareas, todo en la misma transacción
+func (s *TaskService) applyBatch(ctx context.Context, writes []*bulkWrite) error {
+	return s.withinTx(ctx, func(ctx context.Context) error {
+		if err := s.taskRepo.ApplyBatch(ctx, buildTaskBatch(writes)); err != nil {
+			return err
+		}
+		for _, write := range writes {
+			if write == nil {
+				continue
+			}
+			if err := s.recordEvents(ctx, write.write.Task, write.write.Task); err != nil {
+				return err
+			}
+			if write.next != nil {
+				if err := s.assignNextOccurrence(ctx, write.write.Task, write.next.Task); err != nil {
+					return err
+				}
+				if err := s.recordEvents(ctx, write.next.Task, write.next.Task); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// prepareBulkOperation valida la operación, aplica el cambio sobre la tarea leída y arma su escritura
+func (s *TaskService) prepareBulkOperation(ctx context.Context, op domain.BulkOperation, seen map[int]bool) (*bulkWrite, error) {
+	if err := op.Validate(); err != nil {
+		return nil, err
+	}
+	if op.Action == domain.BulkCreate {
+		task := domain.NewTask(strings.TrimSpace(op.Title), strings.TrimSpace(op.Description))
+		return &bulkWrite{action: op.Action, write: s.batchWrite(ctx, domain.RevisionCreate, nil, task)}, nil
+	}
+
+	// Cada tarea puede aparecer una sola vez: el lote escribe la versión leída de cada una
+	if seen[op.TaskID] {
+		return nil, fmt.Errorf("%w: la tarea %d aparece más de una vez en el lote", domain.ErrInvalidBulkOperation, op.TaskID)
+	}
+	seen[op.TaskID] = true
+
+	task, err := s.taskRepo.GetByID(ctx, op.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ID %d", domain.ErrBulkTaskNotFound, op.TaskID)
+	}
+	if err := task.EnsureWritable(); err != nil {
+		return nil, fmt.Errorf("no se puede modificar la tarea %d: %w", op.TaskID, err)
+	}
+	if op.Version != 0 && op.Version != task.Version {
+		return nil, fmt.Errorf("%w: ID %d, versión esperada %d, actual %d", domain.ErrPreconditionFailed, task.ID, op.Version, task.Version)
+	}
+
+	before := *task
+	switch op.Action {
+	case domain.BulkDelete:
+		deleted := *task
+		now := time.Now().UTC()
+		deleted.DeletedAt = &now
+		deleted.Version++
+		write := s.batchWrite(ctx, domain.RevisionDelete, &before, &deleted)
+		write.Task = task
+		task.RecordEvent(domain.EventTaskDeleted)
+		return &bulkWrite{action: op.Action, write: write}, nil
+	case domain.BulkUpdate:
+		if op.Patch.IsEmpty() {
+			return &bulkWrite{action: op.Action, write: domain.BatchWrite{Task: task}, unchanged: true}, nil
+		}
+		if err := s.applyPatch(ctx, task, op.Patch); err != nil {
+			return nil, err
+		}
+	case domain.BulkComplete:
+		if err := s.ensureNotBlocked(ctx, task.ID); err != nil {
+			return nil, err
+		}
+		task.MarkAsCompleted()
+	case domain.BulkUncomplete:
+		task.MarkAsUncompleted()
+	}
+
+	write := &bulkWrite{action: op.Action, write: s.batchWrite(ctx, updateAction(before.Completed, task), &before, task)}
+
+	// Una tarea recurrente que se completa genera su siguiente ocurrencia en el mismo lote
+	if !before.Completed && task.Completed {
+		next, err := task.NextOccurrence()
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo calcular la siguiente ocurrencia de la tarea %d: %w", task.ID, err)
+		}
+		if next != nil {
+			nextWrite := s.batchWrite(ctx, domain.RevisionCreate, nil, next)
+			write.next = &nextWrite
+		}
+	}
+	return write, nil
+}
+
+// batchWrite arma la escritura de after con su revisión si el historial está habilitado
+func (s *TaskService) batchWrite(ctx context.Context, action domain.RevisionAction, before, after *domain.Task) domain.BatchWrite {
+	write := domain.BatchWrite{Task: after}
+	if s.historyRepo != nil {
+		write.Revision = domain.NewTaskRevision(action, actorFromContext(ctx), before, after)
+	}
+	return write
+}
+
+// buildTaskBatch agrupa las escrituras pendientes por tipo
+func buildTaskBatch(writes []*bulkWrite) *domain.TaskBatch {
+	batch := &domain.TaskBatch{}
+	for _, write := range writes {
+		switch {
+		case write == nil || write.unchanged:
+			continue
+		case write.action == domain.BulkCreate:
+			batch.Creates = append(batch.Creates, write.write)
+		case write.action == domain.BulkDelete:
+			batch.Deletes = append(batch.Deletes, write.write)
+		default:
+			batch.Updates = append(batch.Updates, write.write)
+		}
+		if write.next != nil {
+			batch.Creates = append(batch.Creates, *write.next)
+		}
+	}
+	return batch
+}
+
+// abortBulk marca con ErrBulkAborted las operaciones válidas de un lote atómico que no se aplicó
+func abortBulk(results []*domain.BulkResult) {
+	for _, result := range results {
+		if result.Err == nil {
+			result.Err = domain.ErrBulkAborted
+		}
+	}
+}
+
+// assignNextOccurrence copia los responsables de la tarea completada a su siguiente ocurrencia
+func (s *TaskService) assignNextOccurrence(ctx context.Context, task, next *domain.Task) error {
+	if s.assignmentRepo == nil || len(task.Assignees) == 0 {
+		return nil
+	}
+	if err := s.assignmentRepo.Assign(ctx, next.ID, task.Assignees); err != nil {
+		return fmt.Errorf("no se pudieron asignar los responsables a la tarea %d: %w", next.ID, err)
+	}
+	return nil
+}
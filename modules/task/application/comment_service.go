@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// Límites de paginación de comentarios
+const (
+	DefaultCommentPageSize = 20
+	MaxCommentPageSize     = 100
+)
+
+// CommentService maneja los casos de uso de comentarios sobre tareas
+type CommentService struct {
+	commentRepo domain.CommentRepository
+	taskRepo    domain.TaskRepository
+}
+
+// NewCommentService crea una nueva instancia de CommentService
+func NewCommentService(commentRepo domain.CommentRepository, taskRepo domain.TaskRepository) *CommentService {
+	return &CommentService{
+		commentRepo: commentRepo,
+		taskRepo:    taskRepo,
+	}
+}
+
+// AddComment agrega un comentario a una tarea existente
+func (s *CommentService) AddComment(ctx context.Context, taskID int, author, body string) (*domain.Comment, error) {
+	if taskID == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", taskID, err)
+	}
+	if err := task.EnsureWritable(); err != nil {
+		return nil, fmt.Errorf("no se puede comentar la tarea %d: %w", taskID, err)
+	}
+
+	comment, err := domain.NewComment(taskID, author, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.commentRepo.Create(ctx, comment)
+}
+
+// ListComments obtiene una página de comentarios de una tarea y el total disponible
+func (s *CommentService) ListComments(ctx context.Context, taskID, limit, offset int) ([]*domain.Comment, int, error) {
+	if taskID == 0 {
+		return nil, 0, fmt.Errorf("el ID de la tarea es requerido")
+	}
+	if limit <= 0 {
+		limit = DefaultCommentPageSize
+	}
+	if limit > MaxCommentPageSize {
+		limit = MaxCommentPageSize
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	comments, total, err := s.commentRepo.ListByTask(ctx, taskID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("no se pudieron obtener los comentarios de la tarea %d: %w", taskID, err)
+	}
+	return comments, total, nil
+}
+
+// EditComment reemplaza el contenido de un comentario conservando la versión anterior
+func (s *CommentService) EditComment(ctx context.Context, taskID, commentID int, body string) (*domain.Comment, error) {
+	comment, err := s.getWritableComment(ctx, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := comment.Edit(body)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.commentRepo.Update(ctx, comment, revision)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo actualizar el comentario: %w", err)
+	}
+	return updated, nil
+}
+
+// GetCommentHistory obtiene las versiones anteriores de un comentario
+func (s *CommentService) GetCommentHistory(ctx context.Context, taskID, commentID int) ([]*domain.CommentRevision, error) {
+	if _, err := s.getTaskComment(ctx, taskID, commentID); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.commentRepo.GetRevisions(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener el historial del comentario %d: %w", commentID, err)
+	}
+	return revisions, nil
+}
+
+// DeleteComment elimina un comentario de una tarea
+func (s *CommentService) DeleteComment(ctx context.Context, taskID, commentID int) error {
+	if _, err := s.getWritableComment(ctx, taskID, commentID); err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.Delete(ctx, commentID); err != nil {
+		return fmt.Errorf("no se pudo eliminar el comentario %d: %w", commentID, err)
+	}
+	return nil
+}
+
+// getTaskComment obtiene un comentario verificando que pertenece a la tarea
+func (s *CommentService) getTaskComment(ctx context.Context, taskID, commentID int) (*domain.Comment, error) {
+	if taskID == 0 || commentID == 0 {
+		return nil, fmt.Errorf("los IDs de la tarea y del comentario son requeridos")
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo encontrar el comentario con ID %d: %w", commentID, err)
+	}
+	if comment.TaskID != taskID || comment.ArchivedAt != nil {
+		return nil, fmt.Errorf("el comentario %d no pertenece a la tarea %d", commentID, taskID)
+	}
+	return comment, nil
+}
+
+// getWritableComment obtiene un comentario que se puede modificar: los de una tarea archivada son
+// de solo lectura, igual que la tarea
+func (s *CommentService) getWritableComment(ctx context.Context, taskID, commentID int) (*domain.Comment, error) {
+	comment, err := s.getTaskComment(ctx, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", taskID, err)
+	}
+	if err := task.EnsureWritable(); err != nil {
+		return nil, fmt.Errorf("no se puede modificar el comentario %d: %w", commentID, err)
+	}
+	return comment, nil
+}
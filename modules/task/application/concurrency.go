@@ -0,0 +1,33 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// expectedVersionKey es la clave de contexto de las versiones esperadas (If-Match)
+type expectedVersionKey struct{}
+
+// WithExpectedVersion exige que la tarea esté en alguna de las versiones dadas para que la escritura
+// proceda; si no coincide, el caso de uso retorna domain.ErrPreconditionFailed
+func WithExpectedVersion(ctx context.Context, versions ...int) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{}, versions)
+}
+
+// expectedVersions retorna las versiones esperadas, si la petición las definió
+func expectedVersions(ctx context.Context) ([]int, bool) {
+	versions, ok := ctx.Value(expectedVersionKey{}).([]int)
+	return versions, ok
+}
+
+// checkExpectedVersion compara la versión actual de la tarea con las esperadas en el contexto
+func checkExpectedVersion(ctx context.Context, task *domain.Task) error {
+	versions, ok := expectedVersions(ctx)
+	if !ok || slices.Contains(versions, task.Version) {
+		return nil
+	}
+	return fmt.Errorf("%w: ID %d, versión esperada %v, actual %d", domain.ErrPreconditionFailed, task.ID, versions, task.Version)
+}
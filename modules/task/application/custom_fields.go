@@ -0,0 +1,165 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+)
+
+// WithCustomFields habilita los campos personalizados; los campos de tipo user se validan con userRepo
+func WithCustomFields(repo domain.CustomFieldRepository, userRepo userdomain.UserRepository) TaskServiceOption {
+	return func(s *TaskService) {
+		s.customFieldRepo = repo
+		s.userRepo = userRepo
+	}
+}
+
+// SetTaskCustomFields guarda los valores de campos personalizados de una tarea;
+// un valor nil quita el campo y los que no se indican se conservan
+func (s *TaskService) SetTaskCustomFields(ctx context.Context, id int, values map[string]any) (*domain.Task, error) {
+	if s.customFieldRepo == nil {
+		return nil, fmt.Errorf("los campos personalizados no están habilitados")
+	}
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	// Las validaciones y la escritura de los valores van en una transacción
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		// Claves ordenadas para validar y escribir siempre en el mismo orden
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		var set []domain.CustomFieldValue
+		var remove []*domain.CustomFieldDefinition
+		for _, key := range keys {
+			field, err := s.customFieldRepo.GetDefinition(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			if values[key] == nil {
+				remove = append(remove, field)
+				continue
+			}
+			value, err := field.Normalize(values[key])
+			if err != nil {
+				return nil, err
+			}
+			if field.Type == domain.CustomFieldUser {
+				if err := s.ensureUserExists(ctx, field, value.(int)); err != nil {
+					return nil, err
+				}
+			}
+			set = append(set, domain.CustomFieldValue{Field: field, Value: value})
+		}
+
+		return s.touchTask(ctx, task, func(ctx context.Context) error {
+			if err := s.customFieldRepo.SetValues(ctx, id, set, remove); err != nil {
+				return fmt.Errorf("no se pudieron guardar los campos personalizados de la tarea %d: %w", id, err)
+			}
+			return nil
+		})
+	})
+}
+
+// ensureUserExists verifica que el usuario de un campo de tipo user exista
+func (s *TaskService) ensureUserExists(ctx context.Context, field *domain.CustomFieldDefinition, userID int) error {
+	if s.userRepo == nil {
+		return nil
+	}
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil || user == nil {
+		return fmt.Errorf("%w: %s, el usuario %d no existe", domain.ErrInvalidCustomFieldValue, field.Key, userID)
+	}
+	return nil
+}
+
+// resolveCustomFieldQuery completa el tipo y el valor de los filtros y del orden por campos personalizados
+func (s *TaskService) resolveCustomFieldQuery(ctx context.Context, opts *domain.TaskQueryOptions) error {
+	if len(opts.CustomFields) == 0 && opts.SortBy == nil {
+		return nil
+	}
+	if s.customFieldRepo == nil {
+		return fmt.Errorf("los campos personalizados no están habilitados")
+	}
+
+	for i := range opts.CustomFields {
+		filter := &opts.CustomFields[i]
+		field, err := s.customFieldRepo.GetDefinition(ctx, filter.Key)
+		if err != nil {
+			return err
+		}
+		if filter.Value, err = field.ParseFilter(filter.Raw); err != nil {
+			return err
+		}
+		filter.Type = field.Type
+	}
+	if opts.SortBy != nil {
+		field, err := s.customFieldRepo.GetDefinition(ctx, opts.SortBy.Key)
+		if err != nil {
+			return err
+		}
+		opts.SortBy.Type = field.Type
+	}
+	return nil
+}
+
+// CustomFieldService maneja las definiciones de campos personalizados
+type CustomFieldService struct {
+	customFieldRepo domain.CustomFieldRepository
+}
+
+// NewCustomFieldService crea una nueva instancia de CustomFieldService
+func NewCustomFieldService(customFieldRepo domain.CustomFieldRepository) *CustomFieldService {
+	return &CustomFieldService{
+		customFieldRepo: customFieldRepo,
+	}
+}
+
+// CreateField define un campo personalizado nuevo; la clave no se puede repetir
+func (s *CustomFieldService) CreateField(ctx context.Context, key, name string, fieldType domain.CustomFieldType, options []string) (*domain.CustomFieldDefinition, error) {
+	field, err := domain.NewCustomFieldDefinition(key, name, fieldType, options)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.customFieldRepo.GetDefinition(ctx, field.Key)
+	if err == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCustomFieldExists, field.Key)
+	}
+	if !errors.Is(err, domain.ErrCustomFieldNotFound) {
+		return nil, err
+	}
+
+	created, err := s.customFieldRepo.CreateDefinition(ctx, field)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo crear el campo personalizado: %w", err)
+	}
+	return created, nil
+}
+
+// ListFields obtiene las definiciones de campos personalizados
+func (s *CustomFieldService) ListFields(ctx context.Context) ([]*domain.CustomFieldDefinition, error) {
+	fields, err := s.customFieldRepo.ListDefinitions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener los campos personalizados: %w", err)
+	}
+	return fields, nil
+}
+
+// DeleteField elimina un campo personalizado y sus valores en todas las tareas
+func (s *CustomFieldService) DeleteField(ctx context.Context, key string) error {
+	return s.customFieldRepo.DeleteDefinition(ctx, key)
+}
@@ -0,0 +1,132 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// AddDependency registra que la tarea taskID está bloqueada por blockerID
+func (s *TaskService) AddDependency(ctx context.Context, taskID, blockerID int) error {
+	if s.dependencyRepo == nil {
+		return fmt.Errorf("las dependencias entre tareas no están habilitadas")
+	}
+	if taskID == 0 || blockerID == 0 {
+		return fmt.Errorf("los IDs de la tarea y del bloqueador son requeridos")
+	}
+	if taskID == blockerID {
+		return domain.ErrSelfDependency
+	}
+
+	// Verificar que ambas tareas existen; la tarea bloqueada no puede estar archivada
+	if _, err := s.getWritableTask(ctx, taskID); err != nil {
+		return err
+	}
+	if _, err := s.taskRepo.GetByID(ctx, blockerID); err != nil {
+		return fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", blockerID, err)
+	}
+
+	// Rechazar la dependencia si crea un ciclo
+	deps, err := s.dependencyRepo.GetAllDependencies(ctx)
+	if err != nil {
+		return fmt.Errorf("no se pudieron obtener las dependencias: %w", err)
+	}
+	if domain.NewDependencyGraph(deps).WouldCreateCycle(taskID, blockerID) {
+		return fmt.Errorf("la tarea %d no puede depender de la tarea %d: %w", taskID, blockerID, domain.ErrDependencyCycle)
+	}
+
+	if err := s.dependencyRepo.AddDependency(ctx, taskID, blockerID); err != nil {
+		return fmt.Errorf("no se pudo agregar la dependencia: %w", err)
+	}
+	return nil
+}
+
+// RemoveDependency elimina el bloqueo de blockerID sobre taskID
+func (s *TaskService) RemoveDependency(ctx context.Context, taskID, blockerID int) error {
+	if s.dependencyRepo == nil {
+		return fmt.Errorf("las dependencias entre tareas no están habilitadas")
+	}
+	if taskID == 0 || blockerID == 0 {
+		return fmt.Errorf("los IDs de la tarea y del bloqueador son requeridos")
+	}
+
+	if _, err := s.getWritableTask(ctx, taskID); err != nil {
+		return err
+	}
+
+	if err := s.dependencyRepo.RemoveDependency(ctx, taskID, blockerID); err != nil {
+		return fmt.Errorf("no se pudo eliminar la dependencia: %w", err)
+	}
+	return nil
+}
+
+// GetTaskBlockers obtiene las tareas que bloquean a la tarea indicada
+func (s *TaskService) GetTaskBlockers(ctx context.Context, taskID int) ([]*domain.Task, error) {
+	if s.dependencyRepo == nil {
+		return nil, fmt.Errorf("las dependencias entre tareas no están habilitadas")
+	}
+	if taskID == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	blockers, err := s.dependencyRepo.GetBlockers(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener los bloqueadores de la tarea %d: %w", taskID, err)
+	}
+	return blockers, nil
+}
+
+// GetTasksInDependencyOrder obtiene todas las tareas ordenadas de forma que
+// cada bloqueador aparezca antes que las tareas que bloquea
+func (s *TaskService) GetTasksInDependencyOrder(ctx context.Context) ([]*domain.Task, error) {
+	if s.dependencyRepo == nil {
+		return nil, fmt.Errorf("las dependencias entre tareas no están habilitadas")
+	}
+
+	tasks, err := s.taskRepo.GetAll(ctx, domain.TaskQueryOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener las tareas: %w", err)
+	}
+	deps, err := s.dependencyRepo.GetAllDependencies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener las dependencias: %w", err)
+	}
+
+	byID := make(map[int]*domain.Task, len(tasks))
+	ids := make([]int, len(tasks))
+	for i, task := range tasks {
+		byID[task.ID] = task
+		ids[i] = task.ID
+	}
+
+	order, err := domain.NewDependencyGraph(deps).TopologicalOrder(ids)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo ordenar las tareas: %w", err)
+	}
+
+	ordered := make([]*domain.Task, len(order))
+	for i, id := range order {
+		ordered[i] = byID[id]
+	}
+	return ordered, nil
+}
+
+// ensureNotBlocked retorna ErrTaskBlocked si la regla está activa y la tarea
+// tiene bloqueadores sin completar
+func (s *TaskService) ensureNotBlocked(ctx context.Context, taskID int) error {
+	if !s.enforceBlockers || s.dependencyRepo == nil {
+		return nil
+	}
+
+	blockers, err := s.dependencyRepo.GetBlockers(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("no se pudieron obtener los bloqueadores de la tarea %d: %w", taskID, err)
+	}
+	for _, blocker := range blockers {
+		if !blocker.Completed {
+			return fmt.Errorf("no se puede completar la tarea %d, la tarea %d sigue abierta: %w", taskID, blocker.ID, domain.ErrTaskBlocked)
+		}
+	}
+	return nil
+}
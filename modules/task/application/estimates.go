@@ -0,0 +1,119 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// SetTaskEstimate asigna los puntos y las horas estimadas de una tarea;
+// el trabajo restante vuelve a las horas estimadas
+func (s *TaskService) SetTaskEstimate(ctx context.Context, id, storyPoints int, estimatedHours float64) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		before := *task
+		if err := task.SetEstimate(storyPoints, estimatedHours); err != nil {
+			return nil, fmt.Errorf("no se pudo estimar la tarea: %w", err)
+		}
+
+		updatedTask, err := s.saveTask(ctx, &before, task, domain.RevisionUpdate)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo actualizar la tarea: %w", err)
+		}
+		return updatedTask, nil
+	})
+}
+
+// UpdateRemainingWork actualiza las horas que faltan para terminar una tarea
+func (s *TaskService) UpdateRemainingWork(ctx context.Context, id int, remainingHours float64) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		before := *task
+		if err := task.UpdateRemaining(remainingHours); err != nil {
+			return nil, fmt.Errorf("no se pudo actualizar el trabajo restante: %w", err)
+		}
+
+		updatedTask, err := s.saveTask(ctx, &before, task, domain.RevisionUpdate)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo actualizar la tarea: %w", err)
+		}
+		return updatedTask, nil
+	})
+}
+
+// EstimateReportService maneja los reportes de velocidad y burndown
+type EstimateReportService struct {
+	estimateRepo domain.EstimateRepository
+	boardRepo    domain.BoardRepository
+}
+
+// NewEstimateReportService crea una nueva instancia de EstimateReportService
+func NewEstimateReportService(estimateRepo domain.EstimateRepository, boardRepo domain.BoardRepository) *EstimateReportService {
+	return &EstimateReportService{
+		estimateRepo: estimateRepo,
+		boardRepo:    boardRepo,
+	}
+}
+
+// GetVelocity obtiene el trabajo completado por semana (de lunes a domingo, UTC) en el rango;
+// boardID 0 considera todas las tareas
+func (s *EstimateReportService) GetVelocity(ctx context.Context, from, to time.Time, boardID int) (*domain.Velocity, error) {
+	from, to, err := domain.ReportDays(domain.WeekStart(from), to)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	weeks, err := s.estimateRepo.Velocity(ctx, from, to, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo calcular la velocidad: %w", err)
+	}
+	return domain.NewVelocity(from, to, boardID, weeks), nil
+}
+
+// GetBurndown obtiene el trabajo estimado pendiente al terminar cada día del rango;
+// boardID 0 considera todas las tareas
+func (s *EstimateReportService) GetBurndown(ctx context.Context, from, to time.Time, boardID int) (*domain.Burndown, error) {
+	from, to, err := domain.ReportDays(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	days, err := s.estimateRepo.Burndown(ctx, from, to, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo calcular el burndown: %w", err)
+	}
+	return domain.NewBurndown(from, to, boardID, days), nil
+}
+
+// ensureBoard verifica que el tablero exista cuando el reporte se limita a uno
+func (s *EstimateReportService) ensureBoard(ctx context.Context, boardID int) error {
+	if boardID == 0 {
+		return nil
+	}
+	_, err := s.boardRepo.GetByID(ctx, boardID)
+	return err
+}
@@ -0,0 +1,111 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+//go:generate mockgen -source=events.go -destination=mocks/mock_event_publisher.go -package=mocks
+
+// EventPublisher es el puerto para publicar los eventos de dominio de las tareas
+type EventPublisher interface {
+	// Publish entrega los eventos a los suscriptores en el orden recibido
+	Publish(ctx context.Context, events []domain.Event) error
+}
+
+// EventOutbox es el puerto para guardar los eventos de las tareas junto con el cambio que los
+// produce, para entregarlos después aunque el proceso termine antes de publicarlos
+type EventOutbox interface {
+	// Append guarda los eventos en la transacción del contexto
+	Append(ctx context.Context, events []domain.Event) error
+}
+
+// pendingEventsKey es la clave de contexto de los eventos de una transacción en curso
+type pendingEventsKey struct{}
+
+// pendingEvents acumula los eventos de una transacción hasta que se confirma
+type pendingEvents struct {
+	events []domain.Event
+}
+
+// WithEventPublisher publica los eventos de las tareas después de persistir cada cambio
+func WithEventPublisher(publisher EventPublisher) TaskServiceOption {
+	return func(s *TaskService) {
+		s.publisher = publisher
+	}
+}
+
+// WithEventOutbox guarda los eventos de las tareas en el outbox, en la misma transacción que cada
+// cambio. Requiere WithTxManager para que el cambio y sus eventos se guarden de forma atómica
+func WithEventOutbox(outbox EventOutbox) TaskServiceOption {
+	return func(s *TaskService) {
+		s.outbox = outbox
+	}
+}
+
+// writeWithEvents ejecuta write y registra los eventos de task sobre la tarea persistida. Con outbox
+// ambos van en una transacción: si no se pueden guardar los eventos, el cambio se revierte
+func (s *TaskService) writeWithEvents(ctx context.Context, task *domain.Task, write func(ctx context.Context) (*domain.Task, error)) (*domain.Task, error) {
+	run := func(ctx context.Context) (*domain.Task, error) {
+		persisted, err := write(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.recordEvents(ctx, task, persisted); err != nil {
+			return nil, err
+		}
+		return persisted, nil
+	}
+	if s.outbox == nil {
+		return run(ctx)
+	}
+	return inTx(ctx, s, run)
+}
+
+// recordEvents toma los eventos pendientes de task, ya persistida como persisted, los guarda en el
+// outbox y los publica. Dentro de una transacción la publicación espera a que se confirme
+func (s *TaskService) recordEvents(ctx context.Context, task, persisted *domain.Task) error {
+	events := task.PullEvents()
+	if (s.publisher == nil && s.outbox == nil) || len(events) == 0 {
+		return nil
+	}
+
+	if persisted == nil {
+		persisted = task
+	}
+	snapshot := *persisted
+	actor := actorFromContext(ctx)
+	for i := range events {
+		events[i].TaskID = persisted.ID
+		events[i].Task = &snapshot
+		events[i].Actor = actor
+	}
+
+	if s.outbox != nil {
+		if err := s.outbox.Append(ctx, events); err != nil {
+			return fmt.Errorf("no se pudieron guardar los eventos en el outbox: %w", err)
+		}
+	}
+	if s.publisher == nil {
+		return nil
+	}
+	if pending, ok := ctx.Value(pendingEventsKey{}).(*pendingEvents); ok {
+		pending.events = append(pending.events, events...)
+		return nil
+	}
+	s.publish(ctx, events)
+	return nil
+}
+
+// publish entrega los eventos; el cambio ya se guardó, por eso un error solo se registra
+func (s *TaskService) publish(ctx context.Context, events []domain.Event) {
+	if len(events) == 0 {
+		return
+	}
+	if err := s.publisher.Publish(ctx, events); err != nil {
+		log.Printf("Error publicando eventos de tareas: %v", err)
+	}
+}
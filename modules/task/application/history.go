@@ -0,0 +1,169 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// anonymousActor identifica las escrituras sin usuario conocido
+const anonymousActor = "anonymous"
+
+// actorKey es la clave de contexto del usuario que hace la petición
+type actorKey struct{}
+
+// WithHistory habilita el historial de cambios: cada escritura de tareas se
+// guarda junto con su revisión en una misma transacción
+func WithHistory(repo domain.TaskHistoryRepository) TaskServiceOption {
+	return func(s *TaskService) {
+		s.historyRepo = repo
+	}
+}
+
+// WithActor registra en el contexto quién hace la petición, para el historial
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// actorFromContext retorna el usuario de la petición o anonymousActor
+func actorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return anonymousActor
+}
+
+// GetTaskHistory obtiene las revisiones de una tarea, de la más antigua a la más reciente
+func (s *TaskService) GetTaskHistory(ctx context.Context, id int) ([]*domain.TaskRevision, error) {
+	if s.historyRepo == nil {
+		return nil, fmt.Errorf("el historial de tareas no está habilitado")
+	}
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	revisions, err := s.historyRepo.GetRevisions(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener el historial de la tarea %d: %w", id, err)
+	}
+	return revisions, nil
+}
+
+// GetTaskAsOf obtiene la tarea tal como estaba en la fecha at
+func (s *TaskService) GetTaskAsOf(ctx context.Context, id int, at time.Time) (*domain.Task, error) {
+	if s.historyRepo == nil {
+		return nil, fmt.Errorf("el historial de tareas no está habilitado")
+	}
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	revision, err := s.historyRepo.GetRevisionAt(ctx, id, at)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener la tarea %d en esa fecha: %w", id, err)
+	}
+	return revision.Snapshot, nil
+}
+
+// RevertTask restaura los campos editables de una tarea a los de una revisión anterior.
+// La reversión queda registrada como una revisión más.
+func (s *TaskService) RevertTask(ctx context.Context, id, revisionID int) (*domain.Task, error) {
+	if s.historyRepo == nil {
+		return nil, fmt.Errorf("el historial de tareas no está habilitado")
+	}
+	if id == 0 || revisionID == 0 {
+		return nil, fmt.Errorf("los IDs de la tarea y de la revisión son requeridos")
+	}
+
+	// La lectura de la revisión y la escritura de la reversión van en una transacción
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		revision, err := s.historyRepo.GetRevision(ctx, id, revisionID)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo obtener la revisión %d: %w", revisionID, err)
+		}
+
+		before := *task
+		if revision.Snapshot.Completed && !task.Completed {
+			if err := s.ensureNotBlocked(ctx, id); err != nil {
+				return nil, err
+			}
+		}
+		task.RevertTo(revision.Snapshot)
+
+		updatedTask, err := s.saveTask(ctx, &before, task, domain.RevisionRevert)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo revertir la tarea: %w", err)
+		}
+		return updatedTask, nil
+	})
+}
+
+// createTask persiste una tarea nueva con su revisión si el historial está habilitado
+func (s *TaskService) createTask(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	return s.writeWithEvents(ctx, task, func(ctx context.Context) (*domain.Task, error) {
+		if s.historyRepo == nil {
+			return s.taskRepo.Create(ctx, task)
+		}
+		revision := domain.NewTaskRevision(domain.RevisionCreate, actorFromContext(ctx), nil, task)
+		return s.historyRepo.CreateWithRevision(ctx, task, revision)
+	})
+}
+
+// saveTask persiste los cambios de una tarea con su revisión si el historial está habilitado
+func (s *TaskService) saveTask(ctx context.Context, before, task *domain.Task, action domain.RevisionAction) (*domain.Task, error) {
+	return s.writeWithEvents(ctx, task, func(ctx context.Context) (*domain.Task, error) {
+		if s.historyRepo == nil {
+			return s.taskRepo.Update(ctx, task)
+		}
+		revision := domain.NewTaskRevision(action, actorFromContext(ctx), before, task)
+		return s.historyRepo.UpdateWithRevision(ctx, task, revision)
+	})
+}
+
+// touchTask persiste con write un cambio que no pasa por TaskRepository.Update (responsables, campos
+// personalizados, posición, restauración o desarchivado) y lo registra como una actualización:
+// incrementa la versión, guarda la revisión y registra el evento TaskUpdated. before es la tarea
+// leída antes del cambio
+func (s *TaskService) touchTask(ctx context.Context, before *domain.Task, write func(ctx context.Context) error) (*domain.Task, error) {
+	if err := write(ctx); err != nil {
+		return nil, err
+	}
+	task, err := s.taskRepo.GetByID(ctx, before.ID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener la tarea %d: %w", before.ID, err)
+	}
+	task.RecordEvent(domain.EventTaskUpdated)
+	return s.saveTask(ctx, before, task, domain.RevisionUpdate)
+}
+
+// deleteTask mueve una tarea a la papelera con su revisión si el historial está habilitado
+func (s *TaskService) deleteTask(ctx context.Context, task *domain.Task) error {
+	now := time.Now().UTC()
+	deleted := *task
+	deleted.DeletedAt = &now
+	deleted.Version++
+
+	task.RecordEvent(domain.EventTaskDeleted)
+	_, err := s.writeWithEvents(ctx, task, func(ctx context.Context) (*domain.Task, error) {
+		if s.historyRepo == nil {
+			return &deleted, s.taskRepo.Delete(ctx, task.ID)
+		}
+		revision := domain.NewTaskRevision(domain.RevisionDelete, actorFromContext(ctx), task, &deleted)
+		return &deleted, s.historyRepo.DeleteWithRevision(ctx, task.ID, revision)
+	})
+	return err
+}
+
+// updateAction retorna la acción del historial según si la escritura completó la tarea
+func updateAction(wasCompleted bool, task *domain.Task) domain.RevisionAction {
+	if !wasCompleted && task.Completed {
+		return domain.RevisionComplete
+	}
+	return domain.RevisionUpdate
+}
@@ -2,6 +2,8 @@ package application
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
 )
@@ -16,21 +18,201 @@ type TaskServiceInterface interface {
 	// GetTaskByID obtiene una tarea por su ID
 	GetTaskByID(ctx context.Context, id int) (*domain.Task, error)
 	
-	// GetAllTasks obtiene todas las tareas
-	GetAllTasks(ctx context.Context) ([]*domain.Task, error)
+	// GetAllTasks obtiene todas las tareas; las archivadas solo si opts.IncludeArchived
+	GetAllTasks(ctx context.Context, opts domain.TaskQueryOptions) ([]*domain.Task, error)
 	
 	// UpdateTask actualiza una tarea existente
 	UpdateTask(ctx context.Context, id int, title, description string, completed *bool) (*domain.Task, error)
+
+	// PatchTask aplica cambios explícitos a una tarea; solo modifica los campos presentes en el parche
+	PatchTask(ctx context.Context, id int, patch domain.TaskPatch) (*domain.Task, error)
+
+	// BulkTasks aplica un lote de operaciones en modo atómico o best_effort y retorna un resultado por operación
+	BulkTasks(ctx context.Context, mode domain.BulkMode, ops []domain.BulkOperation) ([]*domain.BulkResult, error)
 	
-	// DeleteTask elimina una tarea por su ID
+	// DeleteTask mueve una tarea a la papelera
 	DeleteTask(ctx context.Context, id int) error
 	
 	// GetTasksByStatus obtiene tareas filtradas por estado de completado
-	GetTasksByStatus(ctx context.Context, completed bool) ([]*domain.Task, error)
+	GetTasksByStatus(ctx context.Context, completed bool, opts domain.TaskQueryOptions) ([]*domain.Task, error)
 	
 	// MarkTaskAsCompleted marca una tarea como completada
 	MarkTaskAsCompleted(ctx context.Context, id int) (*domain.Task, error)
 	
 	// MarkTaskAsUncompleted marca una tarea como no completada
 	MarkTaskAsUncompleted(ctx context.Context, id int) (*domain.Task, error)
-}
\ No newline at end of file
+
+	// AddDependency registra que una tarea está bloqueada por otra
+	AddDependency(ctx context.Context, taskID, blockerID int) error
+
+	// RemoveDependency elimina el bloqueo de una tarea sobre otra
+	RemoveDependency(ctx context.Context, taskID, blockerID int) error
+
+	// GetTaskBlockers obtiene las tareas que bloquean a una tarea
+	GetTaskBlockers(ctx context.Context, taskID int) ([]*domain.Task, error)
+
+	// GetTasksInDependencyOrder obtiene las tareas en orden topológico de dependencias
+	GetTasksInDependencyOrder(ctx context.Context) ([]*domain.Task, error)
+
+	// SetTaskSchedule asigna fecha de vencimiento y regla de recurrencia a una tarea
+	SetTaskSchedule(ctx context.Context, id int, dueDate *time.Time, recurrence string) (*domain.Task, error)
+
+	// AssignTask asigna uno o más usuarios activos a una tarea
+	AssignTask(ctx context.Context, taskID int, userIDs []int) (*domain.Task, error)
+
+	// UnassignTask quita a un usuario de los responsables de una tarea
+	UnassignTask(ctx context.Context, taskID, userID int) error
+
+	// GetTasksByAssignee obtiene las tareas asignadas a un usuario
+	GetTasksByAssignee(ctx context.Context, userID int) ([]*domain.Task, error)
+
+	// GetTrash obtiene las tareas que están en la papelera
+	GetTrash(ctx context.Context) ([]*domain.Task, error)
+
+	// RestoreTask saca una tarea de la papelera
+	RestoreTask(ctx context.Context, id int) (*domain.Task, error)
+
+	// PermanentlyDeleteTask elimina definitivamente una tarea de la papelera
+	PermanentlyDeleteTask(ctx context.Context, id int) error
+
+	// ArchiveTask archiva una tarea completada; queda de solo lectura
+	ArchiveTask(ctx context.Context, id int) (*domain.Task, error)
+
+	// UnarchiveTask saca una tarea del archivo
+	UnarchiveTask(ctx context.Context, id int) (*domain.Task, error)
+
+	// ArchiveCompletedTasks archiva las tareas completadas hace más de days días
+	ArchiveCompletedTasks(ctx context.Context, days int) (int, error)
+
+	// GetTaskHistory obtiene las revisiones de una tarea
+	GetTaskHistory(ctx context.Context, id int) ([]*domain.TaskRevision, error)
+
+	// GetTaskAsOf obtiene la tarea tal como estaba en una fecha
+	GetTaskAsOf(ctx context.Context, id int, at time.Time) (*domain.Task, error)
+
+	// RevertTask restaura una tarea a una revisión anterior
+	RevertTask(ctx context.Context, id, revisionID int) (*domain.Task, error)
+
+	// MoveTask ubica una tarea en el orden manual antes de beforeID y/o después de afterID
+	MoveTask(ctx context.Context, id, beforeID, afterID int) (*domain.Task, error)
+
+	// SetTaskEstimate asigna los puntos y las horas estimadas de una tarea
+	SetTaskEstimate(ctx context.Context, id, storyPoints int, estimatedHours float64) (*domain.Task, error)
+
+	// UpdateRemainingWork actualiza las horas que faltan para terminar una tarea
+	UpdateRemainingWork(ctx context.Context, id int, remainingHours float64) (*domain.Task, error)
+
+	// SetTaskCustomFields guarda los valores de campos personalizados de una tarea (nil quita el campo)
+	SetTaskCustomFields(ctx context.Context, id int, values map[string]any) (*domain.Task, error)
+}
+
+// CommentServiceInterface define el contrato para el servicio de comentarios
+type CommentServiceInterface interface {
+	// AddComment agrega un comentario a una tarea
+	AddComment(ctx context.Context, taskID int, author, body string) (*domain.Comment, error)
+
+	// ListComments obtiene una página de comentarios de una tarea y el total
+	ListComments(ctx context.Context, taskID, limit, offset int) ([]*domain.Comment, int, error)
+
+	// EditComment reemplaza el contenido de un comentario guardando la versión anterior
+	EditComment(ctx context.Context, taskID, commentID int, body string) (*domain.Comment, error)
+
+	// GetCommentHistory obtiene las versiones anteriores de un comentario
+	GetCommentHistory(ctx context.Context, taskID, commentID int) ([]*domain.CommentRevision, error)
+
+	// DeleteComment elimina un comentario
+	DeleteComment(ctx context.Context, taskID, commentID int) error
+}
+
+// AttachmentServiceInterface define el contrato para el servicio de archivos adjuntos
+type AttachmentServiceInterface interface {
+	// UploadAttachment guarda un archivo adjunto a una tarea leyendo el contenido en streaming
+	UploadAttachment(ctx context.Context, taskID int, fileName string, content io.Reader) (*domain.Attachment, error)
+
+	// ListAttachments obtiene los adjuntos de una tarea
+	ListAttachments(ctx context.Context, taskID int) ([]*domain.Attachment, error)
+
+	// OpenAttachment obtiene los metadatos y el contenido de un adjunto
+	OpenAttachment(ctx context.Context, taskID, attachmentID int) (*domain.Attachment, io.ReadCloser, error)
+
+	// DeleteAttachment elimina un adjunto
+	DeleteAttachment(ctx context.Context, taskID, attachmentID int) error
+}
+
+// BoardServiceInterface define el contrato para el servicio de tableros kanban
+type BoardServiceInterface interface {
+	// CreateBoard crea un tablero con sus columnas en el orden recibido
+	CreateBoard(ctx context.Context, name string, swimlaneBy domain.SwimlaneMode, columns []domain.BoardColumn) (*domain.Board, error)
+
+	// GetBoard obtiene la vista completa del tablero agrupada por filas y columnas
+	GetBoard(ctx context.Context, id int) (*domain.BoardSnapshot, error)
+
+	// MoveCard ubica la tarea al final de una columna respetando su límite WIP
+	MoveCard(ctx context.Context, boardID, taskID, columnID int) (*domain.BoardCard, error)
+
+	// RemoveCard quita la tarea del tablero
+	RemoveCard(ctx context.Context, boardID, taskID int) error
+}
+
+// TimeTrackingServiceInterface define el contrato para el servicio de registro de tiempo
+type TimeTrackingServiceInterface interface {
+	// StartTimer inicia un temporizador del usuario sobre la tarea
+	StartTimer(ctx context.Context, taskID, userID int) (*domain.TimeEntry, error)
+
+	// StopTimer detiene el temporizador en curso del usuario
+	StopTimer(ctx context.Context, userID int) (*domain.TimeEntry, error)
+
+	// LogTime registra tiempo manualmente con duración y nota
+	LogTime(ctx context.Context, taskID, userID int, startedAt *time.Time, duration time.Duration, note string) (*domain.TimeEntry, error)
+
+	// ListTimeEntries obtiene los registros de una tarea y el total de segundos
+	ListTimeEntries(ctx context.Context, taskID int) ([]*domain.TimeEntry, int64, error)
+
+	// GetBoardTime obtiene el total de segundos registrados en las tareas de un tablero
+	GetBoardTime(ctx context.Context, boardID int) (int64, error)
+
+	// GetProjectTime obtiene el total de segundos registrados en las tareas de un proyecto
+	GetProjectTime(ctx context.Context, project string) (int64, error)
+
+	// GetTimeReport agrega el tiempo registrado en un rango por día, usuario, tarea o proyecto
+	GetTimeReport(ctx context.Context, from, to time.Time, groupBy domain.TimeReportGroup) (*domain.TimeReport, error)
+}
+
+// EstimateReportServiceInterface define el contrato para los reportes de velocidad y burndown
+type EstimateReportServiceInterface interface {
+	// GetVelocity obtiene el trabajo completado por semana en un rango
+	GetVelocity(ctx context.Context, from, to time.Time, boardID int) (*domain.Velocity, error)
+
+	// GetBurndown obtiene el trabajo estimado pendiente al terminar cada día de un rango
+	GetBurndown(ctx context.Context, from, to time.Time, boardID int) (*domain.Burndown, error)
+}
+
+// CustomFieldServiceInterface define el contrato para las definiciones de campos personalizados
+type CustomFieldServiceInterface interface {
+	// CreateField define un campo personalizado nuevo
+	CreateField(ctx context.Context, key, name string, fieldType domain.CustomFieldType, options []string) (*domain.CustomFieldDefinition, error)
+
+	// ListFields obtiene las definiciones de campos personalizados
+	ListFields(ctx context.Context) ([]*domain.CustomFieldDefinition, error)
+
+	// DeleteField elimina un campo personalizado y sus valores
+	DeleteField(ctx context.Context, key string) error
+}
+
+// TemplateServiceInterface define el contrato para las plantillas de tareas
+type TemplateServiceInterface interface {
+	// CreateTemplate guarda una plantilla nueva
+	CreateTemplate(ctx context.Context, name, description string, tasks []domain.TemplateTask) (*domain.TaskTemplate, error)
+
+	// GetTemplate obtiene una plantilla por su ID
+	GetTemplate(ctx context.Context, id int) (*domain.TaskTemplate, error)
+
+	// ListTemplates obtiene todas las plantillas
+	ListTemplates(ctx context.Context) ([]*domain.TaskTemplate, error)
+
+	// DeleteTemplate elimina una plantilla
+	DeleteTemplate(ctx context.Context, id int) error
+
+	// InstantiateTemplate crea las tareas de la plantilla en una sola operación
+	InstantiateTemplate(ctx context.Context, id int, variables map[string]string, start time.Time) ([]*domain.Task, error)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: assignment_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=assignment_repository.go -destination=../application/mocks/mock_assignment_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAssignmentRepository is a mock of AssignmentRepository interface.
+type MockAssignmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAssignmentRepositoryMockRecorder is the mock recorder for MockAssignmentRepository.
+type MockAssignmentRepositoryMockRecorder struct {
+	mock *MockAssignmentRepository
+}
+
+// NewMockAssignmentRepository creates a new mock instance.
+func NewMockAssignmentRepository(ctrl *gomock.Controller) *MockAssignmentRepository {
+	mock := &MockAssignmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAssignmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentRepository) EXPECT() *MockAssignmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Assign mocks base method.
+func (m *MockAssignmentRepository) Assign(ctx context.Context, taskID int, userIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Assign", ctx, taskID, userIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Assign indicates an expected call of Assign.
+func (mr *MockAssignmentRepositoryMockRecorder) Assign(ctx, taskID, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockAssignmentRepository)(nil).Assign), ctx, taskID, userIDs)
+}
+
+// GetTasksByAssignee mocks base method.
+func (m *MockAssignmentRepository) GetTasksByAssignee(ctx context.Context, userID int) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasksByAssignee", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasksByAssignee indicates an expected call of GetTasksByAssignee.
+func (mr *MockAssignmentRepositoryMockRecorder) GetTasksByAssignee(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByAssignee", reflect.TypeOf((*MockAssignmentRepository)(nil).GetTasksByAssignee), ctx, userID)
+}
+
+// Unassign mocks base method.
+func (m *MockAssignmentRepository) Unassign(ctx context.Context, taskID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unassign", ctx, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unassign indicates an expected call of Unassign.
+func (mr *MockAssignmentRepositoryMockRecorder) Unassign(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockAssignmentRepository)(nil).Unassign), ctx, taskID, userID)
+}
+
+// UnassignUser mocks base method.
+func (m *MockAssignmentRepository) UnassignUser(ctx context.Context, userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignUser", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignUser indicates an expected call of UnassignUser.
+func (mr *MockAssignmentRepositoryMockRecorder) UnassignUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignUser", reflect.TypeOf((*MockAssignmentRepository)(nil).UnassignUser), ctx, userID)
+}
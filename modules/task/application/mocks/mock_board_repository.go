@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: board_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=board_repository.go -destination=../application/mocks/mock_board_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBoardRepository is a mock of BoardRepository interface.
+type MockBoardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBoardRepositoryMockRecorder is the mock recorder for MockBoardRepository.
+type MockBoardRepositoryMockRecorder struct {
+	mock *MockBoardRepository
+}
+
+// NewMockBoardRepository creates a new mock instance.
+func NewMockBoardRepository(ctrl *gomock.Controller) *MockBoardRepository {
+	mock := &MockBoardRepository{ctrl: ctrl}
+	mock.recorder = &MockBoardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardRepository) EXPECT() *MockBoardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountCards mocks base method.
+func (m *MockBoardRepository) CountCards(ctx context.Context, columnID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCards", ctx, columnID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCards indicates an expected call of CountCards.
+func (mr *MockBoardRepositoryMockRecorder) CountCards(ctx, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCards", reflect.TypeOf((*MockBoardRepository)(nil).CountCards), ctx, columnID)
+}
+
+// Create mocks base method.
+func (m *MockBoardRepository) Create(ctx context.Context, board *domain.Board) (*domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, board)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBoardRepositoryMockRecorder) Create(ctx, board any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBoardRepository)(nil).Create), ctx, board)
+}
+
+// GetByID mocks base method.
+func (m *MockBoardRepository) GetByID(ctx context.Context, id int) (*domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockBoardRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockBoardRepository)(nil).GetByID), ctx, id)
+}
+
+// GetCard mocks base method.
+func (m *MockBoardRepository) GetCard(ctx context.Context, boardID, taskID int) (*domain.BoardCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCard", ctx, boardID, taskID)
+	ret0, _ := ret[0].(*domain.BoardCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCard indicates an expected call of GetCard.
+func (mr *MockBoardRepositoryMockRecorder) GetCard(ctx, boardID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCard", reflect.TypeOf((*MockBoardRepository)(nil).GetCard), ctx, boardID, taskID)
+}
+
+// GetSnapshot mocks base method.
+func (m *MockBoardRepository) GetSnapshot(ctx context.Context, id int) (*domain.Board, []domain.BoardCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshot", ctx, id)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].([]domain.BoardCard)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshot indicates an expected call of GetSnapshot.
+func (mr *MockBoardRepositoryMockRecorder) GetSnapshot(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockBoardRepository)(nil).GetSnapshot), ctx, id)
+}
+
+// LastCardRank mocks base method.
+func (m *MockBoardRepository) LastCardRank(ctx context.Context, columnID int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastCardRank", ctx, columnID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastCardRank indicates an expected call of LastCardRank.
+func (mr *MockBoardRepositoryMockRecorder) LastCardRank(ctx, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastCardRank", reflect.TypeOf((*MockBoardRepository)(nil).LastCardRank), ctx, columnID)
+}
+
+// PutCard mocks base method.
+func (m *MockBoardRepository) PutCard(ctx context.Context, card *domain.BoardCard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutCard", ctx, card)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutCard indicates an expected call of PutCard.
+func (mr *MockBoardRepositoryMockRecorder) PutCard(ctx, card any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCard", reflect.TypeOf((*MockBoardRepository)(nil).PutCard), ctx, card)
+}
+
+// RemoveCard mocks base method.
+func (m *MockBoardRepository) RemoveCard(ctx context.Context, boardID, taskID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCard", ctx, boardID, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCard indicates an expected call of RemoveCard.
+func (mr *MockBoardRepositoryMockRecorder) RemoveCard(ctx, boardID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCard", reflect.TypeOf((*MockBoardRepository)(nil).RemoveCard), ctx, boardID, taskID)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: custom_field_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=custom_field_repository.go -destination=../application/mocks/mock_custom_field_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCustomFieldRepository is a mock of CustomFieldRepository interface.
+type MockCustomFieldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldRepositoryMockRecorder is the mock recorder for MockCustomFieldRepository.
+type MockCustomFieldRepositoryMockRecorder struct {
+	mock *MockCustomFieldRepository
+}
+
+// NewMockCustomFieldRepository creates a new mock instance.
+func NewMockCustomFieldRepository(ctrl *gomock.Controller) *MockCustomFieldRepository {
+	mock := &MockCustomFieldRepository{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldRepository) EXPECT() *MockCustomFieldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateDefinition mocks base method.
+func (m *MockCustomFieldRepository) CreateDefinition(ctx context.Context, field *domain.CustomFieldDefinition) (*domain.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDefinition", ctx, field)
+	ret0, _ := ret[0].(*domain.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDefinition indicates an expected call of CreateDefinition.
+func (mr *MockCustomFieldRepositoryMockRecorder) CreateDefinition(ctx, field any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDefinition", reflect.TypeOf((*MockCustomFieldRepository)(nil).CreateDefinition), ctx, field)
+}
+
+// DeleteDefinition mocks base method.
+func (m *MockCustomFieldRepository) DeleteDefinition(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDefinition", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDefinition indicates an expected call of DeleteDefinition.
+func (mr *MockCustomFieldRepositoryMockRecorder) DeleteDefinition(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDefinition", reflect.TypeOf((*MockCustomFieldRepository)(nil).DeleteDefinition), ctx, key)
+}
+
+// GetDefinition mocks base method.
+func (m *MockCustomFieldRepository) GetDefinition(ctx context.Context, key string) (*domain.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefinition", ctx, key)
+	ret0, _ := ret[0].(*domain.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefinition indicates an expected call of GetDefinition.
+func (mr *MockCustomFieldRepositoryMockRecorder) GetDefinition(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefinition", reflect.TypeOf((*MockCustomFieldRepository)(nil).GetDefinition), ctx, key)
+}
+
+// ListDefinitions mocks base method.
+func (m *MockCustomFieldRepository) ListDefinitions(ctx context.Context) ([]*domain.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDefinitions", ctx)
+	ret0, _ := ret[0].([]*domain.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDefinitions indicates an expected call of ListDefinitions.
+func (mr *MockCustomFieldRepositoryMockRecorder) ListDefinitions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDefinitions", reflect.TypeOf((*MockCustomFieldRepository)(nil).ListDefinitions), ctx)
+}
+
+// SetValues mocks base method.
+func (m *MockCustomFieldRepository) SetValues(ctx context.Context, taskID int, set []domain.CustomFieldValue, remove []*domain.CustomFieldDefinition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetValues", ctx, taskID, set, remove)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetValues indicates an expected call of SetValues.
+func (mr *MockCustomFieldRepositoryMockRecorder) SetValues(ctx, taskID, set, remove any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValues", reflect.TypeOf((*MockCustomFieldRepository)(nil).SetValues), ctx, taskID, set, remove)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dependency_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=dependency_repository.go -destination=../application/mocks/mock_dependency_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDependencyRepository is a mock of DependencyRepository interface.
+type MockDependencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDependencyRepositoryMockRecorder is the mock recorder for MockDependencyRepository.
+type MockDependencyRepositoryMockRecorder struct {
+	mock *MockDependencyRepository
+}
+
+// NewMockDependencyRepository creates a new mock instance.
+func NewMockDependencyRepository(ctrl *gomock.Controller) *MockDependencyRepository {
+	mock := &MockDependencyRepository{ctrl: ctrl}
+	mock.recorder = &MockDependencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyRepository) EXPECT() *MockDependencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddDependency mocks base method.
+func (m *MockDependencyRepository) AddDependency(ctx context.Context, taskID, blockerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDependency", ctx, taskID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDependency indicates an expected call of AddDependency.
+func (mr *MockDependencyRepositoryMockRecorder) AddDependency(ctx, taskID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDependency", reflect.TypeOf((*MockDependencyRepository)(nil).AddDependency), ctx, taskID, blockerID)
+}
+
+// GetAllDependencies mocks base method.
+func (m *MockDependencyRepository) GetAllDependencies(ctx context.Context) ([]domain.Dependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDependencies", ctx)
+	ret0, _ := ret[0].([]domain.Dependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDependencies indicates an expected call of GetAllDependencies.
+func (mr *MockDependencyRepositoryMockRecorder) GetAllDependencies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDependencies", reflect.TypeOf((*MockDependencyRepository)(nil).GetAllDependencies), ctx)
+}
+
+// GetBlockers mocks base method.
+func (m *MockDependencyRepository) GetBlockers(ctx context.Context, taskID int) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockers", ctx, taskID)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockers indicates an expected call of GetBlockers.
+func (mr *MockDependencyRepositoryMockRecorder) GetBlockers(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockers", reflect.TypeOf((*MockDependencyRepository)(nil).GetBlockers), ctx, taskID)
+}
+
+// RemoveDependency mocks base method.
+func (m *MockDependencyRepository) RemoveDependency(ctx context.Context, taskID, blockerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDependency", ctx, taskID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDependency indicates an expected call of RemoveDependency.
+func (mr *MockDependencyRepositoryMockRecorder) RemoveDependency(ctx, taskID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDependency", reflect.TypeOf((*MockDependencyRepository)(nil).RemoveDependency), ctx, taskID, blockerID)
+}
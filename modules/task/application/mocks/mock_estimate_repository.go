@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: estimate_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=estimate_repository.go -destination=../application/mocks/mock_estimate_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEstimateRepository is a mock of EstimateRepository interface.
+type MockEstimateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEstimateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEstimateRepositoryMockRecorder is the mock recorder for MockEstimateRepository.
+type MockEstimateRepositoryMockRecorder struct {
+	mock *MockEstimateRepository
+}
+
+// NewMockEstimateRepository creates a new mock instance.
+func NewMockEstimateRepository(ctrl *gomock.Controller) *MockEstimateRepository {
+	mock := &MockEstimateRepository{ctrl: ctrl}
+	mock.recorder = &MockEstimateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEstimateRepository) EXPECT() *MockEstimateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Burndown mocks base method.
+func (m *MockEstimateRepository) Burndown(ctx context.Context, from, to time.Time, boardID int) ([]domain.BurndownDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Burndown", ctx, from, to, boardID)
+	ret0, _ := ret[0].([]domain.BurndownDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Burndown indicates an expected call of Burndown.
+func (mr *MockEstimateRepositoryMockRecorder) Burndown(ctx, from, to, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burndown", reflect.TypeOf((*MockEstimateRepository)(nil).Burndown), ctx, from, to, boardID)
+}
+
+// Velocity mocks base method.
+func (m *MockEstimateRepository) Velocity(ctx context.Context, from, to time.Time, boardID int) ([]domain.VelocityWeek, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Velocity", ctx, from, to, boardID)
+	ret0, _ := ret[0].([]domain.VelocityWeek)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Velocity indicates an expected call of Velocity.
+func (mr *MockEstimateRepositoryMockRecorder) Velocity(ctx, from, to, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Velocity", reflect.TypeOf((*MockEstimateRepository)(nil).Velocity), ctx, from, to, boardID)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: history_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=history_repository.go -destination=../application/mocks/mock_history_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTaskHistoryRepository is a mock of TaskHistoryRepository interface.
+type MockTaskHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTaskHistoryRepositoryMockRecorder is the mock recorder for MockTaskHistoryRepository.
+type MockTaskHistoryRepositoryMockRecorder struct {
+	mock *MockTaskHistoryRepository
+}
+
+// NewMockTaskHistoryRepository creates a new mock instance.
+func NewMockTaskHistoryRepository(ctrl *gomock.Controller) *MockTaskHistoryRepository {
+	mock := &MockTaskHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskHistoryRepository) EXPECT() *MockTaskHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateWithRevision mocks base method.
+func (m *MockTaskHistoryRepository) CreateWithRevision(ctx context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithRevision", ctx, task, revision)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithRevision indicates an expected call of CreateWithRevision.
+func (mr *MockTaskHistoryRepositoryMockRecorder) CreateWithRevision(ctx, task, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithRevision", reflect.TypeOf((*MockTaskHistoryRepository)(nil).CreateWithRevision), ctx, task, revision)
+}
+
+// DeleteWithRevision mocks base method.
+func (m *MockTaskHistoryRepository) DeleteWithRevision(ctx context.Context, id int, revision *domain.TaskRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWithRevision", ctx, id, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWithRevision indicates an expected call of DeleteWithRevision.
+func (mr *MockTaskHistoryRepositoryMockRecorder) DeleteWithRevision(ctx, id, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWithRevision", reflect.TypeOf((*MockTaskHistoryRepository)(nil).DeleteWithRevision), ctx, id, revision)
+}
+
+// GetRevision mocks base method.
+func (m *MockTaskHistoryRepository) GetRevision(ctx context.Context, taskID, revisionID int) (*domain.TaskRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", ctx, taskID, revisionID)
+	ret0, _ := ret[0].(*domain.TaskRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockTaskHistoryRepositoryMockRecorder) GetRevision(ctx, taskID, revisionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockTaskHistoryRepository)(nil).GetRevision), ctx, taskID, revisionID)
+}
+
+// GetRevisionAt mocks base method.
+func (m *MockTaskHistoryRepository) GetRevisionAt(ctx context.Context, taskID int, at time.Time) (*domain.TaskRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionAt", ctx, taskID, at)
+	ret0, _ := ret[0].(*domain.TaskRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionAt indicates an expected call of GetRevisionAt.
+func (mr *MockTaskHistoryRepositoryMockRecorder) GetRevisionAt(ctx, taskID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionAt", reflect.TypeOf((*MockTaskHistoryRepository)(nil).GetRevisionAt), ctx, taskID, at)
+}
+
+// GetRevisions mocks base method.
+func (m *MockTaskHistoryRepository) GetRevisions(ctx context.Context, taskID int) ([]*domain.TaskRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, taskID)
+	ret0, _ := ret[0].([]*domain.TaskRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockTaskHistoryRepositoryMockRecorder) GetRevisions(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockTaskHistoryRepository)(nil).GetRevisions), ctx, taskID)
+}
+
+// UpdateWithRevision mocks base method.
+func (m *MockTaskHistoryRepository) UpdateWithRevision(ctx context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithRevision", ctx, task, revision)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWithRevision indicates an expected call of UpdateWithRevision.
+func (mr *MockTaskHistoryRepositoryMockRecorder) UpdateWithRevision(ctx, task, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithRevision", reflect.TypeOf((*MockTaskHistoryRepository)(nil).UpdateWithRevision), ctx, task, revision)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,34 @@ func (m *MockTaskRepository) EXPECT() *MockTaskRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ApplyBatch mocks base method.
+func (m *MockTaskRepository) ApplyBatch(ctx context.Context, batch *domain.TaskBatch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyBatch", ctx, batch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyBatch indicates an expected call of ApplyBatch.
+func (mr *MockTaskRepositoryMockRecorder) ApplyBatch(ctx, batch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyBatch", reflect.TypeOf((*MockTaskRepository)(nil).ApplyBatch), ctx, batch)
+}
+
+// Archive mocks base method.
+func (m *MockTaskRepository) Archive(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Archive", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Archive indicates an expected call of Archive.
+func (mr *MockTaskRepositoryMockRecorder) Archive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockTaskRepository)(nil).Archive), ctx, id)
+}
+
 // Create mocks base method.
 func (m *MockTaskRepository) Create(ctx context.Context, task *domain.Task) (*domain.Task, error) {
 	m.ctrl.T.Helper()
@@ -70,19 +99,49 @@ func (mr *MockTaskRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTaskRepository)(nil).Delete), ctx, id)
 }
 
+// DeletePermanently mocks base method.
+func (m *MockTaskRepository) DeletePermanently(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermanently", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePermanently indicates an expected call of DeletePermanently.
+func (mr *MockTaskRepositoryMockRecorder) DeletePermanently(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanently", reflect.TypeOf((*MockTaskRepository)(nil).DeletePermanently), ctx, id)
+}
+
+// GetAdjacentRank mocks base method.
+func (m *MockTaskRepository) GetAdjacentRank(ctx context.Context, rank string, excludeID int, next bool) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdjacentRank", ctx, rank, excludeID, next)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAdjacentRank indicates an expected call of GetAdjacentRank.
+func (mr *MockTaskRepositoryMockRecorder) GetAdjacentRank(ctx, rank, excludeID, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdjacentRank", reflect.TypeOf((*MockTaskRepository)(nil).GetAdjacentRank), ctx, rank, excludeID, next)
+}
+
 // GetAll mocks base method.
-func (m *MockTaskRepository) GetAll(ctx context.Context) ([]*domain.Task, error) {
+func (m *MockTaskRepository) GetAll(ctx context.Context, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret := m.ctrl.Call(m, "GetAll", ctx, opts)
 	ret0, _ := ret[0].([]*domain.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockTaskRepositoryMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) GetAll(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTaskRepository)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTaskRepository)(nil).GetAll), ctx, opts)
 }
 
 // GetByID mocks base method.
@@ -101,18 +160,120 @@ func (mr *MockTaskRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 }
 
 // GetByStatus mocks base method.
-func (m *MockTaskRepository) GetByStatus(ctx context.Context, completed bool) ([]*domain.Task, error) {
+func (m *MockTaskRepository) GetByStatus(ctx context.Context, completed bool, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByStatus", ctx, completed)
+	ret := m.ctrl.Call(m, "GetByStatus", ctx, completed, opts)
 	ret0, _ := ret[0].([]*domain.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByStatus indicates an expected call of GetByStatus.
-func (mr *MockTaskRepositoryMockRecorder) GetByStatus(ctx, completed any) *gomock.Call {
+func (mr *MockTaskRepositoryMockRecorder) GetByStatus(ctx, completed, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStatus", reflect.TypeOf((*MockTaskRepository)(nil).GetByStatus), ctx, completed, opts)
+}
+
+// GetCompletedBefore mocks base method.
+func (m *MockTaskRepository) GetCompletedBefore(ctx context.Context, before time.Time) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletedBefore", ctx, before)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletedBefore indicates an expected call of GetCompletedBefore.
+func (mr *MockTaskRepositoryMockRecorder) GetCompletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedBefore", reflect.TypeOf((*MockTaskRepository)(nil).GetCompletedBefore), ctx, before)
+}
+
+// GetDeleted mocks base method.
+func (m *MockTaskRepository) GetDeleted(ctx context.Context) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", ctx)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted.
+func (mr *MockTaskRepositoryMockRecorder) GetDeleted(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByStatus", reflect.TypeOf((*MockTaskRepository)(nil).GetByStatus), ctx, completed)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockTaskRepository)(nil).GetDeleted), ctx)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockTaskRepository) GetDeletedByID(ctx context.Context, id int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockTaskRepositoryMockRecorder) GetDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockTaskRepository)(nil).GetDeletedByID), ctx, id)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockTaskRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx, before)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockTaskRepositoryMockRecorder) PurgeDeleted(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockTaskRepository)(nil).PurgeDeleted), ctx, before)
+}
+
+// RebalanceRanks mocks base method.
+func (m *MockTaskRepository) RebalanceRanks(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalanceRanks", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebalanceRanks indicates an expected call of RebalanceRanks.
+func (mr *MockTaskRepositoryMockRecorder) RebalanceRanks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalanceRanks", reflect.TypeOf((*MockTaskRepository)(nil).RebalanceRanks), ctx)
+}
+
+// Restore mocks base method.
+func (m *MockTaskRepository) Restore(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTaskRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTaskRepository)(nil).Restore), ctx, id)
+}
+
+// Unarchive mocks base method.
+func (m *MockTaskRepository) Unarchive(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unarchive", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unarchive indicates an expected call of Unarchive.
+func (mr *MockTaskRepositoryMockRecorder) Unarchive(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unarchive", reflect.TypeOf((*MockTaskRepository)(nil).Unarchive), ctx, id)
 }
 
 // Update mocks base method.
@@ -129,3 +290,17 @@ func (mr *MockTaskRepositoryMockRecorder) Update(ctx, task any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTaskRepository)(nil).Update), ctx, task)
 }
+
+// UpdateRank mocks base method.
+func (m *MockTaskRepository) UpdateRank(ctx context.Context, id int, rank string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRank", ctx, id, rank)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRank indicates an expected call of UpdateRank.
+func (mr *MockTaskRepositoryMockRecorder) UpdateRank(ctx, id, rank any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRank", reflect.TypeOf((*MockTaskRepository)(nil).UpdateRank), ctx, id, rank)
+}
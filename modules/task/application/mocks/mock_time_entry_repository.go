@@ -0,0 +1,177 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: time_entry_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=time_entry_repository.go -destination=../application/mocks/mock_time_entry_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTimeEntryRepository is a mock of TimeEntryRepository interface.
+type MockTimeEntryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeEntryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeEntryRepositoryMockRecorder is the mock recorder for MockTimeEntryRepository.
+type MockTimeEntryRepositoryMockRecorder struct {
+	mock *MockTimeEntryRepository
+}
+
+// NewMockTimeEntryRepository creates a new mock instance.
+func NewMockTimeEntryRepository(ctrl *gomock.Controller) *MockTimeEntryRepository {
+	mock := &MockTimeEntryRepository{ctrl: ctrl}
+	mock.recorder = &MockTimeEntryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeEntryRepository) EXPECT() *MockTimeEntryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BoardTotal mocks base method.
+func (m *MockTimeEntryRepository) BoardTotal(ctx context.Context, boardID int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BoardTotal", ctx, boardID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BoardTotal indicates an expected call of BoardTotal.
+func (mr *MockTimeEntryRepositoryMockRecorder) BoardTotal(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BoardTotal", reflect.TypeOf((*MockTimeEntryRepository)(nil).BoardTotal), ctx, boardID)
+}
+
+// Create mocks base method.
+func (m *MockTimeEntryRepository) Create(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(*domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTimeEntryRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTimeEntryRepository)(nil).Create), ctx, entry)
+}
+
+// Finish mocks base method.
+func (m *MockTimeEntryRepository) Finish(ctx context.Context, entry *domain.TimeEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockTimeEntryRepositoryMockRecorder) Finish(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockTimeEntryRepository)(nil).Finish), ctx, entry)
+}
+
+// GetRunning mocks base method.
+func (m *MockTimeEntryRepository) GetRunning(ctx context.Context, userID int) (*domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRunning", ctx, userID)
+	ret0, _ := ret[0].(*domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRunning indicates an expected call of GetRunning.
+func (mr *MockTimeEntryRepositoryMockRecorder) GetRunning(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRunning", reflect.TypeOf((*MockTimeEntryRepository)(nil).GetRunning), ctx, userID)
+}
+
+// ListByTask mocks base method.
+func (m *MockTimeEntryRepository) ListByTask(ctx context.Context, taskID int) ([]*domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTask", ctx, taskID)
+	ret0, _ := ret[0].([]*domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTask indicates an expected call of ListByTask.
+func (mr *MockTimeEntryRepositoryMockRecorder) ListByTask(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTask", reflect.TypeOf((*MockTimeEntryRepository)(nil).ListByTask), ctx, taskID)
+}
+
+// ProjectTotal mocks base method.
+func (m *MockTimeEntryRepository) ProjectTotal(ctx context.Context, project string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProjectTotal", ctx, project)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProjectTotal indicates an expected call of ProjectTotal.
+func (mr *MockTimeEntryRepositoryMockRecorder) ProjectTotal(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProjectTotal", reflect.TypeOf((*MockTimeEntryRepository)(nil).ProjectTotal), ctx, project)
+}
+
+// Report mocks base method.
+func (m *MockTimeEntryRepository) Report(ctx context.Context, from, to time.Time, groupBy domain.TimeReportGroup) ([]domain.TimeReportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, from, to, groupBy)
+	ret0, _ := ret[0].([]domain.TimeReportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockTimeEntryRepositoryMockRecorder) Report(ctx, from, to, groupBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockTimeEntryRepository)(nil).Report), ctx, from, to, groupBy)
+}
+
+// StartTimer mocks base method.
+func (m *MockTimeEntryRepository) StartTimer(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", ctx, entry)
+	ret0, _ := ret[0].(*domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockTimeEntryRepositoryMockRecorder) StartTimer(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockTimeEntryRepository)(nil).StartTimer), ctx, entry)
+}
+
+// TaskTotal mocks base method.
+func (m *MockTimeEntryRepository) TaskTotal(ctx context.Context, taskID int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskTotal", ctx, taskID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskTotal indicates an expected call of TaskTotal.
+func (mr *MockTimeEntryRepositoryMockRecorder) TaskTotal(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskTotal", reflect.TypeOf((*MockTimeEntryRepository)(nil).TaskTotal), ctx, taskID)
+}
@@ -0,0 +1,101 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// MoveTask ubica una tarea en el orden manual justo después de afterID y/o antes de
+// beforeID (0 si no se indica). Si no queda espacio entre las posiciones vecinas,
+// se reequilibran todos los ranks y se vuelve a calcular.
+func (s *TaskService) MoveTask(ctx context.Context, id, beforeID, afterID int) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+	if beforeID == 0 && afterID == 0 {
+		return nil, fmt.Errorf("%w: se requiere before o after", domain.ErrInvalidMove)
+	}
+	if beforeID == id || afterID == id {
+		return nil, fmt.Errorf("%w: una tarea no puede ubicarse respecto de sí misma", domain.ErrInvalidMove)
+	}
+
+	// El reequilibrio y el nuevo rank van en una transacción: si el movimiento falla, los
+	// ranks reequilibrados tampoco se guardan
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		rank, err := s.rankForMove(ctx, id, beforeID, afterID)
+		if errors.Is(err, domain.ErrRankTooDense) {
+			if err := s.taskRepo.RebalanceRanks(ctx); err != nil {
+				return nil, fmt.Errorf("no se pudieron reequilibrar las posiciones: %w", err)
+			}
+			rank, err = s.rankForMove(ctx, id, beforeID, afterID)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return s.touchTask(ctx, task, func(ctx context.Context) error {
+			if err := s.taskRepo.UpdateRank(ctx, id, rank); err != nil {
+				return fmt.Errorf("no se pudo mover la tarea %d: %w", id, err)
+			}
+			return nil
+		})
+	})
+}
+
+// rankForMove calcula el rank entre las tareas de referencia. Retorna ErrRankTooDense
+// si no queda espacio o si alguna tarea involucrada aún no tiene rank.
+func (s *TaskService) rankForMove(ctx context.Context, id, beforeID, afterID int) (string, error) {
+	var lower, upper string
+	if afterID != 0 {
+		after, err := s.taskRepo.GetByID(ctx, afterID)
+		if err != nil {
+			return "", fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", afterID, err)
+		}
+		lower = after.Rank
+	}
+	if beforeID != 0 {
+		before, err := s.taskRepo.GetByID(ctx, beforeID)
+		if err != nil {
+			return "", fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", beforeID, err)
+		}
+		upper = before.Rank
+	}
+	if (afterID != 0 && lower == "") || (beforeID != 0 && upper == "") {
+		return "", domain.ErrRankTooDense
+	}
+
+	// Con una sola referencia, el otro límite es la tarea vecina
+	if beforeID == 0 {
+		next, found, err := s.taskRepo.GetAdjacentRank(ctx, lower, id, true)
+		if err != nil {
+			return "", fmt.Errorf("no se pudo obtener la posición siguiente: %w", err)
+		}
+		if found {
+			upper = next
+		}
+	}
+	if afterID == 0 {
+		previous, found, err := s.taskRepo.GetAdjacentRank(ctx, upper, id, false)
+		if err != nil {
+			return "", fmt.Errorf("no se pudo obtener la posición anterior: %w", err)
+		}
+		if found && previous == "" {
+			return "", domain.ErrRankTooDense
+		}
+		lower = previous
+	}
+
+	rank, err := domain.RankBetween(lower, upper)
+	if errors.Is(err, domain.ErrInvalidMove) {
+		return "", fmt.Errorf("%w: la tarea %d debe estar antes que la tarea %d", err, afterID, beforeID)
+	}
+	return rank, err
+}
@@ -0,0 +1,69 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// PatchTask aplica cambios explícitos a una tarea: solo se modifican los campos presentes en el
+// parche, lo que permite vaciar campos opcionales como la fecha de vencimiento o la recurrencia
+func (s *TaskService) PatchTask(ctx context.Context, id int, patch domain.TaskPatch) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	// Lectura, escritura y siguiente ocurrencia van en una transacción: si algo falla no se guarda nada
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		// Obtener la tarea existente; las archivadas son de solo lectura
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if patch.IsEmpty() {
+			return task, nil
+		}
+
+		// Estado anterior para el historial
+		before := *task
+		wasCompleted := task.Completed
+		if err := s.applyPatch(ctx, task, patch); err != nil {
+			return nil, err
+		}
+
+		updatedTask, err := s.saveTask(ctx, &before, task, updateAction(wasCompleted, task))
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo actualizar la tarea: %w", err)
+		}
+
+		// Una tarea recurrente que se completa genera su siguiente ocurrencia
+		if !wasCompleted && task.Completed {
+			if err := s.spawnNextOccurrence(ctx, task); err != nil {
+				return nil, err
+			}
+		}
+
+		return updatedTask, nil
+	})
+}
+
+// applyPatch aplica el parche a la tarea y resuelve el cambio de estado de completado,
+// que requiere que la tarea no tenga bloqueadores abiertos
+func (s *TaskService) applyPatch(ctx context.Context, task *domain.Task, patch domain.TaskPatch) error {
+	if err := task.ApplyPatch(patch); err != nil {
+		return err
+	}
+	if patch.Completed == nil || *patch.Completed == task.Completed {
+		return nil
+	}
+	if *patch.Completed {
+		if err := s.ensureNotBlocked(ctx, task.ID); err != nil {
+			return err
+		}
+		task.MarkAsCompleted()
+	} else {
+		task.MarkAsUncompleted()
+	}
+	return nil
+}
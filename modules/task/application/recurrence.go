@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// SetTaskSchedule asigna la fecha de vencimiento y la regla de recurrencia de una tarea.
+// Una regla vacía deja de repetir la tarea.
+func (s *TaskService) SetTaskSchedule(ctx context.Context, id int, dueDate *time.Time, recurrence string) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		before := *task
+		if err := task.SetSchedule(dueDate, recurrence); err != nil {
+			return nil, fmt.Errorf("no se pudo programar la tarea: %w", err)
+		}
+
+		updatedTask, err := s.saveTask(ctx, &before, task, domain.RevisionUpdate)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo actualizar la tarea: %w", err)
+		}
+		return updatedTask, nil
+	})
+}
+
+// spawnNextOccurrence crea la siguiente ocurrencia de una tarea recurrente recién completada
+func (s *TaskService) spawnNextOccurrence(ctx context.Context, task *domain.Task) error {
+	next, err := task.NextOccurrence()
+	if err != nil {
+		return fmt.Errorf("no se pudo calcular la siguiente ocurrencia de la tarea %d: %w", task.ID, err)
+	}
+	if next == nil {
+		return nil
+	}
+
+	created, err := s.createTask(ctx, next)
+	if err != nil {
+		return fmt.Errorf("no se pudo crear la siguiente ocurrencia de la tarea %d: %w", task.ID, err)
+	}
+
+	// La siguiente ocurrencia conserva a los responsables
+	if s.assignmentRepo != nil && len(task.Assignees) > 0 {
+		if err := s.assignmentRepo.Assign(ctx, created.ID, task.Assignees); err != nil {
+			return fmt.Errorf("no se pudieron asignar los responsables a la tarea %d: %w", created.ID, err)
+		}
+	}
+	return nil
+}
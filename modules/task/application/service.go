@@ -5,18 +5,71 @@ import (
 	"fmt"
 
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
 )
 
 // TaskService maneja los casos de uso relacionados con tareas
 type TaskService struct {
-	taskRepo domain.TaskRepository
+	taskRepo        domain.TaskRepository
+	dependencyRepo  domain.DependencyRepository
+	enforceBlockers bool
+	commentRepo     domain.CommentRepository
+	commentPolicy   domain.CommentDeletePolicy
+	attachmentRepo  domain.AttachmentRepository
+	blobStore       domain.BlobStore
+	assignmentRepo  domain.AssignmentRepository
+	userRepo        userdomain.UserRepository
+	historyRepo     domain.TaskHistoryRepository
+	customFieldRepo domain.CustomFieldRepository
+	tx              TxManager
+	publisher       EventPublisher
+	outbox          EventOutbox
+}
+
+// TaskServiceOption configura dependencias opcionales de TaskService
+type TaskServiceOption func(*TaskService)
+
+// WithDependencyRepository habilita las dependencias entre tareas
+func WithDependencyRepository(repo domain.DependencyRepository) TaskServiceOption {
+	return func(s *TaskService) {
+		s.dependencyRepo = repo
+	}
+}
+
+// WithBlockerEnforcement impide completar tareas con bloqueadores sin completar
+func WithBlockerEnforcement(enabled bool) TaskServiceOption {
+	return func(s *TaskService) {
+		s.enforceBlockers = enabled
+	}
+}
+
+// WithCommentRepository define qué hacer con los comentarios al eliminar definitivamente una tarea
+func WithCommentRepository(repo domain.CommentRepository, policy domain.CommentDeletePolicy) TaskServiceOption {
+	return func(s *TaskService) {
+		s.commentRepo = repo
+		s.commentPolicy = policy
+	}
+}
+
+// WithAttachments libera los adjuntos al eliminar definitivamente una tarea: sus metadatos y los
+// blobs que ningún otro adjunto usa
+func WithAttachments(repo domain.AttachmentRepository, blobStore domain.BlobStore) TaskServiceOption {
+	return func(s *TaskService) {
+		s.attachmentRepo = repo
+		s.blobStore = blobStore
+	}
 }
 
 // NewTaskService crea una nueva instancia de TaskService
-func NewTaskService(taskRepo domain.TaskRepository) *TaskService {
-	return &TaskService{
+func NewTaskService(taskRepo domain.TaskRepository, opts ...TaskServiceOption) *TaskService {
+	s := &TaskService{
 		taskRepo: taskRepo,
+		tx:       noTxManager{},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // CreateTask crea una nueva tarea
@@ -37,7 +90,7 @@ func (s *TaskService) CreateTask(ctx context.Context, title, description string)
 	}
 
 	// Persistir usando el repositorio
-	return s.taskRepo.Create(ctx, task)
+	return s.createTask(ctx, task)
 }
 
 // GetTaskByID obtiene una tarea por su ID
@@ -54,9 +107,13 @@ func (s *TaskService) GetTaskByID(ctx context.Context, id int) (*domain.Task, er
 	return task, nil
 }
 
-// GetAllTasks obtiene todas las tareas
-func (s *TaskService) GetAllTasks(ctx context.Context) ([]*domain.Task, error) {
-	tasks, err := s.taskRepo.GetAll(ctx)
+// GetAllTasks obtiene todas las tareas; las archivadas solo si opts.IncludeArchived
+func (s *TaskService) GetAllTasks(ctx context.Context, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
+	if err := s.resolveCustomFieldQuery(ctx, &opts); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.GetAll(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("no se pudieron obtener las tareas: %w", err)
 	}
@@ -64,80 +121,54 @@ func (s *TaskService) GetAllTasks(ctx context.Context) ([]*domain.Task, error) {
 	return tasks, nil
 }
 
-// UpdateTask actualiza una tarea existente
+// UpdateTask actualiza una tarea existente; un título o descripción vacíos no se modifican.
+// Para vaciar campos o aplicar cambios parciales explícitos se usa PatchTask.
 func (s *TaskService) UpdateTask(ctx context.Context, id int, title, description string, completed *bool) (*domain.Task, error) {
 	if id == 0 {
 		return nil, fmt.Errorf("El ID de la tarea es requerido")
 	}
 
-	// Obtener la tarea existente
-	task, err := s.taskRepo.GetByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", id, err)
-	}
-
-	// Preparar los valores finales para la actualización
-	finalTitle := task.Title             // Valor actual por defecto
-	finalDescription := task.Description // Valor actual por defecto
-
-	// Actualizar solo los campos proporcionados (no vacíos)
+	patch := domain.TaskPatch{Completed: completed}
 	if title != "" {
-		finalTitle = title
+		patch.Title = &title
 	}
 	if description != "" {
-		finalDescription = description
-	}
-
-	// Aplicar la actualización UNA SOLA VEZ con los valores finales
-	task.Update(finalTitle, finalDescription)
-
-	// Actualizar estado de completado si se proporciona
-	if completed != nil {
-		if *completed {
-			task.MarkAsCompleted()
-		} else {
-			task.MarkAsUncompleted()
-		}
-	}
-
-	// Validar la tarea actualizada
-	if !task.IsValid() {
-		return nil, fmt.Errorf("la tarea actualizada no es valida")
-	}
-
-	// AQUÍ ES DONDE SE GUARDAN LOS CAMBIOS EN LA BASE DE DATOS
-	updatedTask, err := s.taskRepo.Update(ctx, task)
-	if err != nil {
-		return nil, fmt.Errorf("no se pudo actualizar la tarea: %w", err)
+		patch.Description = &description
 	}
-
-	return updatedTask, nil
+	return s.PatchTask(ctx, id, patch)
 }
 
-// DeleteTask elimina una tarea por su ID
+// DeleteTask mueve una tarea a la papelera; se puede restaurar hasta que se purgue
 func (s *TaskService) DeleteTask(ctx context.Context, id int) error {
 	if id == 0 {
 		return fmt.Errorf("el ID de la tarea es requerido")
 	}
 
-	// Verificar que la tarea existe antes de eliminarla
-	_, err := s.taskRepo.GetByID(ctx, id)
-	if err != nil {
-		return fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", id, err)
-	}
+	// La verificación y el envío a la papelera van en una transacción
+	return s.withinTx(ctx, func(ctx context.Context) error {
+		// Verificar que la tarea existe y no está archivada antes de eliminarla
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return err
+		}
 
-	// Eliminar la tarea
-	err = s.taskRepo.Delete(ctx, id)
-	if err != nil {
-		return fmt.Errorf("no se pudo eliminar la tarea con ID %d: %w", id, err)
-	}
+		// Mover la tarea a la papelera
+		err = s.deleteTask(ctx, task)
+		if err != nil {
+			return fmt.Errorf("no se pudo eliminar la tarea con ID %d: %w", id, err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 // GetTasksByStatus obtiene tareas filtradas por estado de completado
-func (s *TaskService) GetTasksByStatus(ctx context.Context, completed bool) ([]*domain.Task, error) {
-	tasks, err := s.taskRepo.GetByStatus(ctx, completed)
+func (s *TaskService) GetTasksByStatus(ctx context.Context, completed bool, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
+	if err := s.resolveCustomFieldQuery(ctx, &opts); err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.taskRepo.GetByStatus(ctx, completed, opts)
 	if err != nil {
 		return nil, fmt.Errorf("no se pudieron obtener las tareas con estado completado=%t: %w", completed, err)
 	}
@@ -151,22 +182,39 @@ func (s *TaskService) MarkTaskAsCompleted(ctx context.Context, id int) (*domain.
 		return nil, fmt.Errorf("el ID de la tarea es requerido")
 	}
 
-	// Obtener la tarea existente
-	task, err := s.taskRepo.GetByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", id, err)
-	}
+	// Lectura, escritura y siguiente ocurrencia van en una transacción: si algo falla no se guarda nada
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		// Obtener la tarea existente; las archivadas son de solo lectura
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
 
-	// Marcar como completada
-	task.MarkAsCompleted()
+		// Verificar bloqueadores antes de completar
+		if err := s.ensureNotBlocked(ctx, id); err != nil {
+			return nil, err
+		}
 
-	// Persistir los cambios
-	updatedTask, err := s.taskRepo.Update(ctx, task)
-	if err != nil {
-		return nil, fmt.Errorf("no se pudo marcar la tarea como completada: %w", err)
-	}
+		// Marcar como completada
+		before := *task
+		wasCompleted := task.Completed
+		task.MarkAsCompleted()
+
+		// Persistir los cambios
+		updatedTask, err := s.saveTask(ctx, &before, task, updateAction(wasCompleted, task))
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo marcar la tarea como completada: %w", err)
+		}
+
+		// Una tarea recurrente que se completa genera su siguiente ocurrencia
+		if !wasCompleted {
+			if err := s.spawnNextOccurrence(ctx, task); err != nil {
+				return nil, err
+			}
+		}
 
-	return updatedTask, nil
+		return updatedTask, nil
+	})
 }
 
 // MarkTaskAsUncompleted marca una tarea como no completada
@@ -175,20 +223,24 @@ func (s *TaskService) MarkTaskAsUncompleted(ctx context.Context, id int) (*domai
 		return nil, fmt.Errorf("el ID de la tarea es requerido")
 	}
 
-	// Obtener la tarea existente
-	task, err := s.taskRepo.GetByID(ctx, id)
-	if err != nil {
-		return nil, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", id, err)
-	}
+	// Lectura y escritura van en una transacción
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		// Obtener la tarea existente; las archivadas son de solo lectura
+		task, err := s.getWritableTask(ctx, id)
+		if err != nil {
+			return nil, err
+		}
 
-	// Marcar como no completada
-	task.MarkAsUncompleted()
+		// Marcar como no completada
+		before := *task
+		task.MarkAsUncompleted()
 
-	// Persistir los cambios
-	updatedTask, err := s.taskRepo.Update(ctx, task)
-	if err != nil {
-		return nil, fmt.Errorf("no se pudo marcar la tarea como no completada: %w", err)
-	}
+		// Persistir los cambios
+		updatedTask, err := s.saveTask(ctx, &before, task, domain.RevisionUpdate)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo marcar la tarea como no completada: %w", err)
+		}
 
-	return updatedTask, nil
+		return updatedTask, nil
+	})
 }
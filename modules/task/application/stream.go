@@ -0,0 +1,64 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+//go:generate mockgen -source=stream.go -destination=../presentation/mocks/mock_event_stream.go -package=mocks
+
+// StreamedEvent es un evento de tarea con su posición en el registro de eventos del stream
+type StreamedEvent struct {
+	ID    uint64
+	Event domain.Event
+}
+
+// EventSubscription es una suscripción al stream de eventos de tareas
+type EventSubscription struct {
+	// Backlog son los eventos registrados después del ID desde el que se reanudó
+	Backlog []StreamedEvent
+	// LastID es el ID del último evento registrado al suscribirse; los eventos nuevos tienen IDs mayores
+	LastID uint64
+	// Missed indica que el registro ya no tiene todos los eventos desde ese ID
+	Missed bool
+	// Events entrega los eventos nuevos; se cierra si el suscriptor se queda atrás
+	Events <-chan StreamedEvent
+	// Close termina la suscripción
+	Close func()
+}
+
+// EventStream es el puerto del stream de eventos de tareas en vivo
+type EventStream interface {
+	// Subscribe se suscribe a los eventos nuevos; con lastID > 0 reanuda después de ese evento
+	Subscribe(lastID uint64) *EventSubscription
+}
+
+// EventStreamFilter selecciona los eventos del stream según el estado de la tarea después del cambio
+type EventStreamFilter struct {
+	// Status es pending o completed; vacío no filtra
+	Status string
+	// Project es el valor del campo personalizado project; vacío no filtra
+	Project string
+}
+
+// Matches indica si el evento pasa el filtro
+func (f EventStreamFilter) Matches(event domain.Event) bool {
+	if f.Status == "" && f.Project == "" {
+		return true
+	}
+	task := event.Task
+	if task == nil {
+		return false
+	}
+	if f.Status == "completed" && !task.Completed || f.Status == "pending" && task.Completed {
+		return false
+	}
+	if f.Project != "" {
+		project, ok := task.CustomFields[domain.ProjectCustomField]
+		if !ok || fmt.Sprint(project) != f.Project {
+			return false
+		}
+	}
+	return true
+}
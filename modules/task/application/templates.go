@@ -0,0 +1,133 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// TemplateService maneja las plantillas de tareas y su instanciación
+type TemplateService struct {
+	templateRepo    domain.TemplateRepository
+	customFieldRepo domain.CustomFieldRepository
+}
+
+// NewTemplateService crea una nueva instancia de TemplateService; customFieldRepo puede ser nil
+// si no se usan campos personalizados en las plantillas
+func NewTemplateService(templateRepo domain.TemplateRepository, customFieldRepo domain.CustomFieldRepository) *TemplateService {
+	return &TemplateService{
+		templateRepo:    templateRepo,
+		customFieldRepo: customFieldRepo,
+	}
+}
+
+// CreateTemplate guarda una plantilla nueva; los campos personalizados deben estar definidos
+func (s *TemplateService) CreateTemplate(ctx context.Context, name, description string, tasks []domain.TemplateTask) (*domain.TaskTemplate, error) {
+	template, err := domain.NewTaskTemplate(name, description, tasks)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCustomFields(ctx, template.Tasks); err != nil {
+		return nil, err
+	}
+
+	created, err := s.templateRepo.Create(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo crear la plantilla: %w", err)
+	}
+	return created, nil
+}
+
+// checkCustomFields verifica que existan las definiciones de los campos usados en la plantilla
+func (s *TemplateService) checkCustomFields(ctx context.Context, tasks []domain.TemplateTask) error {
+	for _, task := range tasks {
+		if len(task.CustomFields) > 0 && s.customFieldRepo == nil {
+			return fmt.Errorf("%w: los campos personalizados no están habilitados", domain.ErrInvalidTemplate)
+		}
+		for _, key := range slices.Sorted(maps.Keys(task.CustomFields)) {
+			if _, err := s.customFieldRepo.GetDefinition(ctx, key); err != nil {
+				return fmt.Errorf("%w: %w", domain.ErrInvalidTemplate, err)
+			}
+		}
+		if err := s.checkCustomFields(ctx, task.Children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTemplate obtiene una plantilla por su ID
+func (s *TemplateService) GetTemplate(ctx context.Context, id int) (*domain.TaskTemplate, error) {
+	return s.templateRepo.GetByID(ctx, id)
+}
+
+// ListTemplates obtiene todas las plantillas
+func (s *TemplateService) ListTemplates(ctx context.Context) ([]*domain.TaskTemplate, error) {
+	templates, err := s.templateRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener las plantillas: %w", err)
+	}
+	return templates, nil
+}
+
+// DeleteTemplate elimina una plantilla; las tareas ya creadas con ella se conservan
+func (s *TemplateService) DeleteTemplate(ctx context.Context, id int) error {
+	return s.templateRepo.Delete(ctx, id)
+}
+
+// InstantiateTemplate crea todas las tareas de la plantilla de una vez, reemplazando las variables
+// y calculando los vencimientos desde start; si falla una tarea no se crea ninguna
+func (s *TemplateService) InstantiateTemplate(ctx context.Context, id int, variables map[string]string, start time.Time) ([]*domain.Task, error) {
+	template, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := template.Plan(variables, start)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := actorFromContext(ctx)
+	for _, planned := range plan {
+		if err := s.resolvePlannedFields(ctx, planned); err != nil {
+			return nil, err
+		}
+		planned.Revision = domain.NewTaskRevision(domain.RevisionCreate, actor, nil, planned.Task)
+	}
+
+	tasks, err := s.templateRepo.Instantiate(ctx, plan)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo instanciar la plantilla %d: %w", id, err)
+	}
+	return tasks, nil
+}
+
+// resolvePlannedFields normaliza los campos personalizados de una tarea planificada con sus definiciones
+func (s *TemplateService) resolvePlannedFields(ctx context.Context, planned *domain.PlannedTask) error {
+	if len(planned.Fields) == 0 {
+		return nil
+	}
+	if s.customFieldRepo == nil {
+		return fmt.Errorf("%w: los campos personalizados no están habilitados", domain.ErrInvalidTemplate)
+	}
+
+	planned.Task.CustomFields = make(map[string]any, len(planned.Fields))
+	for _, key := range slices.Sorted(maps.Keys(planned.Fields)) {
+		field, err := s.customFieldRepo.GetDefinition(ctx, key)
+		if err != nil {
+			return fmt.Errorf("%w: %w", domain.ErrInvalidTemplate, err)
+		}
+		value, err := field.Normalize(planned.Fields[key])
+		if err != nil {
+			return err
+		}
+		planned.Values = append(planned.Values, domain.CustomFieldValue{Field: field, Value: value})
+		planned.Task.CustomFields[key] = value
+	}
+	return nil
+}
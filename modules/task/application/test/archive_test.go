@@ -0,0 +1,167 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_ArchiveTask_Success verifica que se archiva una tarea completada y que el archivado
+// se guarda como una actualización, con su revisión, antes de marcarla como archivada
+func TestTaskService_ArchiveTask_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithHistory(mockHistory))
+
+	now := time.Now().UTC()
+	gomock.InOrder(
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Completed: true}, nil),
+		mockHistory.EXPECT().UpdateWithRevision(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+				assert.Contains(t, revision.Changes, "archived_at")
+				return task, nil
+			}),
+		mockRepo.EXPECT().Archive(gomock.Any(), 1).Return(nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Completed: true, ArchivedAt: &now}, nil),
+	)
+
+	// Act
+	task, err := service.ArchiveTask(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, task.IsArchived())
+}
+
+// TestTaskService_ArchiveTask_NotCompleted verifica que no se archivan tareas pendientes
+func TestTaskService_ArchiveTask_NotCompleted(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil)
+
+	// Act
+	task, err := service.ArchiveTask(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrArchiveNotCompleted)
+	assert.Nil(t, task)
+}
+
+// TestTaskService_UnarchiveTask_RecordsUpdate verifica que el desarchivado se guarda como una
+// actualización de la tarea, con su revisión
+func TestTaskService_UnarchiveTask_RecordsUpdate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithHistory(mockHistory))
+
+	archivedAt := time.Now().UTC()
+	gomock.InOrder(
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Completed: true, ArchivedAt: &archivedAt, Version: 2}, nil),
+		mockRepo.EXPECT().Unarchive(gomock.Any(), 1).Return(nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Completed: true, Version: 2}, nil),
+		mockHistory.EXPECT().UpdateWithRevision(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+				assert.Equal(t, domain.FieldChange{From: archivedAt, To: nil}, revision.Changes["archived_at"])
+				task.Version++
+				return task, nil
+			}),
+	)
+
+	// Act
+	task, err := service.UnarchiveTask(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, task.IsArchived())
+	assert.Equal(t, 3, task.Version)
+}
+
+// TestTaskService_UpdateTask_Archived verifica que una tarea archivada es de solo lectura
+func TestTaskService_UpdateTask_Archived(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	now := time.Now().UTC()
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "T", Description: "D", Completed: true, ArchivedAt: &now}, nil)
+	// No se espera Update
+
+	// Act
+	task, err := service.UpdateTask(context.Background(), 1, "Nuevo", "", nil)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrTaskArchived)
+	assert.Nil(t, task)
+}
+
+// TestTaskService_ArchiveCompletedTasks verifica el cálculo de la fecha límite y que cada tarea
+// archivada publica TaskUpdated
+func TestTaskService_ArchiveCompletedTasks(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockPublisher := mocks.NewMockEventPublisher(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithEventPublisher(mockPublisher))
+
+	mockRepo.EXPECT().GetCompletedBefore(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, before time.Time) ([]*domain.Task, error) {
+			assert.WithinDuration(t, time.Now().UTC().AddDate(0, 0, -30), before, time.Minute)
+			return []*domain.Task{{ID: 1, Completed: true}, {ID: 2, Completed: true}}, nil
+		})
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+		return task, nil
+	}).Times(2)
+	mockRepo.EXPECT().Archive(gomock.Any(), 1).Return(nil)
+	mockRepo.EXPECT().Archive(gomock.Any(), 2).Return(nil)
+	var published []int
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []domain.Event) error {
+		assert.Equal(t, domain.EventTaskUpdated, events[0].Type)
+		published = append(published, events[0].TaskID)
+		return nil
+	}).Times(2)
+
+	// Act
+	archived, err := service.ArchiveCompletedTasks(context.Background(), 30)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, archived)
+	assert.Equal(t, []int{1, 2}, published)
+}
+
+// TestTaskService_ArchiveCompletedTasks_NegativeDays verifica la validación de días
+func TestTaskService_ArchiveCompletedTasks_NegativeDays(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := application.NewTaskService(mocks.NewMockTaskRepository(ctrl))
+
+	_, err := service.ArchiveCompletedTasks(context.Background(), -1)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package application_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	userdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeUserRepository es un UserRepository en memoria; solo GetByID es relevante para las asignaciones
+type fakeUserRepository struct {
+	userdomain.UserRepository
+	users map[int]*userdomain.User
+}
+
+func (f *fakeUserRepository) GetByID(_ context.Context, id int) (*userdomain.User, error) {
+	if user, ok := f.users[id]; ok {
+		return user, nil
+	}
+	return nil, fmt.Errorf("usuario con ID %d no encontrado", id)
+}
+
+func newFakeUserRepository() *fakeUserRepository {
+	return &fakeUserRepository{users: map[int]*userdomain.User{
+		1: {ID: 1, Username: "ana", Active: true},
+		2: {ID: 2, Username: "luis", Active: false},
+	}}
+}
+
+// TestTaskService_AssignTask_Success verifica que se asignan usuarios activos y que la asignación
+// incrementa la versión de la tarea y publica TaskUpdated
+func TestTaskService_AssignTask_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAssignments := mocks.NewMockAssignmentRepository(ctrl)
+	mockPublisher := mocks.NewMockEventPublisher(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithAssignments(mockAssignments, newFakeUserRepository()),
+		application.WithEventPublisher(mockPublisher))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 10).Return(&domain.Task{ID: 10, Version: 2}, nil)
+	mockAssignments.EXPECT().Assign(gomock.Any(), 10, []int{1}).Return(nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 10).Return(&domain.Task{ID: 10, Assignees: []int{1}, Version: 2}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+		task.Version++
+		return task, nil
+	})
+	var published []domain.Event
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []domain.Event) error {
+		published = events
+		return nil
+	})
+
+	// Act
+	task, err := service.AssignTask(context.Background(), 10, []int{1})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, task.Assignees)
+	assert.Equal(t, 3, task.Version)
+	if assert.Len(t, published, 1) {
+		assert.Equal(t, domain.EventTaskUpdated, published[0].Type)
+	}
+}
+
+// TestTaskService_AssignTask_InactiveUser verifica que no se asignan usuarios desactivados
+func TestTaskService_AssignTask_InactiveUser(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAssignments := mocks.NewMockAssignmentRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithAssignments(mockAssignments, newFakeUserRepository()))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 10).Return(&domain.Task{ID: 10}, nil)
+	mockAssignments.EXPECT().Assign(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	// Act
+	task, err := service.AssignTask(context.Background(), 10, []int{1, 2})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAssigneeInactive)
+	assert.Nil(t, task)
+}
+
+// TestTaskService_AssignTask_UnknownUser verifica que no se asignan usuarios inexistentes
+func TestTaskService_AssignTask_UnknownUser(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAssignments := mocks.NewMockAssignmentRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithAssignments(mockAssignments, newFakeUserRepository()))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 10).Return(&domain.Task{ID: 10}, nil)
+
+	// Act
+	_, err := service.AssignTask(context.Background(), 10, []int{99})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAssigneeNotFound)
+}
+
+// TestTaskService_UnassignUser verifica que se quitan todas las asignaciones del usuario
+func TestTaskService_UnassignUser(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAssignments := mocks.NewMockAssignmentRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithAssignments(mockAssignments, newFakeUserRepository()))
+
+	mockAssignments.EXPECT().UnassignUser(gomock.Any(), 1).Return(3, nil)
+
+	// Act
+	err := service.UnassignUser(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
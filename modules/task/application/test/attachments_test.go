@@ -0,0 +1,183 @@
+package application_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var testAttachmentLimits = application.AttachmentLimits{
+	MaxSize:      1024,
+	AllowedTypes: []string{"image/*", "text/plain"},
+}
+
+// TestAttachmentService_UploadAttachment_StoresNewBlob verifica que un archivo nuevo se guarda bajo su hash
+func TestAttachmentService_UploadAttachment_StoresNewBlob(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewAttachmentService(mockAttachmentRepo, mockTaskRepo, mockBlobStore, testAttachmentLimits)
+
+	content := "especificación de la tarea"
+	sum := sha256.Sum256([]byte(content))
+	key := domain.AttachmentStorageKey(hex.EncodeToString(sum[:]))
+
+	mockTaskRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil)
+	mockBlobStore.EXPECT().Exists(gomock.Any(), key).Return(false, nil)
+	mockBlobStore.EXPECT().
+		Put(gomock.Any(), key, gomock.Any(), int64(len(content)), "text/plain; charset=utf-8").
+		DoAndReturn(func(_ context.Context, _ string, r io.Reader, _ int64, _ string) error {
+			stored, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, content, string(stored))
+			return nil
+		})
+	mockAttachmentRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, a *domain.Attachment) (*domain.Attachment, error) {
+			a.ID = 7
+			return a, nil
+		})
+
+	// Act
+	attachment, err := service.UploadAttachment(context.Background(), 1, "../../spec.txt", strings.NewReader(content))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 7, attachment.ID)
+	assert.Equal(t, "spec.txt", attachment.FileName)
+	assert.Equal(t, int64(len(content)), attachment.Size)
+	assert.Equal(t, hex.EncodeToString(sum[:]), attachment.SHA256)
+}
+
+// TestAttachmentService_UploadAttachment_DeduplicatesBlob verifica que no se sube dos veces el mismo contenido
+func TestAttachmentService_UploadAttachment_DeduplicatesBlob(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewAttachmentService(mockAttachmentRepo, mockTaskRepo, mockBlobStore, testAttachmentLimits)
+
+	mockTaskRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil)
+	mockBlobStore.EXPECT().Exists(gomock.Any(), gomock.Any()).Return(true, nil)
+	mockBlobStore.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockAttachmentRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, a *domain.Attachment) (*domain.Attachment, error) {
+			return a, nil
+		})
+
+	// Act
+	_, err := service.UploadAttachment(context.Background(), 1, "copia.txt", strings.NewReader("repetido"))
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestAttachmentService_UploadAttachment_TooLarge verifica que se rechazan archivos sobre el límite
+func TestAttachmentService_UploadAttachment_TooLarge(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewAttachmentService(mockAttachmentRepo, mockTaskRepo, mockBlobStore, testAttachmentLimits)
+
+	mockTaskRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil)
+
+	// Act
+	content := strings.Repeat("a", int(testAttachmentLimits.MaxSize)+1)
+	attachment, err := service.UploadAttachment(context.Background(), 1, "grande.txt", strings.NewReader(content))
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAttachmentTooLarge)
+	assert.Nil(t, attachment)
+}
+
+// TestAttachmentService_UploadAttachment_TypeNotAllowed verifica que el tipo se detecta por contenido
+func TestAttachmentService_UploadAttachment_TypeNotAllowed(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewAttachmentService(mockAttachmentRepo, mockTaskRepo, mockBlobStore, testAttachmentLimits)
+
+	mockTaskRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil)
+
+	// Act: un PDF con extensión .png sigue siendo un PDF
+	attachment, err := service.UploadAttachment(context.Background(), 1, "falso.png", bytes.NewReader([]byte("%PDF-1.7\n...")))
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrAttachmentTypeNotAllowed)
+	assert.Nil(t, attachment)
+}
+
+// TestAttachmentService_DeleteAttachment_KeepsSharedBlob verifica que el blob se conserva si otro adjunto lo usa
+func TestAttachmentService_DeleteAttachment_KeepsSharedBlob(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewAttachmentService(mockAttachmentRepo, mockTaskRepo, mockBlobStore, testAttachmentLimits)
+
+	existing := &domain.Attachment{ID: 7, TaskID: 1, StorageKey: "sha256/ab/abc"}
+	mockAttachmentRepo.EXPECT().GetByID(gomock.Any(), 7).Return(existing, nil)
+	mockAttachmentRepo.EXPECT().Delete(gomock.Any(), 7).Return(nil)
+	mockAttachmentRepo.EXPECT().CountByStorageKey(gomock.Any(), "sha256/ab/abc").Return(1, nil)
+	mockBlobStore.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(0)
+
+	// Act
+	err := service.DeleteAttachment(context.Background(), 1, 7)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestAttachmentService_DeleteAttachment_RemovesLastBlob verifica que el último adjunto elimina el blob
+func TestAttachmentService_DeleteAttachment_RemovesLastBlob(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewAttachmentService(mockAttachmentRepo, mockTaskRepo, mockBlobStore, testAttachmentLimits)
+
+	existing := &domain.Attachment{ID: 7, TaskID: 1, StorageKey: "sha256/ab/abc"}
+	mockAttachmentRepo.EXPECT().GetByID(gomock.Any(), 7).Return(existing, nil)
+	mockAttachmentRepo.EXPECT().Delete(gomock.Any(), 7).Return(nil)
+	mockAttachmentRepo.EXPECT().CountByStorageKey(gomock.Any(), "sha256/ab/abc").Return(0, nil)
+	mockBlobStore.EXPECT().Delete(gomock.Any(), "sha256/ab/abc").Return(nil)
+
+	// Act
+	err := service.DeleteAttachment(context.Background(), 1, 7)
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,157 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// testBoard retorna un tablero con una columna pendiente limitada y una completada
+func testBoard() *domain.Board {
+	return &domain.Board{ID: 1, Name: "Sprint", SwimlaneBy: domain.SwimlaneNone, Columns: []domain.BoardColumn{
+		{ID: 10, Name: "Haciendo", State: domain.ColumnStatePending, WIPLimit: 2},
+		{ID: 20, Name: "Hecho", State: domain.ColumnStateCompleted},
+	}}
+}
+
+// TestBoardService_MoveCard_WIPLimit verifica que no se supera el límite de la columna
+func TestBoardService_MoveCard_WIPLimit(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoards := mocks.NewMockBoardRepository(ctrl)
+	mockTasks := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewBoardService(mockBoards, application.NewTaskService(mockTasks))
+
+	mockBoards.EXPECT().GetByID(gomock.Any(), 1).Return(testBoard(), nil)
+	mockTasks.EXPECT().GetByID(gomock.Any(), 5).Return(&domain.Task{ID: 5, Title: "T"}, nil)
+	mockBoards.EXPECT().GetCard(gomock.Any(), 1, 5).Return(nil, domain.ErrBoardCardNotFound)
+	mockBoards.EXPECT().CountCards(gomock.Any(), 10).Return(2, nil)
+	// No se espera PutCard
+
+	// Act
+	card, err := service.MoveCard(context.Background(), 1, 5, 10)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrWIPLimitExceeded)
+	assert.Nil(t, card)
+}
+
+// TestBoardService_MoveCard_CompletesTask verifica que la columna completada completa la tarea
+func TestBoardService_MoveCard_CompletesTask(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoards := mocks.NewMockBoardRepository(ctrl)
+	mockTasks := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewBoardService(mockBoards, application.NewTaskService(mockTasks))
+
+	mockBoards.EXPECT().GetByID(gomock.Any(), 1).Return(testBoard(), nil)
+	mockTasks.EXPECT().GetByID(gomock.Any(), 5).Return(&domain.Task{ID: 5, Title: "T"}, nil).Times(2)
+	mockBoards.EXPECT().GetCard(gomock.Any(), 1, 5).Return(&domain.BoardCard{BoardID: 1, ColumnID: 10, TaskID: 5, Rank: "i"}, nil)
+	mockBoards.EXPECT().CountCards(gomock.Any(), 20).Return(7, nil)
+	mockTasks.EXPECT().Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+			assert.True(t, task.Completed)
+			return task, nil
+		})
+	mockBoards.EXPECT().LastCardRank(gomock.Any(), 20).Return("i", nil)
+	mockBoards.EXPECT().PutCard(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, card *domain.BoardCard) error {
+			assert.Equal(t, 20, card.ColumnID)
+			assert.Greater(t, card.Rank, "i")
+			return nil
+		})
+
+	// Act
+	card, err := service.MoveCard(context.Background(), 1, 5, 20)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, card.Completed)
+}
+
+// TestBoardService_MoveCard_RollsBackTaskState verifica que el cambio de estado de la tarea ocurre en
+// la misma transacción que la tarjeta: si la tarjeta no se guarda, la transacción falla y los eventos
+// de la tarea no se publican
+func TestBoardService_MoveCard_RollsBackTaskState(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoards := mocks.NewMockBoardRepository(ctrl)
+	mockTasks := mocks.NewMockTaskRepository(ctrl)
+	mockTx := mocks.NewMockTxManager(ctrl)
+	mockPublisher := mocks.NewMockEventPublisher(ctrl)
+	service := application.NewBoardService(mockBoards,
+		application.NewTaskService(mockTasks, application.WithTxManager(mockTx), application.WithEventPublisher(mockPublisher)))
+
+	putErr := errors.New("error guardando la tarjeta")
+	var inTx bool
+	// La transacción de MarkTaskAsCompleted se une a la del movimiento
+	mockTx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			outer := !inTx
+			inTx = true
+			err := fn(ctx)
+			if outer {
+				inTx = false
+			}
+			return err
+		}).Times(2)
+	mockBoards.EXPECT().GetByID(gomock.Any(), 1).Return(testBoard(), nil)
+	mockTasks.EXPECT().GetByID(gomock.Any(), 5).Return(&domain.Task{ID: 5, Title: "T"}, nil).Times(2)
+	mockBoards.EXPECT().GetCard(gomock.Any(), 1, 5).Return(nil, domain.ErrBoardCardNotFound)
+	mockBoards.EXPECT().CountCards(gomock.Any(), 20).DoAndReturn(func(context.Context, int) (int, error) {
+		assert.True(t, inTx, "el conteo ocurre dentro de la transacción")
+		return 0, nil
+	})
+	mockTasks.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+		return task, nil
+	})
+	mockBoards.EXPECT().LastCardRank(gomock.Any(), 20).Return("", nil)
+	mockBoards.EXPECT().PutCard(gomock.Any(), gomock.Any()).Return(putErr)
+	// No se espera Publish
+
+	// Act
+	card, err := service.MoveCard(context.Background(), 1, 5, 20)
+
+	// Assert
+	assert.ErrorIs(t, err, putErr)
+	assert.Nil(t, card)
+}
+
+// TestBoardService_MoveCard_UnknownColumn verifica que la columna debe pertenecer al tablero
+func TestBoardService_MoveCard_UnknownColumn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoards := mocks.NewMockBoardRepository(ctrl)
+	service := application.NewBoardService(mockBoards, application.NewTaskService(mocks.NewMockTaskRepository(ctrl)))
+
+	mockBoards.EXPECT().GetByID(gomock.Any(), 1).Return(testBoard(), nil)
+
+	_, err := service.MoveCard(context.Background(), 1, 5, 99)
+
+	assert.ErrorIs(t, err, domain.ErrBoardColumnNotFound)
+}
+
+// TestBoardService_CreateBoard_Invalid verifica que un tablero sin columnas no se guarda
+func TestBoardService_CreateBoard_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := application.NewBoardService(mocks.NewMockBoardRepository(ctrl), application.NewTaskService(mocks.NewMockTaskRepository(ctrl)))
+
+	_, err := service.CreateBoard(context.Background(), "Sprint", domain.SwimlaneNone, nil)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidBoard)
+}
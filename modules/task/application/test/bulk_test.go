@@ -0,0 +1,200 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_BulkTasks_Atomic verifica que las operaciones se envían al repositorio en un solo lote
+func TestTaskService_BulkTasks_Atomic(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D", Version: 3}, nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2, Title: "T", Description: "D", Version: 1}, nil)
+	mockRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, batch *domain.TaskBatch) error {
+			assert.Len(t, batch.Creates, 1)
+			assert.Len(t, batch.Updates, 1)
+			assert.Len(t, batch.Deletes, 1)
+			assert.True(t, batch.Updates[0].Task.Completed)
+			assert.Equal(t, 3, batch.Updates[0].Task.Version)
+			batch.Creates[0].Task.ID = 10
+			return nil
+		})
+
+	// Act
+	results, err := service.BulkTasks(context.Background(), domain.BulkAtomic, []domain.BulkOperation{
+		{Action: domain.BulkCreate, Title: "Nueva", Description: "D"},
+		{Action: domain.BulkComplete, TaskID: 1},
+		{Action: domain.BulkDelete, TaskID: 2},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+	for _, result := range results {
+		assert.NoError(t, result.Err)
+	}
+	assert.Equal(t, 10, results[0].TaskID)
+}
+
+// TestTaskService_BulkTasks_AtomicAborts verifica que una operación inválida impide aplicar el lote atómico
+func TestTaskService_BulkTasks_AtomicAborts(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D", Version: 3}, nil)
+	// No se espera ApplyBatch
+
+	// Act
+	results, err := service.BulkTasks(context.Background(), domain.BulkAtomic, []domain.BulkOperation{
+		{Action: domain.BulkCreate, Title: "Nueva", Description: "D"},
+		{Action: domain.BulkUncomplete, TaskID: 1, Version: 2},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.ErrorIs(t, results[0].Err, domain.ErrBulkAborted)
+	assert.ErrorIs(t, results[1].Err, domain.ErrPreconditionFailed)
+}
+
+// TestTaskService_BulkTasks_BestEffortConflict verifica que en best_effort se reintenta el lote sin
+// las tareas que otra escritura modificó
+func TestTaskService_BulkTasks_BestEffortConflict(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D", Version: 1}, nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2, Title: "T", Description: "D", Version: 1}, nil)
+	gomock.InOrder(
+		mockRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).Return(&domain.BatchConflictError{TaskIDs: []int{2}}),
+		mockRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, batch *domain.TaskBatch) error {
+				assert.Len(t, batch.Updates, 1)
+				assert.Equal(t, 1, batch.Updates[0].Task.ID)
+				return nil
+			}),
+	)
+
+	// Act
+	results, err := service.BulkTasks(context.Background(), domain.BulkBestEffort, []domain.BulkOperation{
+		{Action: domain.BulkComplete, TaskID: 1},
+		{Action: domain.BulkComplete, TaskID: 2},
+		{Action: domain.BulkDelete, TaskID: 1},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, domain.ErrVersionConflict)
+	assert.ErrorIs(t, results[2].Err, domain.ErrInvalidBulkOperation)
+}
+
+// TestTaskService_BulkTasks_RecurringCompletion verifica que la siguiente ocurrencia se crea en el mismo lote
+func TestTaskService_BulkTasks_RecurringCompletion(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	due := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "T", Description: "D", DueDate: &due, Recurrence: "FREQ=WEEKLY", Version: 1}, nil)
+	mockRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, batch *domain.TaskBatch) error {
+			assert.Len(t, batch.Updates, 1)
+			assert.Len(t, batch.Creates, 1)
+			assert.Equal(t, due.AddDate(0, 0, 7), *batch.Creates[0].Task.DueDate)
+			return nil
+		})
+
+	// Act
+	results, err := service.BulkTasks(context.Background(), domain.BulkAtomic, []domain.BulkOperation{
+		{Action: domain.BulkComplete, TaskID: 1},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, results[0].Err)
+}
+
+// TestTaskService_BulkTasks_RecurringAssignmentFails verifica que los responsables de la siguiente
+// ocurrencia se asignan en la transacción del lote y que un error hace fallar el lote completo
+func TestTaskService_BulkTasks_RecurringAssignmentFails(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAssignments := mocks.NewMockAssignmentRepository(ctrl)
+	mockTx := mocks.NewMockTxManager(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithAssignments(mockAssignments, newFakeUserRepository()), application.WithTxManager(mockTx))
+
+	assignErr := errors.New("error asignando")
+	var inTx bool
+	mockTx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			inTx = true
+			defer func() { inTx = false }()
+			return fn(ctx)
+		})
+	due := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "T", Description: "D", DueDate: &due, Recurrence: "FREQ=WEEKLY", Assignees: []int{7}, Version: 1}, nil)
+	mockRepo.EXPECT().ApplyBatch(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, batch *domain.TaskBatch) error {
+			batch.Creates[0].Task.ID = 2
+			return nil
+		})
+	mockAssignments.EXPECT().Assign(gomock.Any(), 2, []int{7}).
+		DoAndReturn(func(context.Context, int, []int) error {
+			assert.True(t, inTx, "la asignación ocurre dentro de la transacción del lote")
+			return assignErr
+		})
+
+	// Act
+	results, err := service.BulkTasks(context.Background(), domain.BulkAtomic, []domain.BulkOperation{
+		{Action: domain.BulkComplete, TaskID: 1},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, assignErr)
+	assert.Nil(t, results)
+}
+
+// TestTaskService_BulkTasks_InvalidRequest verifica los límites del lote
+func TestTaskService_BulkTasks_InvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service := application.NewTaskService(mocks.NewMockTaskRepository(ctrl))
+
+	_, err := service.BulkTasks(context.Background(), domain.BulkAtomic, nil)
+	assert.ErrorIs(t, err, domain.ErrInvalidBulkRequest)
+
+	_, err = service.BulkTasks(context.Background(), "eventual", []domain.BulkOperation{{Action: domain.BulkDelete, TaskID: 1}})
+	assert.ErrorIs(t, err, domain.ErrInvalidBulkRequest)
+}
@@ -0,0 +1,230 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestCommentService_AddComment_Success verifica que se agrega un comentario a una tarea existente
+func TestCommentService_AddComment_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewCommentService(mockCommentRepo, mockTaskRepo)
+
+	mockTaskRepo.EXPECT().
+		GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "Tarea"}, nil).
+		Times(1)
+
+	mockCommentRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, c *domain.Comment) (*domain.Comment, error) {
+			c.ID = 10
+			return c, nil
+		}).
+		Times(1)
+
+	// Act
+	comment, err := service.AddComment(context.Background(), 1, "ana", "**hola**")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 10, comment.ID)
+	assert.Equal(t, "ana", comment.Author)
+}
+
+// TestCommentService_AddComment_EmptyBody_ShouldReturnError verifica que un comentario vacío es rechazado
+func TestCommentService_AddComment_EmptyBody_ShouldReturnError(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewCommentService(mockCommentRepo, mockTaskRepo)
+
+	mockTaskRepo.EXPECT().
+		GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "Tarea"}, nil).
+		Times(1)
+
+	// Act
+	comment, err := service.AddComment(context.Background(), 1, "ana", "   ")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidComment)
+	assert.Nil(t, comment)
+}
+
+// TestCommentService_ListComments_ClampsLimit verifica que el tamaño de página se limita al máximo
+func TestCommentService_ListComments_ClampsLimit(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewCommentService(mockCommentRepo, mockTaskRepo)
+
+	mockCommentRepo.EXPECT().
+		ListByTask(gomock.Any(), 1, application.MaxCommentPageSize, 0).
+		Return([]*domain.Comment{}, 0, nil).
+		Times(1)
+
+	// Act
+	_, _, err := service.ListComments(context.Background(), 1, 1000, -5)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestCommentService_EditComment_SavesRevision verifica que la edición guarda el contenido anterior
+func TestCommentService_EditComment_SavesRevision(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewCommentService(mockCommentRepo, mockTaskRepo)
+
+	existing := &domain.Comment{ID: 10, TaskID: 1, Author: "ana", Body: "original", CreatedAt: time.Now().UTC()}
+
+	mockCommentRepo.EXPECT().
+		GetByID(gomock.Any(), 10).
+		Return(existing, nil).
+		Times(1)
+
+	mockTaskRepo.EXPECT().
+		GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "Tarea"}, nil).
+		Times(1)
+
+	mockCommentRepo.EXPECT().
+		Update(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, c *domain.Comment, r *domain.CommentRevision) (*domain.Comment, error) {
+			assert.Equal(t, "original", r.Body)
+			assert.Equal(t, "editado", c.Body)
+			return c, nil
+		}).
+		Times(1)
+
+	// Act
+	comment, err := service.EditComment(context.Background(), 1, 10, "editado")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, comment.EditedAt)
+}
+
+// TestCommentService_EditComment_OtherTask_ShouldReturnError verifica que no se edita un comentario de otra tarea
+func TestCommentService_EditComment_OtherTask_ShouldReturnError(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewCommentService(mockCommentRepo, mockTaskRepo)
+
+	mockCommentRepo.EXPECT().
+		GetByID(gomock.Any(), 10).
+		Return(&domain.Comment{ID: 10, TaskID: 2, Author: "ana", Body: "original"}, nil).
+		Times(1)
+
+	// Act
+	comment, err := service.EditComment(context.Background(), 1, 10, "editado")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, comment)
+}
+
+// TestCommentService_ArchivedTask_ShouldRejectChanges verifica que los comentarios de una tarea
+// archivada no se editan ni se eliminan
+func TestCommentService_ArchivedTask_ShouldRejectChanges(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTaskRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewCommentService(mockCommentRepo, mockTaskRepo)
+
+	archivedAt := time.Now().UTC()
+	mockCommentRepo.EXPECT().
+		GetByID(gomock.Any(), 10).
+		Return(&domain.Comment{ID: 10, TaskID: 1, Author: "ana", Body: "original"}, nil).
+		Times(2)
+
+	mockTaskRepo.EXPECT().
+		GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "Tarea", Completed: true, ArchivedAt: &archivedAt}, nil).
+		Times(2)
+
+	// Act
+	comment, editErr := service.EditComment(context.Background(), 1, 10, "editado")
+	deleteErr := service.DeleteComment(context.Background(), 1, 10)
+
+	// Assert
+	assert.ErrorIs(t, editErr, domain.ErrTaskArchived)
+	assert.Nil(t, comment)
+	assert.ErrorIs(t, deleteErr, domain.ErrTaskArchived)
+}
+
+// TestTaskService_PermanentlyDeleteTask_ArchivesComments verifica que la política archive conserva los comentarios
+func TestTaskService_PermanentlyDeleteTask_ArchivesComments(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithCommentRepository(mockCommentRepo, domain.CommentDeleteArchive))
+
+	gomock.InOrder(
+		mockRepo.EXPECT().DeletePermanently(gomock.Any(), 1).Return(nil),
+		mockCommentRepo.EXPECT().ArchiveByTask(gomock.Any(), 1).Return(nil),
+	)
+
+	// Act
+	err := service.PermanentlyDeleteTask(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestTaskService_PermanentlyDeleteTask_CascadesComments verifica que la política cascade elimina los comentarios
+func TestTaskService_PermanentlyDeleteTask_CascadesComments(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithCommentRepository(mockCommentRepo, domain.CommentDeleteCascade))
+
+	gomock.InOrder(
+		mockRepo.EXPECT().DeletePermanently(gomock.Any(), 1).Return(nil),
+		mockCommentRepo.EXPECT().DeleteByTask(gomock.Any(), 1).Return(nil),
+	)
+
+	// Act
+	err := service.PermanentlyDeleteTask(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
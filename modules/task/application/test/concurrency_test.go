@@ -0,0 +1,78 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_UpdateTask_ExpectedVersionMismatch verifica que no se escribe si la versión esperada no es la actual
+func TestTaskService_UpdateTask_ExpectedVersionMismatch(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "T", Description: "D", Version: 3}, nil)
+	// No se espera Update
+
+	ctx := application.WithExpectedVersion(context.Background(), 2)
+
+	// Act
+	task, err := service.UpdateTask(ctx, 1, "Nuevo", "", nil)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrPreconditionFailed)
+	assert.Nil(t, task)
+}
+
+// TestTaskService_DeleteTask_ExpectedVersionMatch verifica que la versión esperada correcta permite la escritura
+func TestTaskService_DeleteTask_ExpectedVersionMatch(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Version: 3}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+
+	ctx := application.WithExpectedVersion(context.Background(), 3)
+
+	// Act
+	err := service.DeleteTask(ctx, 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestTaskService_DeleteTask_ExpectedVersionList verifica que basta con que la versión actual esté
+// entre las esperadas
+func TestTaskService_DeleteTask_ExpectedVersionList(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Version: 3}, nil).Times(2)
+	mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+
+	// Act
+	matched := service.DeleteTask(application.WithExpectedVersion(context.Background(), 2, 3), 1)
+	mismatched := service.DeleteTask(application.WithExpectedVersion(context.Background(), 1, 2), 1)
+
+	// Assert
+	assert.NoError(t, matched)
+	assert.ErrorIs(t, mismatched, domain.ErrPreconditionFailed)
+}
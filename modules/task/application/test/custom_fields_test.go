@@ -0,0 +1,127 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_SetTaskCustomFields verifica que los valores se normalizan y un null quita el campo
+func TestTaskService_SetTaskCustomFields(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockFields := mocks.NewMockCustomFieldRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithCustomFields(mockFields, newFakeUserRepository()),
+		application.WithHistory(mockHistory))
+
+	owner := &domain.CustomFieldDefinition{ID: 1, Key: "owner", Type: domain.CustomFieldUser}
+	size := &domain.CustomFieldDefinition{ID: 2, Key: "size", Type: domain.CustomFieldNumber}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "T", Description: "D", CustomFields: map[string]any{"size": 3.0}, Version: 4}, nil)
+	mockFields.EXPECT().GetDefinition(gomock.Any(), "owner").Return(owner, nil)
+	mockFields.EXPECT().GetDefinition(gomock.Any(), "size").Return(size, nil)
+	mockFields.EXPECT().SetValues(gomock.Any(), 1,
+		[]domain.CustomFieldValue{{Field: owner, Value: 1}},
+		[]*domain.CustomFieldDefinition{size}).Return(nil)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "T", Description: "D", CustomFields: map[string]any{"owner": 1.0}, Version: 4}, nil)
+	// El cambio se guarda como una actualización de la tarea, con su revisión
+	mockHistory.EXPECT().UpdateWithRevision(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+			assert.Equal(t, domain.RevisionUpdate, revision.Action)
+			assert.Equal(t, domain.FieldChange{From: map[string]any{"size": 3.0}, To: map[string]any{"owner": 1.0}},
+				revision.Changes["custom_fields"])
+			task.Version++
+			return task, nil
+		})
+
+	// Act
+	task, err := service.SetTaskCustomFields(context.Background(), 1, map[string]any{"owner": 1.0, "size": nil})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{"owner": 1.0}, task.CustomFields)
+	assert.Equal(t, 5, task.Version)
+}
+
+// TestTaskService_SetTaskCustomFields_UnknownUser verifica que un campo user exige un usuario existente
+func TestTaskService_SetTaskCustomFields_UnknownUser(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockFields := mocks.NewMockCustomFieldRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithCustomFields(mockFields, newFakeUserRepository()))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	mockFields.EXPECT().GetDefinition(gomock.Any(), "owner").
+		Return(&domain.CustomFieldDefinition{ID: 1, Key: "owner", Type: domain.CustomFieldUser}, nil)
+	// No se espera SetValues
+
+	// Act
+	task, err := service.SetTaskCustomFields(context.Background(), 1, map[string]any{"owner": 99.0})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldValue)
+	assert.Nil(t, task)
+}
+
+// TestTaskService_GetAllTasks_CustomFieldFilter verifica que el filtro se completa con el tipo del campo
+func TestTaskService_GetAllTasks_CustomFieldFilter(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockFields := mocks.NewMockCustomFieldRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithCustomFields(mockFields, nil))
+
+	mockFields.EXPECT().GetDefinition(gomock.Any(), "size").
+		Return(&domain.CustomFieldDefinition{ID: 2, Key: "size", Type: domain.CustomFieldNumber}, nil).Times(2)
+	mockRepo.EXPECT().GetAll(gomock.Any(), domain.TaskQueryOptions{
+		CustomFields: []domain.CustomFieldFilter{{Key: "size", Raw: "3", Type: domain.CustomFieldNumber, Value: 3.0}},
+		SortBy:       &domain.CustomFieldSort{Key: "size", Desc: true, Type: domain.CustomFieldNumber},
+	}).Return([]*domain.Task{}, nil)
+
+	// Act
+	_, err := service.GetAllTasks(context.Background(), domain.TaskQueryOptions{
+		CustomFields: []domain.CustomFieldFilter{{Key: "size", Raw: "3"}},
+		SortBy:       &domain.CustomFieldSort{Key: "size", Desc: true},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestCustomFieldService_CreateField_Duplicate verifica que no se repiten claves
+func TestCustomFieldService_CreateField_Duplicate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFields := mocks.NewMockCustomFieldRepository(ctrl)
+	service := application.NewCustomFieldService(mockFields)
+
+	mockFields.EXPECT().GetDefinition(gomock.Any(), "size").
+		Return(&domain.CustomFieldDefinition{ID: 2, Key: "size", Type: domain.CustomFieldNumber}, nil)
+	// No se espera CreateDefinition
+
+	// Act
+	field, err := service.CreateField(context.Background(), "size", "Tamaño", domain.CustomFieldNumber, nil)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrCustomFieldExists)
+	assert.Nil(t, field)
+}
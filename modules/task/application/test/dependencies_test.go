@@ -0,0 +1,150 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_AddDependency_Success verifica que se registra una dependencia válida
+func TestTaskService_AddDependency_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockDeps := mocks.NewMockDependencyRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithDependencyRepository(mockDeps))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2}, nil).Times(1)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil).Times(1)
+	mockDeps.EXPECT().GetAllDependencies(gomock.Any()).Return(nil, nil).Times(1)
+	mockDeps.EXPECT().AddDependency(gomock.Any(), 2, 1).Return(nil).Times(1)
+
+	// Act
+	err := service.AddDependency(context.Background(), 2, 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestTaskService_AddDependency_Cycle verifica que se rechazan dependencias que crean ciclos
+func TestTaskService_AddDependency_Cycle(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockDeps := mocks.NewMockDependencyRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithDependencyRepository(mockDeps))
+
+	// 2 ya está bloqueada por 1, ahora se intenta bloquear 1 con 2
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil).Times(1)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2}, nil).Times(1)
+	mockDeps.EXPECT().GetAllDependencies(gomock.Any()).
+		Return([]domain.Dependency{{TaskID: 2, BlockerID: 1}}, nil).
+		Times(1)
+	mockDeps.EXPECT().AddDependency(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	// Act
+	err := service.AddDependency(context.Background(), 1, 2)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+}
+
+// TestTaskService_AddDependency_Self verifica que una tarea no puede bloquearse a sí misma
+func TestTaskService_AddDependency_Self(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockDeps := mocks.NewMockDependencyRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithDependencyRepository(mockDeps))
+
+	err := service.AddDependency(context.Background(), 1, 1)
+
+	assert.ErrorIs(t, err, domain.ErrSelfDependency)
+}
+
+// TestTaskService_GetTasksInDependencyOrder verifica el orden topológico de las tareas
+func TestTaskService_GetTasksInDependencyOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockDeps := mocks.NewMockDependencyRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithDependencyRepository(mockDeps))
+
+	mockRepo.EXPECT().GetAll(gomock.Any(), domain.TaskQueryOptions{}).
+		Return([]*domain.Task{{ID: 1}, {ID: 2}, {ID: 3}}, nil).
+		Times(1)
+	mockDeps.EXPECT().GetAllDependencies(gomock.Any()).
+		Return([]domain.Dependency{{TaskID: 1, BlockerID: 3}}, nil).
+		Times(1)
+
+	tasks, err := service.GetTasksInDependencyOrder(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+	assert.Equal(t, 2, tasks[0].ID)
+	assert.Equal(t, 3, tasks[1].ID)
+	assert.Equal(t, 1, tasks[2].ID)
+}
+
+// TestTaskService_MarkTaskAsCompleted_BlockedRefused verifica que no se completa una tarea con bloqueadores abiertos
+func TestTaskService_MarkTaskAsCompleted_BlockedRefused(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockDeps := mocks.NewMockDependencyRepository(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithDependencyRepository(mockDeps),
+		application.WithBlockerEnforcement(true),
+	)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2, Title: "T", Description: "D"}, nil).Times(1)
+	mockDeps.EXPECT().GetBlockers(gomock.Any(), 2).
+		Return([]*domain.Task{{ID: 1, Completed: false}}, nil).
+		Times(1)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+	// Act
+	result, err := service.MarkTaskAsCompleted(context.Background(), 2)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrTaskBlocked)
+	assert.Nil(t, result)
+}
+
+// TestTaskService_MarkTaskAsCompleted_BlockersDone verifica que se completa si todos los bloqueadores están completos
+func TestTaskService_MarkTaskAsCompleted_BlockersDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockDeps := mocks.NewMockDependencyRepository(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithDependencyRepository(mockDeps),
+		application.WithBlockerEnforcement(true),
+	)
+
+	task := &domain.Task{ID: 2, Title: "T", Description: "D"}
+	mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(task, nil).Times(1)
+	mockDeps.EXPECT().GetBlockers(gomock.Any(), 2).
+		Return([]*domain.Task{{ID: 1, Completed: true}}, nil).
+		Times(1)
+	mockRepo.EXPECT().Update(gomock.Any(), task).Return(task, nil).Times(1)
+
+	result, err := service.MarkTaskAsCompleted(context.Background(), 2)
+
+	assert.NoError(t, err)
+	assert.True(t, result.Completed)
+}
@@ -0,0 +1,119 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_SetTaskEstimate verifica que la estimación se persiste con el trabajo restante
+func TestTaskService_SetTaskEstimate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D", Version: 2}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+			assert.Equal(t, 5, task.StoryPoints)
+			assert.Equal(t, 8.0, task.EstimatedHours)
+			assert.Equal(t, 8.0, task.RemainingHours)
+			return task, nil
+		})
+
+	// Act
+	task, err := service.SetTaskEstimate(context.Background(), 1, 5, 8)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, task)
+}
+
+// TestTaskService_UpdateRemainingWork_Negative verifica que no se acepta trabajo restante negativo
+func TestTaskService_UpdateRemainingWork_Negative(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	// No se espera Update
+
+	// Act
+	task, err := service.UpdateRemainingWork(context.Background(), 1, -2)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidEstimate)
+	assert.Nil(t, task)
+}
+
+// TestEstimateReportService_GetVelocity verifica que el rango empieza el lunes y se calculan los promedios
+func TestEstimateReportService_GetVelocity(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEstimates := mocks.NewMockEstimateRepository(ctrl)
+	service := application.NewEstimateReportService(mockEstimates, mocks.NewMockBoardRepository(ctrl))
+
+	wednesday := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 16, 0, 0, 0, 0, time.UTC)
+	mockEstimates.EXPECT().Velocity(gomock.Any(), monday, to, 0).Return([]domain.VelocityWeek{
+		{WeekStart: "2026-03-02", StoryPoints: 6, Tasks: 2},
+		{WeekStart: "2026-03-09", StoryPoints: 2, Tasks: 1},
+	}, nil)
+
+	// Act
+	velocity, err := service.GetVelocity(context.Background(), wednesday, to, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 4.0, velocity.AveragePoints)
+	assert.Equal(t, 3, velocity.CompletedTasks)
+}
+
+// TestEstimateReportService_GetBurndown_UnknownBoard verifica que el tablero debe existir
+func TestEstimateReportService_GetBurndown_UnknownBoard(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBoards := mocks.NewMockBoardRepository(ctrl)
+	service := application.NewEstimateReportService(mocks.NewMockEstimateRepository(ctrl), mockBoards)
+
+	mockBoards.EXPECT().GetByID(gomock.Any(), 9).Return(nil, domain.ErrBoardNotFound)
+	// No se espera Burndown
+
+	// Act
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	burndown, err := service.GetBurndown(context.Background(), from, from.AddDate(0, 0, 14), 9)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrBoardNotFound)
+	assert.Nil(t, burndown)
+}
+
+// TestEstimateReportService_GetBurndown_InvalidRange verifica que se rechaza un rango invertido
+func TestEstimateReportService_GetBurndown_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := application.NewEstimateReportService(mocks.NewMockEstimateRepository(ctrl), mocks.NewMockBoardRepository(ctrl))
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	_, err := service.GetBurndown(context.Background(), from, from.AddDate(0, 0, -1), 0)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidReportRange)
+}
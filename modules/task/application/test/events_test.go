@@ -0,0 +1,201 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_CreateTask_PublishesEvent verifica que al crear se publica task.created con el ID asignado
+func TestTaskService_CreateTask_PublishesEvent(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockPublisher := mocks.NewMockEventPublisher(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithEventPublisher(mockPublisher))
+
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+			created := *task
+			created.ID = 5
+			return &created, nil
+		})
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events []domain.Event) error {
+			assert.Len(t, events, 1)
+			assert.Equal(t, domain.EventTaskCreated, events[0].Type)
+			assert.Equal(t, 5, events[0].TaskID)
+			assert.Equal(t, "ana", events[0].Actor)
+			return nil
+		})
+
+	// Act
+	_, err := service.CreateTask(application.WithActor(context.Background(), "ana"), "T", "D")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestTaskService_MarkTaskAsCompleted_PublishesEvent verifica que completar publica task.completed
+func TestTaskService_MarkTaskAsCompleted_PublishesEvent(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockPublisher := mocks.NewMockEventPublisher(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithEventPublisher(mockPublisher))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) { return task, nil })
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, events []domain.Event) error {
+			assert.Len(t, events, 1)
+			assert.Equal(t, domain.EventTaskCompleted, events[0].Type)
+			assert.True(t, events[0].Task.Completed)
+			return nil
+		})
+
+	// Act
+	_, err := service.MarkTaskAsCompleted(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestTaskService_Events_NotPublishedOnFailure verifica que no se publica nada si la escritura falla
+func TestTaskService_Events_NotPublishedOnFailure(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockPublisher := mocks.NewMockEventPublisher(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithEventPublisher(mockPublisher))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(errors.New("error de base de datos"))
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(0)
+
+	// Act
+	err := service.DeleteTask(context.Background(), 1)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+// TestTaskService_Events_PublishedAfterCommit verifica que dentro de una transacción los eventos se
+// publican después de confirmarla y se descartan si se revierte
+func TestTaskService_Events_PublishedAfterCommit(t *testing.T) {
+	tests := []struct {
+		name     string
+		commit   error
+		wantSent int
+	}{
+		{name: "confirmada", wantSent: 1},
+		{name: "revertida", commit: errors.New("error confirmando"), wantSent: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			mockTx := mocks.NewMockTxManager(ctrl)
+			mockPublisher := mocks.NewMockEventPublisher(ctrl)
+			service := application.NewTaskService(mockRepo, application.WithTxManager(mockTx), application.WithEventPublisher(mockPublisher))
+
+			published := false
+			mockTx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					assert.NoError(t, fn(ctx))
+					assert.False(t, published, "los eventos no se publican antes de confirmar")
+					return tt.commit
+				})
+			mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+			mockRepo.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, events []domain.Event) error {
+					published = true
+					assert.Equal(t, domain.EventTaskDeleted, events[0].Type)
+					return nil
+				}).Times(tt.wantSent)
+
+			// Act
+			err := service.DeleteTask(context.Background(), 1)
+
+			// Assert
+			assert.ErrorIs(t, err, tt.commit)
+		})
+	}
+}
+
+// TestTaskService_CreateTask_WritesOutboxInTransaction verifica que los eventos se guardan en el outbox
+// dentro de la transacción de la escritura y que un fallo del outbox hace fallar la escritura
+func TestTaskService_CreateTask_WritesOutboxInTransaction(t *testing.T) {
+	tests := []struct {
+		name      string
+		appendErr error
+	}{
+		{name: "guardado"},
+		{name: "falla el outbox", appendErr: errors.New("error guardando en el outbox")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockTaskRepository(ctrl)
+			mockTx := mocks.NewMockTxManager(ctrl)
+			mockOutbox := mocks.NewMockEventOutbox(ctrl)
+			service := application.NewTaskService(mockRepo, application.WithTxManager(mockTx), application.WithEventOutbox(mockOutbox))
+
+			inTx := false
+			mockTx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					inTx = true
+					defer func() { inTx = false }()
+					return fn(ctx)
+				})
+			mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+					created := *task
+					created.ID = 3
+					return &created, nil
+				})
+			mockOutbox.EXPECT().Append(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, events []domain.Event) error {
+					assert.True(t, inTx, "el outbox se escribe dentro de la transacción")
+					assert.Len(t, events, 1)
+					assert.Equal(t, domain.EventTaskCreated, events[0].Type)
+					assert.Equal(t, 3, events[0].TaskID)
+					return tt.appendErr
+				})
+
+			// Act
+			task, err := service.CreateTask(context.Background(), "T", "D")
+
+			// Assert
+			if tt.appendErr != nil {
+				assert.ErrorIs(t, err, tt.appendErr)
+				assert.Nil(t, task)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, 3, task.ID)
+		})
+	}
+}
@@ -161,12 +161,12 @@ func TestTaskService_GetAllTasks_Success(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().
-		GetAll(gomock.Any()).
+		GetAll(gomock.Any(), domain.TaskQueryOptions{}).
 		Return(expectedTasks, nil).
 		Times(1)
 
 	// Act
-	result, err := service.GetAllTasks(context.Background())
+	result, err := service.GetAllTasks(context.Background(), domain.TaskQueryOptions{})
 
 	// Assert
 	assert.NoError(t, err)                             //Verificar que no hay error
@@ -190,12 +190,12 @@ func TestTaskService_GetAllTasks_EmptyResult_ShouldReturnEmptySlice(t *testing.T
 	emptyTasks := []*domain.Task{}
 
 	mockRepo.EXPECT().
-		GetAll(gomock.Any()).
+		GetAll(gomock.Any(), domain.TaskQueryOptions{}).
 		Return(emptyTasks, nil).
 		Times(1)
 
 	// Act
-	result, err := service.GetAllTasks(context.Background())
+	result, err := service.GetAllTasks(context.Background(), domain.TaskQueryOptions{})
 
 	// Assert
 	assert.NoError(t, err)   //Verificar que no hay error
@@ -214,12 +214,12 @@ func TestTaskService_GetAllTasks_RepositoryError_ShouldPropagateError(t *testing
 	repositoryError := errors.New("database error")
 
 	mockRepo.EXPECT().
-		GetAll(gomock.Any()).
+		GetAll(gomock.Any(), domain.TaskQueryOptions{}).
 		Return(nil, repositoryError).
 		Times(1)
 
 	// Act
-	result, err := service.GetAllTasks(context.Background())
+	result, err := service.GetAllTasks(context.Background(), domain.TaskQueryOptions{})
 
 	// Assert
 	assert.Error(t, err)
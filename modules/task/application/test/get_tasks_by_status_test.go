@@ -44,12 +44,12 @@ func TestTaskService_GetTasksByStatus_Success_CompletedTasks(t *testing.T) {
 
 	// Expectativa del mock
 	mockRepo.EXPECT().
-		GetByStatus(gomock.Any(), true).
+		GetByStatus(gomock.Any(), true, domain.TaskQueryOptions{}).
 		Return(completedTasks, nil).
 		Times(1)
 
 	// Act
-	result, err := service.GetTasksByStatus(context.Background(), true)
+	result, err := service.GetTasksByStatus(context.Background(), true, domain.TaskQueryOptions{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -92,12 +92,12 @@ func TestTaskService_GetTasksByStatus_Success_IncompleteTasks(t *testing.T) {
 
 	// Expectativa del mock
 	mockRepo.EXPECT().
-		GetByStatus(gomock.Any(), false).
+		GetByStatus(gomock.Any(), false, domain.TaskQueryOptions{}).
 		Return(incompleteTasks, nil).
 		Times(1)
 
 	// Act
-	result, err := service.GetTasksByStatus(context.Background(), false)
+	result, err := service.GetTasksByStatus(context.Background(), false, domain.TaskQueryOptions{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -123,12 +123,12 @@ func TestTaskService_GetTasksByStatus_Success_EmptyResult(t *testing.T) {
 
 	// Expectativa del mock
 	mockRepo.EXPECT().
-		GetByStatus(gomock.Any(), true).
+		GetByStatus(gomock.Any(), true, domain.TaskQueryOptions{}).
 		Return(emptyTasks, nil).
 		Times(1)
 
 	// Act
-	result, err := service.GetTasksByStatus(context.Background(), true)
+	result, err := service.GetTasksByStatus(context.Background(), true, domain.TaskQueryOptions{})
 
 	// Assert
 	assert.NoError(t, err)
@@ -149,12 +149,12 @@ func TestTaskService_GetTasksByStatus_RepositoryError_ShouldPropagateError(t *te
 
 	// Expectativa del mock
 	mockRepo.EXPECT().
-		GetByStatus(gomock.Any(), true).
+		GetByStatus(gomock.Any(), true, domain.TaskQueryOptions{}).
 		Return(nil, repositoryError).
 		Times(1)
 
 	// Act
-	result, err := service.GetTasksByStatus(context.Background(), true)
+	result, err := service.GetTasksByStatus(context.Background(), true, domain.TaskQueryOptions{})
 
 	// Assert
 	assert.Error(t, err)
@@ -0,0 +1,121 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_UpdateTask_RecordsRevision verifica que completar una tarea registra la revisión con el usuario
+func TestTaskService_UpdateTask_RecordsRevision(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithHistory(mockHistory))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "T", Description: "D", Version: 1}, nil)
+	// Con historial la escritura pasa por el repositorio de historial, no por Update
+	mockHistory.EXPECT().UpdateWithRevision(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+			assert.Equal(t, domain.RevisionComplete, revision.Action)
+			assert.Equal(t, "7", revision.Actor)
+			assert.Equal(t, domain.FieldChange{From: false, To: true}, revision.Changes["completed"])
+			return task, nil
+		})
+
+	ctx := application.WithActor(context.Background(), "7")
+	completed := true
+
+	// Act
+	task, err := service.UpdateTask(ctx, 1, "", "", &completed)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, task.Completed)
+}
+
+// TestTaskService_DeleteTask_RecordsRevision verifica que eliminar registra la revisión con usuario anónimo
+func TestTaskService_DeleteTask_RecordsRevision(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithHistory(mockHistory))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Version: 2}, nil)
+	mockHistory.EXPECT().DeleteWithRevision(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, revision *domain.TaskRevision) error {
+			assert.Equal(t, domain.RevisionDelete, revision.Action)
+			assert.Equal(t, "anonymous", revision.Actor)
+			assert.NotNil(t, revision.Snapshot.DeletedAt)
+			return nil
+		})
+
+	// Act
+	err := service.DeleteTask(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestTaskService_RevertTask_Success verifica que se restauran los campos de la revisión
+func TestTaskService_RevertTask_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithHistory(mockHistory))
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		Return(&domain.Task{ID: 1, Title: "Nuevo", Description: "D", Version: 3}, nil)
+	mockHistory.EXPECT().GetRevision(gomock.Any(), 1, 10).
+		Return(&domain.TaskRevision{ID: 10, TaskID: 1, Snapshot: &domain.Task{ID: 1, Title: "Viejo", Description: "D"}}, nil)
+	mockHistory.EXPECT().UpdateWithRevision(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+			assert.Equal(t, domain.RevisionRevert, revision.Action)
+			assert.Equal(t, 3, task.Version)
+			return task, nil
+		})
+
+	// Act
+	task, err := service.RevertTask(context.Background(), 1, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Viejo", task.Title)
+}
+
+// TestTaskService_GetTaskAsOf_NoRevision verifica el error cuando la tarea no tenía revisiones en la fecha
+func TestTaskService_GetTaskAsOf_NoRevision(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithHistory(mockHistory))
+
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockHistory.EXPECT().GetRevisionAt(gomock.Any(), 1, at).Return(nil, domain.ErrNoRevisionAt)
+
+	// Act
+	task, err := service.GetTaskAsOf(context.Background(), 1, at)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrNoRevisionAt)
+	assert.Nil(t, task)
+}
@@ -0,0 +1,90 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_MoveTask_RequiresAnchor verifica que se exige before o after
+func TestTaskService_MoveTask_RequiresAnchor(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	// Act
+	task, err := service.MoveTask(context.Background(), 1, 0, 0)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidMove)
+	assert.Nil(t, task)
+}
+
+// TestTaskService_MoveTask_AfterAnchor verifica que la tarea queda entre la referencia y su siguiente
+// y que el movimiento incrementa su versión
+func TestTaskService_MoveTask_AfterAnchor(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Rank: "z", Version: 1}, nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2, Rank: "a"}, nil),
+		mockRepo.EXPECT().GetAdjacentRank(gomock.Any(), "a", 1, true).Return("c", true, nil),
+		mockRepo.EXPECT().UpdateRank(gomock.Any(), 1, "b").Return(nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Rank: "b", Version: 1}, nil),
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+			task.Version++
+			return task, nil
+		}),
+	)
+
+	// Act
+	task, err := service.MoveTask(context.Background(), 1, 0, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "b", task.Rank)
+	assert.Equal(t, 2, task.Version)
+}
+
+// TestTaskService_MoveTask_RebalancesUnranked verifica que se reequilibra si la referencia no tiene rank
+func TestTaskService_MoveTask_RebalancesUnranked(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2}, nil),
+		mockRepo.EXPECT().RebalanceRanks(gomock.Any()).Return(nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 2).Return(&domain.Task{ID: 2, Rank: "4"}, nil),
+		mockRepo.EXPECT().GetAdjacentRank(gomock.Any(), "4", 1, false).Return("", false, nil),
+		mockRepo.EXPECT().UpdateRank(gomock.Any(), 1, "2").Return(nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Rank: "2"}, nil),
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+			return task, nil
+		}),
+	)
+
+	// Act
+	task, err := service.MoveTask(context.Background(), 1, 2, 0)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "2", task.Rank)
+}
@@ -0,0 +1,79 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_PatchTask_ClearsDueDate verifica que el parche puede quitar la fecha de vencimiento
+func TestTaskService_PatchTask_ClearsDueDate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	due := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D", DueDate: &due, Version: 1}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+			assert.Nil(t, task.DueDate)
+			assert.Equal(t, "T", task.Title)
+			return task, nil
+		})
+
+	// Act
+	task, err := service.PatchTask(context.Background(), 1, domain.TaskPatch{ClearDueDate: true})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, task)
+}
+
+// TestTaskService_PatchTask_Empty verifica que un parche vacío no guarda la tarea
+func TestTaskService_PatchTask_Empty(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	// No se espera Update
+
+	// Act
+	task, err := service.PatchTask(context.Background(), 1, domain.TaskPatch{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "T", task.Title)
+}
+
+// TestTaskService_PatchTask_InvalidTitle verifica que no se guarda un título vacío
+func TestTaskService_PatchTask_InvalidTitle(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	blank := ""
+
+	// Act
+	task, err := service.PatchTask(context.Background(), 1, domain.TaskPatch{Title: &blank})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidPatch)
+	assert.Nil(t, task)
+}
@@ -0,0 +1,116 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_MarkTaskAsCompleted_SpawnsNextOccurrence verifica que completar una tarea recurrente crea la siguiente
+func TestTaskService_MarkTaskAsCompleted_SpawnsNextOccurrence(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	due := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	existingTask := &domain.Task{
+		ID:          1,
+		Title:       "Reporte semanal",
+		Description: "Enviar reporte",
+		DueDate:     &due,
+		Recurrence:  "FREQ=WEEKLY",
+		Occurrence:  1,
+	}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(existingTask, nil).Times(1)
+	mockRepo.EXPECT().Update(gomock.Any(), existingTask).Return(existingTask, nil).Times(1)
+	mockRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, next *domain.Task) (*domain.Task, error) {
+			assert.Equal(t, "Reporte semanal", next.Title)
+			assert.Equal(t, "FREQ=WEEKLY", next.Recurrence)
+			assert.Equal(t, 2, next.Occurrence)
+			assert.Equal(t, time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC), *next.DueDate)
+			assert.False(t, next.Completed)
+			next.ID = 2
+			return next, nil
+		}).
+		Times(1)
+
+	// Act
+	result, err := service.MarkTaskAsCompleted(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.ID)
+	assert.True(t, result.Completed)
+}
+
+// TestTaskService_MarkTaskAsCompleted_AlreadyCompletedDoesNotSpawn verifica que no se duplican ocurrencias
+func TestTaskService_MarkTaskAsCompleted_AlreadyCompletedDoesNotSpawn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	due := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	existingTask := &domain.Task{ID: 1, Title: "T", Description: "D", Completed: true, DueDate: &due, Recurrence: "FREQ=DAILY", Occurrence: 1}
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(existingTask, nil).Times(1)
+	mockRepo.EXPECT().Update(gomock.Any(), existingTask).Return(existingTask, nil).Times(1)
+	mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+	_, err := service.MarkTaskAsCompleted(context.Background(), 1)
+
+	assert.NoError(t, err)
+}
+
+// TestTaskService_SetTaskSchedule_Success verifica que se normaliza y persiste la regla
+func TestTaskService_SetTaskSchedule_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	existingTask := &domain.Task{ID: 1, Title: "T", Description: "D"}
+	due := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(existingTask, nil).Times(1)
+	mockRepo.EXPECT().Update(gomock.Any(), existingTask).Return(existingTask, nil).Times(1)
+
+	result, err := service.SetTaskSchedule(context.Background(), 1, &due, "freq=monthly;bymonthday=1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=1", result.Recurrence)
+	assert.Equal(t, 1, result.Occurrence)
+	assert.Equal(t, due, *result.DueDate)
+}
+
+// TestTaskService_SetTaskSchedule_InvalidRule verifica que una regla inválida no se persiste
+func TestTaskService_SetTaskSchedule_InvalidRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	due := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil).Times(1)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := service.SetTaskSchedule(context.Background(), 1, &due, "FREQ=HOURLY")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidRecurrence)
+	assert.Nil(t, result)
+}
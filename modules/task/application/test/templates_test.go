@@ -0,0 +1,97 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTemplateService_InstantiateTemplate verifica que el plan llega completo al repositorio con campos y revisiones
+func TestTemplateService_InstantiateTemplate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplates := mocks.NewMockTemplateRepository(ctrl)
+	mockFields := mocks.NewMockCustomFieldRepository(ctrl)
+	service := application.NewTemplateService(mockTemplates, mockFields)
+
+	template, _ := domain.NewTaskTemplate("Onboarding", "", []domain.TemplateTask{{
+		Title:        "Onboarding de {{name}}",
+		Description:  "Bienvenida",
+		CustomFields: map[string]any{"size": 3.0},
+		Children:     []domain.TemplateTask{{Title: "Cuenta", Description: "Correo"}},
+	}})
+	size := &domain.CustomFieldDefinition{ID: 2, Key: "size", Type: domain.CustomFieldNumber}
+
+	mockTemplates.EXPECT().GetByID(gomock.Any(), 1).Return(template, nil)
+	mockFields.EXPECT().GetDefinition(gomock.Any(), "size").Return(size, nil)
+	mockTemplates.EXPECT().Instantiate(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, plan []*domain.PlannedTask) ([]*domain.Task, error) {
+			assert.Len(t, plan, 2)
+			assert.Equal(t, "Onboarding de Ana", plan[0].Task.Title)
+			assert.Equal(t, []domain.CustomFieldValue{{Field: size, Value: 3.0}}, plan[0].Values)
+			assert.Equal(t, 0, plan[1].Parent)
+			for _, planned := range plan {
+				assert.NotNil(t, planned.Revision)
+			}
+			return []*domain.Task{plan[0].Task, plan[1].Task}, nil
+		})
+
+	// Act
+	tasks, err := service.InstantiateTemplate(context.Background(), 1, map[string]string{"name": "Ana"}, time.Now())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+}
+
+// TestTemplateService_InstantiateTemplate_MissingVariable verifica que no se crea nada si faltan variables
+func TestTemplateService_InstantiateTemplate_MissingVariable(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplates := mocks.NewMockTemplateRepository(ctrl)
+	service := application.NewTemplateService(mockTemplates, nil)
+
+	template, _ := domain.NewTaskTemplate("Onboarding", "", []domain.TemplateTask{{Title: "Onboarding de {{name}}", Description: "D"}})
+	mockTemplates.EXPECT().GetByID(gomock.Any(), 1).Return(template, nil)
+	// No se espera Instantiate
+
+	// Act
+	tasks, err := service.InstantiateTemplate(context.Background(), 1, nil, time.Now())
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrMissingTemplateVariable)
+	assert.Nil(t, tasks)
+}
+
+// TestTemplateService_CreateTemplate_UnknownCustomField verifica que los campos de la plantilla deben existir
+func TestTemplateService_CreateTemplate_UnknownCustomField(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTemplates := mocks.NewMockTemplateRepository(ctrl)
+	mockFields := mocks.NewMockCustomFieldRepository(ctrl)
+	service := application.NewTemplateService(mockTemplates, mockFields)
+
+	mockFields.EXPECT().GetDefinition(gomock.Any(), "color").Return(nil, domain.ErrCustomFieldNotFound)
+	// No se espera Create
+
+	// Act
+	template, err := service.CreateTemplate(context.Background(), "Colores", "", []domain.TemplateTask{
+		{Title: "T", Description: "D", CustomFields: map[string]any{"color": "rojo"}},
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidTemplate)
+	assert.Nil(t, template)
+}
@@ -0,0 +1,121 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTimeTrackingService_StartTimer_Archived verifica que no se registra tiempo en tareas archivadas
+func TestTimeTrackingService_StartTimer_Archived(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTime := mocks.NewMockTimeEntryRepository(ctrl)
+	mockTasks := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTimeTrackingService(mockTime, mockTasks, mocks.NewMockBoardRepository(ctrl))
+
+	now := time.Now().UTC()
+	mockTasks.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Completed: true, ArchivedAt: &now}, nil)
+	// No se espera StartTimer
+
+	// Act
+	entry, err := service.StartTimer(context.Background(), 1, 3)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrTaskArchived)
+	assert.Nil(t, entry)
+}
+
+// TestTimeTrackingService_StopTimer verifica que se guarda la duración del temporizador en curso
+func TestTimeTrackingService_StopTimer(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTime := mocks.NewMockTimeEntryRepository(ctrl)
+	service := application.NewTimeTrackingService(mockTime, mocks.NewMockTaskRepository(ctrl), mocks.NewMockBoardRepository(ctrl))
+
+	started := time.Now().UTC().Add(-2 * time.Minute)
+	mockTime.EXPECT().GetRunning(gomock.Any(), 3).Return(&domain.TimeEntry{ID: 9, TaskID: 1, UserID: 3, StartedAt: started}, nil)
+	mockTime.EXPECT().Finish(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.TimeEntry) error {
+			assert.NotNil(t, entry.EndedAt)
+			return nil
+		})
+
+	// Act
+	entry, err := service.StopTimer(context.Background(), 3)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.InDelta(t, 120, entry.DurationSeconds, 2)
+}
+
+// TestTimeTrackingService_LogTime_DefaultStart verifica que sin inicio el registro termina ahora
+func TestTimeTrackingService_LogTime_DefaultStart(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTime := mocks.NewMockTimeEntryRepository(ctrl)
+	mockTasks := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTimeTrackingService(mockTime, mockTasks, mocks.NewMockBoardRepository(ctrl))
+
+	mockTasks.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1}, nil)
+	mockTime.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+			entry.ID = 4
+			return entry, nil
+		})
+
+	// Act
+	entry, err := service.LogTime(context.Background(), 1, 3, nil, 45*time.Minute, "revisión")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2700), entry.DurationSeconds)
+	assert.WithinDuration(t, time.Now(), *entry.EndedAt, 2*time.Second)
+}
+
+// TestTimeTrackingService_GetTimeReport_InvalidRange verifica la validación del rango y la agrupación
+func TestTimeTrackingService_GetTimeReport_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service := application.NewTimeTrackingService(mocks.NewMockTimeEntryRepository(ctrl), mocks.NewMockTaskRepository(ctrl), mocks.NewMockBoardRepository(ctrl))
+	now := time.Now()
+
+	_, err := service.GetTimeReport(context.Background(), now, now.Add(-time.Hour), domain.TimeReportByDay)
+	assert.ErrorIs(t, err, domain.ErrInvalidTimeReport)
+	_, err = service.GetTimeReport(context.Background(), now, now.Add(time.Hour), "week")
+	assert.ErrorIs(t, err, domain.ErrInvalidTimeReport)
+}
+
+// TestTimeTrackingService_GetProjectTime verifica que el total se pide por el valor del campo project
+// y que un proyecto vacío se rechaza sin consultar el repositorio
+func TestTimeTrackingService_GetProjectTime(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTimeRepo := mocks.NewMockTimeEntryRepository(ctrl)
+	service := application.NewTimeTrackingService(mockTimeRepo, mocks.NewMockTaskRepository(ctrl), mocks.NewMockBoardRepository(ctrl))
+	mockTimeRepo.EXPECT().ProjectTotal(gomock.Any(), "web").Return(int64(5400), nil)
+
+	// Act
+	total, err := service.GetProjectTime(context.Background(), "web")
+	_, blankErr := service.GetProjectTime(context.Background(), " ")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5400), total)
+	assert.ErrorIs(t, blankErr, domain.ErrInvalidProject)
+}
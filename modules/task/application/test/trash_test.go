@@ -0,0 +1,181 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_RestoreTask_Success verifica que la restauración se guarda como una actualización
+// de la tarea, con su revisión y su evento
+func TestTaskService_RestoreTask_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockHistory := mocks.NewMockTaskHistoryRepository(ctrl)
+	mockPublisher := mocks.NewMockEventPublisher(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithHistory(mockHistory), application.WithEventPublisher(mockPublisher))
+
+	deletedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	gomock.InOrder(
+		mockRepo.EXPECT().GetDeletedByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "Tarea", DeletedAt: &deletedAt, Version: 3}, nil),
+		mockRepo.EXPECT().Restore(gomock.Any(), 1).Return(nil),
+		mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "Tarea", Version: 3}, nil),
+		mockHistory.EXPECT().UpdateWithRevision(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+				assert.Contains(t, revision.Changes, "deleted_at")
+				task.Version++
+				return task, nil
+			}),
+	)
+	mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []domain.Event) error {
+		assert.Equal(t, domain.EventTaskUpdated, events[0].Type)
+		return nil
+	})
+
+	// Act
+	task, err := service.RestoreTask(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, task.ID)
+	assert.Equal(t, 4, task.Version)
+}
+
+// TestTaskService_RestoreTask_NotInTrash verifica que se conserva el error de dominio
+func TestTaskService_RestoreTask_NotInTrash(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	service := application.NewTaskService(mockRepo)
+
+	mockRepo.EXPECT().GetDeletedByID(gomock.Any(), 1).Return(nil, domain.ErrTaskNotInTrash)
+	// No se espera Restore
+
+	// Act
+	task, err := service.RestoreTask(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrTaskNotInTrash)
+	assert.Nil(t, task)
+}
+
+// TestTaskService_PurgeTrash_ProcessesComments verifica que la purga usa la retención y procesa los comentarios
+func TestTaskService_PurgeTrash_ProcessesComments(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithCommentRepository(mockCommentRepo, domain.CommentDeleteCascade))
+
+	retention := 24 * time.Hour
+	mockRepo.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, before time.Time) ([]int, error) {
+			assert.WithinDuration(t, time.Now().UTC().Add(-retention), before, time.Minute)
+			return []int{2, 5}, nil
+		})
+	mockCommentRepo.EXPECT().DeleteByTask(gomock.Any(), 2).Return(nil)
+	mockCommentRepo.EXPECT().DeleteByTask(gomock.Any(), 5).Return(nil)
+
+	// Act
+	purged, err := service.PurgeTrash(context.Background(), retention)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, purged)
+}
+
+// TestTaskService_PermanentlyDeleteTask_NotInTrash verifica que no se tocan los comentarios si la tarea no está en la papelera
+func TestTaskService_PermanentlyDeleteTask_NotInTrash(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockCommentRepo := mocks.NewMockCommentRepository(ctrl)
+	service := application.NewTaskService(mockRepo,
+		application.WithCommentRepository(mockCommentRepo, domain.CommentDeleteCascade))
+
+	mockRepo.EXPECT().DeletePermanently(gomock.Any(), 1).Return(errors.New("sin filas"))
+
+	// Act
+	err := service.PermanentlyDeleteTask(context.Background(), 1)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+// TestTaskService_PermanentlyDeleteTask_ReleasesBlobs verifica que se eliminan los blobs que ya no usa
+// ningún adjunto y se conservan los compartidos con otra tarea
+func TestTaskService_PermanentlyDeleteTask_ReleasesBlobs(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithAttachments(mockAttachmentRepo, mockBlobStore))
+
+	gomock.InOrder(
+		mockAttachmentRepo.EXPECT().ListByTask(gomock.Any(), 1).Return([]*domain.Attachment{
+			{ID: 1, TaskID: 1, StorageKey: "ab/propio"},
+			{ID: 2, TaskID: 1, StorageKey: "cd/compartido"},
+		}, nil),
+		mockRepo.EXPECT().DeletePermanently(gomock.Any(), 1).Return(nil),
+	)
+	mockAttachmentRepo.EXPECT().CountByStorageKey(gomock.Any(), "ab/propio").Return(0, nil)
+	mockAttachmentRepo.EXPECT().CountByStorageKey(gomock.Any(), "cd/compartido").Return(1, nil)
+	mockBlobStore.EXPECT().Delete(gomock.Any(), "ab/propio").Return(nil).Times(1)
+
+	// Act
+	err := service.PermanentlyDeleteTask(context.Background(), 1)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestTaskService_PurgeTrash_ReleasesBlobs verifica que la purga libera los blobs de las tareas
+// purgadas y no toca los adjuntos de las tareas recientes de la papelera
+func TestTaskService_PurgeTrash_ReleasesBlobs(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockAttachmentRepo := mocks.NewMockAttachmentRepository(ctrl)
+	mockBlobStore := mocks.NewMockBlobStore(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithAttachments(mockAttachmentRepo, mockBlobStore))
+
+	old := time.Now().UTC().Add(-48 * time.Hour)
+	recent := time.Now().UTC()
+	mockRepo.EXPECT().GetDeleted(gomock.Any()).Return([]*domain.Task{
+		{ID: 2, DeletedAt: &old},
+		{ID: 3, DeletedAt: &recent},
+	}, nil)
+	mockAttachmentRepo.EXPECT().ListByTask(gomock.Any(), 2).Return([]*domain.Attachment{{ID: 7, TaskID: 2, StorageKey: "ab/viejo"}}, nil)
+	mockRepo.EXPECT().PurgeDeleted(gomock.Any(), gomock.Any()).Return([]int{2}, nil)
+	mockAttachmentRepo.EXPECT().CountByStorageKey(gomock.Any(), "ab/viejo").Return(0, nil)
+	mockBlobStore.EXPECT().Delete(gomock.Any(), "ab/viejo").Return(nil)
+
+	// Act
+	purged, err := service.PurgeTrash(context.Background(), 24*time.Hour)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, purged)
+}
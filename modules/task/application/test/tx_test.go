@@ -0,0 +1,82 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskService_DeleteTask_RunsInTransaction verifica que la lectura y el borrado usan el contexto
+// de la transacción y que el error llega al TxManager para revertirla
+func TestTaskService_DeleteTask_RunsInTransaction(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockTx := mocks.NewMockTxManager(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithTxManager(mockTx))
+
+	type txKey struct{}
+	errDelete := errors.New("error de base de datos")
+	mockTx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			err := fn(context.WithValue(ctx, txKey{}, true))
+			assert.ErrorIs(t, err, errDelete)
+			return err
+		})
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).
+		DoAndReturn(func(ctx context.Context, id int) (*domain.Task, error) {
+			assert.Equal(t, true, ctx.Value(txKey{}))
+			return &domain.Task{ID: 1, Title: "T", Description: "D"}, nil
+		})
+	mockRepo.EXPECT().Delete(gomock.Any(), 1).
+		DoAndReturn(func(ctx context.Context, id int) error {
+			assert.Equal(t, true, ctx.Value(txKey{}))
+			return errDelete
+		})
+
+	// Act
+	err := service.DeleteTask(context.Background(), 1)
+
+	// Assert
+	assert.ErrorIs(t, err, errDelete)
+}
+
+// TestTaskService_PatchTask_RunsInTransaction verifica que UpdateTask lee y guarda dentro de la transacción
+func TestTaskService_PatchTask_RunsInTransaction(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockTaskRepository(ctrl)
+	mockTx := mocks.NewMockTxManager(ctrl)
+	service := application.NewTaskService(mockRepo, application.WithTxManager(mockTx))
+
+	calls := 0
+	mockTx.EXPECT().WithinTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			calls++
+			return fn(ctx)
+		})
+	mockRepo.EXPECT().GetByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D", Version: 1}, nil)
+	mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, task *domain.Task) (*domain.Task, error) {
+			assert.Equal(t, 1, calls, "Update debe ejecutarse dentro de WithinTx")
+			return task, nil
+		})
+
+	// Act
+	task, err := service.UpdateTask(context.Background(), 1, "Nuevo", "", nil)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Nuevo", task.Title)
+	assert.Equal(t, 1, calls)
+}
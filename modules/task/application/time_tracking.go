@@ -0,0 +1,126 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// TimeTrackingService maneja los casos de uso del registro de tiempo sobre tareas
+type TimeTrackingService struct {
+	timeRepo  domain.TimeEntryRepository
+	taskRepo  domain.TaskRepository
+	boardRepo domain.BoardRepository
+}
+
+// NewTimeTrackingService crea una nueva instancia de TimeTrackingService
+func NewTimeTrackingService(timeRepo domain.TimeEntryRepository, taskRepo domain.TaskRepository, boardRepo domain.BoardRepository) *TimeTrackingService {
+	return &TimeTrackingService{
+		timeRepo:  timeRepo,
+		taskRepo:  taskRepo,
+		boardRepo: boardRepo,
+	}
+}
+
+// StartTimer inicia un temporizador del usuario sobre la tarea; un usuario solo puede tener uno en curso
+func (s *TimeTrackingService) StartTimer(ctx context.Context, taskID, userID int) (*domain.TimeEntry, error) {
+	if err := s.ensureWritableTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	entry, err := domain.StartTimeEntry(taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.timeRepo.StartTimer(ctx, entry)
+}
+
+// StopTimer detiene el temporizador en curso del usuario y guarda su duración
+func (s *TimeTrackingService) StopTimer(ctx context.Context, userID int) (*domain.TimeEntry, error) {
+	entry, err := s.timeRepo.GetRunning(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := entry.Stop(time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.timeRepo.Finish(ctx, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// LogTime registra tiempo manualmente; sin startedAt se asume que el trabajo terminó ahora
+func (s *TimeTrackingService) LogTime(ctx context.Context, taskID, userID int, startedAt *time.Time, duration time.Duration, note string) (*domain.TimeEntry, error) {
+	if err := s.ensureWritableTask(ctx, taskID); err != nil {
+		return nil, err
+	}
+	start := time.Now().Add(-duration)
+	if startedAt != nil {
+		start = *startedAt
+	}
+	entry, err := domain.NewManualTimeEntry(taskID, userID, start, duration, note)
+	if err != nil {
+		return nil, err
+	}
+	return s.timeRepo.Create(ctx, entry)
+}
+
+// ListTimeEntries obtiene los registros de una tarea y el total de segundos registrados
+func (s *TimeTrackingService) ListTimeEntries(ctx context.Context, taskID int) ([]*domain.TimeEntry, int64, error) {
+	if _, err := s.taskRepo.GetByID(ctx, taskID); err != nil {
+		return nil, 0, fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", taskID, err)
+	}
+	entries, err := s.timeRepo.ListByTask(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.timeRepo.TaskTotal(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+// GetBoardTime obtiene el total de segundos registrados en las tareas de un tablero
+func (s *TimeTrackingService) GetBoardTime(ctx context.Context, boardID int) (int64, error) {
+	if _, err := s.boardRepo.GetByID(ctx, boardID); err != nil {
+		return 0, fmt.Errorf("no se pudo obtener el tablero %d: %w", boardID, err)
+	}
+	return s.timeRepo.BoardTotal(ctx, boardID)
+}
+
+// GetProjectTime obtiene el total de segundos registrados en las tareas de un proyecto, es decir,
+// las que tienen ese valor en el campo personalizado project
+func (s *TimeTrackingService) GetProjectTime(ctx context.Context, project string) (int64, error) {
+	if strings.TrimSpace(project) == "" {
+		return 0, domain.ErrInvalidProject
+	}
+	return s.timeRepo.ProjectTotal(ctx, project)
+}
+
+// GetTimeReport agrega el tiempo registrado en [from, to) por día, usuario, tarea o proyecto
+func (s *TimeTrackingService) GetTimeReport(ctx context.Context, from, to time.Time, groupBy domain.TimeReportGroup) (*domain.TimeReport, error) {
+	if !groupBy.IsValid() || !from.Before(to) {
+		return nil, domain.ErrInvalidTimeReport
+	}
+	rows, err := s.timeRepo.Report(ctx, from, to, groupBy)
+	if err != nil {
+		return nil, err
+	}
+	return domain.NewTimeReport(from, to, groupBy, rows), nil
+}
+
+// ensureWritableTask verifica que la tarea exista y no esté archivada
+func (s *TimeTrackingService) ensureWritableTask(ctx context.Context, taskID int) error {
+	task, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("no se pudo encontrar la tarea con ID %d: %w", taskID, err)
+	}
+	if err := task.EnsureWritable(); err != nil {
+		return fmt.Errorf("no se puede registrar tiempo en la tarea %d: %w", taskID, err)
+	}
+	return nil
+}
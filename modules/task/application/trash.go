@@ -0,0 +1,202 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// GetTrash obtiene las tareas que están en la papelera
+func (s *TaskService) GetTrash(ctx context.Context) ([]*domain.Task, error) {
+	tasks, err := s.taskRepo.GetDeleted(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener la papelera: %w", err)
+	}
+	return tasks, nil
+}
+
+// RestoreTask saca una tarea de la papelera
+func (s *TaskService) RestoreTask(ctx context.Context, id int) (*domain.Task, error) {
+	if id == 0 {
+		return nil, fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	// La restauración se guarda como una actualización, con su revisión y su evento
+	return inTx(ctx, s, func(ctx context.Context) (*domain.Task, error) {
+		trashed, err := s.taskRepo.GetDeletedByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo restaurar la tarea con ID %d: %w", id, err)
+		}
+		return s.touchTask(ctx, trashed, func(ctx context.Context) error {
+			if err := s.taskRepo.Restore(ctx, id); err != nil {
+				return fmt.Errorf("no se pudo restaurar la tarea con ID %d: %w", id, err)
+			}
+			return nil
+		})
+	})
+}
+
+// PermanentlyDeleteTask elimina definitivamente una tarea de la papelera
+// y procesa sus comentarios según la política configurada
+func (s *TaskService) PermanentlyDeleteTask(ctx context.Context, id int) error {
+	if id == 0 {
+		return fmt.Errorf("el ID de la tarea es requerido")
+	}
+
+	// La tarea y sus comentarios se eliminan en una transacción. Los blobs no se pueden
+	// recuperar, así que se eliminan solo después de confirmarla
+	attachments, err := inTx(ctx, s, func(ctx context.Context) ([]*domain.Attachment, error) {
+		// Los metadatos de los adjuntos se eliminan con la tarea, así que se leen antes
+		attachments, err := s.taskAttachments(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.taskRepo.DeletePermanently(ctx, id); err != nil {
+			return nil, fmt.Errorf("no se pudo eliminar definitivamente la tarea con ID %d: %w", id, err)
+		}
+		if err := s.releaseComments(ctx, id); err != nil {
+			return nil, err
+		}
+		return attachments, nil
+	})
+	if err != nil {
+		return err
+	}
+	return s.releaseBlobs(ctx, attachments)
+}
+
+// PurgeTrash elimina definitivamente las tareas que llevan en la papelera más de retention
+// y retorna cuántas se eliminaron
+func (s *TaskService) PurgeTrash(ctx context.Context, retention time.Duration) (int, error) {
+	before := time.Now().UTC().Add(-retention)
+
+	// Como en PermanentlyDeleteTask, los blobs se eliminan después de confirmar la transacción
+	var released []*domain.Attachment
+	ids, err := inTx(ctx, s, func(ctx context.Context) ([]int, error) {
+		attachments, err := s.expiredTrashAttachments(ctx, before)
+		if err != nil {
+			return nil, err
+		}
+
+		ids, err := s.taskRepo.PurgeDeleted(ctx, before)
+		if err != nil {
+			return nil, fmt.Errorf("no se pudo purgar la papelera: %w", err)
+		}
+
+		for _, id := range ids {
+			if err := s.releaseComments(ctx, id); err != nil {
+				return nil, err
+			}
+			released = append(released, attachments[id]...)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), s.releaseBlobs(ctx, released)
+}
+
+// RunTrashPurger purga la papelera cada interval hasta que se cancele ctx
+func (s *TaskService) RunTrashPurger(ctx context.Context, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := s.PurgeTrash(ctx, retention)
+			if err != nil {
+				log.Printf("Error purgando la papelera: %v", err)
+				continue
+			}
+			if purged > 0 {
+				log.Printf("Papelera purgada: %d tareas eliminadas definitivamente", purged)
+			}
+		}
+	}
+}
+
+// releaseComments elimina o archiva los comentarios de una tarea eliminada definitivamente
+func (s *TaskService) releaseComments(ctx context.Context, taskID int) error {
+	if s.commentRepo == nil {
+		return nil
+	}
+
+	var err error
+	if s.commentPolicy == domain.CommentDeleteArchive {
+		err = s.commentRepo.ArchiveByTask(ctx, taskID)
+	} else {
+		err = s.commentRepo.DeleteByTask(ctx, taskID)
+	}
+	if err != nil {
+		return fmt.Errorf("no se pudieron procesar los comentarios de la tarea %d: %w", taskID, err)
+	}
+	return nil
+}
+
+// taskAttachments obtiene los adjuntos de una tarea que se va a eliminar definitivamente
+func (s *TaskService) taskAttachments(ctx context.Context, taskID int) ([]*domain.Attachment, error) {
+	if s.attachmentRepo == nil {
+		return nil, nil
+	}
+	attachments, err := s.attachmentRepo.ListByTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener los adjuntos de la tarea %d: %w", taskID, err)
+	}
+	return attachments, nil
+}
+
+// expiredTrashAttachments obtiene por tarea los adjuntos de las tareas que la purga va a eliminar.
+// Si una tarea se restaura antes de purgar, no está entre las purgadas y sus adjuntos no se tocan
+func (s *TaskService) expiredTrashAttachments(ctx context.Context, before time.Time) (map[int][]*domain.Attachment, error) {
+	if s.attachmentRepo == nil {
+		return nil, nil
+	}
+	trash, err := s.taskRepo.GetDeleted(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo obtener la papelera: %w", err)
+	}
+
+	attachments := make(map[int][]*domain.Attachment)
+	for _, task := range trash {
+		if task.DeletedAt == nil || !task.DeletedAt.Before(before) {
+			continue
+		}
+		taskAttachments, err := s.taskAttachments(ctx, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		attachments[task.ID] = taskAttachments
+	}
+	return attachments, nil
+}
+
+// releaseBlobs elimina los blobs de los adjuntos de tareas eliminadas que ya no usa ningún otro
+// adjunto; los metadatos ya se eliminaron con la tarea
+func (s *TaskService) releaseBlobs(ctx context.Context, attachments []*domain.Attachment) error {
+	released := make(map[string]bool)
+	for _, attachment := range attachments {
+		if released[attachment.StorageKey] {
+			continue
+		}
+		remaining, err := s.attachmentRepo.CountByStorageKey(ctx, attachment.StorageKey)
+		if err != nil {
+			return fmt.Errorf("error verificando referencias del blob: %w", err)
+		}
+		if remaining > 0 {
+			continue
+		}
+		if err := s.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+			return fmt.Errorf("error eliminando el blob del adjunto %d: %w", attachment.ID, err)
+		}
+		released[attachment.StorageKey] = true
+	}
+	return nil
+}
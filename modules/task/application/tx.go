@@ -0,0 +1,58 @@
+package application
+
+import "context"
+
+//go:generate mockgen -source=tx.go -destination=mocks/mock_tx_manager.go -package=mocks
+
+// TxManager es el puerto para ejecutar varias operaciones de repositorio de forma atómica
+type TxManager interface {
+	// WithinTx ejecuta fn en una transacción; los repositorios que reciben el contexto de fn se unen
+	// a ella y, si fn retorna error, no se guarda ningún cambio
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// noTxManager ejecuta fn sin transacción; se usa cuando el servicio no tiene TxManager
+type noTxManager struct{}
+
+// WithinTx ejecuta fn con el mismo contexto
+func (noTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// WithTxManager ejecuta en una transacción los casos de uso que leen y escriben varias veces
+func WithTxManager(tx TxManager) TaskServiceOption {
+	return func(s *TaskService) {
+		s.tx = tx
+	}
+}
+
+// withinTx ejecuta fn en una transacción. Los eventos de las tareas que fn persiste se publican
+// solo si la transacción se confirma
+func (s *TaskService) withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := s.tx.(noTxManager); ok {
+		return fn(ctx)
+	}
+	if _, ok := ctx.Value(pendingEventsKey{}).(*pendingEvents); ok {
+		return s.tx.WithinTx(ctx, fn)
+	}
+
+	pending := &pendingEvents{}
+	if err := s.tx.WithinTx(context.WithValue(ctx, pendingEventsKey{}, pending), fn); err != nil {
+		return err
+	}
+	if s.publisher != nil {
+		s.publish(ctx, pending.events)
+	}
+	return nil
+}
+
+// inTx ejecuta fn en una transacción del servicio y retorna su resultado
+func inTx[T any](ctx context.Context, s *TaskService, fn func(ctx context.Context) (T, error)) (T, error) {
+	var result T
+	err := s.withinTx(ctx, func(ctx context.Context) error {
+		var err error
+		result, err = fn(ctx)
+		return err
+	})
+	return result, err
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrTaskArchived indica que la tarea está archivada y es de solo lectura
+	ErrTaskArchived = errors.New("la tarea está archivada y es de solo lectura")
+	// ErrTaskNotArchived indica que la tarea no existe o no está archivada
+	ErrTaskNotArchived = errors.New("la tarea no está archivada")
+	// ErrArchiveNotCompleted indica que solo se pueden archivar tareas completadas
+	ErrArchiveNotCompleted = errors.New("solo se pueden archivar tareas completadas")
+)
+
+// TaskQueryOptions ajusta qué tareas incluyen los listados
+type TaskQueryOptions struct {
+	// IncludeArchived incluye las tareas archivadas, que por defecto se excluyen
+	IncludeArchived bool
+	// CustomFields filtra por igualdad de campos personalizados (todas las condiciones)
+	CustomFields []CustomFieldFilter
+	// SortBy ordena por un campo personalizado en lugar del orden manual
+	SortBy *CustomFieldSort
+}
+
+// IsArchived indica si la tarea está archivada
+func (t *Task) IsArchived() bool {
+	return t.ArchivedAt != nil
+}
+
+// EnsureWritable retorna ErrTaskArchived si la tarea no se puede modificar
+func (t *Task) EnsureWritable() error {
+	if t.IsArchived() {
+		return ErrTaskArchived
+	}
+	return nil
+}
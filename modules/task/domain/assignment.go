@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrAssigneeNotFound indica que el usuario a asignar no existe
+	ErrAssigneeNotFound = errors.New("el usuario a asignar no existe")
+	// ErrAssigneeInactive indica que el usuario a asignar está desactivado
+	ErrAssigneeInactive = errors.New("el usuario a asignar no está activo")
+)
+
+// ParseAssigneeIDs convierte la lista "3,1,2" que retornan los repositorios en IDs ordenados
+func ParseAssigneeIDs(value string) []int {
+	ids := []int{}
+	for _, part := range strings.Split(value, ",") {
+		if id, err := strconv.Atoi(strings.TrimSpace(part)); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=assignment_repository.go -destination=../application/mocks/mock_assignment_repository.go -package=mocks
+
+// AssignmentRepository define el contrato para persistir los responsables de las tareas
+type AssignmentRepository interface {
+	// Assign agrega responsables a una tarea; asignar dos veces al mismo usuario no falla
+	Assign(ctx context.Context, taskID int, userIDs []int) error
+	// Unassign quita un responsable de una tarea
+	Unassign(ctx context.Context, taskID, userID int) error
+	// UnassignUser quita al usuario de todas sus tareas y retorna cuántas asignaciones eliminó
+	UnassignUser(ctx context.Context, userID int) (int, error)
+	// GetTasksByAssignee obtiene las tareas asignadas a un usuario
+	GetTasksByAssignee(ctx context.Context, userID int) ([]*Task, error)
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrAttachmentTooLarge indica que el archivo supera el tamaño máximo permitido
+	ErrAttachmentTooLarge = errors.New("el archivo supera el tamaño máximo permitido")
+	// ErrAttachmentTypeNotAllowed indica que el tipo MIME del archivo no está permitido
+	ErrAttachmentTypeNotAllowed = errors.New("tipo de archivo no permitido")
+	// ErrInvalidAttachment indica que el adjunto no tiene los campos requeridos
+	ErrInvalidAttachment = errors.New("el adjunto requiere nombre de archivo")
+)
+
+// Attachment representa los metadatos de un archivo adjunto a una tarea.
+// El contenido se guarda en un BlobStore bajo StorageKey, que se deriva del
+// hash SHA-256 para que archivos idénticos compartan el mismo blob.
+type Attachment struct {
+	ID          int       `json:"id" db:"id"`
+	TaskID      int       `json:"task_id" db:"task_id"`
+	FileName    string    `json:"file_name" db:"file_name"`
+	ContentType string    `json:"content_type" db:"content_type"`
+	Size        int64     `json:"size" db:"size"`
+	SHA256      string    `json:"sha256" db:"sha256"`
+	StorageKey  string    `json:"-" db:"storage_key"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+// AttachmentStorageKey retorna la clave del blob para un hash SHA-256 en hexadecimal
+func AttachmentStorageKey(sha256Hex string) string {
+	return "sha256/" + sha256Hex[:2] + "/" + sha256Hex
+}
+
+// SanitizeFileName deja solo el nombre base del archivo, sin rutas ni caracteres de control
+func SanitizeFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if r < 0x20 || r == 0x7f || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	if name == "." || name == "/" {
+		return ""
+	}
+	return strings.TrimSpace(name)
+}
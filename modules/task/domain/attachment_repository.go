@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=attachment_repository.go -destination=../application/mocks/mock_attachment_repository.go -package=mocks
+
+// AttachmentRepository define el contrato para persistir los metadatos de adjuntos
+type AttachmentRepository interface {
+	// Create guarda los metadatos de un nuevo adjunto
+	Create(ctx context.Context, attachment *Attachment) (*Attachment, error)
+	// GetByID obtiene un adjunto por su ID
+	GetByID(ctx context.Context, id int) (*Attachment, error)
+	// ListByTask obtiene los adjuntos de una tarea
+	ListByTask(ctx context.Context, taskID int) ([]*Attachment, error)
+	// Delete elimina los metadatos de un adjunto
+	Delete(ctx context.Context, id int) error
+	// CountByStorageKey cuenta los adjuntos que comparten un mismo blob
+	CountByStorageKey(ctx context.Context, storageKey string) (int, error)
+}
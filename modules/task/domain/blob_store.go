@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+//go:generate mockgen -source=blob_store.go -destination=../application/mocks/mock_blob_store.go -package=mocks
+
+// ErrBlobNotFound indica que no existe un blob con la clave pedida
+var ErrBlobNotFound = errors.New("blob no encontrado")
+
+// BlobStore define el contrato para guardar contenido binario fuera de la base de datos
+type BlobStore interface {
+	// Put guarda size bytes leídos de r bajo la clave indicada, sin cargarlos completos en memoria
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get abre el contenido de un blob; quien llama debe cerrarlo
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Exists indica si ya existe un blob con la clave
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete elimina un blob; no falla si no existe
+	Delete(ctx context.Context, key string) error
+}
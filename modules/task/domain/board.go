@@ -0,0 +1,227 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidBoard indica que el tablero no tiene nombre, columnas o configuración válidas
+	ErrInvalidBoard = errors.New("el tablero requiere nombre y al menos una columna válida")
+	// ErrBoardNotFound indica que el tablero no existe
+	ErrBoardNotFound = errors.New("el tablero no existe")
+	// ErrBoardColumnNotFound indica que la columna no pertenece al tablero
+	ErrBoardColumnNotFound = errors.New("la columna no pertenece al tablero")
+	// ErrBoardCardNotFound indica que la tarea no está en el tablero
+	ErrBoardCardNotFound = errors.New("la tarea no está en el tablero")
+	// ErrWIPLimitExceeded indica que la columna de destino alcanzó su límite de trabajo en curso
+	ErrWIPLimitExceeded = errors.New("la columna alcanzó su límite de trabajo en curso")
+)
+
+// ColumnState es el estado de tarea al que corresponde una columna
+type ColumnState string
+
+const (
+	// ColumnStatePending agrupa tareas sin completar
+	ColumnStatePending ColumnState = "pending"
+	// ColumnStateCompleted agrupa tareas completadas
+	ColumnStateCompleted ColumnState = "completed"
+)
+
+// SwimlaneMode define cómo se agrupan las tarjetas en filas
+type SwimlaneMode string
+
+const (
+	// SwimlaneNone muestra todas las tarjetas en una sola fila
+	SwimlaneNone SwimlaneMode = "none"
+	// SwimlaneAssignee agrupa las tarjetas por responsable
+	SwimlaneAssignee SwimlaneMode = "assignee"
+)
+
+// Board representa un tablero kanban con columnas ordenadas
+type Board struct {
+	ID         int           `json:"id" db:"id"`
+	Name       string        `json:"name" db:"name"`
+	SwimlaneBy SwimlaneMode  `json:"swimlane_by" db:"swimlane_by"`
+	Columns    []BoardColumn `json:"columns"`
+	CreatedAt  time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at" db:"updated_at"`
+}
+
+// BoardColumn es una columna del tablero asociada a un estado de tarea
+type BoardColumn struct {
+	ID        int         `json:"id" db:"id"`
+	BoardID   int         `json:"board_id" db:"board_id"`
+	Name      string      `json:"name" db:"name"`
+	Position  int         `json:"position" db:"position"`
+	State     ColumnState `json:"state" db:"state"`
+	WIPLimit  int         `json:"wip_limit" db:"wip_limit"` // 0 = sin límite
+	CardCount int         `json:"card_count"`               // Calculado en la vista del tablero
+}
+
+// BoardCard es una tarea ubicada en una columna del tablero
+type BoardCard struct {
+	BoardID   int        `json:"board_id"`
+	ColumnID  int        `json:"column_id"`
+	TaskID    int        `json:"task_id"`
+	Rank      string     `json:"rank"`
+	Title     string     `json:"title"`
+	Completed bool       `json:"completed"`
+	Blocked   bool       `json:"blocked"`
+	DueDate   *time.Time `json:"due_date,omitempty"`
+	Assignees []int      `json:"assignees"`
+}
+
+// NewBoardCard crea la tarjeta de una tarea en una columna
+func NewBoardCard(boardID, columnID int, rank string, task *Task) *BoardCard {
+	assignees := task.Assignees
+	if assignees == nil {
+		assignees = []int{}
+	}
+	return &BoardCard{
+		BoardID:   boardID,
+		ColumnID:  columnID,
+		TaskID:    task.ID,
+		Rank:      rank,
+		Title:     task.Title,
+		Completed: task.Completed,
+		Blocked:   task.Blocked,
+		DueDate:   task.DueDate,
+		Assignees: assignees,
+	}
+}
+
+// Swimlane es una fila del tablero con las tarjetas de cada columna
+type Swimlane struct {
+	Key        string          `json:"key"`
+	AssigneeID *int            `json:"assignee_id,omitempty"`
+	Columns    []SwimlaneCells `json:"columns"`
+}
+
+// SwimlaneCells son las tarjetas de una columna dentro de una fila
+type SwimlaneCells struct {
+	ColumnID int         `json:"column_id"`
+	Cards    []BoardCard `json:"cards"`
+}
+
+// BoardSnapshot es la vista completa de un tablero con sus tarjetas
+type BoardSnapshot struct {
+	Board
+	Swimlanes []Swimlane `json:"swimlanes"`
+}
+
+// NewBoard crea un tablero validando nombre, agrupación y columnas; las posiciones siguen el orden recibido
+func NewBoard(name string, swimlaneBy SwimlaneMode, columns []BoardColumn) (*Board, error) {
+	if swimlaneBy == "" {
+		swimlaneBy = SwimlaneNone
+	}
+	now := time.Now().UTC()
+	board := &Board{
+		Name:       strings.TrimSpace(name),
+		SwimlaneBy: swimlaneBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	for i, column := range columns {
+		column.Name = strings.TrimSpace(column.Name)
+		column.Position = i
+		board.Columns = append(board.Columns, column)
+	}
+	if !board.IsValid() {
+		return nil, ErrInvalidBoard
+	}
+	return board, nil
+}
+
+// IsValid verifica que el tablero tenga nombre, una agrupación conocida y columnas válidas
+func (b *Board) IsValid() bool {
+	if b.Name == "" || len(b.Columns) == 0 {
+		return false
+	}
+	if b.SwimlaneBy != SwimlaneNone && b.SwimlaneBy != SwimlaneAssignee {
+		return false
+	}
+	for _, column := range b.Columns {
+		if column.Name == "" || column.WIPLimit < 0 {
+			return false
+		}
+		if column.State != ColumnStatePending && column.State != ColumnStateCompleted {
+			return false
+		}
+	}
+	return true
+}
+
+// Column busca una columna del tablero por su ID
+func (b *Board) Column(id int) (*BoardColumn, bool) {
+	for i := range b.Columns {
+		if b.Columns[i].ID == id {
+			return &b.Columns[i], true
+		}
+	}
+	return nil, false
+}
+
+// AllowsCard indica si la columna admite una tarjeta más sin superar su límite
+func (c *BoardColumn) AllowsCard(current int) bool {
+	return c.WIPLimit == 0 || current < c.WIPLimit
+}
+
+// BuildBoardSnapshot agrupa las tarjetas por fila y columna; las tarjetas deben venir ordenadas por rank
+func BuildBoardSnapshot(board *Board, cards []BoardCard) *BoardSnapshot {
+	snapshot := &BoardSnapshot{Board: *board, Swimlanes: []Swimlane{}}
+	snapshot.Columns = append([]BoardColumn(nil), board.Columns...)
+
+	lanes := map[string]int{}
+	laneFor := func(key string, assigneeID *int) *Swimlane {
+		if i, ok := lanes[key]; ok {
+			return &snapshot.Swimlanes[i]
+		}
+		lane := Swimlane{Key: key, AssigneeID: assigneeID, Columns: make([]SwimlaneCells, len(board.Columns))}
+		for i, column := range board.Columns {
+			lane.Columns[i] = SwimlaneCells{ColumnID: column.ID, Cards: []BoardCard{}}
+		}
+		lanes[key] = len(snapshot.Swimlanes)
+		snapshot.Swimlanes = append(snapshot.Swimlanes, lane)
+		return &snapshot.Swimlanes[len(snapshot.Swimlanes)-1]
+	}
+	// La fila por defecto existe aunque el tablero esté vacío
+	if board.SwimlaneBy == SwimlaneNone {
+		laneFor("all", nil)
+	} else {
+		laneFor("unassigned", nil)
+	}
+
+	for _, card := range cards {
+		index := -1
+		for i := range snapshot.Columns {
+			if snapshot.Columns[i].ID == card.ColumnID {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			continue
+		}
+		snapshot.Columns[index].CardCount++
+
+		// Una tarea con varios responsables aparece en la fila de cada uno
+		switch {
+		case board.SwimlaneBy == SwimlaneAssignee && len(card.Assignees) > 0:
+			for _, userID := range card.Assignees {
+				id := userID
+				lane := laneFor("user:"+strconv.Itoa(userID), &id)
+				lane.Columns[index].Cards = append(lane.Columns[index].Cards, card)
+			}
+		case board.SwimlaneBy == SwimlaneAssignee:
+			lane := laneFor("unassigned", nil)
+			lane.Columns[index].Cards = append(lane.Columns[index].Cards, card)
+		default:
+			lane := laneFor("all", nil)
+			lane.Columns[index].Cards = append(lane.Columns[index].Cards, card)
+		}
+	}
+	return snapshot
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=board_repository.go -destination=../application/mocks/mock_board_repository.go -package=mocks
+
+// BoardRepository define el contrato para persistir tableros kanban y sus tarjetas
+type BoardRepository interface {
+	// Create guarda un tablero junto con sus columnas
+	Create(ctx context.Context, board *Board) (*Board, error)
+	// GetByID obtiene un tablero con sus columnas ordenadas por posición
+	GetByID(ctx context.Context, id int) (*Board, error)
+	// GetSnapshot obtiene el tablero, sus columnas y sus tarjetas ordenadas por rank en una sola consulta
+	GetSnapshot(ctx context.Context, id int) (*Board, []BoardCard, error)
+	// GetCard obtiene la tarjeta de una tarea en el tablero
+	GetCard(ctx context.Context, boardID, taskID int) (*BoardCard, error)
+	// CountCards cuenta las tarjetas de tareas vigentes en una columna. Dentro de una transacción
+	// bloquea la columna hasta que termine, para que dos movimientos no superen el límite WIP a la vez
+	CountCards(ctx context.Context, columnID int) (int, error)
+	// LastCardRank obtiene el mayor rank de una columna, o "" si está vacía
+	LastCardRank(ctx context.Context, columnID int) (string, error)
+	// PutCard ubica la tarea en la columna, creando o moviendo su tarjeta
+	PutCard(ctx context.Context, card *BoardCard) error
+	// RemoveCard quita la tarea del tablero
+	RemoveCard(ctx context.Context, boardID, taskID int) error
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewBoard_Validation verifica nombre, columnas, estados y límites
+func TestNewBoard_Validation(t *testing.T) {
+	column := BoardColumn{Name: "Por hacer", State: ColumnStatePending}
+
+	board, err := NewBoard(" Sprint ", "", []BoardColumn{column, {Name: "Hecho", State: ColumnStateCompleted}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Sprint", board.Name)
+	assert.Equal(t, SwimlaneNone, board.SwimlaneBy)
+	assert.Equal(t, 1, board.Columns[1].Position)
+
+	_, err = NewBoard("", SwimlaneNone, []BoardColumn{column})
+	assert.ErrorIs(t, err, ErrInvalidBoard)
+	_, err = NewBoard("Sprint", SwimlaneNone, nil)
+	assert.ErrorIs(t, err, ErrInvalidBoard)
+	_, err = NewBoard("Sprint", "priority", []BoardColumn{column})
+	assert.ErrorIs(t, err, ErrInvalidBoard)
+	_, err = NewBoard("Sprint", SwimlaneNone, []BoardColumn{{Name: "X", State: "doing"}})
+	assert.ErrorIs(t, err, ErrInvalidBoard)
+	_, err = NewBoard("Sprint", SwimlaneNone, []BoardColumn{{Name: "X", State: ColumnStatePending, WIPLimit: -1}})
+	assert.ErrorIs(t, err, ErrInvalidBoard)
+}
+
+// TestBoardColumn_AllowsCard verifica el límite WIP; 0 significa sin límite
+func TestBoardColumn_AllowsCard(t *testing.T) {
+	assert.True(t, (&BoardColumn{}).AllowsCard(100))
+	assert.True(t, (&BoardColumn{WIPLimit: 2}).AllowsCard(1))
+	assert.False(t, (&BoardColumn{WIPLimit: 2}).AllowsCard(2))
+}
+
+// TestBuildBoardSnapshot_Assignee verifica la agrupación por responsable y los conteos
+func TestBuildBoardSnapshot_Assignee(t *testing.T) {
+	// Arrange
+	board := &Board{ID: 1, SwimlaneBy: SwimlaneAssignee, Columns: []BoardColumn{{ID: 10}, {ID: 20}}}
+	cards := []BoardCard{
+		{TaskID: 1, ColumnID: 10, Assignees: []int{3}},
+		{TaskID: 2, ColumnID: 20, Assignees: []int{3, 4}},
+		{TaskID: 3, ColumnID: 20},
+	}
+
+	// Act
+	snapshot := BuildBoardSnapshot(board, cards)
+
+	// Assert
+	assert.Equal(t, 1, snapshot.Columns[0].CardCount)
+	assert.Equal(t, 2, snapshot.Columns[1].CardCount)
+	assert.Len(t, snapshot.Swimlanes, 3)
+	assert.Equal(t, "unassigned", snapshot.Swimlanes[0].Key)
+	assert.Equal(t, 3, snapshot.Swimlanes[0].Columns[1].Cards[0].TaskID)
+	assert.Equal(t, "user:3", snapshot.Swimlanes[1].Key)
+	assert.Len(t, snapshot.Swimlanes[1].Columns[0].Cards, 1)
+	assert.Len(t, snapshot.Swimlanes[1].Columns[1].Cards, 1)
+	assert.Equal(t, 4, *snapshot.Swimlanes[2].AssigneeID)
+	assert.Empty(t, snapshot.Swimlanes[2].Columns[0].Cards)
+	assert.Empty(t, board.Columns[0].CardCount, "el tablero original no se modifica")
+}
+
+// TestBuildBoardSnapshot_Empty verifica que un tablero vacío tiene una fila con todas sus columnas
+func TestBuildBoardSnapshot_Empty(t *testing.T) {
+	snapshot := BuildBoardSnapshot(&Board{SwimlaneBy: SwimlaneNone, Columns: []BoardColumn{{ID: 1}, {ID: 2}}}, nil)
+
+	assert.Len(t, snapshot.Swimlanes, 1)
+	assert.Equal(t, "all", snapshot.Swimlanes[0].Key)
+	assert.Len(t, snapshot.Swimlanes[0].Columns, 2)
+	assert.NotNil(t, snapshot.Swimlanes[0].Columns[1].Cards)
+}
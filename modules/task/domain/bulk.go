@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidBulkRequest indica un lote vacío, demasiado grande o con un modo desconocido
+	ErrInvalidBulkRequest = errors.New("el lote requiere entre 1 y 500 operaciones y un modo atomic o best_effort")
+	// ErrInvalidBulkOperation indica una operación desconocida, sin ID o que repite una tarea del lote
+	ErrInvalidBulkOperation = errors.New("la operación del lote no es válida")
+	// ErrBulkTaskNotFound indica que la tarea de una operación no existe o está en la papelera
+	ErrBulkTaskNotFound = errors.New("la tarea no existe o está en la papelera")
+	// ErrBulkAborted indica que la operación no se aplicó porque otra operación del lote atómico falló
+	ErrBulkAborted = errors.New("la operación no se aplicó porque otra operación del lote falló")
+)
+
+// MaxBulkOperations es la cantidad máxima de operaciones de un lote
+const MaxBulkOperations = 500
+
+// BulkAction es el tipo de una operación del lote
+type BulkAction string
+
+const (
+	// BulkCreate crea una tarea
+	BulkCreate BulkAction = "create"
+	// BulkUpdate aplica un parche a una tarea
+	BulkUpdate BulkAction = "update"
+	// BulkComplete marca una tarea como completada
+	BulkComplete BulkAction = "complete"
+	// BulkUncomplete marca una tarea como no completada
+	BulkUncomplete BulkAction = "uncomplete"
+	// BulkDelete mueve una tarea a la papelera
+	BulkDelete BulkAction = "delete"
+)
+
+// BulkMode define qué pasa con el lote cuando una operación falla
+type BulkMode string
+
+const (
+	// BulkAtomic aplica todas las operaciones o ninguna
+	BulkAtomic BulkMode = "atomic"
+	// BulkBestEffort aplica las operaciones válidas e informa el error de las demás
+	BulkBestEffort BulkMode = "best_effort"
+)
+
+// IsValid verifica que el modo sea conocido
+func (m BulkMode) IsValid() bool {
+	return m == BulkAtomic || m == BulkBestEffort
+}
+
+// BulkOperation es una operación del lote. Title y Description son para create y Patch para update;
+// Version, si no es cero, exige que la tarea siga en esa versión (como If-Match)
+type BulkOperation struct {
+	Action      BulkAction
+	TaskID      int
+	Title       string
+	Description string
+	Patch       TaskPatch
+	Version     int
+}
+
+// Validate verifica que la operación tenga los datos que requiere su tipo
+func (op BulkOperation) Validate() error {
+	switch op.Action {
+	case BulkCreate:
+		if op.TaskID != 0 || op.Version != 0 {
+			return fmt.Errorf("%w: create no acepta id ni version", ErrInvalidBulkOperation)
+		}
+		if strings.TrimSpace(op.Title) == "" || strings.TrimSpace(op.Description) == "" {
+			return fmt.Errorf("%w: create requiere título y descripción", ErrInvalidBulkOperation)
+		}
+		return nil
+	case BulkUpdate, BulkComplete, BulkUncomplete, BulkDelete:
+		if op.TaskID <= 0 || op.Version < 0 {
+			return fmt.Errorf("%w: %s requiere el ID de la tarea", ErrInvalidBulkOperation, op.Action)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: operación desconocida %q", ErrInvalidBulkOperation, op.Action)
+}
+
+// BulkResult es el resultado de una operación del lote: Task si se aplicó o Err si no
+type BulkResult struct {
+	Index  int
+	Action BulkAction
+	TaskID int
+	Task   *Task
+	Err    error
+}
+
+// BatchWrite es una escritura de un lote junto con su revisión (nil si el historial no está habilitado)
+type BatchWrite struct {
+	Task     *Task
+	Revision *TaskRevision
+}
+
+// TaskBatch agrupa las escrituras de un lote. Updates y Deletes llevan la versión leída de cada tarea;
+// el repositorio actualiza ID, versión y fechas de las tareas al aplicarlas
+type TaskBatch struct {
+	Creates []BatchWrite
+	Updates []BatchWrite
+	Deletes []BatchWrite
+}
+
+// IsEmpty indica si el lote no tiene escrituras
+func (b *TaskBatch) IsEmpty() bool {
+	return len(b.Creates) == 0 && len(b.Updates) == 0 && len(b.Deletes) == 0
+}
+
+// BatchConflictError indica las tareas de un lote que cambiaron, se archivaron o se eliminaron
+// desde que se leyeron; el repositorio no aplica ninguna escritura del lote
+type BatchConflictError struct {
+	TaskIDs []int
+}
+
+// Error implementa error
+func (e *BatchConflictError) Error() string {
+	return fmt.Sprintf("%v: IDs %v", ErrVersionConflict, e.TaskIDs)
+}
+
+// Unwrap permite comparar el error con ErrVersionConflict
+func (e *BatchConflictError) Unwrap() error {
+	return ErrVersionConflict
+}
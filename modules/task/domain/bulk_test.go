@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBulkOperation_Validate verifica los datos que requiere cada tipo de operación
+func TestBulkOperation_Validate(t *testing.T) {
+	assert.NoError(t, BulkOperation{Action: BulkCreate, Title: "T", Description: "D"}.Validate())
+	assert.NoError(t, BulkOperation{Action: BulkDelete, TaskID: 3, Version: 2}.Validate())
+
+	assert.ErrorIs(t, BulkOperation{Action: BulkCreate, Title: " ", Description: "D"}.Validate(), ErrInvalidBulkOperation)
+	assert.ErrorIs(t, BulkOperation{Action: BulkCreate, TaskID: 1, Title: "T", Description: "D"}.Validate(), ErrInvalidBulkOperation)
+	assert.ErrorIs(t, BulkOperation{Action: BulkComplete}.Validate(), ErrInvalidBulkOperation)
+	assert.ErrorIs(t, BulkOperation{Action: "archive", TaskID: 1}.Validate(), ErrInvalidBulkOperation)
+}
+
+// TestBatchConflictError verifica que el conflicto de un lote se compara con ErrVersionConflict
+func TestBatchConflictError(t *testing.T) {
+	err := error(&BatchConflictError{TaskIDs: []int{4}})
+	assert.ErrorIs(t, err, ErrVersionConflict)
+	assert.Contains(t, err.Error(), "[4]")
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ErrInvalidComment indica que el comentario no tiene los campos requeridos
+var ErrInvalidComment = errors.New("el comentario requiere autor y contenido")
+
+// CommentDeletePolicy define qué ocurre con los comentarios al eliminar su tarea
+type CommentDeletePolicy string
+
+const (
+	// CommentDeleteCascade elimina los comentarios junto con la tarea
+	CommentDeleteCascade CommentDeletePolicy = "cascade"
+	// CommentDeleteArchive conserva los comentarios marcándolos como archivados
+	CommentDeleteArchive CommentDeletePolicy = "archive"
+)
+
+// Comment representa un comentario en Markdown sobre una tarea
+type Comment struct {
+	ID         int        `json:"id" db:"id"`
+	TaskID     int        `json:"task_id" db:"task_id"`
+	Author     string     `json:"author" db:"author"`
+	Body       string     `json:"body" db:"body"` // Markdown
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at" db:"updated_at"`
+	EditedAt   *time.Time `json:"edited_at,omitempty" db:"edited_at"`
+	ArchivedAt *time.Time `json:"archived_at,omitempty" db:"archived_at"`
+}
+
+// CommentRevision guarda el contenido anterior de un comentario editado
+type CommentRevision struct {
+	ID        int       `json:"id" db:"id"`
+	CommentID int       `json:"comment_id" db:"comment_id"`
+	Body      string    `json:"body" db:"body"`
+	EditedAt  time.Time `json:"edited_at" db:"edited_at"`
+}
+
+// NewComment crea un nuevo comentario validando sus campos
+func NewComment(taskID int, author, body string) (*Comment, error) {
+	now := time.Now().UTC()
+	comment := &Comment{
+		TaskID:    taskID,
+		Author:    strings.TrimSpace(author),
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if !comment.IsValid() {
+		return nil, ErrInvalidComment
+	}
+	return comment, nil
+}
+
+// Edit reemplaza el contenido y retorna la revisión con el contenido anterior
+func (c *Comment) Edit(body string) (*CommentRevision, error) {
+	if strings.TrimSpace(body) == "" {
+		return nil, ErrInvalidComment
+	}
+
+	now := time.Now().UTC()
+	revision := &CommentRevision{
+		CommentID: c.ID,
+		Body:      c.Body,
+		EditedAt:  now,
+	}
+	c.Body = body
+	c.EditedAt = &now
+	c.UpdatedAt = now
+	return revision, nil
+}
+
+// IsValid valida que el comentario tenga los campos requeridos
+func (c *Comment) IsValid() bool {
+	return c.TaskID != 0 && c.Author != "" && strings.TrimSpace(c.Body) != ""
+}
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=comment_repository.go -destination=../application/mocks/mock_comment_repository.go -package=mocks
+
+// CommentRepository define el contrato para persistir comentarios de tareas
+
+type CommentRepository interface {
+	// Create guarda un nuevo comentario
+	Create(ctx context.Context, comment *Comment) (*Comment, error)
+	// GetByID obtiene un comentario por su ID
+	GetByID(ctx context.Context, id int) (*Comment, error)
+	// ListByTask obtiene una página de comentarios no archivados de una tarea y el total
+	ListByTask(ctx context.Context, taskID, limit, offset int) ([]*Comment, int, error)
+	// Update guarda el comentario editado junto con la revisión anterior
+	Update(ctx context.Context, comment *Comment, revision *CommentRevision) (*Comment, error)
+	// GetRevisions obtiene el historial de ediciones de un comentario
+	GetRevisions(ctx context.Context, commentID int) ([]*CommentRevision, error)
+	// Delete elimina un comentario y su historial
+	Delete(ctx context.Context, id int) error
+	// DeleteByTask elimina todos los comentarios de una tarea
+	DeleteByTask(ctx context.Context, taskID int) error
+	// ArchiveByTask archiva todos los comentarios de una tarea
+	ArchiveByTask(ctx context.Context, taskID int) error
+}
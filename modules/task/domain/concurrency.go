@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrVersionConflict indica que la tarea cambió entre la lectura y la escritura
+	ErrVersionConflict = errors.New("la tarea fue modificada por otra petición")
+	// ErrPreconditionFailed indica que la versión esperada (If-Match) no es la versión actual de la tarea
+	ErrPreconditionFailed = errors.New("la versión de la tarea no coincide con la esperada")
+)
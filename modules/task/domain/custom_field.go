@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidCustomField indica que la definición del campo personalizado no es válida
+	ErrInvalidCustomField = errors.New("el campo personalizado requiere clave (a-z, 0-9, _), nombre, tipo válido y opciones solo si es enum")
+	// ErrCustomFieldNotFound indica que no existe un campo personalizado con esa clave
+	ErrCustomFieldNotFound = errors.New("el campo personalizado no existe")
+	// ErrCustomFieldExists indica que ya existe un campo personalizado con esa clave
+	ErrCustomFieldExists = errors.New("ya existe un campo personalizado con esa clave")
+	// ErrInvalidCustomFieldValue indica que el valor no corresponde al tipo del campo
+	ErrInvalidCustomFieldValue = errors.New("el valor no es válido para el campo personalizado")
+)
+
+// ProjectCustomField es la clave del campo personalizado que indica el proyecto de una tarea; las
+// tareas no tienen una entidad de proyecto propia
+const ProjectCustomField = "project"
+
+// customFieldKeyPattern restringe las claves a identificadores seguros para usar en consultas y URLs
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// maxCustomTextLength es el largo máximo de un valor de texto
+const maxCustomTextLength = 500
+
+// CustomFieldType es el tipo de valor que acepta un campo personalizado
+type CustomFieldType string
+
+const (
+	// CustomFieldText acepta texto libre
+	CustomFieldText CustomFieldType = "text"
+	// CustomFieldNumber acepta números
+	CustomFieldNumber CustomFieldType = "number"
+	// CustomFieldDate acepta fechas YYYY-MM-DD
+	CustomFieldDate CustomFieldType = "date"
+	// CustomFieldEnum acepta una de las opciones de la definición
+	CustomFieldEnum CustomFieldType = "enum"
+	// CustomFieldUser acepta el ID de un usuario
+	CustomFieldUser CustomFieldType = "user"
+)
+
+// IsValid verifica que el tipo sea conocido
+func (t CustomFieldType) IsValid() bool {
+	switch t {
+	case CustomFieldText, CustomFieldNumber, CustomFieldDate, CustomFieldEnum, CustomFieldUser:
+		return true
+	}
+	return false
+}
+
+// IsNumeric indica si los valores del tipo se comparan y ordenan como números
+func (t CustomFieldType) IsNumeric() bool {
+	return t == CustomFieldNumber || t == CustomFieldUser
+}
+
+// CustomFieldDefinition describe un campo personalizado disponible para todas las tareas
+type CustomFieldDefinition struct {
+	ID        int             `json:"id" db:"id"`
+	Key       string          `json:"key" db:"key"`
+	Name      string          `json:"name" db:"name"`
+	Type      CustomFieldType `json:"type" db:"type"`
+	Options   []string        `json:"options,omitempty" db:"options"` // Solo para enum
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+}
+
+// NewCustomFieldDefinition crea una definición validando la clave, el tipo y las opciones
+func NewCustomFieldDefinition(key, name string, fieldType CustomFieldType, options []string) (*CustomFieldDefinition, error) {
+	key = strings.TrimSpace(key)
+	name = strings.TrimSpace(name)
+	if !customFieldKeyPattern.MatchString(key) || name == "" || !fieldType.IsValid() {
+		return nil, ErrInvalidCustomField
+	}
+
+	var normalized []string
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || slices.Contains(normalized, option) {
+			return nil, fmt.Errorf("%w: opción vacía o repetida", ErrInvalidCustomField)
+		}
+		normalized = append(normalized, option)
+	}
+	if (fieldType == CustomFieldEnum) != (len(normalized) > 0) {
+		return nil, fmt.Errorf("%w: solo los campos enum tienen opciones y las requieren", ErrInvalidCustomField)
+	}
+
+	return &CustomFieldDefinition{
+		Key:       key,
+		Name:      name,
+		Type:      fieldType,
+		Options:   normalized,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Normalize valida un valor recibido en JSON y lo convierte a su forma almacenada:
+// string para text, date y enum, float64 para number e int para user
+func (d *CustomFieldDefinition) Normalize(value any) (any, error) {
+	invalid := func(expected string) error {
+		return fmt.Errorf("%w: %s debe ser %s", ErrInvalidCustomFieldValue, d.Key, expected)
+	}
+
+	switch d.Type {
+	case CustomFieldText:
+		text, ok := value.(string)
+		text = strings.TrimSpace(text)
+		if !ok || text == "" || len(text) > maxCustomTextLength {
+			return nil, invalid("un texto de hasta 500 caracteres")
+		}
+		return text, nil
+	case CustomFieldNumber:
+		number, ok := value.(float64)
+		if !ok || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, invalid("un número")
+		}
+		return number, nil
+	case CustomFieldDate:
+		text, ok := value.(string)
+		if _, err := time.Parse("2006-01-02", text); !ok || err != nil {
+			return nil, invalid("una fecha YYYY-MM-DD")
+		}
+		return text, nil
+	case CustomFieldEnum:
+		text, ok := value.(string)
+		if !ok || !slices.Contains(d.Options, text) {
+			return nil, invalid("una de: " + strings.Join(d.Options, ", "))
+		}
+		return text, nil
+	case CustomFieldUser:
+		number, ok := value.(float64)
+		if !ok || number < 1 || number != math.Trunc(number) || number > math.MaxInt32 {
+			return nil, invalid("el ID de un usuario")
+		}
+		return int(number), nil
+	}
+	return nil, ErrInvalidCustomField
+}
+
+// ParseFilter interpreta el valor de un filtro recibido como texto en la URL
+func (d *CustomFieldDefinition) ParseFilter(raw string) (any, error) {
+	if d.Type.IsNumeric() {
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s debe ser numérico", ErrInvalidCustomFieldValue, d.Key)
+		}
+		return d.Normalize(number)
+	}
+	return d.Normalize(raw)
+}
+
+// CustomFieldValue es el valor normalizado de un campo personalizado en una tarea
+type CustomFieldValue struct {
+	Field *CustomFieldDefinition
+	Value any
+}
+
+// CustomFieldFilter filtra las tareas cuyo campo Key es igual a Raw; el servicio completa
+// Type y Value con la definición del campo
+type CustomFieldFilter struct {
+	Key   string
+	Raw   string
+	Type  CustomFieldType
+	Value any
+}
+
+// CustomFieldSort ordena las tareas por el campo Key; las que no tienen valor van al final
+type CustomFieldSort struct {
+	Key  string
+	Desc bool
+	Type CustomFieldType
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "context"
+
+//go:generate mockgen -source=custom_field_repository.go -destination=../application/mocks/mock_custom_field_repository.go -package=mocks
+
+// CustomFieldRepository define el contrato para las definiciones y los valores de campos personalizados
+type CustomFieldRepository interface {
+	// CreateDefinition guarda una definición nueva
+	CreateDefinition(ctx context.Context, field *CustomFieldDefinition) (*CustomFieldDefinition, error)
+	// ListDefinitions obtiene las definiciones ordenadas por clave
+	ListDefinitions(ctx context.Context) ([]*CustomFieldDefinition, error)
+	// GetDefinition obtiene una definición por su clave; retorna ErrCustomFieldNotFound si no existe
+	GetDefinition(ctx context.Context, key string) (*CustomFieldDefinition, error)
+	// DeleteDefinition elimina una definición y sus valores en todas las tareas
+	DeleteDefinition(ctx context.Context, key string) error
+	// SetValues guarda y quita valores de una tarea en una sola escritura que incrementa su versión
+	SetValues(ctx context.Context, taskID int, set []CustomFieldValue, remove []*CustomFieldDefinition) error
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewCustomFieldDefinition verifica las reglas de clave, tipo y opciones
+func TestNewCustomFieldDefinition(t *testing.T) {
+	field, err := NewCustomFieldDefinition(" priority ", "Prioridad", CustomFieldEnum, []string{"alta", " baja"})
+	assert.NoError(t, err)
+	assert.Equal(t, "priority", field.Key)
+	assert.Equal(t, []string{"alta", "baja"}, field.Options)
+
+	_, err = NewCustomFieldDefinition("Priority", "Prioridad", CustomFieldText, nil)
+	assert.ErrorIs(t, err, ErrInvalidCustomField)
+	_, err = NewCustomFieldDefinition("size", "Tamaño", "color", nil)
+	assert.ErrorIs(t, err, ErrInvalidCustomField)
+	_, err = NewCustomFieldDefinition("size", "Tamaño", CustomFieldEnum, nil)
+	assert.ErrorIs(t, err, ErrInvalidCustomField)
+	_, err = NewCustomFieldDefinition("size", "Tamaño", CustomFieldNumber, []string{"s"})
+	assert.ErrorIs(t, err, ErrInvalidCustomField)
+	_, err = NewCustomFieldDefinition("size", "Tamaño", CustomFieldEnum, []string{"s", "s"})
+	assert.ErrorIs(t, err, ErrInvalidCustomField)
+}
+
+// TestCustomFieldDefinition_Normalize verifica la validación de valores por tipo
+func TestCustomFieldDefinition_Normalize(t *testing.T) {
+	cases := []struct {
+		fieldType CustomFieldType
+		input     any
+		want      any
+		valid     bool
+	}{
+		{CustomFieldText, " hola ", "hola", true},
+		{CustomFieldText, 3.0, nil, false},
+		{CustomFieldNumber, 2.5, 2.5, true},
+		{CustomFieldNumber, "2.5", nil, false},
+		{CustomFieldDate, "2026-03-02", "2026-03-02", true},
+		{CustomFieldDate, "02/03/2026", nil, false},
+		{CustomFieldEnum, "alta", "alta", true},
+		{CustomFieldEnum, "media", nil, false},
+		{CustomFieldUser, 7.0, 7, true},
+		{CustomFieldUser, 1.5, nil, false},
+	}
+	for _, tc := range cases {
+		field := &CustomFieldDefinition{Key: "f", Type: tc.fieldType, Options: []string{"alta", "baja"}}
+		got, err := field.Normalize(tc.input)
+		if tc.valid {
+			assert.NoError(t, err, "%s %v", tc.fieldType, tc.input)
+			assert.Equal(t, tc.want, got)
+		} else {
+			assert.ErrorIs(t, err, ErrInvalidCustomFieldValue, "%s %v", tc.fieldType, tc.input)
+		}
+	}
+
+	// Los filtros llegan como texto y se interpretan según el tipo
+	number := &CustomFieldDefinition{Key: "size", Type: CustomFieldNumber}
+	value, err := number.ParseFilter("3")
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, value)
+	_, err = number.ParseFilter("tres")
+	assert.ErrorIs(t, err, ErrInvalidCustomFieldValue)
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Errores relacionados con dependencias entre tareas
+var (
+	// ErrSelfDependency indica que una tarea intentó bloquearse a sí misma
+	ErrSelfDependency = errors.New("una tarea no puede depender de sí misma")
+	// ErrDependencyCycle indica que la dependencia crearía un ciclo
+	ErrDependencyCycle = errors.New("la dependencia crearía un ciclo")
+	// ErrTaskBlocked indica que la tarea tiene bloqueadores sin completar
+	ErrTaskBlocked = errors.New("la tarea tiene bloqueadores sin completar")
+)
+
+// Dependency representa que la tarea TaskID está bloqueada por la tarea BlockerID
+type Dependency struct {
+	TaskID    int       `json:"task_id" db:"task_id"`
+	BlockerID int       `json:"blocker_id" db:"blocker_id"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// DependencyGraph es un grafo dirigido bloqueador -> tarea bloqueada
+type DependencyGraph struct {
+	edges map[int][]int
+}
+
+// NewDependencyGraph construye el grafo a partir de las dependencias existentes
+func NewDependencyGraph(deps []Dependency) *DependencyGraph {
+	g := &DependencyGraph{edges: make(map[int][]int)}
+	for _, d := range deps {
+		g.edges[d.BlockerID] = append(g.edges[d.BlockerID], d.TaskID)
+	}
+	return g
+}
+
+// WouldCreateCycle indica si agregar "taskID bloqueada por blockerID" crearía un ciclo.
+// Ocurre cuando blockerID ya es alcanzable desde taskID.
+func (g *DependencyGraph) WouldCreateCycle(taskID, blockerID int) bool {
+	if taskID == blockerID {
+		return true
+	}
+
+	visited := map[int]bool{taskID: true}
+	stack := []int{taskID}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, next := range g.edges[current] {
+			if next == blockerID {
+				return true
+			}
+			if !visited[next] {
+				visited[next] = true
+				stack = append(stack, next)
+			}
+		}
+	}
+	return false
+}
+
+// TopologicalOrder ordena los IDs de modo que cada bloqueador aparezca antes que
+// las tareas que bloquea. A igualdad de condiciones se ordena por ID ascendente.
+// Las aristas hacia IDs que no están en taskIDs se ignoran.
+func (g *DependencyGraph) TopologicalOrder(taskIDs []int) ([]int, error) {
+	known := make(map[int]bool, len(taskIDs))
+	for _, id := range taskIDs {
+		known[id] = true
+	}
+
+	inDegree := make(map[int]int, len(taskIDs))
+	for _, id := range taskIDs {
+		for _, next := range g.edges[id] {
+			if known[next] {
+				inDegree[next]++
+			}
+		}
+	}
+
+	var ready []int
+	for _, id := range taskIDs {
+		if inDegree[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+	sort.Ints(ready)
+
+	order := make([]int, 0, len(taskIDs))
+	for len(ready) > 0 {
+		current := ready[0]
+		ready = ready[1:]
+		order = append(order, current)
+
+		for _, next := range g.edges[current] {
+			if !known[next] {
+				continue
+			}
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				ready = append(ready, next)
+				sort.Ints(ready)
+			}
+		}
+	}
+
+	if len(order) != len(known) {
+		return nil, ErrDependencyCycle
+	}
+	return order, nil
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=dependency_repository.go -destination=../application/mocks/mock_dependency_repository.go -package=mocks
+
+// DependencyRepository define el contrato para persistir dependencias entre tareas
+
+type DependencyRepository interface {
+	// AddDependency registra que taskID está bloqueada por blockerID
+	AddDependency(ctx context.Context, taskID, blockerID int) error
+	// RemoveDependency elimina el bloqueo de blockerID sobre taskID
+	RemoveDependency(ctx context.Context, taskID, blockerID int) error
+	// GetBlockers obtiene las tareas que bloquean a taskID
+	GetBlockers(ctx context.Context, taskID int) ([]*Task, error)
+	// GetAllDependencies obtiene todas las dependencias registradas
+	GetAllDependencies(ctx context.Context) ([]Dependency, error)
+}
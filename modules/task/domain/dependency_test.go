@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDependencyGraph_WouldCreateCycle_Direct verifica la detección de un ciclo directo
+func TestDependencyGraph_WouldCreateCycle_Direct(t *testing.T) {
+	// Arrange: 2 está bloqueada por 1
+	graph := NewDependencyGraph([]Dependency{{TaskID: 2, BlockerID: 1}})
+
+	// Act & Assert: 1 bloqueada por 2 cerraría el ciclo
+	assert.True(t, graph.WouldCreateCycle(1, 2))
+	assert.False(t, graph.WouldCreateCycle(3, 2))
+}
+
+// TestDependencyGraph_WouldCreateCycle_Transitive verifica la detección de un ciclo transitivo
+func TestDependencyGraph_WouldCreateCycle_Transitive(t *testing.T) {
+	// Arrange: 1 -> 2 -> 3 (1 bloquea a 2, 2 bloquea a 3)
+	graph := NewDependencyGraph([]Dependency{
+		{TaskID: 2, BlockerID: 1},
+		{TaskID: 3, BlockerID: 2},
+	})
+
+	// Act & Assert
+	assert.True(t, graph.WouldCreateCycle(1, 3))
+	assert.False(t, graph.WouldCreateCycle(3, 1)) // Redundante, pero no es un ciclo
+}
+
+// TestDependencyGraph_WouldCreateCycle_Self verifica que una tarea no puede bloquearse a sí misma
+func TestDependencyGraph_WouldCreateCycle_Self(t *testing.T) {
+	graph := NewDependencyGraph(nil)
+
+	assert.True(t, graph.WouldCreateCycle(1, 1))
+}
+
+// TestDependencyGraph_TopologicalOrder verifica que los bloqueadores van primero
+func TestDependencyGraph_TopologicalOrder(t *testing.T) {
+	// Arrange: 3 bloquea a 1, 1 bloquea a 2; 4 no tiene dependencias
+	graph := NewDependencyGraph([]Dependency{
+		{TaskID: 1, BlockerID: 3},
+		{TaskID: 2, BlockerID: 1},
+	})
+
+	// Act
+	order, err := graph.TopologicalOrder([]int{1, 2, 3, 4})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1, 2, 4}, order)
+}
+
+// TestDependencyGraph_TopologicalOrder_IgnoresUnknownTasks verifica que se ignoran tareas fuera del conjunto
+func TestDependencyGraph_TopologicalOrder_IgnoresUnknownTasks(t *testing.T) {
+	graph := NewDependencyGraph([]Dependency{{TaskID: 1, BlockerID: 99}})
+
+	order, err := graph.TopologicalOrder([]int{1, 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, order)
+}
+
+// TestDependencyGraph_TopologicalOrder_Cycle verifica que un ciclo existente retorna error
+func TestDependencyGraph_TopologicalOrder_Cycle(t *testing.T) {
+	graph := NewDependencyGraph([]Dependency{
+		{TaskID: 1, BlockerID: 2},
+		{TaskID: 2, BlockerID: 1},
+	})
+
+	order, err := graph.TopologicalOrder([]int{1, 2})
+
+	assert.ErrorIs(t, err, ErrDependencyCycle)
+	assert.Nil(t, order)
+}
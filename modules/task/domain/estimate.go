@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// MaxReportDays es la cantidad máxima de días que abarca un reporte de velocidad o burndown
+const MaxReportDays = 366
+
+var (
+	// ErrInvalidEstimate indica que la estimación o el trabajo restante son negativos
+	ErrInvalidEstimate = errors.New("la estimación y el trabajo restante no pueden ser negativos")
+	// ErrInvalidReportRange indica que el rango del reporte está vacío o es demasiado largo
+	ErrInvalidReportRange = errors.New("el reporte requiere un rango válido de hasta 366 días")
+)
+
+// SetEstimate asigna los puntos y las horas estimadas; el trabajo restante vuelve a
+// las horas estimadas salvo que la tarea ya esté completada
+func (t *Task) SetEstimate(storyPoints int, estimatedHours float64) error {
+	if storyPoints < 0 || estimatedHours < 0 {
+		return ErrInvalidEstimate
+	}
+	t.StoryPoints = storyPoints
+	t.EstimatedHours = estimatedHours
+	if !t.Completed {
+		t.RemainingHours = estimatedHours
+	}
+	t.UpdatedAt = time.Now().UTC()
+	t.RecordEvent(EventTaskUpdated)
+	return nil
+}
+
+// UpdateRemaining actualiza las horas de trabajo que faltan para terminar la tarea
+func (t *Task) UpdateRemaining(remainingHours float64) error {
+	if remainingHours < 0 {
+		return ErrInvalidEstimate
+	}
+	t.RemainingHours = remainingHours
+	t.UpdatedAt = time.Now().UTC()
+	t.RecordEvent(EventTaskUpdated)
+	return nil
+}
+
+// ReportDays normaliza un rango a días completos en UTC: from se lleva al inicio de su día
+// y to (exclusivo) al inicio del día siguiente si no cae a medianoche
+func ReportDays(from, to time.Time) (time.Time, time.Time, error) {
+	from = from.UTC().Truncate(24 * time.Hour)
+	end := to.UTC().Truncate(24 * time.Hour)
+	if end.Before(to) {
+		end = end.AddDate(0, 0, 1)
+	}
+	if !from.Before(end) || end.Sub(from) > MaxReportDays*24*time.Hour {
+		return time.Time{}, time.Time{}, ErrInvalidReportRange
+	}
+	return from, end, nil
+}
+
+// WeekStart retorna el lunes (UTC) de la semana de at
+func WeekStart(at time.Time) time.Time {
+	day := at.UTC().Truncate(24 * time.Hour)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// VelocityWeek es el trabajo completado en una semana que empieza el lunes WeekStart
+type VelocityWeek struct {
+	WeekStart   string  `json:"week_start"`
+	StoryPoints int     `json:"story_points"`
+	Hours       float64 `json:"hours"`
+	Tasks       int     `json:"tasks"`
+}
+
+// Velocity es el trabajo completado por semana en un rango y su promedio
+type Velocity struct {
+	From           time.Time      `json:"from"`
+	To             time.Time      `json:"to"`
+	BoardID        int            `json:"board_id,omitempty"`
+	Weeks          []VelocityWeek `json:"weeks"`
+	AveragePoints  float64        `json:"average_points"`
+	AverageHours   float64        `json:"average_hours"`
+	CompletedTasks int            `json:"completed_tasks"`
+}
+
+// NewVelocity arma el reporte a partir de las semanas agregadas y calcula los promedios
+func NewVelocity(from, to time.Time, boardID int, weeks []VelocityWeek) *Velocity {
+	velocity := &Velocity{From: from, To: to, BoardID: boardID, Weeks: weeks}
+	if velocity.Weeks == nil {
+		velocity.Weeks = []VelocityWeek{}
+	}
+	var points int
+	var hours float64
+	for _, week := range velocity.Weeks {
+		points += week.StoryPoints
+		hours += week.Hours
+		velocity.CompletedTasks += week.Tasks
+	}
+	if n := len(velocity.Weeks); n > 0 {
+		velocity.AveragePoints = float64(points) / float64(n)
+		velocity.AverageHours = hours / float64(n)
+	}
+	return velocity
+}
+
+// BurndownDay es el trabajo estimado que seguía pendiente al terminar un día
+type BurndownDay struct {
+	Day             string  `json:"day"`
+	RemainingPoints int     `json:"remaining_points"`
+	RemainingHours  float64 `json:"remaining_hours"`
+	OpenTasks       int     `json:"open_tasks"`
+}
+
+// Burndown es la serie diaria del trabajo pendiente en un rango
+type Burndown struct {
+	From    time.Time     `json:"from"`
+	To      time.Time     `json:"to"`
+	BoardID int           `json:"board_id,omitempty"`
+	Days    []BurndownDay `json:"days"`
+}
+
+// NewBurndown arma el reporte a partir de los días agregados
+func NewBurndown(from, to time.Time, boardID int, days []BurndownDay) *Burndown {
+	if days == nil {
+		days = []BurndownDay{}
+	}
+	return &Burndown{From: from, To: to, BoardID: boardID, Days: days}
+}
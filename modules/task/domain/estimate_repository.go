@@ -0,0 +1,19 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=estimate_repository.go -destination=../application/mocks/mock_estimate_repository.go -package=mocks
+
+// EstimateRepository define el contrato para los reportes de velocidad y burndown,
+// que se agregan en la base de datos. boardID 0 considera todas las tareas
+type EstimateRepository interface {
+	// Velocity suma el trabajo completado en [from, to) por semanas que empiezan en from,
+	// incluidas las semanas sin tareas completadas
+	Velocity(ctx context.Context, from, to time.Time, boardID int) ([]VelocityWeek, error)
+	// Burndown suma, para cada día de [from, to), la estimación de las tareas creadas
+	// y aún no completadas al terminar ese día
+	Burndown(ctx context.Context, from, to time.Time, boardID int) ([]BurndownDay, error)
+}
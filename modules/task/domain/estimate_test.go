@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTask_SetEstimate verifica que estimar reinicia el trabajo restante de una tarea pendiente
+func TestTask_SetEstimate(t *testing.T) {
+	task := NewTask("Estimar", "D")
+	task.RemainingHours = 1
+
+	assert.NoError(t, task.SetEstimate(5, 8))
+	assert.Equal(t, 5, task.StoryPoints)
+	assert.Equal(t, 8.0, task.RemainingHours)
+
+	assert.ErrorIs(t, task.SetEstimate(-1, 8), ErrInvalidEstimate)
+	assert.ErrorIs(t, task.UpdateRemaining(-0.5), ErrInvalidEstimate)
+
+	// Completar la tarea deja el trabajo restante en cero y reestimarla no lo cambia
+	task.MarkAsCompleted()
+	assert.Zero(t, task.RemainingHours)
+	assert.NoError(t, task.SetEstimate(3, 4))
+	assert.Zero(t, task.RemainingHours)
+}
+
+// TestReportDays verifica la normalización del rango a días completos
+func TestReportDays(t *testing.T) {
+	from, to, err := ReportDays(time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC), time.Date(2026, 3, 4, 9, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), to)
+
+	_, _, err = ReportDays(to, from)
+	assert.ErrorIs(t, err, ErrInvalidReportRange)
+	_, _, err = ReportDays(from, from.AddDate(2, 0, 0))
+	assert.ErrorIs(t, err, ErrInvalidReportRange)
+}
+
+// TestWeekStart verifica que las semanas empiezan el lunes
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monday, WeekStart(monday.Add(10*time.Hour)))
+	assert.Equal(t, monday, WeekStart(time.Date(2026, 3, 8, 23, 0, 0, 0, time.UTC)))
+}
+
+// TestNewVelocity verifica los promedios, contando las semanas sin tareas completadas
+func TestNewVelocity(t *testing.T) {
+	velocity := NewVelocity(time.Time{}, time.Time{}, 0, []VelocityWeek{
+		{WeekStart: "2026-03-02", StoryPoints: 8, Hours: 10, Tasks: 2},
+		{WeekStart: "2026-03-09"},
+	})
+
+	assert.Equal(t, 4.0, velocity.AveragePoints)
+	assert.Equal(t, 5.0, velocity.AverageHours)
+	assert.Equal(t, 2, velocity.CompletedTasks)
+	assert.NotNil(t, NewVelocity(time.Time{}, time.Time{}, 0, nil).Weeks)
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// EventType identifica el tipo de un evento de dominio de tareas
+type EventType string
+
+const (
+	// EventTaskCreated se registra al crear una tarea
+	EventTaskCreated EventType = "task.created"
+	// EventTaskUpdated se registra al cambiar los campos editables de una tarea
+	EventTaskUpdated EventType = "task.updated"
+	// EventTaskCompleted se registra cuando una tarea pendiente se completa
+	EventTaskCompleted EventType = "task.completed"
+	// EventTaskReopened se registra cuando una tarea completada vuelve a quedar pendiente
+	EventTaskReopened EventType = "task.reopened"
+	// EventTaskDeleted se registra al mover una tarea a la papelera
+	EventTaskDeleted EventType = "task.deleted"
+)
+
+// Event es un cambio ocurrido en una tarea
+type Event struct {
+	Type   EventType `json:"type"`
+	TaskID int       `json:"task_id"`
+	// Task es el estado de la tarea después del cambio
+	Task       *Task     `json:"task"`
+	Actor      string    `json:"actor"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// RecordEvent registra un evento pendiente de publicar; un task.updated repetido se registra una sola vez
+func (t *Task) RecordEvent(eventType EventType) {
+	if eventType == EventTaskUpdated && slices.ContainsFunc(t.events, func(e Event) bool { return e.Type == EventTaskUpdated }) {
+		return
+	}
+	t.events = append(t.events, Event{Type: eventType, OccurredAt: time.Now().UTC()})
+}
+
+// PullEvents retorna los eventos pendientes en el orden en que ocurrieron y los quita de la tarea.
+// Cada evento lleva el ID y una copia del estado actual de la tarea
+func (t *Task) PullEvents() []Event {
+	events := t.events
+	t.events = nil
+	if len(events) == 0 {
+		return nil
+	}
+
+	snapshot := *t
+	for i := range events {
+		events[i].TaskID = t.ID
+		events[i].Task = &snapshot
+	}
+	return events
+}
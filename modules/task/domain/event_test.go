@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// eventTypes retorna los tipos de los eventos en orden
+func eventTypes(events []Event) []EventType {
+	types := make([]EventType, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	return types
+}
+
+// TestTask_RecordsEvents verifica los eventos que registran los cambios de la tarea
+func TestTask_RecordsEvents(t *testing.T) {
+	// Arrange
+	task := NewTask("T", "D")
+	task.PullEvents()
+	title := "Nuevo"
+
+	// Act
+	task.Update("Otro", "")
+	_ = task.ApplyPatch(TaskPatch{Title: &title})
+	task.MarkAsCompleted()
+	task.MarkAsCompleted()
+	task.MarkAsUncompleted()
+	task.MarkAsUncompleted()
+
+	// Assert: task.updated se registra una sola vez y solo los cambios de estado cuentan
+	assert.Equal(t, []EventType{EventTaskUpdated, EventTaskCompleted, EventTaskReopened}, eventTypes(task.PullEvents()))
+}
+
+// TestTask_ApplyPatch_OnlyCompleted verifica que un parche que solo cambia el estado no registra task.updated
+func TestTask_ApplyPatch_OnlyCompleted(t *testing.T) {
+	// Arrange
+	task := &Task{ID: 1, Title: "T", Description: "D"}
+	completed := true
+
+	// Act
+	err := task.ApplyPatch(TaskPatch{Completed: &completed})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, task.PullEvents())
+}
+
+// TestTask_PullEvents verifica que los eventos llevan el estado de la tarea y se quitan al leerlos
+func TestTask_PullEvents(t *testing.T) {
+	// Arrange
+	task := NewTask("T", "D")
+	task.ID = 7
+
+	// Act
+	events := task.PullEvents()
+
+	// Assert
+	assert.Len(t, events, 1)
+	assert.Equal(t, EventTaskCreated, events[0].Type)
+	assert.Equal(t, 7, events[0].TaskID)
+	assert.Equal(t, "T", events[0].Task.Title)
+	assert.False(t, events[0].OccurredAt.IsZero())
+	assert.Nil(t, task.PullEvents())
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"time"
+)
+
+// Errores relacionados con el historial de cambios
+var (
+	// ErrRevisionNotFound indica que la revisión no existe o pertenece a otra tarea
+	ErrRevisionNotFound = errors.New("la revisión no existe")
+	// ErrNoRevisionAt indica que la tarea no tenía revisiones en la fecha consultada
+	ErrNoRevisionAt = errors.New("la tarea no tiene revisiones en esa fecha")
+)
+
+// RevisionAction identifica el tipo de escritura que generó una revisión
+type RevisionAction string
+
+const (
+	RevisionCreate   RevisionAction = "create"
+	RevisionUpdate   RevisionAction = "update"
+	RevisionComplete RevisionAction = "complete"
+	RevisionDelete   RevisionAction = "delete"
+	RevisionRevert   RevisionAction = "revert"
+)
+
+// FieldChange guarda el valor anterior y el nuevo de un campo
+type FieldChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
+
+// TaskRevision registra una escritura sobre una tarea: quién, cuándo, qué cambió
+// y cómo quedó la tarea (Snapshot), para consultarla en el tiempo o revertirla
+type TaskRevision struct {
+	ID        int                    `json:"id" db:"id"`
+	TaskID    int                    `json:"task_id" db:"task_id"`
+	Action    RevisionAction         `json:"action" db:"action"`
+	Actor     string                 `json:"actor" db:"actor"`
+	Changes   map[string]FieldChange `json:"changes" db:"changes"`
+	Snapshot  *Task                  `json:"-" db:"snapshot"`
+	CreatedAt time.Time              `json:"created_at" db:"created_at"`
+}
+
+// NewTaskRevision crea la revisión de una escritura; before es nil al crear la tarea
+func NewTaskRevision(action RevisionAction, actor string, before, after *Task) *TaskRevision {
+	return &TaskRevision{
+		TaskID:    after.ID,
+		Action:    action,
+		Actor:     actor,
+		Changes:   DiffTasks(before, after),
+		Snapshot:  after,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// DiffTasks retorna los campos editables que difieren entre before y after
+func DiffTasks(before, after *Task) map[string]FieldChange {
+	if before == nil {
+		before = &Task{}
+	}
+	changes := make(map[string]FieldChange)
+	if before.Title != after.Title {
+		changes["title"] = FieldChange{From: before.Title, To: after.Title}
+	}
+	if before.Description != after.Description {
+		changes["description"] = FieldChange{From: before.Description, To: after.Description}
+	}
+	if before.Completed != after.Completed {
+		changes["completed"] = FieldChange{From: before.Completed, To: after.Completed}
+	}
+	if !sameTime(before.DueDate, after.DueDate) {
+		changes["due_date"] = FieldChange{From: timeValue(before.DueDate), To: timeValue(after.DueDate)}
+	}
+	if before.Recurrence != after.Recurrence {
+		changes["recurrence"] = FieldChange{From: before.Recurrence, To: after.Recurrence}
+	}
+	if before.StoryPoints != after.StoryPoints {
+		changes["story_points"] = FieldChange{From: before.StoryPoints, To: after.StoryPoints}
+	}
+	if before.EstimatedHours != after.EstimatedHours {
+		changes["estimated_hours"] = FieldChange{From: before.EstimatedHours, To: after.EstimatedHours}
+	}
+	if before.RemainingHours != after.RemainingHours {
+		changes["remaining_hours"] = FieldChange{From: before.RemainingHours, To: after.RemainingHours}
+	}
+	if before.Rank != after.Rank {
+		changes["rank"] = FieldChange{From: before.Rank, To: after.Rank}
+	}
+	if !slices.Equal(before.Assignees, after.Assignees) {
+		changes["assignees"] = FieldChange{From: before.Assignees, To: after.Assignees}
+	}
+	if !reflect.DeepEqual(before.CustomFields, after.CustomFields) && (len(before.CustomFields) > 0 || len(after.CustomFields) > 0) {
+		changes["custom_fields"] = FieldChange{From: before.CustomFields, To: after.CustomFields}
+	}
+	if !sameTime(before.ArchivedAt, after.ArchivedAt) {
+		changes["archived_at"] = FieldChange{From: timeValue(before.ArchivedAt), To: timeValue(after.ArchivedAt)}
+	}
+	if !sameTime(before.DeletedAt, after.DeletedAt) {
+		changes["deleted_at"] = FieldChange{From: timeValue(before.DeletedAt), To: timeValue(after.DeletedAt)}
+	}
+	return changes
+}
+
+// RevertTo restaura los campos editables de la tarea a los de una revisión anterior
+func (t *Task) RevertTo(snapshot *Task) {
+	t.Title = snapshot.Title
+	t.Description = snapshot.Description
+	t.DueDate = snapshot.DueDate
+	t.Recurrence = snapshot.Recurrence
+	t.StoryPoints = snapshot.StoryPoints
+	t.EstimatedHours = snapshot.EstimatedHours
+	if snapshot.Completed {
+		t.MarkAsCompleted()
+	} else {
+		t.MarkAsUncompleted()
+	}
+	t.RemainingHours = snapshot.RemainingHours
+	t.UpdatedAt = time.Now().UTC()
+	t.RecordEvent(EventTaskUpdated)
+}
+
+// sameTime compara dos fechas opcionales
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// timeValue convierte una fecha opcional en un valor serializable (nil si no hay fecha)
+func timeValue(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC()
+}
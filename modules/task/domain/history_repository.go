@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=history_repository.go -destination=../application/mocks/mock_history_repository.go -package=mocks
+
+// TaskHistoryRepository persiste las escrituras de tareas junto con su revisión
+// en una misma transacción, y consulta el historial resultante
+type TaskHistoryRepository interface {
+	// CreateWithRevision crea la tarea y registra la revisión con la tarea creada
+	CreateWithRevision(ctx context.Context, task *Task, revision *TaskRevision) (*Task, error)
+	// UpdateWithRevision actualiza la tarea (con las mismas reglas que TaskRepository.Update) y registra la revisión
+	UpdateWithRevision(ctx context.Context, task *Task, revision *TaskRevision) (*Task, error)
+	// DeleteWithRevision mueve la tarea a la papelera y registra la revisión
+	DeleteWithRevision(ctx context.Context, id int, revision *TaskRevision) error
+	// GetRevisions obtiene el historial de una tarea, de la revisión más antigua a la más reciente
+	GetRevisions(ctx context.Context, taskID int) ([]*TaskRevision, error)
+	// GetRevision obtiene una revisión de la tarea; retorna ErrRevisionNotFound si no existe
+	GetRevision(ctx context.Context, taskID, revisionID int) (*TaskRevision, error)
+	// GetRevisionAt obtiene la última revisión registrada hasta at; retorna ErrNoRevisionAt si no hay ninguna
+	GetRevisionAt(ctx context.Context, taskID int, at time.Time) (*TaskRevision, error)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDiffTasks_Create verifica que al crear se registran los campos con valor
+func TestDiffTasks_Create(t *testing.T) {
+	task := NewTask("Titulo", "Descripcion")
+
+	changes := DiffTasks(nil, task)
+
+	assert.Equal(t, FieldChange{From: "", To: "Titulo"}, changes["title"])
+	assert.Equal(t, FieldChange{From: "", To: "Descripcion"}, changes["description"])
+	assert.NotContains(t, changes, "completed")
+	assert.NotContains(t, changes, "due_date")
+}
+
+// TestDiffTasks_OnlyChangedFields verifica que solo se registran los campos modificados
+func TestDiffTasks_OnlyChangedFields(t *testing.T) {
+	due := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	before := &Task{Title: "A", Description: "D", DueDate: &due}
+	sameDue := due
+	after := &Task{Title: "B", Description: "D", DueDate: &sameDue, Completed: true}
+
+	changes := DiffTasks(before, after)
+
+	assert.Len(t, changes, 2)
+	assert.Equal(t, FieldChange{From: "A", To: "B"}, changes["title"])
+	assert.Equal(t, FieldChange{From: false, To: true}, changes["completed"])
+}
+
+// TestDiffTasks_RelatedFields verifica que se registran los cambios guardados fuera de las columnas
+// de la tarea
+func TestDiffTasks_RelatedFields(t *testing.T) {
+	before := &Task{Title: "A", Rank: "b", Assignees: []int{1}, CustomFields: map[string]any{}}
+	after := &Task{Title: "A", Rank: "c", Assignees: []int{1, 2}, CustomFields: map[string]any{"severity": "alta"}}
+
+	changes := DiffTasks(before, after)
+
+	assert.Len(t, changes, 3)
+	assert.Equal(t, FieldChange{From: "b", To: "c"}, changes["rank"])
+	assert.Equal(t, FieldChange{From: []int{1}, To: []int{1, 2}}, changes["assignees"])
+	assert.Equal(t, FieldChange{From: map[string]any{}, To: map[string]any{"severity": "alta"}}, changes["custom_fields"])
+	// Un mapa vacío y uno nil no son un cambio
+	assert.NotContains(t, DiffTasks(&Task{}, &Task{CustomFields: map[string]any{}}), "custom_fields")
+}
+
+// TestTask_RevertTo verifica que se restauran los campos editables de la revisión
+func TestTask_RevertTo(t *testing.T) {
+	due := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	task := &Task{ID: 1, Title: "Nuevo", Description: "Nueva", Version: 5}
+	task.MarkAsCompleted()
+	snapshot := &Task{ID: 1, Title: "Viejo", Description: "Vieja", DueDate: &due, Version: 2}
+
+	task.RevertTo(snapshot)
+
+	assert.Equal(t, "Viejo", task.Title)
+	assert.Equal(t, "Vieja", task.Description)
+	assert.Equal(t, &due, task.DueDate)
+	assert.False(t, task.Completed)
+	assert.Nil(t, task.CompletedAt)
+	assert.Equal(t, 5, task.Version) // La versión no se revierte: la escritura usa la actual
+}
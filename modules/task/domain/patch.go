@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidPatch indica que el parche no se puede aplicar a la tarea
+	ErrInvalidPatch = errors.New("el parche no es válido")
+	// ErrPatchTestFailed indica que una operación test de JSON Patch no se cumplió
+	ErrPatchTestFailed = errors.New("la operación test del parche no se cumplió")
+)
+
+// TaskPatch describe los cambios explícitos a una tarea; un campo nil no se modifica.
+// Para quitar la fecha de vencimiento se usa ClearDueDate.
+type TaskPatch struct {
+	Title          *string
+	Description    *string
+	Completed      *bool
+	DueDate        *time.Time
+	ClearDueDate   bool
+	Recurrence     *string
+	StoryPoints    *int
+	EstimatedHours *float64
+	RemainingHours *float64
+}
+
+// IsEmpty indica si el parche no cambia ningún campo
+func (p TaskPatch) IsEmpty() bool {
+	return p == TaskPatch{}
+}
+
+// ApplyPatch aplica título, descripción, calendario y estimación; el estado de completado
+// lo resuelve el servicio porque depende de los bloqueadores de la tarea
+func (t *Task) ApplyPatch(p TaskPatch) error {
+	if p.DueDate != nil && p.ClearDueDate {
+		return fmt.Errorf("%w: due_date no puede asignarse y quitarse a la vez", ErrInvalidPatch)
+	}
+
+	if p.Title != nil {
+		title := strings.TrimSpace(*p.Title)
+		if title == "" {
+			return fmt.Errorf("%w: title no puede quedar vacío", ErrInvalidPatch)
+		}
+		t.Title = title
+	}
+	if p.Description != nil {
+		description := strings.TrimSpace(*p.Description)
+		if description == "" {
+			return fmt.Errorf("%w: description no puede quedar vacía", ErrInvalidPatch)
+		}
+		t.Description = description
+	}
+
+	if p.DueDate != nil || p.ClearDueDate || p.Recurrence != nil {
+		dueDate, recurrence := t.DueDate, t.Recurrence
+		if p.ClearDueDate {
+			dueDate = nil
+		}
+		if p.DueDate != nil {
+			due := p.DueDate.UTC()
+			dueDate = &due
+		}
+		if p.Recurrence != nil {
+			recurrence = strings.TrimSpace(*p.Recurrence)
+		}
+		if err := t.SetSchedule(dueDate, recurrence); err != nil {
+			return err
+		}
+	}
+
+	if p.StoryPoints != nil || p.EstimatedHours != nil {
+		points, hours, remaining := t.StoryPoints, t.EstimatedHours, t.RemainingHours
+		if p.StoryPoints != nil {
+			points = *p.StoryPoints
+		}
+		if p.EstimatedHours != nil {
+			hours = *p.EstimatedHours
+		}
+		if err := t.SetEstimate(points, hours); err != nil {
+			return err
+		}
+		// Cambiar solo los puntos no reinicia el trabajo restante
+		if p.EstimatedHours == nil {
+			t.RemainingHours = remaining
+		}
+	}
+	if p.RemainingHours != nil {
+		if err := t.UpdateRemaining(*p.RemainingHours); err != nil {
+			return err
+		}
+	}
+
+	t.UpdatedAt = time.Now().UTC()
+	// Cambiar solo el estado de completado no es una actualización: se registra al completar o reabrir
+	fields := p
+	fields.Completed = nil
+	if !fields.IsEmpty() {
+		t.RecordEvent(EventTaskUpdated)
+	}
+	return nil
+}
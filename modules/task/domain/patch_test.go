@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTask_ApplyPatch_ClearsOptionalFields verifica que un parche puede vaciar la fecha y la recurrencia
+func TestTask_ApplyPatch_ClearsOptionalFields(t *testing.T) {
+	task := NewTask("Tarea", "D")
+	due := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, task.SetSchedule(&due, "FREQ=WEEKLY"))
+
+	empty := ""
+	assert.NoError(t, task.ApplyPatch(TaskPatch{ClearDueDate: true, Recurrence: &empty}))
+	assert.Nil(t, task.DueDate)
+	assert.Empty(t, task.Recurrence)
+}
+
+// TestTask_ApplyPatch_Invalid verifica que no se aceptan títulos vacíos ni fechas contradictorias
+func TestTask_ApplyPatch_Invalid(t *testing.T) {
+	task := NewTask("Tarea", "D")
+
+	blank := "  "
+	assert.ErrorIs(t, task.ApplyPatch(TaskPatch{Title: &blank}), ErrInvalidPatch)
+
+	due := time.Now()
+	assert.ErrorIs(t, task.ApplyPatch(TaskPatch{DueDate: &due, ClearDueDate: true}), ErrInvalidPatch)
+	assert.Equal(t, "Tarea", task.Title)
+}
+
+// TestTask_ApplyPatch_StoryPointsKeepRemaining verifica que cambiar solo los puntos no reinicia el trabajo restante
+func TestTask_ApplyPatch_StoryPointsKeepRemaining(t *testing.T) {
+	task := NewTask("Tarea", "D")
+	assert.NoError(t, task.SetEstimate(3, 8))
+	assert.NoError(t, task.UpdateRemaining(2))
+
+	points := 5
+	assert.NoError(t, task.ApplyPatch(TaskPatch{StoryPoints: &points}))
+	assert.Equal(t, 5, task.StoryPoints)
+	assert.Equal(t, 2.0, task.RemainingHours)
+
+	hours := 10.0
+	assert.NoError(t, task.ApplyPatch(TaskPatch{EstimatedHours: &hours}))
+	assert.Equal(t, 10.0, task.RemainingHours)
+}
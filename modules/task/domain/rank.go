@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errores relacionados con el orden manual de las tareas
+var (
+	// ErrInvalidMove indica que las tareas de referencia no permiten ubicar la tarea
+	ErrInvalidMove = errors.New("la posición indicada no es válida")
+	// ErrRankTooDense indica que no queda espacio entre dos ranks y hay que reequilibrarlos
+	ErrRankTooDense = errors.New("no queda espacio entre las posiciones; se requiere reequilibrar")
+)
+
+// rankDigits son los dígitos de los ranks, en orden lexicográfico (base 36)
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// MaxRankLength es el largo máximo de un rank calculado al mover una tarea;
+// al superarlo se reequilibran todos los ranks
+const MaxRankLength = 32
+
+const rankBase = len(rankDigits)
+
+// RankBetween calcula un rank estrictamente entre lower y upper. Un lower vacío
+// es el inicio de la lista y un upper vacío el final. Los ranks generados nunca
+// terminan en el dígito mínimo, así siempre queda espacio antes de cada uno.
+func RankBetween(lower, upper string) (string, error) {
+	if upper != "" && lower >= upper {
+		return "", ErrInvalidMove
+	}
+
+	var rank []byte
+	upperTight := upper != ""
+	for i := 0; i < MaxRankLength; i++ {
+		lo := 0
+		if i < len(lower) {
+			lo = rankDigit(lower[i])
+		}
+		hi := rankBase
+		if upperTight && i < len(upper) {
+			hi = rankDigit(upper[i])
+		}
+
+		if hi-lo > 1 {
+			return string(append(rank, rankDigits[(lo+hi)/2])), nil
+		}
+		// Sin espacio en esta posición: copiar el dígito inferior y seguir con el siguiente
+		rank = append(rank, rankDigits[lo])
+		if hi > lo {
+			upperTight = false
+		}
+	}
+	return "", ErrRankTooDense
+}
+
+// RankAfter calcula un rank mayor que rank, lo más corto posible, para agregar tareas al final
+func RankAfter(rank string) string {
+	for i := 0; i < len(rank); i++ {
+		if d := rankDigit(rank[i]); d < rankBase-1 {
+			return rank[:i] + string(rankDigits[d+1])
+		}
+	}
+	if rank == "" {
+		return string(rankDigits[rankBase/2])
+	}
+	// Todos los dígitos son el máximo: alargar con el menor dígito válido
+	return rank + string(rankDigits[1])
+}
+
+// EvenRanks genera n ranks crecientes del mismo largo, repartidos en la primera
+// mitad del espacio para dejar lugar entre ellos y al final de la lista
+func EvenRanks(n int) []string {
+	width, space := 1, rankBase
+	for space < (n+1)*2*rankBase*rankBase {
+		width++
+		space *= rankBase
+	}
+	step := space / (2 * (n + 1))
+
+	ranks := make([]string, n)
+	for i := range ranks {
+		value := step * (i + 1)
+		if value%rankBase == 0 {
+			value++ // Evitar que termine en el dígito mínimo
+		}
+		ranks[i] = formatRank(value, width)
+	}
+	return ranks
+}
+
+// rankDigit retorna el valor de un dígito de rank
+func rankDigit(c byte) int {
+	return strings.IndexByte(rankDigits, c)
+}
+
+// formatRank escribe value en base 36 con width dígitos
+func formatRank(value, width int) string {
+	rank := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		rank[i] = rankDigits[value%rankBase]
+		value /= rankBase
+	}
+	return string(rank)
+}
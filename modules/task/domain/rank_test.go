@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRankBetween_Ordering verifica que el rank calculado queda entre los límites
+func TestRankBetween_Ordering(t *testing.T) {
+	cases := []struct{ lower, upper string }{
+		{"", ""},
+		{"", "i"},
+		{"i", ""},
+		{"a", "b"},
+		{"a", "a1"},
+		{"az", "b"},
+		{"h", "hz"},
+	}
+	for _, c := range cases {
+		rank, err := RankBetween(c.lower, c.upper)
+		require.NoError(t, err, c)
+		assert.Greater(t, rank, c.lower, c)
+		if c.upper != "" {
+			assert.Less(t, rank, c.upper, c)
+		}
+		assert.NotEqual(t, byte('0'), rank[len(rank)-1], c)
+	}
+}
+
+// TestRankBetween_InvalidRange verifica que límites desordenados se rechazan
+func TestRankBetween_InvalidRange(t *testing.T) {
+	_, err := RankBetween("b", "a")
+	assert.ErrorIs(t, err, ErrInvalidMove)
+}
+
+// TestRankBetween_TooDense verifica que insertar siempre en el mismo hueco termina pidiendo reequilibrar
+func TestRankBetween_TooDense(t *testing.T) {
+	lower, upper := "a", "b"
+	var err error
+	for i := 0; i < 1000 && err == nil; i++ {
+		var rank string
+		rank, err = RankBetween(lower, upper)
+		upper = rank
+	}
+	assert.ErrorIs(t, err, ErrRankTooDense)
+}
+
+// TestRankAfter verifica que agregar al final mantiene el orden con ranks cortos
+func TestRankAfter(t *testing.T) {
+	rank := ""
+	for i := 0; i < 100; i++ {
+		next := RankAfter(rank)
+		assert.Greater(t, next, rank)
+		rank = next
+	}
+	assert.LessOrEqual(t, len(rank), 4)
+}
+
+// TestEvenRanks verifica que los ranks reequilibrados son crecientes y dejan espacio entre ellos
+func TestEvenRanks(t *testing.T) {
+	ranks := EvenRanks(500)
+
+	require.Len(t, ranks, 500)
+	assert.True(t, sort.StringsAreSorted(ranks))
+	for i := 1; i < len(ranks); i++ {
+		between, err := RankBetween(ranks[i-1], ranks[i])
+		require.NoError(t, err)
+		assert.Less(t, len(between), 6)
+	}
+}
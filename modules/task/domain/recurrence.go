@@ -0,0 +1,259 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRecurrence indica que la regla de recurrencia no es válida
+var ErrInvalidRecurrence = errors.New("regla de recurrencia inválida")
+
+// Frequency es la frecuencia base de una regla de recurrencia
+type Frequency string
+
+const (
+	FrequencyDaily   Frequency = "DAILY"
+	FrequencyWeekly  Frequency = "WEEKLY"
+	FrequencyMonthly Frequency = "MONTHLY"
+)
+
+// maxMonthlySearch limita la búsqueda de meses que contengan el día pedido (p. ej. día 31)
+const maxMonthlySearch = 48
+
+// untilLayout es el formato de UNTIL (RFC 5545, siempre en UTC)
+const untilLayout = "20060102T150405Z"
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule es un subconjunto de RRULE (RFC 5545):
+// FREQ=DAILY|WEEKLY|MONTHLY;INTERVAL=n;BYDAY=MO,WE;BYMONTHDAY=d;UNTIL=20261231T000000Z;COUNT=n
+// Admite además TZID=<zona IANA> para calcular las ocurrencias en hora local,
+// ya que las fechas se persisten en UTC.
+type RecurrenceRule struct {
+	Frequency Frequency
+	Interval  int
+	Weekdays  []time.Weekday // Solo WEEKLY; vacío = mismo día que la ocurrencia actual
+	MonthDay  int            // Solo MONTHLY; 0 = mismo día que la ocurrencia actual
+	Until     *time.Time
+	Count     int            // Total de ocurrencias; 0 = sin límite
+	Location  *time.Location // Zona en la que se conserva la hora local; nil = zona de la fecha
+}
+
+// ParseRecurrenceRule interpreta una regla con formato RRULE
+func ParseRecurrenceRule(value string) (*RecurrenceRule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("%w: la regla está vacía", ErrInvalidRecurrence)
+	}
+
+	rule := &RecurrenceRule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return nil, fmt.Errorf("%w: parte mal formada %q", ErrInvalidRecurrence, part)
+		}
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.Frequency = Frequency(strings.ToUpper(val))
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: INTERVAL debe ser un entero positivo", ErrInvalidRecurrence)
+			}
+			rule.Interval = n
+		case "BYDAY":
+			for _, code := range strings.Split(strings.ToUpper(val), ",") {
+				day, ok := weekdayCodes[code]
+				if !ok {
+					return nil, fmt.Errorf("%w: día %q desconocido", ErrInvalidRecurrence, code)
+				}
+				rule.Weekdays = append(rule.Weekdays, day)
+			}
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 || n > 31 {
+				return nil, fmt.Errorf("%w: BYMONTHDAY debe estar entre 1 y 31", ErrInvalidRecurrence)
+			}
+			rule.MonthDay = n
+		case "UNTIL":
+			until, err := time.Parse(untilLayout, strings.ToUpper(val))
+			if err != nil {
+				return nil, fmt.Errorf("%w: UNTIL debe tener formato AAAAMMDDTHHMMSSZ", ErrInvalidRecurrence)
+			}
+			rule.Until = &until
+		case "TZID":
+			loc, err := time.LoadLocation(val)
+			if err != nil {
+				return nil, fmt.Errorf("%w: zona horaria %q desconocida", ErrInvalidRecurrence, val)
+			}
+			rule.Location = loc
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("%w: COUNT debe ser un entero positivo", ErrInvalidRecurrence)
+			}
+			rule.Count = n
+		default:
+			return nil, fmt.Errorf("%w: propiedad %q no soportada", ErrInvalidRecurrence, key)
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// validate verifica la coherencia de la regla
+func (r *RecurrenceRule) validate() error {
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly:
+	case "":
+		return fmt.Errorf("%w: FREQ es requerido", ErrInvalidRecurrence)
+	default:
+		return fmt.Errorf("%w: FREQ %q no soportada", ErrInvalidRecurrence, r.Frequency)
+	}
+
+	if len(r.Weekdays) > 0 && r.Frequency != FrequencyWeekly {
+		return fmt.Errorf("%w: BYDAY solo aplica a FREQ=WEEKLY", ErrInvalidRecurrence)
+	}
+	if r.MonthDay != 0 && r.Frequency != FrequencyMonthly {
+		return fmt.Errorf("%w: BYMONTHDAY solo aplica a FREQ=MONTHLY", ErrInvalidRecurrence)
+	}
+	if r.Until != nil && r.Count != 0 {
+		return fmt.Errorf("%w: UNTIL y COUNT son excluyentes", ErrInvalidRecurrence)
+	}
+	return nil
+}
+
+// String serializa la regla en formato RRULE canónico
+func (r *RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		days := append([]time.Weekday(nil), r.Weekdays...)
+		sort.Slice(days, func(i, j int) bool { return weekdayOffset(days[i]) < weekdayOffset(days[j]) })
+		codes := make([]string, len(days))
+		for i, day := range days {
+			codes[i] = strings.ToUpper(day.String()[:2])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.MonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilLayout))
+	}
+	if r.Count != 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Location != nil {
+		parts = append(parts, "TZID="+r.Location.String())
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next calcula la ocurrencia siguiente a current, que es la ocurrencia número
+// occurrence (base 1). Retorna false si la regla ya no genera más ocurrencias.
+// La hora local de current se conserva aunque haya cambios de horario (DST).
+func (r *RecurrenceRule) Next(current time.Time, occurrence int) (time.Time, bool) {
+	if r.Count != 0 && occurrence >= r.Count {
+		return time.Time{}, false
+	}
+	if r.Location != nil {
+		current = current.In(r.Location)
+	}
+
+	var next time.Time
+	switch r.Frequency {
+	case FrequencyDaily:
+		next = addDays(current, r.Interval)
+	case FrequencyWeekly:
+		next = r.nextWeekly(current)
+	case FrequencyMonthly:
+		var ok bool
+		if next, ok = r.nextMonthly(current); !ok {
+			return time.Time{}, false
+		}
+	default:
+		return time.Time{}, false
+	}
+
+	if r.Until != nil && next.After(*r.Until) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// nextWeekly busca el siguiente día permitido dentro de semanas múltiplo de Interval
+// contadas desde la semana de current (las semanas empiezan el lunes)
+func (r *RecurrenceRule) nextWeekly(current time.Time) time.Time {
+	if len(r.Weekdays) == 0 {
+		return addDays(current, 7*r.Interval)
+	}
+
+	allowed := make(map[time.Weekday]bool, len(r.Weekdays))
+	for _, day := range r.Weekdays {
+		allowed[day] = true
+	}
+
+	startOffset := weekdayOffset(current.Weekday())
+	for days := 1; days <= 7*r.Interval+7; days++ {
+		week := (startOffset + days) / 7
+		candidate := addDays(current, days)
+		if week%r.Interval == 0 && allowed[candidate.Weekday()] {
+			return candidate
+		}
+	}
+	// No alcanzable: BYDAY siempre tiene al menos un día permitido
+	return addDays(current, 7*r.Interval)
+}
+
+// nextMonthly avanza Interval meses omitiendo los meses que no tienen el día pedido
+func (r *RecurrenceRule) nextMonthly(current time.Time) (time.Time, bool) {
+	day := r.MonthDay
+	if day == 0 {
+		day = current.Day()
+	}
+
+	for i := 1; i <= maxMonthlySearch; i++ {
+		// Normalizar al día 1 evita el desborde de time.Date al sumar meses
+		firstOfMonth := time.Date(current.Year(), current.Month()+time.Month(i*r.Interval), 1,
+			current.Hour(), current.Minute(), current.Second(), current.Nanosecond(), current.Location())
+		if day <= daysIn(firstOfMonth.Year(), firstOfMonth.Month()) {
+			return firstOfMonth.AddDate(0, 0, day-1), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// addDays suma días de calendario conservando la hora local
+func addDays(t time.Time, days int) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// weekdayOffset retorna la posición del día en una semana que empieza el lunes
+func weekdayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+// daysIn retorna la cantidad de días del mes
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,336 @@
+package domain
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // Zonas IANA embebidas para que los tests no dependan del sistema
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+// TestParseRecurrenceRule_Valid verifica la interpretación y normalización de reglas válidas
+func TestParseRecurrenceRule_Valid(t *testing.T) {
+	cases := map[string]string{
+		"FREQ=DAILY":                                 "FREQ=DAILY",
+		"RRULE:freq=daily;interval=1":                "FREQ=DAILY",
+		"FREQ=DAILY;INTERVAL=3":                      "FREQ=DAILY;INTERVAL=3",
+		"FREQ=WEEKLY;BYDAY=FR,MO":                    "FREQ=WEEKLY;BYDAY=MO,FR",
+		"FREQ=WEEKLY;BYDAY=SU,SA;INTERVAL=2":         "FREQ=WEEKLY;INTERVAL=2;BYDAY=SA,SU",
+		"FREQ=MONTHLY;BYMONTHDAY=31":                 "FREQ=MONTHLY;BYMONTHDAY=31",
+		"FREQ=MONTHLY;COUNT=6":                       "FREQ=MONTHLY;COUNT=6",
+		"FREQ=DAILY;UNTIL=20261231T235959Z":          "FREQ=DAILY;UNTIL=20261231T235959Z",
+		"FREQ=WEEKLY;TZID=America/Santiago;BYDAY=MO": "FREQ=WEEKLY;BYDAY=MO;TZID=America/Santiago",
+	}
+
+	for input, expected := range cases {
+		t.Run(input, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(input)
+			require.NoError(t, err)
+			assert.Equal(t, expected, rule.String())
+		})
+	}
+}
+
+// TestParseRecurrenceRule_Invalid verifica que se rechazan reglas mal formadas o incoherentes
+func TestParseRecurrenceRule_Invalid(t *testing.T) {
+	cases := []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=YEARLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;INTERVAL=abc",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=WEEKLY;BYMONTHDAY=3",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;UNTIL=2026-12-31",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=3;UNTIL=20261231T000000Z",
+		"FREQ=DAILY;TZID=Marte/Olympus",
+		"FREQ=DAILY;BYHOUR=9",
+		"FREQ",
+	}
+
+	for _, input := range cases {
+		t.Run(input, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(input)
+			assert.ErrorIs(t, err, ErrInvalidRecurrence)
+			assert.Nil(t, rule)
+		})
+	}
+}
+
+// TestRecurrenceRule_Next_Daily verifica la recurrencia diaria con intervalo
+func TestRecurrenceRule_Next_Daily(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=DAILY;INTERVAL=2")
+	require.NoError(t, err)
+
+	current := time.Date(2026, 1, 30, 9, 0, 0, 0, time.UTC)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC), next)
+}
+
+// TestRecurrenceRule_Next_Daily_SpringForward verifica que se conserva la hora local al adelantar el reloj
+func TestRecurrenceRule_Next_Daily_SpringForward(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	rule, err := ParseRecurrenceRule("FREQ=DAILY")
+	require.NoError(t, err)
+
+	// 8 de marzo de 2026: EE. UU. pasa de EST (-5) a EDT (-4)
+	current := time.Date(2026, 3, 7, 9, 0, 0, 0, ny)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 3, 8, 9, 0, 0, 0, ny), next)
+	assert.Equal(t, 23*time.Hour, next.Sub(current)) // El día del cambio dura 23 horas
+}
+
+// TestRecurrenceRule_Next_Daily_FallBack verifica que se conserva la hora local al atrasar el reloj
+func TestRecurrenceRule_Next_Daily_FallBack(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	rule, err := ParseRecurrenceRule("FREQ=DAILY")
+	require.NoError(t, err)
+
+	// 1 de noviembre de 2026: EE. UU. pasa de EDT (-4) a EST (-5)
+	current := time.Date(2026, 10, 31, 9, 0, 0, 0, ny)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 11, 1, 9, 0, 0, 0, ny), next)
+	assert.Equal(t, 25*time.Hour, next.Sub(current))
+}
+
+// TestRecurrenceRule_Next_TZIDFromUTC verifica que TZID conserva la hora local aunque la fecha venga en UTC
+func TestRecurrenceRule_Next_TZIDFromUTC(t *testing.T) {
+	santiago := mustLoadLocation(t, "America/Santiago")
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY;TZID=America/Santiago")
+	require.NoError(t, err)
+
+	// Chile atrasa el reloj el 5 de abril de 2026 (de -3 a -4)
+	local := time.Date(2026, 4, 1, 8, 30, 0, 0, santiago)
+	next, ok := rule.Next(local.UTC(), 1)
+
+	assert.True(t, ok)
+	assert.True(t, next.Equal(time.Date(2026, 4, 8, 8, 30, 0, 0, santiago)))
+	assert.Equal(t, 8, next.In(santiago).Hour())
+	assert.Equal(t, 30, next.In(santiago).Minute())
+	assert.Equal(t, 7*24*time.Hour+time.Hour, next.Sub(local))
+}
+
+// TestRecurrenceRule_Next_Daily_NonexistentLocalTime verifica la normalización de una hora que no existe por DST
+func TestRecurrenceRule_Next_Daily_NonexistentLocalTime(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	rule, err := ParseRecurrenceRule("FREQ=DAILY")
+	require.NoError(t, err)
+
+	// 02:30 del 8 de marzo de 2026 no existe en Nueva York
+	current := time.Date(2026, 3, 7, 2, 30, 0, 0, ny)
+	next, ok := rule.Next(current, 1)
+
+	// Go normaliza la hora inexistente con uno de los dos desfases; ambos caen el día 8
+	assert.True(t, ok)
+	assert.Equal(t, 8, next.Day())
+	assert.GreaterOrEqual(t, next.Sub(current), 23*time.Hour)
+	assert.LessOrEqual(t, next.Sub(current), 24*time.Hour)
+}
+
+// TestRecurrenceRule_Next_WeeklyByDay verifica la recurrencia semanal con varios días
+func TestRecurrenceRule_Next_WeeklyByDay(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO,WE,FR")
+	require.NoError(t, err)
+
+	// Lunes 5 de enero de 2026
+	monday := time.Date(2026, 1, 5, 10, 0, 0, 0, time.UTC)
+
+	wednesday, ok := rule.Next(monday, 1)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 1, 7, 10, 0, 0, 0, time.UTC), wednesday)
+
+	friday, ok := rule.Next(wednesday, 2)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 1, 9, 10, 0, 0, 0, time.UTC), friday)
+
+	nextMonday, ok := rule.Next(friday, 3)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 1, 12, 10, 0, 0, 0, time.UTC), nextMonday)
+}
+
+// TestRecurrenceRule_Next_WeeklyInterval verifica que se saltan las semanas fuera del intervalo
+func TestRecurrenceRule_Next_WeeklyInterval(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU")
+	require.NoError(t, err)
+
+	// Martes 6 de enero de 2026: el domingo 11 sigue en la misma semana
+	tuesday := time.Date(2026, 1, 6, 10, 0, 0, 0, time.UTC)
+	sunday, ok := rule.Next(tuesday, 1)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 1, 11, 10, 0, 0, 0, time.UTC), sunday)
+
+	// Desde el domingo se salta una semana completa hasta el martes 20
+	next, ok := rule.Next(sunday, 2)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 1, 20, 10, 0, 0, 0, time.UTC), next)
+}
+
+// TestRecurrenceRule_Next_WeeklyWithoutByDay verifica que sin BYDAY se repite el mismo día
+func TestRecurrenceRule_Next_WeeklyWithoutByDay(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY")
+	require.NoError(t, err)
+
+	current := time.Date(2026, 2, 26, 17, 0, 0, 0, time.UTC)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 3, 5, 17, 0, 0, 0, time.UTC), next)
+}
+
+// TestRecurrenceRule_Next_Weekly_AcrossFallBack verifica la recurrencia semanal cruzando un cambio de horario
+func TestRecurrenceRule_Next_Weekly_AcrossFallBack(t *testing.T) {
+	madrid := mustLoadLocation(t, "Europe/Madrid")
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=MO")
+	require.NoError(t, err)
+
+	// Europa atrasa el reloj el 25 de octubre de 2026
+	current := time.Date(2026, 10, 19, 9, 0, 0, 0, madrid)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 10, 26, 9, 0, 0, 0, madrid), next)
+	assert.Equal(t, 7*24*time.Hour+time.Hour, next.Sub(current))
+}
+
+// TestRecurrenceRule_Next_MonthlySameDay verifica la recurrencia mensual por defecto
+func TestRecurrenceRule_Next_MonthlySameDay(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=MONTHLY")
+	require.NoError(t, err)
+
+	current := time.Date(2026, 12, 15, 8, 0, 0, 0, time.UTC)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2027, 1, 15, 8, 0, 0, 0, time.UTC), next)
+}
+
+// TestRecurrenceRule_Next_MonthlySkipsShortMonths verifica que se omiten los meses sin el día pedido
+func TestRecurrenceRule_Next_MonthlySkipsShortMonths(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=MONTHLY;BYMONTHDAY=31")
+	require.NoError(t, err)
+
+	jan := time.Date(2026, 1, 31, 8, 0, 0, 0, time.UTC)
+	next, ok := rule.Next(jan, 1)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 3, 31, 8, 0, 0, 0, time.UTC), next)
+
+	next, ok = rule.Next(next, 2)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 5, 31, 8, 0, 0, 0, time.UTC), next)
+}
+
+// TestRecurrenceRule_Next_MonthlyLeapDay verifica el 29 de febrero con intervalo mensual
+func TestRecurrenceRule_Next_MonthlyLeapDay(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=MONTHLY;INTERVAL=12;BYMONTHDAY=29")
+	require.NoError(t, err)
+
+	// 2028 es bisiesto; 2029-2031 no, el siguiente es 2032
+	current := time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2032, 2, 29, 12, 0, 0, 0, time.UTC), next)
+}
+
+// TestRecurrenceRule_Next_Monthly_AcrossSpringForward verifica la recurrencia mensual cruzando un cambio de horario
+func TestRecurrenceRule_Next_Monthly_AcrossSpringForward(t *testing.T) {
+	ny := mustLoadLocation(t, "America/New_York")
+	rule, err := ParseRecurrenceRule("FREQ=MONTHLY;BYMONTHDAY=1")
+	require.NoError(t, err)
+
+	current := time.Date(2026, 3, 1, 23, 0, 0, 0, ny)
+	next, ok := rule.Next(current, 1)
+
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 4, 1, 23, 0, 0, 0, ny), next)
+	_, offsetBefore := current.Zone()
+	_, offsetAfter := next.Zone()
+	assert.Equal(t, 3600, offsetAfter-offsetBefore)
+}
+
+// TestRecurrenceRule_Next_Count verifica que COUNT limita el total de ocurrencias
+func TestRecurrenceRule_Next_Count(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=DAILY;COUNT=3")
+	require.NoError(t, err)
+
+	current := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	_, ok := rule.Next(current, 2)
+	assert.True(t, ok)
+
+	_, ok = rule.Next(current, 3)
+	assert.False(t, ok)
+}
+
+// TestRecurrenceRule_Next_Until verifica que UNTIL es inclusivo
+func TestRecurrenceRule_Next_Until(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=DAILY;UNTIL=20260103T090000Z")
+	require.NoError(t, err)
+
+	next, ok := rule.Next(time.Date(2026, 1, 2, 9, 0, 0, 0, time.UTC), 1)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2026, 1, 3, 9, 0, 0, 0, time.UTC), next)
+
+	_, ok = rule.Next(next, 2)
+	assert.False(t, ok)
+}
+
+// TestTask_SetSchedule_RequiresDueDate verifica que una tarea recurrente necesita fecha de vencimiento
+func TestTask_SetSchedule_RequiresDueDate(t *testing.T) {
+	task := NewTask("Reporte", "Semanal")
+
+	err := task.SetSchedule(nil, "FREQ=WEEKLY")
+
+	assert.ErrorIs(t, err, ErrInvalidRecurrence)
+	assert.Empty(t, task.Recurrence)
+}
+
+// TestTask_NextOccurrence verifica que la siguiente ocurrencia avanza la fecha y el contador
+func TestTask_NextOccurrence(t *testing.T) {
+	due := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	task := NewTask("Reporte", "Semanal")
+	require.NoError(t, task.SetSchedule(&due, "freq=weekly;count=2"))
+	assert.Equal(t, "FREQ=WEEKLY;COUNT=2", task.Recurrence)
+
+	next, err := task.NextOccurrence()
+	require.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, 0, next.ID)
+	assert.Equal(t, task.Title, next.Title)
+	assert.Equal(t, task.Recurrence, next.Recurrence)
+	assert.Equal(t, 2, next.Occurrence)
+	assert.Equal(t, time.Date(2026, 1, 12, 9, 0, 0, 0, time.UTC), *next.DueDate)
+	assert.False(t, next.Completed)
+
+	// Con COUNT=2 la segunda ocurrencia es la última
+	last, err := next.NextOccurrence()
+	assert.NoError(t, err)
+	assert.Nil(t, last)
+}
+
+// TestTask_NextOccurrence_NotRecurring verifica que una tarea sin regla no genera ocurrencias
+func TestTask_NextOccurrence_NotRecurring(t *testing.T) {
+	task := NewTask("Única", "Sin recurrencia")
+
+	next, err := task.NextOccurrence()
+
+	assert.NoError(t, err)
+	assert.Nil(t, next)
+}
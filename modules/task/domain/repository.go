@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 //go:generate mockgen -source=repository.go -destination=../application/mocks/mock_task_repository.go -package=mocks
@@ -11,14 +12,46 @@ import (
 type TaskRepository interface {
 	// Create guarda una nueva tarea en el repositorio
 	Create(ctx context.Context, task *Task) (*Task, error)
-	// GetByID obtiene una tarea por si ID
+	// GetByID obtiene una tarea por si ID, esté archivada o no
 	GetByID(ctx context.Context, id int) (*Task, error)
-	// GetAll obtiene todas las tareas
-	GetAll(ctx context.Context) ([]*Task, error)
-	// Update actualiza una tarea por su id
+	// GetAll obtiene todas las tareas; las archivadas solo si opts.IncludeArchived
+	GetAll(ctx context.Context, opts TaskQueryOptions) ([]*Task, error)
+	// Update actualiza una tarea por su id si su versión sigue siendo task.Version;
+	// retorna ErrVersionConflict si otra escritura la modificó. Las tareas archivadas no se modifican
 	Update(ctx context.Context, task *Task) (*Task, error)
-	// Delete elimina una tarea por su id
+	// Delete mueve una tarea a la papelera; las consultas anteriores excluyen las tareas en la papelera
 	Delete(ctx context.Context, id int) error
-	// GetByStatus obtiene tareas por su estado
-	GetByStatus(ctx context.Context, completed bool) ([]*Task, error)
+	// Restore saca una tarea de la papelera; no incrementa la versión, el servicio guarda la
+	// restauración como una actualización de la tarea
+	Restore(ctx context.Context, id int) error
+	// GetDeleted obtiene las tareas que están en la papelera
+	GetDeleted(ctx context.Context) ([]*Task, error)
+	// GetDeletedByID obtiene una tarea de la papelera; retorna ErrTaskNotInTrash si no está en ella
+	GetDeletedByID(ctx context.Context, id int) (*Task, error)
+	// DeletePermanently elimina definitivamente una tarea que está en la papelera
+	DeletePermanently(ctx context.Context, id int) error
+	// PurgeDeleted elimina definitivamente las tareas enviadas a la papelera antes de before y retorna sus IDs
+	PurgeDeleted(ctx context.Context, before time.Time) ([]int, error)
+	// GetByStatus obtiene tareas por su estado; las archivadas solo si opts.IncludeArchived
+	GetByStatus(ctx context.Context, completed bool, opts TaskQueryOptions) ([]*Task, error)
+	// Archive archiva una tarea completada; no incrementa la versión, el servicio guarda el
+	// archivado como una actualización de la tarea
+	Archive(ctx context.Context, id int) error
+	// Unarchive saca una tarea del archivo; no incrementa la versión, el servicio guarda el
+	// desarchivado como una actualización de la tarea
+	Unarchive(ctx context.Context, id int) error
+	// GetCompletedBefore obtiene las tareas completadas antes de before que no están archivadas
+	GetCompletedBefore(ctx context.Context, before time.Time) ([]*Task, error)
+	// GetAdjacentRank obtiene el rank inmediatamente anterior (o siguiente si next) a rank, sin contar
+	// la tarea excludeID ni las de la papelera; found es false si no hay ninguna tarea en esa dirección
+	GetAdjacentRank(ctx context.Context, rank string, excludeID int, next bool) (adjacent string, found bool, err error)
+	// UpdateRank cambia la posición de una tarea en el orden manual; no incrementa la versión, el
+	// servicio guarda el movimiento como una actualización de la tarea
+	UpdateRank(ctx context.Context, id int, rank string) error
+	// RebalanceRanks reparte ranks equidistantes entre las tareas que no están en la papelera manteniendo su orden
+	RebalanceRanks(ctx context.Context) error
+	// ApplyBatch aplica las escrituras de un lote en una transacción, con una sentencia por tipo de
+	// escritura, y guarda sus revisiones. Si alguna tarea cambió desde que se leyó retorna
+	// *BatchConflictError y no aplica ninguna escritura
+	ApplyBatch(ctx context.Context, batch *TaskBatch) error
 }
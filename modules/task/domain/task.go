@@ -1,39 +1,73 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // Task representa una tarea en el sistema
 type Task struct {
-	ID          int       `json:"id" db:"id"`
-	Title       string    `json:"title" db:"title"`
-	Description string    `json:"description" db:"description"`
-	Completed   bool      `json:"completed" db:"completed"`
-	CreatedAt   time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+	ID             int            `json:"id" db:"id"`
+	Title          string         `json:"title" db:"title"`
+	Description    string         `json:"description" db:"description"`
+	Completed      bool           `json:"completed" db:"completed"`
+	Blocked        bool           `json:"blocked" db:"blocked"` // Calculado: tiene bloqueadores sin completar
+	DueDate        *time.Time     `json:"due_date,omitempty" db:"due_date"`
+	Recurrence     string         `json:"recurrence,omitempty" db:"recurrence"`       // Regla RRULE normalizada
+	Occurrence     int            `json:"occurrence,omitempty" db:"occurrence"`       // Número de ocurrencia (base 1)
+	Assignees      []int          `json:"assignees" db:"assignees"`                   // IDs de los usuarios responsables
+	Version        int            `json:"version" db:"version"`                       // Se incrementa en cada escritura (control de concurrencia optimista)
+	Rank           string         `json:"rank" db:"rank"`                             // Posición en el orden manual (lexicográfico)
+	StoryPoints    int            `json:"story_points" db:"story_points"`             // Estimación en puntos (0 = sin estimar)
+	EstimatedHours float64        `json:"estimated_hours" db:"estimated_hours"`       // Estimación en horas (0 = sin estimar)
+	RemainingHours float64        `json:"remaining_hours" db:"remaining_hours"`       // Trabajo pendiente en horas
+	CustomFields   map[string]any `json:"custom_fields,omitempty" db:"custom_fields"` // Valores de campos personalizados por clave
+	CompletedAt    *time.Time     `json:"completed_at,omitempty" db:"completed_at"`
+	ArchivedAt     *time.Time     `json:"archived_at,omitempty" db:"archived_at"` // Archivada (solo lectura) desde esta fecha
+	CreatedAt      time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at" db:"updated_at"`
+	DeletedAt      *time.Time     `json:"deleted_at,omitempty" db:"deleted_at"` // En la papelera desde esta fecha
+
+	// events son los eventos de dominio pendientes de publicar
+	events []Event
 }
 
 // NewTask crea una nueva instancia de Task
 func NewTask(title, description string) *Task {
 	// agregar el tiempo en UTC
 	now := time.Now().UTC()
-	return &Task{
+	task := &Task{
 		Title:       title,
 		Description: description,
 		Completed:   false,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
+	task.RecordEvent(EventTaskCreated)
+	return task
 }
 
 // MarkAsCompleted marca la tarea como completada
 func (t *Task) MarkAsCompleted() {
+	now := time.Now().UTC()
+	if !t.Completed {
+		t.RecordEvent(EventTaskCompleted)
+	}
+	if !t.Completed || t.CompletedAt == nil {
+		t.CompletedAt = &now
+	}
 	t.Completed = true
-	t.UpdatedAt = time.Now().UTC()
+	t.RemainingHours = 0
+	t.UpdatedAt = now
 }
 
 // MarkAsUncompleted marca la tarea como incompleta
 func (t *Task) MarkAsUncompleted() {
+	if t.Completed {
+		t.RecordEvent(EventTaskReopened)
+	}
 	t.Completed = false
+	t.CompletedAt = nil
 	t.UpdatedAt = time.Now().UTC()
 }
 
@@ -46,9 +80,61 @@ func (t *Task) Update(title, description string) {
 		t.Description = description
 	}
 	t.UpdatedAt = time.Now().UTC()
+	t.RecordEvent(EventTaskUpdated)
 }
 
 // IsValid valida que la tarea tenga los campos requeridos
 func (t *Task) IsValid() bool {
 	return t.Title != "" && t.Description != ""
 }
+
+// SetSchedule asigna la fecha de vencimiento y la regla de recurrencia.
+// Una tarea recurrente necesita fecha de vencimiento para calcular la siguiente ocurrencia.
+func (t *Task) SetSchedule(dueDate *time.Time, recurrence string) error {
+	if recurrence != "" {
+		if dueDate == nil {
+			return fmt.Errorf("%w: una tarea recurrente requiere fecha de vencimiento", ErrInvalidRecurrence)
+		}
+		rule, err := ParseRecurrenceRule(recurrence)
+		if err != nil {
+			return err
+		}
+		recurrence = rule.String()
+	}
+
+	t.DueDate = dueDate
+	t.Recurrence = recurrence
+	if recurrence != "" && t.Occurrence == 0 {
+		t.Occurrence = 1
+	}
+	t.UpdatedAt = time.Now().UTC()
+	t.RecordEvent(EventTaskUpdated)
+	return nil
+}
+
+// NextOccurrence crea la siguiente ocurrencia de una tarea recurrente con la
+// fecha de vencimiento desplazada. Retorna nil si la tarea no es recurrente o
+// si la regla ya no genera más ocurrencias.
+func (t *Task) NextOccurrence() (*Task, error) {
+	if t.Recurrence == "" || t.DueDate == nil {
+		return nil, nil
+	}
+
+	rule, err := ParseRecurrenceRule(t.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence := max(t.Occurrence, 1)
+	nextDue, ok := rule.Next(*t.DueDate, occurrence)
+	if !ok {
+		return nil, nil
+	}
+
+	next := NewTask(t.Title, t.Description)
+	nextDue = nextDue.UTC()
+	next.DueDate = &nextDue
+	next.Recurrence = t.Recurrence
+	next.Occurrence = occurrence + 1
+	return next, nil
+}
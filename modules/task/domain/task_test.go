@@ -57,6 +57,35 @@ func TestTask_MarkAsCompleted(t *testing.T) {
 	// Assert
 	assert.True(t, task.Completed)
 	assert.True(t, task.UpdatedAt.After(originalUpdatedAt))
+	assert.NotNil(t, task.CompletedAt)
+}
+
+// TestTask_MarkAsCompleted_KeepsCompletedAt verifica que completar de nuevo no cambia la fecha de completado
+func TestTask_MarkAsCompleted_KeepsCompletedAt(t *testing.T) {
+	// Arrange
+	task := NewTask("Test", "Description")
+	task.MarkAsCompleted()
+	completedAt := *task.CompletedAt
+
+	time.Sleep(1 * time.Millisecond)
+
+	// Act
+	task.MarkAsCompleted()
+
+	// Assert
+	assert.Equal(t, completedAt, *task.CompletedAt)
+}
+
+// TestTask_EnsureWritable verifica que una tarea archivada es de solo lectura
+func TestTask_EnsureWritable(t *testing.T) {
+	task := NewTask("Test", "Description")
+	assert.NoError(t, task.EnsureWritable())
+
+	now := time.Now().UTC()
+	task.ArchivedAt = &now
+
+	assert.True(t, task.IsArchived())
+	assert.ErrorIs(t, task.EnsureWritable(), ErrTaskArchived)
 }
 
 // TestTask_MarkAsUncompleted verifica marcar tarea como incompleta
@@ -75,6 +104,7 @@ func TestTask_MarkAsUncompleted(t *testing.T) {
 	// Assert
 	assert.False(t, task.Completed)
 	assert.True(t, task.UpdatedAt.After(originalUpdatedAt))
+	assert.Nil(t, task.CompletedAt)
 }
 
 // TestTask_Update_BothFields verifica actualización de ambos campos
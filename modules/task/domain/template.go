@@ -0,0 +1,220 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidTemplate indica que la plantilla no es válida
+	ErrInvalidTemplate = errors.New("la plantilla requiere nombre y tareas con título y descripción o checklist")
+	// ErrTemplateNotFound indica que la plantilla no existe
+	ErrTemplateNotFound = errors.New("la plantilla no existe")
+	// ErrMissingTemplateVariable indica que faltan valores para variables de la plantilla
+	ErrMissingTemplateVariable = errors.New("faltan variables de la plantilla")
+)
+
+const (
+	// MaxTemplateTasks es la cantidad máxima de tareas de una plantilla, contando las hijas
+	MaxTemplateTasks = 100
+	// MaxTemplateDepth es la profundidad máxima del árbol de tareas
+	MaxTemplateDepth = 5
+	// maxTemplateDueDays limita el vencimiento relativo a unos diez años
+	maxTemplateDueDays = 3650
+)
+
+// templatePlaceholder reconoce las variables {{nombre}} en los textos de una plantilla
+var templatePlaceholder = regexp.MustCompile(`\{\{\s*([a-z][a-z0-9_]*)\s*\}\}`)
+
+// TemplateTask es una tarea de la plantilla; sus hijas bloquean a la tarea al instanciarla
+type TemplateTask struct {
+	Title          string         `json:"title"`
+	Description    string         `json:"description,omitempty"`
+	DueInDays      *int           `json:"due_in_days,omitempty"` // Días desde la fecha de inicio
+	StoryPoints    int            `json:"story_points,omitempty"`
+	EstimatedHours float64        `json:"estimated_hours,omitempty"`
+	Checklist      []string       `json:"checklist,omitempty"`     // Se agrega a la descripción como lista de casillas
+	CustomFields   map[string]any `json:"custom_fields,omitempty"` // Valores por defecto de campos personalizados
+	Children       []TemplateTask `json:"children,omitempty"`
+}
+
+// TaskTemplate describe un conjunto de tareas que se crea de una vez
+type TaskTemplate struct {
+	ID          int            `json:"id" db:"id"`
+	Name        string         `json:"name" db:"name"`
+	Description string         `json:"description" db:"description"`
+	Variables   []string       `json:"variables" db:"-"` // Calculado: variables usadas en los textos
+	Tasks       []TemplateTask `json:"tasks" db:"tasks"`
+	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at" db:"updated_at"`
+}
+
+// PlannedTask es una tarea lista para crearse al instanciar una plantilla
+type PlannedTask struct {
+	Task     *Task
+	Parent   int                // Índice de la tarea padre en el plan (-1 si es raíz)
+	Fields   map[string]any     // Campos personalizados con las variables ya reemplazadas
+	Values   []CustomFieldValue // Fields normalizados con sus definiciones (los completa el servicio)
+	Revision *TaskRevision      // Revisión de creación (opcional)
+}
+
+// NewTaskTemplate crea una plantilla validando nombre, tamaño y profundidad del árbol
+func NewTaskTemplate(name, description string, tasks []TemplateTask) (*TaskTemplate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(tasks) == 0 {
+		return nil, ErrInvalidTemplate
+	}
+
+	count := 0
+	if err := validateTemplateTasks(tasks, 1, &count); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	template := &TaskTemplate{
+		Name:        name,
+		Description: strings.TrimSpace(description),
+		Tasks:       tasks,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	template.Variables = template.collectVariables()
+	return template, nil
+}
+
+// validateTemplateTasks valida recursivamente las tareas y cuenta el total
+func validateTemplateTasks(tasks []TemplateTask, depth int, count *int) error {
+	if depth > MaxTemplateDepth {
+		return fmt.Errorf("%w: máximo %d niveles de tareas", ErrInvalidTemplate, MaxTemplateDepth)
+	}
+	for i := range tasks {
+		task := &tasks[i]
+		*count++
+		if *count > MaxTemplateTasks {
+			return fmt.Errorf("%w: máximo %d tareas", ErrInvalidTemplate, MaxTemplateTasks)
+		}
+		task.Title = strings.TrimSpace(task.Title)
+		if task.Title == "" || (strings.TrimSpace(task.Description) == "" && len(task.Checklist) == 0) {
+			return ErrInvalidTemplate
+		}
+		if task.StoryPoints < 0 || task.EstimatedHours < 0 {
+			return fmt.Errorf("%w: %s", ErrInvalidEstimate, task.Title)
+		}
+		if task.DueInDays != nil && (*task.DueInDays < -maxTemplateDueDays || *task.DueInDays > maxTemplateDueDays) {
+			return fmt.Errorf("%w: due_in_days fuera de rango en %s", ErrInvalidTemplate, task.Title)
+		}
+		for _, item := range task.Checklist {
+			if strings.TrimSpace(item) == "" {
+				return fmt.Errorf("%w: elemento de checklist vacío en %s", ErrInvalidTemplate, task.Title)
+			}
+		}
+		if err := validateTemplateTasks(task.Children, depth+1, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// collectVariables obtiene las variables usadas en la plantilla, ordenadas y sin repetir
+func (t *TaskTemplate) collectVariables() []string {
+	found := map[string]bool{}
+	var walk func(tasks []TemplateTask)
+	collect := func(text string) {
+		for _, match := range templatePlaceholder.FindAllStringSubmatch(text, -1) {
+			found[match[1]] = true
+		}
+	}
+	walk = func(tasks []TemplateTask) {
+		for _, task := range tasks {
+			collect(task.Title)
+			collect(task.Description)
+			for _, item := range task.Checklist {
+				collect(item)
+			}
+			for _, value := range task.CustomFields {
+				if text, ok := value.(string); ok {
+					collect(text)
+				}
+			}
+			walk(task.Children)
+		}
+	}
+	walk(t.Tasks)
+	return slices.Sorted(maps.Keys(found))
+}
+
+// Refresh recalcula los datos derivados de una plantilla leída del almacenamiento
+func (t *TaskTemplate) Refresh() {
+	t.Variables = t.collectVariables()
+}
+
+// Plan crea las tareas de la plantilla en orden (cada padre antes que sus hijas), reemplazando
+// las variables y calculando los vencimientos desde el día de start (UTC)
+func (t *TaskTemplate) Plan(variables map[string]string, start time.Time) ([]*PlannedTask, error) {
+	var missing []string
+	for _, name := range t.collectVariables() {
+		if _, ok := variables[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingTemplateVariable, strings.Join(missing, ", "))
+	}
+
+	render := func(text string) string {
+		return templatePlaceholder.ReplaceAllStringFunc(text, func(match string) string {
+			return variables[templatePlaceholder.FindStringSubmatch(match)[1]]
+		})
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	var planned []*PlannedTask
+	var walk func(tasks []TemplateTask, parent int) error
+	walk = func(tasks []TemplateTask, parent int) error {
+		for _, item := range tasks {
+			description := strings.TrimSpace(render(item.Description))
+			if len(item.Checklist) > 0 {
+				var checklist strings.Builder
+				for _, entry := range item.Checklist {
+					checklist.WriteString("\n- [ ] " + render(strings.TrimSpace(entry)))
+				}
+				description = strings.TrimSpace(description + "\n" + checklist.String())
+			}
+
+			task := NewTask(strings.TrimSpace(render(item.Title)), description)
+			if !task.IsValid() {
+				return fmt.Errorf("%w: una variable dejó vacío el título o la descripción", ErrInvalidTemplate)
+			}
+			if err := task.SetEstimate(item.StoryPoints, item.EstimatedHours); err != nil {
+				return err
+			}
+			if item.DueInDays != nil {
+				due := start.AddDate(0, 0, *item.DueInDays)
+				task.DueDate = &due
+			}
+
+			values := make(map[string]any, len(item.CustomFields))
+			for key, value := range item.CustomFields {
+				if text, ok := value.(string); ok {
+					value = render(text)
+				}
+				values[key] = value
+			}
+
+			planned = append(planned, &PlannedTask{Task: task, Parent: parent, Fields: values})
+			if err := walk(item.Children, len(planned)-1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(t.Tasks, -1); err != nil {
+		return nil, err
+	}
+	return planned, nil
+}
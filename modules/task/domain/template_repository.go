@@ -0,0 +1,20 @@
+package domain
+
+import "context"
+
+//go:generate mockgen -source=template_repository.go -destination=../application/mocks/mock_template_repository.go -package=mocks
+
+// TemplateRepository define el contrato para las plantillas de tareas
+type TemplateRepository interface {
+	// Create guarda una plantilla nueva
+	Create(ctx context.Context, template *TaskTemplate) (*TaskTemplate, error)
+	// GetByID obtiene una plantilla; ErrTemplateNotFound si no existe
+	GetByID(ctx context.Context, id int) (*TaskTemplate, error)
+	// List obtiene las plantillas ordenadas por nombre
+	List(ctx context.Context) ([]*TaskTemplate, error)
+	// Delete elimina una plantilla; las tareas ya creadas se conservan
+	Delete(ctx context.Context, id int) error
+	// Instantiate crea las tareas del plan en una transacción: cada hija bloquea a su padre y
+	// se guardan sus campos personalizados y revisiones; si algo falla no se crea ninguna
+	Instantiate(ctx context.Context, plan []*PlannedTask) ([]*Task, error)
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewTaskTemplate verifica la validación y las variables detectadas
+func TestNewTaskTemplate(t *testing.T) {
+	days := 3
+	template, err := NewTaskTemplate("Onboarding", "", []TemplateTask{{
+		Title:        "Onboarding de {{name}}",
+		Description:  "Bienvenida al equipo {{ team }}",
+		CustomFields: map[string]any{"owner_name": "{{manager}}"},
+		Children: []TemplateTask{
+			{Title: "Crear cuenta para {{name}}", Checklist: []string{"Correo", "VPN"}, DueInDays: &days},
+		},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"manager", "name", "team"}, template.Variables)
+
+	_, err = NewTaskTemplate("", "", []TemplateTask{{Title: "T", Description: "D"}})
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+	_, err = NewTaskTemplate("Vacía", "", nil)
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+	_, err = NewTaskTemplate("Sin descripción", "", []TemplateTask{{Title: "T"}})
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+
+	// Árbol más profundo que MaxTemplateDepth
+	deep := TemplateTask{Title: "T", Description: "D"}
+	for i := 0; i < MaxTemplateDepth; i++ {
+		deep = TemplateTask{Title: "T", Description: "D", Children: []TemplateTask{deep}}
+	}
+	_, err = NewTaskTemplate("Profunda", "", []TemplateTask{deep})
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+}
+
+// TestTaskTemplate_Plan verifica el reemplazo de variables, el orden padre-hija y los vencimientos relativos
+func TestTaskTemplate_Plan(t *testing.T) {
+	days := 2
+	template, err := NewTaskTemplate("Onboarding", "", []TemplateTask{{
+		Title:          "Onboarding de {{name}}",
+		Description:    "Bienvenida",
+		EstimatedHours: 4,
+		CustomFields:   map[string]any{"owner_name": "{{name}}", "size": 3.0},
+		Children: []TemplateTask{
+			{Title: "Cuenta de {{name}}", Checklist: []string{"Correo {{name}}"}, DueInDays: &days},
+			{Title: "Equipo", Description: "Laptop"},
+		},
+	}})
+	assert.NoError(t, err)
+
+	_, err = template.Plan(map[string]string{}, time.Now())
+	assert.ErrorIs(t, err, ErrMissingTemplateVariable)
+
+	start := time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC)
+	plan, err := template.Plan(map[string]string{"name": "Ana"}, start)
+	assert.NoError(t, err)
+	assert.Len(t, plan, 3)
+
+	assert.Equal(t, "Onboarding de Ana", plan[0].Task.Title)
+	assert.Equal(t, -1, plan[0].Parent)
+	assert.Equal(t, 4.0, plan[0].Task.RemainingHours)
+	assert.Equal(t, map[string]any{"owner_name": "Ana", "size": 3.0}, plan[0].Fields)
+	assert.Nil(t, plan[0].Task.DueDate)
+
+	assert.Equal(t, 0, plan[1].Parent)
+	assert.True(t, strings.HasSuffix(plan[1].Task.Description, "- [ ] Correo Ana"))
+	assert.Equal(t, time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC), *plan[1].Task.DueDate)
+	assert.Equal(t, 0, plan[2].Parent)
+
+	// Una variable vacía que deja el título en blanco invalida la instanciación
+	blank, _ := NewTaskTemplate("Solo variable", "", []TemplateTask{{Title: "{{name}}", Description: "D"}})
+	_, err = blank.Plan(map[string]string{"name": " "}, start)
+	assert.ErrorIs(t, err, ErrInvalidTemplate)
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidTimeEntry indica que el registro de tiempo no tiene tarea, usuario o duración válidos
+	ErrInvalidTimeEntry = errors.New("el registro de tiempo requiere tarea, usuario y una duración positiva")
+	// ErrTimerAlreadyRunning indica que el usuario ya tiene un temporizador en curso
+	ErrTimerAlreadyRunning = errors.New("el usuario ya tiene un temporizador en curso")
+	// ErrNoRunningTimer indica que el usuario no tiene un temporizador en curso
+	ErrNoRunningTimer = errors.New("el usuario no tiene un temporizador en curso")
+	// ErrInvalidProject indica que no se indicó el proyecto cuyo tiempo se consulta
+	ErrInvalidProject = errors.New("el proyecto es requerido")
+	// ErrInvalidTimeReport indica que el rango o la agrupación del reporte no son válidos
+	ErrInvalidTimeReport = errors.New("el reporte requiere un rango válido y agrupar por day, user, task o project")
+)
+
+// TimeEntry es un registro del tiempo que un usuario dedicó a una tarea;
+// mientras EndedAt es nil el temporizador está en curso
+type TimeEntry struct {
+	ID              int        `json:"id" db:"id"`
+	TaskID          int        `json:"task_id" db:"task_id"`
+	UserID          int        `json:"user_id" db:"user_id"`
+	StartedAt       time.Time  `json:"started_at" db:"started_at"`
+	EndedAt         *time.Time `json:"ended_at,omitempty" db:"ended_at"`
+	DurationSeconds int64      `json:"duration_seconds" db:"duration_seconds"`
+	Note            string     `json:"note" db:"note"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+}
+
+// StartTimeEntry crea un registro en curso que empieza ahora
+func StartTimeEntry(taskID, userID int) (*TimeEntry, error) {
+	if taskID == 0 || userID == 0 {
+		return nil, ErrInvalidTimeEntry
+	}
+	now := time.Now().UTC()
+	return &TimeEntry{
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: now,
+		CreatedAt: now,
+	}, nil
+}
+
+// NewManualTimeEntry crea un registro cerrado con la duración indicada; el inicio se redondea al segundo
+func NewManualTimeEntry(taskID, userID int, startedAt time.Time, duration time.Duration, note string) (*TimeEntry, error) {
+	seconds := int64(duration / time.Second)
+	if taskID == 0 || userID == 0 || seconds <= 0 {
+		return nil, ErrInvalidTimeEntry
+	}
+	startedAt = startedAt.UTC().Truncate(time.Second)
+	endedAt := startedAt.Add(time.Duration(seconds) * time.Second)
+	return &TimeEntry{
+		TaskID:          taskID,
+		UserID:          userID,
+		StartedAt:       startedAt,
+		EndedAt:         &endedAt,
+		DurationSeconds: seconds,
+		Note:            strings.TrimSpace(note),
+		CreatedAt:       time.Now().UTC(),
+	}, nil
+}
+
+// IsRunning indica si el temporizador sigue en curso
+func (e *TimeEntry) IsRunning() bool {
+	return e.EndedAt == nil
+}
+
+// Stop cierra el registro en curso calculando su duración en segundos
+func (e *TimeEntry) Stop(at time.Time) error {
+	if !e.IsRunning() {
+		return ErrNoRunningTimer
+	}
+	at = at.UTC()
+	if at.Before(e.StartedAt) {
+		at = e.StartedAt
+	}
+	e.EndedAt = &at
+	e.DurationSeconds = int64(at.Sub(e.StartedAt) / time.Second)
+	return nil
+}
+
+// TimeReportGroup es la dimensión por la que se agrupa el reporte de tiempo
+type TimeReportGroup string
+
+const (
+	// TimeReportByDay agrupa por día de inicio (UTC, YYYY-MM-DD)
+	TimeReportByDay TimeReportGroup = "day"
+	// TimeReportByUser agrupa por usuario
+	TimeReportByUser TimeReportGroup = "user"
+	// TimeReportByTask agrupa por tarea
+	TimeReportByTask TimeReportGroup = "task"
+	// TimeReportByProject agrupa por el campo personalizado project; las tareas sin proyecto quedan
+	// en la clave vacía
+	TimeReportByProject TimeReportGroup = "project"
+)
+
+// IsValid verifica que la agrupación sea conocida
+func (g TimeReportGroup) IsValid() bool {
+	return g == TimeReportByDay || g == TimeReportByUser || g == TimeReportByTask || g == TimeReportByProject
+}
+
+// TimeReportRow es el tiempo acumulado de un grupo del reporte
+type TimeReportRow struct {
+	Key     string `json:"key"`
+	Seconds int64  `json:"seconds"`
+	Entries int    `json:"entries"`
+}
+
+// TimeReport es el tiempo registrado en un rango agrupado por una dimensión
+type TimeReport struct {
+	From         time.Time       `json:"from"`
+	To           time.Time       `json:"to"`
+	GroupBy      TimeReportGroup `json:"group_by"`
+	Rows         []TimeReportRow `json:"rows"`
+	TotalSeconds int64           `json:"total_seconds"`
+}
+
+// NewTimeReport arma el reporte a partir de las filas agregadas y calcula el total
+func NewTimeReport(from, to time.Time, groupBy TimeReportGroup, rows []TimeReportRow) *TimeReport {
+	report := &TimeReport{From: from, To: to, GroupBy: groupBy, Rows: rows}
+	if report.Rows == nil {
+		report.Rows = []TimeReportRow{}
+	}
+	for _, row := range report.Rows {
+		report.TotalSeconds += row.Seconds
+	}
+	return report
+}
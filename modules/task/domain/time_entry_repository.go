@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=time_entry_repository.go -destination=../application/mocks/mock_time_entry_repository.go -package=mocks
+
+// TimeEntryRepository define el contrato para persistir registros de tiempo
+type TimeEntryRepository interface {
+	// Create guarda un registro de tiempo manual
+	Create(ctx context.Context, entry *TimeEntry) (*TimeEntry, error)
+	// StartTimer guarda un registro en curso; retorna ErrTimerAlreadyRunning si el usuario ya tiene uno
+	StartTimer(ctx context.Context, entry *TimeEntry) (*TimeEntry, error)
+	// GetRunning obtiene el registro en curso del usuario o ErrNoRunningTimer
+	GetRunning(ctx context.Context, userID int) (*TimeEntry, error)
+	// Finish guarda el fin y la duración de un registro en curso
+	Finish(ctx context.Context, entry *TimeEntry) error
+	// ListByTask obtiene los registros de una tarea del más reciente al más antiguo
+	ListByTask(ctx context.Context, taskID int) ([]*TimeEntry, error)
+	// TaskTotal suma los segundos registrados en una tarea
+	TaskTotal(ctx context.Context, taskID int) (int64, error)
+	// BoardTotal suma los segundos registrados en las tareas de un tablero
+	BoardTotal(ctx context.Context, boardID int) (int64, error)
+	// ProjectTotal suma los segundos registrados en las tareas cuyo campo project tiene ese valor
+	ProjectTotal(ctx context.Context, project string) (int64, error)
+	// Report agrega los registros cerrados que empezaron en [from, to) por día, usuario, tarea o proyecto
+	Report(ctx context.Context, from, to time.Time, groupBy TimeReportGroup) ([]TimeReportRow, error)
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewManualTimeEntry verifica la duración en segundos y el fin calculado
+func TestNewManualTimeEntry(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 500, time.UTC)
+
+	entry, err := NewManualTimeEntry(1, 2, start, 90*time.Minute, " reunión ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5400), entry.DurationSeconds)
+	assert.Equal(t, time.Date(2026, 3, 2, 10, 30, 0, 0, time.UTC), *entry.EndedAt)
+	assert.Equal(t, "reunión", entry.Note)
+	assert.False(t, entry.IsRunning())
+
+	_, err = NewManualTimeEntry(1, 2, start, 500*time.Millisecond, "")
+	assert.ErrorIs(t, err, ErrInvalidTimeEntry)
+	_, err = NewManualTimeEntry(1, 0, start, time.Hour, "")
+	assert.ErrorIs(t, err, ErrInvalidTimeEntry)
+}
+
+// TestTimeEntry_Stop verifica que detener calcula la duración una sola vez
+func TestTimeEntry_Stop(t *testing.T) {
+	entry, err := StartTimeEntry(1, 2)
+	assert.NoError(t, err)
+	assert.True(t, entry.IsRunning())
+
+	assert.NoError(t, entry.Stop(entry.StartedAt.Add(25*time.Minute)))
+	assert.Equal(t, int64(1500), entry.DurationSeconds)
+	assert.ErrorIs(t, entry.Stop(time.Now()), ErrNoRunningTimer)
+}
+
+// TestNewTimeReport verifica el total del reporte
+func TestNewTimeReport(t *testing.T) {
+	report := NewTimeReport(time.Time{}, time.Time{}, TimeReportByUser, []TimeReportRow{{Key: "1", Seconds: 60}, {Key: "2", Seconds: 30}})
+
+	assert.Equal(t, int64(90), report.TotalSeconds)
+	assert.NotNil(t, NewTimeReport(time.Time{}, time.Time{}, TimeReportByDay, nil).Rows)
+	assert.False(t, TimeReportGroup("week").IsValid())
+}
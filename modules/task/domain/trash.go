@@ -0,0 +1,6 @@
+package domain
+
+import "errors"
+
+// ErrTaskNotInTrash indica que la tarea no existe o no está en la papelera
+var ErrTaskNotInTrash = errors.New("la tarea no está en la papelera")
@@ -0,0 +1,89 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTaskRepository_ArchiveAndUnarchive(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	pending, err := repo.Create(ctx, &domain.Task{Title: "Pendiente", Description: "D"})
+	require.NoError(t, err)
+	done := &domain.Task{Title: "Hecha", Description: "D"}
+	done.MarkAsCompleted()
+	done, err = repo.Create(ctx, done)
+	require.NoError(t, err)
+
+	// Solo se archivan tareas completadas
+	require.ErrorIs(t, repo.Archive(ctx, pending.ID), domain.ErrArchiveNotCompleted)
+	require.NoError(t, repo.Archive(ctx, done.ID))
+
+	// Los listados excluyen las archivadas salvo que se pidan
+	all, err := repo.GetAll(ctx, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	all, err = repo.GetAll(ctx, domain.TaskQueryOptions{IncludeArchived: true})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	completed, err := repo.GetByStatus(ctx, true, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Empty(t, completed)
+	completed, err = repo.GetByStatus(ctx, true, domain.TaskQueryOptions{IncludeArchived: true})
+	require.NoError(t, err)
+	require.Len(t, completed, 1)
+
+	// La tarea archivada se puede consultar pero no modificar
+	archived, err := repo.GetByID(ctx, done.ID)
+	require.NoError(t, err)
+	require.NotNil(t, archived.ArchivedAt)
+	require.NotNil(t, archived.CompletedAt)
+	archived.Title = "Cambiada"
+	_, err = repo.Update(ctx, archived)
+	require.Error(t, err)
+
+	require.NoError(t, repo.Unarchive(ctx, done.ID))
+	require.ErrorIs(t, repo.Unarchive(ctx, done.ID), domain.ErrTaskNotArchived)
+	unarchived, err := repo.GetByID(ctx, done.ID)
+	require.NoError(t, err)
+	require.Nil(t, unarchived.ArchivedAt)
+}
+
+func TestSQLiteTaskRepository_GetCompletedBefore(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	oldTask := &domain.Task{Title: "Vieja", Description: "D"}
+	oldTask.MarkAsCompleted()
+	oldCompletedAt := time.Now().UTC().AddDate(0, 0, -10)
+	oldTask.CompletedAt = &oldCompletedAt
+	_, err := repo.Create(ctx, oldTask)
+	require.NoError(t, err)
+
+	recent := &domain.Task{Title: "Reciente", Description: "D"}
+	recent.MarkAsCompleted()
+	_, err = repo.Create(ctx, recent)
+	require.NoError(t, err)
+
+	_, err = repo.Create(ctx, &domain.Task{Title: "Pendiente", Description: "D"})
+	require.NoError(t, err)
+
+	completed, err := repo.GetCompletedBefore(ctx, time.Now().UTC().AddDate(0, 0, -7))
+	require.NoError(t, err)
+	require.Len(t, completed, 1)
+	require.Equal(t, "Vieja", completed[0].Title)
+
+	// Las tareas ya archivadas no se vuelven a obtener
+	require.NoError(t, repo.Archive(ctx, completed[0].ID))
+	completed, err = repo.GetCompletedBefore(ctx, time.Now().UTC().AddDate(0, 0, -7))
+	require.NoError(t, err)
+	require.Empty(t, completed)
+}
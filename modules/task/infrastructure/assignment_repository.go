@@ -0,0 +1,103 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// SQLiteAssignmentRepository implementa AssignmentRepository usando SQLite
+type SQLiteAssignmentRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteAssignmentRepository crea una nueva instancia del repositorio de asignaciones
+func NewSQLiteAssignmentRepository(db *database.SQLiteDB) domain.AssignmentRepository {
+	return &SQLiteAssignmentRepository{
+		db: db,
+	}
+}
+
+// Assign agrega responsables a una tarea en una transacción
+func (r *SQLiteAssignmentRepository) Assign(ctx context.Context, taskID int, userIDs []int) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT OR IGNORE INTO task_assignees (task_id, user_id, created_at) VALUES (?, ?, ?)`
+	now := time.Now().UTC()
+	for _, userID := range userIDs {
+		if _, err := tx.ExecContext(ctx, query, taskID, userID, now); err != nil {
+			return fmt.Errorf("error insertando asignación: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
+
+// Unassign quita un responsable de una tarea
+func (r *SQLiteAssignmentRepository) Unassign(ctx context.Context, taskID, userID int) error {
+	query := `DELETE FROM task_assignees WHERE task_id = ? AND user_id = ?`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, taskID, userID)
+	if err != nil {
+		return fmt.Errorf("error eliminando asignación: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando eliminacion: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("el usuario %d no está asignado a la tarea %d", userID, taskID)
+	}
+	return nil
+}
+
+// UnassignUser quita al usuario de todas sus tareas
+func (r *SQLiteAssignmentRepository) UnassignUser(ctx context.Context, userID int) (int, error) {
+	result, err := r.db.Conn(ctx).ExecContext(ctx, `DELETE FROM task_assignees WHERE user_id = ?`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("error eliminando asignaciones del usuario: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("error verificando eliminacion: %w", err)
+	}
+	return int(rowsAffected), nil
+}
+
+// GetTasksByAssignee obtiene las tareas asignadas a un usuario usando el índice por user_id
+func (r *SQLiteAssignmentRepository) GetTasksByAssignee(ctx context.Context, userID int) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks
+		WHERE id IN (SELECT task_id FROM task_assignees WHERE user_id = ?) AND deleted_at IS NULL AND archived_at IS NULL
+		ORDER BY created_at DESC`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo tareas asignadas: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando tarea: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return tasks, nil
+}
@@ -0,0 +1,93 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormTaskAssigneeModel es el modelo de GORM para la tabla task_assignees
+type GormTaskAssigneeModel struct {
+	TaskID    int       `gorm:"primaryKey;autoIncrement:false"`
+	UserID    int       `gorm:"primaryKey;autoIncrement:false;index"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormTaskAssigneeModel) TableName() string {
+	return "task_assignees"
+}
+
+// GormAssignmentRepository implementa AssignmentRepository usando GORM
+type GormAssignmentRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAssignmentRepository crea una nueva instancia del repositorio de asignaciones GORM
+func NewGormAssignmentRepository(db *gorm.DB) domain.AssignmentRepository {
+	return &GormAssignmentRepository{
+		db: db,
+	}
+}
+
+// Assign agrega responsables a una tarea con una sola inserción
+func (r *GormAssignmentRepository) Assign(ctx context.Context, taskID int, userIDs []int) error {
+	models := make([]GormTaskAssigneeModel, len(userIDs))
+	for i, userID := range userIDs {
+		models[i] = GormTaskAssigneeModel{TaskID: taskID, UserID: userID}
+	}
+
+	if err := database.GormConn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&models).Error; err != nil {
+		return fmt.Errorf("error creando asignaciones con GORM: %w", err)
+	}
+	return nil
+}
+
+// Unassign quita un responsable de una tarea
+func (r *GormAssignmentRepository) Unassign(ctx context.Context, taskID, userID int) error {
+	result := database.GormConn(ctx, r.db).
+		Where("task_id = ? AND user_id = ?", taskID, userID).
+		Delete(&GormTaskAssigneeModel{})
+	if result.Error != nil {
+		return fmt.Errorf("error eliminando asignación con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("el usuario %d no está asignado a la tarea %d", userID, taskID)
+	}
+	return nil
+}
+
+// UnassignUser quita al usuario de todas sus tareas
+func (r *GormAssignmentRepository) UnassignUser(ctx context.Context, userID int) (int, error) {
+	result := database.GormConn(ctx, r.db).Where("user_id = ?", userID).Delete(&GormTaskAssigneeModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("error eliminando asignaciones del usuario con GORM: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// GetTasksByAssignee obtiene las tareas asignadas a un usuario con un JOIN sobre task_assignees
+func (r *GormAssignmentRepository) GetTasksByAssignee(ctx context.Context, userID int) ([]*domain.Task, error) {
+	var gormTasks []GormTaskModel
+
+	err := database.GormConn(ctx, r.db).
+		Scopes(archivedScope(domain.TaskQueryOptions{})).
+		Select(gormTaskSelect).
+		Joins("JOIN task_assignees ta ON ta.task_id = tasks.id AND ta.user_id = ?", userID).
+		Order("tasks.created_at DESC").
+		Find(&gormTasks).Error
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo tareas asignadas con GORM: %w", err)
+	}
+
+	tasks := make([]*domain.Task, len(gormTasks))
+	for i, gormTask := range gormTasks {
+		tasks[i] = gormTask.ToDomain()
+	}
+	return tasks, nil
+}
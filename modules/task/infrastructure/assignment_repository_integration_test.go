@@ -0,0 +1,81 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteAssignmentRepository_AssignAndQuery(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	assignments := NewSQLiteAssignmentRepository(sqliteDB)
+
+	a, err := repo.Create(ctx, &domain.Task{Title: "A", Description: "D"})
+	require.NoError(t, err)
+	b, err := repo.Create(ctx, &domain.Task{Title: "B", Description: "D"})
+	require.NoError(t, err)
+
+	require.NoError(t, assignments.Assign(ctx, a.ID, []int{3, 1}))
+	// Asignar de nuevo al mismo usuario no falla ni lo duplica
+	require.NoError(t, assignments.Assign(ctx, a.ID, []int{1}))
+	require.NoError(t, assignments.Assign(ctx, b.ID, []int{1}))
+
+	fetched, err := repo.GetByID(ctx, a.ID)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 3}, fetched.Assignees)
+
+	mine, err := assignments.GetTasksByAssignee(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, mine, 2)
+
+	mine, err = assignments.GetTasksByAssignee(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+	require.Equal(t, a.ID, mine[0].ID)
+
+	require.NoError(t, assignments.Unassign(ctx, a.ID, 3))
+	require.Error(t, assignments.Unassign(ctx, a.ID, 3))
+
+	removed, err := assignments.UnassignUser(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, removed)
+
+	fetched, err = repo.GetByID(ctx, b.ID)
+	require.NoError(t, err)
+	require.Empty(t, fetched.Assignees)
+}
+
+func TestSQLiteAssignmentRepository_DeleteTaskCleanup(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	assignments := NewSQLiteAssignmentRepository(sqliteDB)
+
+	task, err := repo.Create(ctx, &domain.Task{Title: "A", Description: "D"})
+	require.NoError(t, err)
+	require.NoError(t, assignments.Assign(ctx, task.ID, []int{1}))
+
+	// Las tareas en la papelera no aparecen entre las asignadas
+	require.NoError(t, repo.Delete(ctx, task.ID))
+	mine, err := assignments.GetTasksByAssignee(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, mine)
+
+	// Al restaurarla vuelve con sus responsables
+	require.NoError(t, repo.Restore(ctx, task.ID))
+	mine, err = assignments.GetTasksByAssignee(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, mine, 1)
+
+	require.NoError(t, repo.Delete(ctx, task.ID))
+	require.NoError(t, repo.DeletePermanently(ctx, task.ID))
+
+	var count int
+	require.NoError(t, sqliteDB.GetDB().QueryRowContext(ctx, `SELECT COUNT(*) FROM task_assignees`).Scan(&count))
+	require.Zero(t, count)
+}
@@ -0,0 +1,131 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// attachmentColumns son las columnas que se leen de un adjunto
+const attachmentColumns = `id, task_id, file_name, content_type, size, sha256, storage_key, created_at`
+
+// scanAttachment lee un adjunto en el orden definido por attachmentColumns
+func scanAttachment(row rowScanner) (*domain.Attachment, error) {
+	attachment := &domain.Attachment{}
+	err := row.Scan(
+		&attachment.ID,
+		&attachment.TaskID,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.SHA256,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// SQLiteAttachmentRepository implementa AttachmentRepository usando SQLite
+type SQLiteAttachmentRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteAttachmentRepository crea una nueva instancia del repositorio de adjuntos
+func NewSQLiteAttachmentRepository(db *database.SQLiteDB) domain.AttachmentRepository {
+	return &SQLiteAttachmentRepository{
+		db: db,
+	}
+}
+
+// Create inserta los metadatos de un adjunto
+func (r *SQLiteAttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) (*domain.Attachment, error) {
+	query := `INSERT INTO task_attachments (task_id, file_name, content_type, size, sha256, storage_key, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
+	now := time.Now().UTC()
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query,
+		attachment.TaskID, attachment.FileName, attachment.ContentType,
+		attachment.Size, attachment.SHA256, attachment.StorageKey, now)
+	if err != nil {
+		return nil, fmt.Errorf("error insertando adjunto: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID del adjunto insertado: %w", err)
+	}
+	attachment.ID = int(id)
+	attachment.CreatedAt = now
+
+	return attachment, nil
+}
+
+// GetByID obtiene un adjunto por su ID
+func (r *SQLiteAttachmentRepository) GetByID(ctx context.Context, id int) (*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM task_attachments WHERE id = ?`
+
+	attachment, err := scanAttachment(r.db.Conn(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("adjunto con ID %d no encontrado", id)
+		}
+		return nil, fmt.Errorf("error obteniendo adjunto: %w", err)
+	}
+	return attachment, nil
+}
+
+// ListByTask obtiene los adjuntos de una tarea, del más antiguo al más reciente
+func (r *SQLiteAttachmentRepository) ListByTask(ctx context.Context, taskID int) ([]*domain.Attachment, error) {
+	query := `SELECT ` + attachmentColumns + ` FROM task_attachments WHERE task_id = ? ORDER BY created_at, id`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo adjuntos: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []*domain.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando adjunto: %w", err)
+		}
+		attachments = append(attachments, attachment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return attachments, nil
+}
+
+// Delete elimina los metadatos de un adjunto
+func (r *SQLiteAttachmentRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.Conn(ctx).ExecContext(ctx, `DELETE FROM task_attachments WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("error eliminando adjunto: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando eliminacion: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("adjunto con ID %d no encontrado", id)
+	}
+	return nil
+}
+
+// CountByStorageKey cuenta los adjuntos que apuntan al mismo blob
+func (r *SQLiteAttachmentRepository) CountByStorageKey(ctx context.Context, storageKey string) (int, error) {
+	var count int
+	query := `SELECT COUNT(*) FROM task_attachments WHERE storage_key = ?`
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, query, storageKey).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error contando adjuntos: %w", err)
+	}
+	return count, nil
+}
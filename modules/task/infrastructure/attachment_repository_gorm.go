@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormAttachmentModel es el modelo de GORM para la tabla task_attachments
+type GormAttachmentModel struct {
+	ID          int       `gorm:"primaryKey;autoIncrement"`
+	TaskID      int       `gorm:"not null;index"`
+	FileName    string    `gorm:"not null;size:255"`
+	ContentType string    `gorm:"not null;size:255"`
+	Size        int64     `gorm:"not null"`
+	SHA256      string    `gorm:"column:sha256;not null;size:64"`
+	StorageKey  string    `gorm:"not null;size:255;index"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormAttachmentModel) TableName() string {
+	return "task_attachments"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (g *GormAttachmentModel) ToDomain() *domain.Attachment {
+	return &domain.Attachment{
+		ID:          g.ID,
+		TaskID:      g.TaskID,
+		FileName:    g.FileName,
+		ContentType: g.ContentType,
+		Size:        g.Size,
+		SHA256:      g.SHA256,
+		StorageKey:  g.StorageKey,
+		CreatedAt:   g.CreatedAt,
+	}
+}
+
+// GormAttachmentRepository implementa AttachmentRepository usando GORM
+type GormAttachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewGormAttachmentRepository crea una nueva instancia del repositorio de adjuntos GORM
+func NewGormAttachmentRepository(db *gorm.DB) domain.AttachmentRepository {
+	return &GormAttachmentRepository{
+		db: db,
+	}
+}
+
+// Create inserta los metadatos de un adjunto con GORM
+func (r *GormAttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) (*domain.Attachment, error) {
+	model := &GormAttachmentModel{
+		TaskID:      attachment.TaskID,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		StorageKey:  attachment.StorageKey,
+	}
+
+	if err := database.GormConn(ctx, r.db).Create(model).Error; err != nil {
+		return nil, fmt.Errorf("error creando adjunto con GORM: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// GetByID obtiene un adjunto por su ID usando GORM
+func (r *GormAttachmentRepository) GetByID(ctx context.Context, id int) (*domain.Attachment, error) {
+	var model GormAttachmentModel
+
+	if err := database.GormConn(ctx, r.db).First(&model, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("adjunto con ID %d no encontrado", id)
+		}
+		return nil, fmt.Errorf("error obteniendo adjunto con GORM: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// ListByTask obtiene los adjuntos de una tarea usando GORM
+func (r *GormAttachmentRepository) ListByTask(ctx context.Context, taskID int) ([]*domain.Attachment, error) {
+	var models []GormAttachmentModel
+	if err := database.GormConn(ctx, r.db).Where("task_id = ?", taskID).Order("created_at, id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo adjuntos con GORM: %w", err)
+	}
+
+	attachments := make([]*domain.Attachment, len(models))
+	for i, model := range models {
+		attachments[i] = model.ToDomain()
+	}
+	return attachments, nil
+}
+
+// Delete elimina los metadatos de un adjunto usando GORM
+func (r *GormAttachmentRepository) Delete(ctx context.Context, id int) error {
+	result := database.GormConn(ctx, r.db).Delete(&GormAttachmentModel{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("error eliminando adjunto con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("adjunto con id %d no encontrado", id)
+	}
+	return nil
+}
+
+// CountByStorageKey cuenta los adjuntos que apuntan al mismo blob usando GORM
+func (r *GormAttachmentRepository) CountByStorageKey(ctx context.Context, storageKey string) (int, error) {
+	var count int64
+	if err := database.GormConn(ctx, r.db).Model(&GormAttachmentModel{}).Where("storage_key = ?", storageKey).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("error contando adjuntos con GORM: %w", err)
+	}
+	return int(count), nil
+}
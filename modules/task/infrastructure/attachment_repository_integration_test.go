@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteAttachmentRepository_CRUDAndSharedBlobs(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteAttachmentRepository(sqliteDB)
+
+	key := domain.AttachmentStorageKey("abcdef")
+	first, err := repo.Create(ctx, &domain.Attachment{
+		TaskID: 1, FileName: "a.png", ContentType: "image/png", Size: 10, SHA256: "abcdef", StorageKey: key,
+	})
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, &domain.Attachment{
+		TaskID: 2, FileName: "b.png", ContentType: "image/png", Size: 10, SHA256: "abcdef", StorageKey: key,
+	})
+	require.NoError(t, err)
+
+	fetched, err := repo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	require.Equal(t, "a.png", fetched.FileName)
+	require.Equal(t, int64(10), fetched.Size)
+
+	list, err := repo.ListByTask(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+
+	count, err := repo.CountByStorageKey(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	require.NoError(t, repo.Delete(ctx, first.ID))
+	require.Error(t, repo.Delete(ctx, first.ID))
+
+	count, err = repo.CountByStorageKey(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,115 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// LocalBlobStore implementa BlobStore guardando cada blob como un archivo bajo un directorio raíz
+type LocalBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore crea el directorio raíz si no existe y retorna el almacén
+func NewLocalBlobStore(root string) (domain.BlobStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("error creando directorio de adjuntos %s: %w", root, err)
+	}
+	return &LocalBlobStore{root: root}, nil
+}
+
+// path resuelve la ruta de un blob impidiendo salir del directorio raíz
+func (s *LocalBlobStore) path(key string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("clave de blob inválida: %q", key)
+	}
+	return filepath.Join(s.root, clean), nil
+}
+
+// Put escribe el blob en un archivo temporal y lo renombra al terminar,
+// así un lector nunca ve un archivo a medio escribir
+func (s *LocalBlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creando directorio del blob: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("error creando archivo temporal: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error escribiendo blob: %w", err)
+	}
+	if written != size {
+		return fmt.Errorf("error escribiendo blob: se esperaban %d bytes y se recibieron %d", size, written)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error guardando blob: %w", err)
+	}
+	return nil
+}
+
+// Get abre el archivo del blob
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrBlobNotFound, key)
+		}
+		return nil, fmt.Errorf("error abriendo blob: %w", err)
+	}
+	return file, nil
+}
+
+// Exists indica si el archivo del blob existe
+func (s *LocalBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error verificando blob: %w", err)
+	}
+	return true, nil
+}
+
+// Delete elimina el archivo del blob
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error eliminando blob: %w", err)
+	}
+	return nil
+}
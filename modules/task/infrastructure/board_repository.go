@@ -0,0 +1,262 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// boardSnapshotQuery lee el tablero, sus columnas y las tarjetas de tareas vigentes en una sola consulta;
+// %s es la agregación de responsables propia de cada motor
+const boardSnapshotQuery = `SELECT b.id, b.name, b.swimlane_by, b.created_at, b.updated_at,
+	c.id, c.name, c.position, c.state, c.wip_limit,
+	k.task_id, k.rank, k.title, k.completed, k.due_date, k.blocked, k.assignees
+	FROM boards b
+	JOIN board_columns c ON c.board_id = b.id
+	LEFT JOIN (
+		SELECT bc.column_id, bc.task_id, bc.rank, t.title, t.completed, t.due_date,
+			EXISTS (SELECT 1 FROM task_dependencies d JOIN tasks bl ON bl.id = d.blocker_id
+				WHERE d.task_id = t.id AND bl.completed = FALSE AND bl.deleted_at IS NULL) AS blocked,
+			COALESCE((SELECT %s FROM task_assignees a WHERE a.task_id = t.id), '') AS assignees
+		FROM board_cards bc
+		JOIN tasks t ON t.id = bc.task_id
+		WHERE t.deleted_at IS NULL AND t.archived_at IS NULL
+	) k ON k.column_id = c.id
+	WHERE b.id = ?
+	ORDER BY c.position, k.rank, k.task_id`
+
+// countCardsQuery cuenta las tarjetas de una columna sin las tareas en papelera ni archivadas
+const countCardsQuery = `SELECT COUNT(*) FROM board_cards bc JOIN tasks t ON t.id = bc.task_id
+	WHERE bc.column_id = ? AND t.deleted_at IS NULL AND t.archived_at IS NULL`
+
+// sqliteLockColumnQuery toma el bloqueo de escritura antes de contar; SQLite no bloquea filas, así
+// que una escritura sin cambios reserva la base hasta que termine la transacción
+const sqliteLockColumnQuery = `UPDATE board_columns SET wip_limit = wip_limit WHERE id = ?`
+
+// putCardQuery crea la tarjeta o la mueve si la tarea ya estaba en el tablero
+const putCardQuery = `INSERT INTO board_cards (board_id, column_id, task_id, rank, created_at, updated_at)
+	VALUES (?, ?, ?, ?, ?, ?)
+	ON CONFLICT (board_id, task_id) DO UPDATE SET column_id = excluded.column_id, rank = excluded.rank, updated_at = excluded.updated_at`
+
+// scanBoardSnapshot arma el tablero y sus tarjetas a partir de las filas de boardSnapshotQuery
+func scanBoardSnapshot(rows *sql.Rows) (*domain.Board, []domain.BoardCard, error) {
+	var board *domain.Board
+	cards := []domain.BoardCard{}
+	for rows.Next() {
+		var b domain.Board
+		var column domain.BoardColumn
+		var taskID sql.NullInt64
+		var rank, title, assignees sql.NullString
+		var completed, blocked sql.NullBool
+		var dueDate sql.NullTime
+		err := rows.Scan(
+			&b.ID, &b.Name, &b.SwimlaneBy, &b.CreatedAt, &b.UpdatedAt,
+			&column.ID, &column.Name, &column.Position, &column.State, &column.WIPLimit,
+			&taskID, &rank, &title, &completed, &dueDate, &blocked, &assignees,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error escaneando tablero: %w", err)
+		}
+		if board == nil {
+			b.Columns = []domain.BoardColumn{}
+			board = &b
+		}
+		column.BoardID = board.ID
+		if n := len(board.Columns); n == 0 || board.Columns[n-1].ID != column.ID {
+			board.Columns = append(board.Columns, column)
+		}
+		if !taskID.Valid {
+			continue
+		}
+		card := domain.BoardCard{
+			BoardID:   board.ID,
+			ColumnID:  column.ID,
+			TaskID:    int(taskID.Int64),
+			Rank:      rank.String,
+			Title:     title.String,
+			Completed: completed.Bool,
+			Blocked:   blocked.Bool,
+			Assignees: domain.ParseAssigneeIDs(assignees.String),
+		}
+		if dueDate.Valid {
+			card.DueDate = &dueDate.Time
+		}
+		cards = append(cards, card)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error iterando tablero: %w", err)
+	}
+	if board == nil {
+		return nil, nil, domain.ErrBoardNotFound
+	}
+	return board, cards, nil
+}
+
+// SQLiteBoardRepository implementa BoardRepository usando SQLite
+type SQLiteBoardRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteBoardRepository crea una nueva instancia del repositorio de tableros
+func NewSQLiteBoardRepository(db *database.SQLiteDB) domain.BoardRepository {
+	return &SQLiteBoardRepository{
+		db: db,
+	}
+}
+
+// Create inserta el tablero y sus columnas en una transacción
+func (r *SQLiteBoardRepository) Create(ctx context.Context, board *domain.Board) (*domain.Board, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	result, err := tx.ExecContext(ctx,
+		`INSERT INTO boards (name, swimlane_by, created_at, updated_at) VALUES (?, ?, ?, ?)`,
+		board.Name, board.SwimlaneBy, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("error insertando tablero: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID del tablero insertado: %w", err)
+	}
+	board.ID = int(id)
+	board.CreatedAt = now
+	board.UpdatedAt = now
+
+	for i := range board.Columns {
+		column := &board.Columns[i]
+		result, err := tx.ExecContext(ctx,
+			`INSERT INTO board_columns (board_id, name, position, state, wip_limit) VALUES (?, ?, ?, ?, ?)`,
+			board.ID, column.Name, column.Position, column.State, column.WIPLimit)
+		if err != nil {
+			return nil, fmt.Errorf("error insertando columna del tablero: %w", err)
+		}
+		columnID, err := result.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("error obteniendo ID de la columna insertada: %w", err)
+		}
+		column.ID = int(columnID)
+		column.BoardID = board.ID
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return board, nil
+}
+
+// GetByID obtiene un tablero con sus columnas ordenadas por posición
+func (r *SQLiteBoardRepository) GetByID(ctx context.Context, id int) (*domain.Board, error) {
+	query := `SELECT b.id, b.name, b.swimlane_by, b.created_at, b.updated_at,
+		c.id, c.name, c.position, c.state, c.wip_limit
+		FROM boards b JOIN board_columns c ON c.board_id = b.id
+		WHERE b.id = ? ORDER BY c.position`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo tablero: %w", err)
+	}
+	defer rows.Close()
+
+	var board *domain.Board
+	for rows.Next() {
+		var b domain.Board
+		var column domain.BoardColumn
+		if err := rows.Scan(&b.ID, &b.Name, &b.SwimlaneBy, &b.CreatedAt, &b.UpdatedAt,
+			&column.ID, &column.Name, &column.Position, &column.State, &column.WIPLimit); err != nil {
+			return nil, fmt.Errorf("error escaneando tablero: %w", err)
+		}
+		if board == nil {
+			board = &b
+		}
+		column.BoardID = board.ID
+		board.Columns = append(board.Columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando tablero: %w", err)
+	}
+	if board == nil {
+		return nil, domain.ErrBoardNotFound
+	}
+	return board, nil
+}
+
+// GetSnapshot obtiene el tablero completo con sus tarjetas en una sola consulta
+func (r *SQLiteBoardRepository) GetSnapshot(ctx context.Context, id int) (*domain.Board, []domain.BoardCard, error) {
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, fmt.Sprintf(boardSnapshotQuery, "GROUP_CONCAT(a.user_id)"), id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error obteniendo tablero: %w", err)
+	}
+	defer rows.Close()
+
+	return scanBoardSnapshot(rows)
+}
+
+// GetCard obtiene la tarjeta de una tarea en el tablero
+func (r *SQLiteBoardRepository) GetCard(ctx context.Context, boardID, taskID int) (*domain.BoardCard, error) {
+	query := `SELECT board_id, column_id, task_id, rank FROM board_cards WHERE board_id = ? AND task_id = ?`
+
+	card := &domain.BoardCard{}
+	err := r.db.Conn(ctx).QueryRowContext(ctx, query, boardID, taskID).Scan(&card.BoardID, &card.ColumnID, &card.TaskID, &card.Rank)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrBoardCardNotFound
+		}
+		return nil, fmt.Errorf("error obteniendo tarjeta: %w", err)
+	}
+	return card, nil
+}
+
+// CountCards cuenta las tarjetas de tareas vigentes en una columna, con la columna bloqueada
+func (r *SQLiteBoardRepository) CountCards(ctx context.Context, columnID int) (int, error) {
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, sqliteLockColumnQuery, columnID); err != nil {
+		return 0, fmt.Errorf("error bloqueando la columna: %w", err)
+	}
+	var count int
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, countCardsQuery, columnID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("error contando tarjetas: %w", err)
+	}
+	return count, nil
+}
+
+// LastCardRank obtiene el mayor rank de una columna, o "" si está vacía
+func (r *SQLiteBoardRepository) LastCardRank(ctx context.Context, columnID int) (string, error) {
+	var rank sql.NullString
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, `SELECT MAX(rank) FROM board_cards WHERE column_id = ?`, columnID).Scan(&rank); err != nil {
+		return "", fmt.Errorf("error obteniendo orden de la columna: %w", err)
+	}
+	return rank.String, nil
+}
+
+// PutCard ubica la tarea en la columna, creando o moviendo su tarjeta
+func (r *SQLiteBoardRepository) PutCard(ctx context.Context, card *domain.BoardCard) error {
+	now := time.Now().UTC()
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, putCardQuery, card.BoardID, card.ColumnID, card.TaskID, card.Rank, now, now); err != nil {
+		return fmt.Errorf("error guardando tarjeta: %w", err)
+	}
+	return nil
+}
+
+// RemoveCard quita la tarea del tablero
+func (r *SQLiteBoardRepository) RemoveCard(ctx context.Context, boardID, taskID int) error {
+	result, err := r.db.Conn(ctx).ExecContext(ctx, `DELETE FROM board_cards WHERE board_id = ? AND task_id = ?`, boardID, taskID)
+	if err != nil {
+		return fmt.Errorf("error quitando tarjeta: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando tarjeta quitada: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrBoardCardNotFound
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormBoardModel es el modelo de GORM para la tabla boards
+type GormBoardModel struct {
+	ID         int       `gorm:"primaryKey;autoIncrement"`
+	Name       string    `gorm:"not null;size:255"`
+	SwimlaneBy string    `gorm:"not null;size:32"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormBoardModel) TableName() string {
+	return "boards"
+}
+
+// GormBoardColumnModel es el modelo de GORM para la tabla board_columns
+type GormBoardColumnModel struct {
+	ID       int    `gorm:"primaryKey;autoIncrement"`
+	BoardID  int    `gorm:"not null;index"`
+	Name     string `gorm:"not null;size:255"`
+	Position int    `gorm:"not null"`
+	State    string `gorm:"not null;size:32"`
+	WIPLimit int    `gorm:"column:wip_limit;not null"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormBoardColumnModel) TableName() string {
+	return "board_columns"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (g *GormBoardColumnModel) ToDomain() domain.BoardColumn {
+	return domain.BoardColumn{
+		ID:       g.ID,
+		BoardID:  g.BoardID,
+		Name:     g.Name,
+		Position: g.Position,
+		State:    domain.ColumnState(g.State),
+		WIPLimit: g.WIPLimit,
+	}
+}
+
+// GormBoardCardModel es el modelo de GORM para la tabla board_cards
+type GormBoardCardModel struct {
+	BoardID   int       `gorm:"primaryKey"`
+	TaskID    int       `gorm:"primaryKey"`
+	ColumnID  int       `gorm:"not null"`
+	Rank      string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormBoardCardModel) TableName() string {
+	return "board_cards"
+}
+
+// GormBoardRepository implementa BoardRepository usando GORM
+type GormBoardRepository struct {
+	db *gorm.DB
+}
+
+// NewGormBoardRepository crea una nueva instancia del repositorio de tableros con GORM
+func NewGormBoardRepository(db *gorm.DB) domain.BoardRepository {
+	return &GormBoardRepository{
+		db: db,
+	}
+}
+
+// Create inserta el tablero y sus columnas en una transacción
+func (r *GormBoardRepository) Create(ctx context.Context, board *domain.Board) (*domain.Board, error) {
+	err := database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		model := &GormBoardModel{Name: board.Name, SwimlaneBy: string(board.SwimlaneBy)}
+		if err := tx.Create(model).Error; err != nil {
+			return fmt.Errorf("error insertando tablero: %w", err)
+		}
+		board.ID = model.ID
+		board.CreatedAt = model.CreatedAt
+		board.UpdatedAt = model.UpdatedAt
+
+		for i := range board.Columns {
+			column := &board.Columns[i]
+			columnModel := &GormBoardColumnModel{
+				BoardID:  board.ID,
+				Name:     column.Name,
+				Position: column.Position,
+				State:    string(column.State),
+				WIPLimit: column.WIPLimit,
+			}
+			if err := tx.Create(columnModel).Error; err != nil {
+				return fmt.Errorf("error insertando columna del tablero: %w", err)
+			}
+			column.ID = columnModel.ID
+			column.BoardID = board.ID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return board, nil
+}
+
+// GetByID obtiene un tablero con sus columnas ordenadas por posición
+func (r *GormBoardRepository) GetByID(ctx context.Context, id int) (*domain.Board, error) {
+	var model GormBoardModel
+	if err := database.GormConn(ctx, r.db).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("error obteniendo tablero: %w", err)
+	}
+
+	var columns []GormBoardColumnModel
+	if err := database.GormConn(ctx, r.db).Where("board_id = ?", id).Order("position").Find(&columns).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo columnas del tablero: %w", err)
+	}
+
+	board := &domain.Board{
+		ID:         model.ID,
+		Name:       model.Name,
+		SwimlaneBy: domain.SwimlaneMode(model.SwimlaneBy),
+		CreatedAt:  model.CreatedAt,
+		UpdatedAt:  model.UpdatedAt,
+	}
+	for i := range columns {
+		board.Columns = append(board.Columns, columns[i].ToDomain())
+	}
+	return board, nil
+}
+
+// GetSnapshot obtiene el tablero completo con sus tarjetas en una sola consulta
+func (r *GormBoardRepository) GetSnapshot(ctx context.Context, id int) (*domain.Board, []domain.BoardCard, error) {
+	rows, err := database.GormConn(ctx, r.db).Raw(fmt.Sprintf(boardSnapshotQuery, "string_agg(a.user_id::text, ',')"), id).Rows()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error obteniendo tablero: %w", err)
+	}
+	defer rows.Close()
+
+	return scanBoardSnapshot(rows)
+}
+
+// GetCard obtiene la tarjeta de una tarea en el tablero
+func (r *GormBoardRepository) GetCard(ctx context.Context, boardID, taskID int) (*domain.BoardCard, error) {
+	var model GormBoardCardModel
+	err := database.GormConn(ctx, r.db).Where("board_id = ? AND task_id = ?", boardID, taskID).First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrBoardCardNotFound
+		}
+		return nil, fmt.Errorf("error obteniendo tarjeta: %w", err)
+	}
+	return &domain.BoardCard{BoardID: model.BoardID, ColumnID: model.ColumnID, TaskID: model.TaskID, Rank: model.Rank}, nil
+}
+
+// CountCards cuenta las tarjetas de tareas vigentes en una columna; bloquea la fila de la columna
+// hasta que termine la transacción
+func (r *GormBoardRepository) CountCards(ctx context.Context, columnID int) (int, error) {
+	db := database.GormConn(ctx, r.db)
+	var locked []int
+	if err := db.Raw(`SELECT id FROM board_columns WHERE id = ? FOR UPDATE`, columnID).Scan(&locked).Error; err != nil {
+		return 0, fmt.Errorf("error bloqueando la columna: %w", err)
+	}
+	var count int
+	if err := db.Raw(countCardsQuery, columnID).Scan(&count).Error; err != nil {
+		return 0, fmt.Errorf("error contando tarjetas: %w", err)
+	}
+	return count, nil
+}
+
+// LastCardRank obtiene el mayor rank de una columna, o "" si está vacía
+func (r *GormBoardRepository) LastCardRank(ctx context.Context, columnID int) (string, error) {
+	var rank string
+	err := database.GormConn(ctx, r.db).Model(&GormBoardCardModel{}).
+		Where("column_id = ?", columnID).
+		Select("COALESCE(MAX(rank), '')").
+		Scan(&rank).Error
+	if err != nil {
+		return "", fmt.Errorf("error obteniendo orden de la columna: %w", err)
+	}
+	return rank, nil
+}
+
+// PutCard ubica la tarea en la columna, creando o moviendo su tarjeta
+func (r *GormBoardRepository) PutCard(ctx context.Context, card *domain.BoardCard) error {
+	now := time.Now().UTC()
+	if err := database.GormConn(ctx, r.db).Exec(putCardQuery, card.BoardID, card.ColumnID, card.TaskID, card.Rank, now, now).Error; err != nil {
+		return fmt.Errorf("error guardando tarjeta: %w", err)
+	}
+	return nil
+}
+
+// RemoveCard quita la tarea del tablero
+func (r *GormBoardRepository) RemoveCard(ctx context.Context, boardID, taskID int) error {
+	result := database.GormConn(ctx, r.db).Where("board_id = ? AND task_id = ?", boardID, taskID).Delete(&GormBoardCardModel{})
+	if result.Error != nil {
+		return fmt.Errorf("error quitando tarjeta: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrBoardCardNotFound
+	}
+	return nil
+}
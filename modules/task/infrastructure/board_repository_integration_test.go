@@ -0,0 +1,121 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteBoardRepository_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	tasks := NewSQLiteTaskRepository(sqliteDB)
+	boards := NewSQLiteBoardRepository(sqliteDB)
+
+	board, err := domain.NewBoard("Sprint", domain.SwimlaneAssignee, []domain.BoardColumn{
+		{Name: "Por hacer", State: domain.ColumnStatePending},
+		{Name: "Haciendo", State: domain.ColumnStatePending, WIPLimit: 2},
+		{Name: "Hecho", State: domain.ColumnStateCompleted},
+	})
+	require.NoError(t, err)
+	board, err = boards.Create(ctx, board)
+	require.NoError(t, err)
+	require.NotZero(t, board.Columns[2].ID)
+
+	a, err := tasks.Create(ctx, &domain.Task{Title: "A", Description: "D"})
+	require.NoError(t, err)
+	due := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+	b, err := tasks.Create(ctx, &domain.Task{Title: "B", Description: "D", DueDate: &due})
+	require.NoError(t, err)
+	require.NoError(t, NewSQLiteAssignmentRepository(sqliteDB).Assign(ctx, b.ID, []int{7}))
+
+	todo, doing := board.Columns[0].ID, board.Columns[1].ID
+	require.NoError(t, boards.PutCard(ctx, &domain.BoardCard{BoardID: board.ID, ColumnID: todo, TaskID: a.ID, Rank: "i"}))
+	require.NoError(t, boards.PutCard(ctx, &domain.BoardCard{BoardID: board.ID, ColumnID: todo, TaskID: b.ID, Rank: "h"}))
+
+	// Mover una tarjeta existente la cambia de columna sin duplicarla
+	require.NoError(t, boards.PutCard(ctx, &domain.BoardCard{BoardID: board.ID, ColumnID: doing, TaskID: a.ID, Rank: "i"}))
+	count, err := boards.CountCards(ctx, doing)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	last, err := boards.LastCardRank(ctx, todo)
+	require.NoError(t, err)
+	require.Equal(t, "h", last)
+
+	loaded, cards, err := boards.GetSnapshot(ctx, board.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Sprint", loaded.Name)
+	require.Len(t, loaded.Columns, 3)
+	require.Equal(t, "Hecho", loaded.Columns[2].Name)
+	require.Len(t, cards, 2)
+	require.Equal(t, b.ID, cards[0].TaskID)
+	require.Equal(t, []int{7}, cards[0].Assignees)
+	require.NotNil(t, cards[0].DueDate)
+	require.True(t, due.Equal(*cards[0].DueDate))
+	require.Equal(t, doing, cards[1].ColumnID)
+
+	// Las tareas en la papelera no aparecen ni cuentan para el límite WIP
+	require.NoError(t, tasks.Delete(ctx, a.ID))
+	_, cards, err = boards.GetSnapshot(ctx, board.ID)
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	count, err = boards.CountCards(ctx, doing)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	require.NoError(t, boards.RemoveCard(ctx, board.ID, b.ID))
+	require.ErrorIs(t, boards.RemoveCard(ctx, board.ID, b.ID), domain.ErrBoardCardNotFound)
+	_, err = boards.GetCard(ctx, board.ID, b.ID)
+	require.ErrorIs(t, err, domain.ErrBoardCardNotFound)
+
+	_, _, err = boards.GetSnapshot(ctx, board.ID+1)
+	require.ErrorIs(t, err, domain.ErrBoardNotFound)
+}
+
+func TestSQLiteBoardRepository_MoveCardSyncsState(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	service := application.NewBoardService(NewSQLiteBoardRepository(sqliteDB), application.NewTaskService(repo))
+
+	board, err := service.CreateBoard(ctx, "Equipo", "", []domain.BoardColumn{
+		{Name: "Haciendo", State: domain.ColumnStatePending, WIPLimit: 1},
+		{Name: "Hecho", State: domain.ColumnStateCompleted},
+	})
+	require.NoError(t, err)
+	doing, done := board.Columns[0].ID, board.Columns[1].ID
+
+	first, err := repo.Create(ctx, &domain.Task{Title: "Primera", Description: "D"})
+	require.NoError(t, err)
+	second, err := repo.Create(ctx, &domain.Task{Title: "Segunda", Description: "D"})
+	require.NoError(t, err)
+
+	_, err = service.MoveCard(ctx, board.ID, first.ID, doing)
+	require.NoError(t, err)
+	_, err = service.MoveCard(ctx, board.ID, second.ID, doing)
+	require.ErrorIs(t, err, domain.ErrWIPLimitExceeded)
+
+	// Al llegar a la columna completada la tarea se completa
+	card, err := service.MoveCard(ctx, board.ID, first.ID, done)
+	require.NoError(t, err)
+	require.True(t, card.Completed)
+	stored, err := repo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	require.True(t, stored.Completed)
+
+	_, err = service.MoveCard(ctx, board.ID, second.ID, doing)
+	require.NoError(t, err)
+
+	snapshot, err := service.GetBoard(ctx, board.ID)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Swimlanes, 1)
+	require.Equal(t, 1, snapshot.Columns[0].CardCount)
+	require.Equal(t, 1, snapshot.Columns[1].CardCount)
+	require.Equal(t, "Segunda", snapshot.Swimlanes[0].Columns[0].Cards[0].Title)
+	require.True(t, snapshot.Swimlanes[0].Columns[1].Cards[0].Completed)
+}
@@ -0,0 +1,259 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// batchUpdateColumns son las columnas de la tabla de valores de un lote de actualizaciones,
+// en el orden de batchUpdateArgs; id y version identifican la fila a actualizar
+var batchUpdateColumns = []string{"id", "version", "title", "description", "completed", "due_date", "recurrence",
+	"occurrence", "completed_at", "story_points", "estimated_hours", "remaining_hours"}
+
+// batchValues arma n filas VALUES; types, si no es nil, agrega un CAST por columna para
+// que PostgreSQL conozca el tipo de los parámetros
+func batchValues(n, columns int, types []string) string {
+	cells := make([]string, columns)
+	for i := range cells {
+		cells[i] = "?"
+		if types != nil {
+			cells[i] = "CAST(? AS " + types[i] + ")"
+		}
+	}
+	row := "(" + strings.Join(cells, ", ") + ")"
+	return strings.TrimSuffix(strings.Repeat(row+", ", n), ", ")
+}
+
+// batchUpdateQuery arma la actualización de varias tareas en una sola sentencia; solo se actualizan
+// las que siguen en la versión leída y retorna los IDs actualizados
+func batchUpdateQuery(rows int, types []string) string {
+	return `WITH v(` + strings.Join(batchUpdateColumns, ", ") + `) AS (VALUES ` + batchValues(rows, len(batchUpdateColumns), types) + `)
+		UPDATE tasks SET title = v.title, description = v.description, completed = v.completed, due_date = v.due_date,
+			recurrence = v.recurrence, occurrence = v.occurrence, completed_at = v.completed_at, story_points = v.story_points,
+			estimated_hours = v.estimated_hours, remaining_hours = v.remaining_hours, updated_at = ?, version = tasks.version + 1
+		FROM v WHERE tasks.id = v.id AND tasks.version = v.version AND tasks.deleted_at IS NULL AND tasks.archived_at IS NULL
+		RETURNING tasks.id`
+}
+
+// batchUpdateArgs retorna los parámetros de batchUpdateQuery
+func batchUpdateArgs(writes []domain.BatchWrite, now time.Time) []any {
+	args := make([]any, 0, len(writes)*len(batchUpdateColumns)+1)
+	for _, write := range writes {
+		task := write.Task
+		args = append(args, task.ID, task.Version, task.Title, task.Description, task.Completed, task.DueDate,
+			task.Recurrence, task.Occurrence, task.CompletedAt, task.StoryPoints, task.EstimatedHours, task.RemainingHours)
+	}
+	return append(args, now)
+}
+
+// batchDeleteQuery arma el envío a la papelera de varias tareas en una sola sentencia; solo se eliminan
+// las que siguen en la versión leída y retorna los IDs eliminados
+func batchDeleteQuery(rows int, types []string) string {
+	return `WITH v(id, version) AS (VALUES ` + batchValues(rows, 2, types) + `)
+		UPDATE tasks SET deleted_at = ?, version = tasks.version + 1
+		FROM v WHERE tasks.id = v.id AND tasks.version = v.version AND tasks.deleted_at IS NULL AND tasks.archived_at IS NULL
+		RETURNING tasks.id`
+}
+
+// batchDeleteArgs retorna los parámetros de batchDeleteQuery
+func batchDeleteArgs(writes []domain.BatchWrite, now time.Time) []any {
+	args := make([]any, 0, len(writes)*2+1)
+	for _, write := range writes {
+		args = append(args, write.Task.ID, write.Task.Version)
+	}
+	return append(args, now)
+}
+
+// assignBatchRanks ubica las tareas nuevas del lote al final del orden manual, una detrás de otra
+func assignBatchRanks(writes []domain.BatchWrite, lastRank string) {
+	for _, write := range writes {
+		if write.Task.Rank == "" {
+			write.Task.Rank = domain.RankAfter(lastRank)
+		}
+		lastRank = max(lastRank, write.Task.Rank)
+	}
+}
+
+// checkBatchRows compara los IDs que la sentencia modificó con los del lote; los que faltan
+// cambiaron desde que se leyeron
+func checkBatchRows(writes []domain.BatchWrite, updated []int) error {
+	var missing []int
+	for _, write := range writes {
+		if !slices.Contains(updated, write.Task.ID) {
+			missing = append(missing, write.Task.ID)
+		}
+	}
+	if len(missing) > 0 {
+		return &domain.BatchConflictError{TaskIDs: missing}
+	}
+	return nil
+}
+
+// applyBatchResults actualiza versión y fechas de las tareas ya escritas y asocia cada revisión
+// con su tarea resultante
+func applyBatchResults(batch *domain.TaskBatch, now time.Time) []*domain.TaskRevision {
+	for _, write := range batch.Updates {
+		write.Task.UpdatedAt = now
+		write.Task.Version++
+	}
+	for _, write := range batch.Deletes {
+		deletedAt := now
+		write.Task.DeletedAt = &deletedAt
+		write.Task.Version++
+	}
+
+	var revisions []*domain.TaskRevision
+	for _, writes := range [][]domain.BatchWrite{batch.Creates, batch.Updates, batch.Deletes} {
+		for _, write := range writes {
+			if write.Revision != nil {
+				write.Revision.TaskID = write.Task.ID
+				write.Revision.Snapshot = write.Task
+				revisions = append(revisions, write.Revision)
+			}
+		}
+	}
+	return revisions
+}
+
+// ApplyBatch aplica el lote en una transacción con un INSERT, un UPDATE de actualizaciones y un
+// UPDATE de eliminaciones de varias filas cada uno
+func (r *SQLiteTaskRepository) ApplyBatch(ctx context.Context, batch *domain.TaskBatch) error {
+	if batch.IsEmpty() {
+		return nil
+	}
+
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	if err := insertBatchTasks(ctx, tx, batch.Creates, now); err != nil {
+		return err
+	}
+	if len(batch.Updates) > 0 {
+		updated, err := queryBatchIDs(ctx, tx, batchUpdateQuery(len(batch.Updates), nil), batchUpdateArgs(batch.Updates, now))
+		if err != nil {
+			return fmt.Errorf("error actualizando tareas del lote: %w", err)
+		}
+		if err := checkBatchRows(batch.Updates, updated); err != nil {
+			return err
+		}
+	}
+	if len(batch.Deletes) > 0 {
+		deleted, err := queryBatchIDs(ctx, tx, batchDeleteQuery(len(batch.Deletes), nil), batchDeleteArgs(batch.Deletes, now))
+		if err != nil {
+			return fmt.Errorf("error eliminando tareas del lote: %w", err)
+		}
+		if err := checkBatchRows(batch.Deletes, deleted); err != nil {
+			return err
+		}
+	}
+
+	if err := insertBatchRevisions(ctx, tx, applyBatchResults(batch, now)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
+
+// insertBatchTasks inserta las tareas nuevas del lote en una sola sentencia. SQLite no garantiza el
+// orden de RETURNING, así que cada ID se asocia a su tarea por el rank, que es único dentro del lote
+func insertBatchTasks(ctx context.Context, tx *database.Tx, writes []domain.BatchWrite, now time.Time) error {
+	if len(writes) == 0 {
+		return nil
+	}
+
+	var lastRank string
+	if err := tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(rank), '') FROM tasks`).Scan(&lastRank); err != nil {
+		return fmt.Errorf("error obteniendo la última posición: %w", err)
+	}
+	assignBatchRanks(writes, lastRank)
+
+	byRank := make(map[string]*domain.Task, len(writes))
+	args := make([]any, 0, len(writes)*13)
+	for _, write := range writes {
+		task := write.Task
+		byRank[task.Rank] = task
+		args = append(args, task.Title, task.Description, task.Completed, task.DueDate, task.Recurrence, task.Occurrence,
+			task.CompletedAt, task.Rank, task.StoryPoints, task.EstimatedHours, task.RemainingHours, now, now)
+	}
+
+	query := `INSERT INTO tasks (title, description, completed, due_date, recurrence, occurrence, completed_at, rank,
+		story_points, estimated_hours, remaining_hours, created_at, updated_at)
+		VALUES ` + batchValues(len(writes), 13, nil) + ` RETURNING id, rank`
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("error insertando tareas del lote: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var rank string
+		if err := rows.Scan(&id, &rank); err != nil {
+			return fmt.Errorf("error escaneando tarea insertada: %w", err)
+		}
+		if task, ok := byRank[rank]; ok {
+			task.ID = id
+			task.Version = 1
+			task.CreatedAt = now
+			task.UpdatedAt = now
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterando tareas insertadas: %w", err)
+	}
+	return nil
+}
+
+// queryBatchIDs ejecuta una sentencia con RETURNING id y retorna los IDs
+func queryBatchIDs(ctx context.Context, tx *database.Tx, query string, args []any) ([]int, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// insertBatchRevisions guarda las revisiones del lote en una sola sentencia
+func insertBatchRevisions(ctx context.Context, tx *database.Tx, revisions []*domain.TaskRevision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(revisions)*6)
+	for _, revision := range revisions {
+		changes, snapshot, err := marshalRevision(revision)
+		if err != nil {
+			return err
+		}
+		args = append(args, revision.TaskID, revision.Action, revision.Actor, changes, snapshot, revision.CreatedAt)
+	}
+
+	query := `INSERT INTO task_revisions (task_id, action, actor, changes, snapshot, created_at) VALUES ` + batchValues(len(revisions), 6, nil)
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("error insertando revisiones del lote: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// gormBatchUpdateTypes son los tipos de PostgreSQL de batchUpdateColumns
+var gormBatchUpdateTypes = []string{"integer", "integer", "text", "text", "boolean", "timestamp", "text",
+	"integer", "timestamp", "integer", "double precision", "double precision"}
+
+// gormBatchDeleteTypes son los tipos de PostgreSQL de la tabla de valores de batchDeleteQuery
+var gormBatchDeleteTypes = []string{"integer", "integer"}
+
+// ApplyBatch aplica el lote en una transacción: las tareas nuevas y las revisiones se insertan con
+// un Create de varias filas y las actualizaciones y eliminaciones con un UPDATE cada una
+func (r *GormTaskRepository) ApplyBatch(ctx context.Context, batch *domain.TaskBatch) error {
+	if batch.IsEmpty() {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	return database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := gormCreateBatchTasks(tx, batch.Creates, now); err != nil {
+			return err
+		}
+		if len(batch.Updates) > 0 {
+			var updated []int
+			query := batchUpdateQuery(len(batch.Updates), gormBatchUpdateTypes)
+			if err := tx.Raw(query, batchUpdateArgs(batch.Updates, now)...).Scan(&updated).Error; err != nil {
+				return fmt.Errorf("error actualizando tareas del lote con GORM: %w", err)
+			}
+			if err := checkBatchRows(batch.Updates, updated); err != nil {
+				return err
+			}
+		}
+		if len(batch.Deletes) > 0 {
+			var deleted []int
+			query := batchDeleteQuery(len(batch.Deletes), gormBatchDeleteTypes)
+			if err := tx.Raw(query, batchDeleteArgs(batch.Deletes, now)...).Scan(&deleted).Error; err != nil {
+				return fmt.Errorf("error eliminando tareas del lote con GORM: %w", err)
+			}
+			if err := checkBatchRows(batch.Deletes, deleted); err != nil {
+				return err
+			}
+		}
+
+		return gormCreateBatchRevisions(tx, applyBatchResults(batch, now))
+	})
+}
+
+// gormCreateBatchTasks inserta las tareas nuevas del lote; GORM asigna los IDs en el orden del slice
+func gormCreateBatchTasks(tx *gorm.DB, writes []domain.BatchWrite, now time.Time) error {
+	if len(writes) == 0 {
+		return nil
+	}
+
+	var lastRank string
+	if err := tx.Model(&GormTaskModel{}).Unscoped().Select("COALESCE(MAX(rank), '')").Scan(&lastRank).Error; err != nil {
+		return fmt.Errorf("error obteniendo la última posición con GORM: %w", err)
+	}
+	assignBatchRanks(writes, lastRank)
+
+	models := make([]GormTaskModel, len(writes))
+	for i, write := range writes {
+		models[i].FromDomain(write.Task)
+		models[i].Version = 1
+		models[i].CreatedAt = now
+		models[i].UpdatedAt = now
+	}
+	if err := tx.Create(&models).Error; err != nil {
+		return fmt.Errorf("error creando tareas del lote con GORM: %w", err)
+	}
+
+	for i, write := range writes {
+		write.Task.ID = models[i].ID
+		write.Task.Version = 1
+		write.Task.CreatedAt = now
+		write.Task.UpdatedAt = now
+	}
+	return nil
+}
+
+// gormCreateBatchRevisions guarda las revisiones del lote con un Create de varias filas
+func gormCreateBatchRevisions(tx *gorm.DB, revisions []*domain.TaskRevision) error {
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	models := make([]GormTaskRevisionModel, len(revisions))
+	for i, revision := range revisions {
+		changes, snapshot, err := marshalRevision(revision)
+		if err != nil {
+			return err
+		}
+		models[i] = GormTaskRevisionModel{
+			TaskID:    revision.TaskID,
+			Action:    string(revision.Action),
+			Actor:     revision.Actor,
+			Changes:   changes,
+			Snapshot:  snapshot,
+			CreatedAt: revision.CreatedAt,
+		}
+	}
+	if err := tx.Create(&models).Error; err != nil {
+		return fmt.Errorf("error insertando revisiones del lote con GORM: %w", err)
+	}
+	for i, revision := range revisions {
+		revision.ID = models[i].ID
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTaskRepository_ApplyBatch(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	historyRepo := NewSQLiteTaskHistoryRepository(sqliteDB)
+
+	toUpdate, err := repo.Create(ctx, domain.NewTask("Actualizar", "D"))
+	require.NoError(t, err)
+	toDelete, err := repo.Create(ctx, domain.NewTask("Eliminar", "D"))
+	require.NoError(t, err)
+
+	first, second := domain.NewTask("Nueva 1", "D"), domain.NewTask("Nueva 2", "D")
+	before := *toUpdate
+	toUpdate.MarkAsCompleted()
+	batch := &domain.TaskBatch{
+		Creates: []domain.BatchWrite{
+			{Task: first, Revision: domain.NewTaskRevision(domain.RevisionCreate, "ana", nil, first)},
+			{Task: second},
+		},
+		Updates: []domain.BatchWrite{
+			{Task: toUpdate, Revision: domain.NewTaskRevision(domain.RevisionComplete, "ana", &before, toUpdate)},
+		},
+		Deletes: []domain.BatchWrite{{Task: toDelete}},
+	}
+	require.NoError(t, repo.ApplyBatch(ctx, batch))
+
+	// Las tareas nuevas reciben su ID y quedan al final del orden manual, en el orden del lote
+	require.NotZero(t, first.ID)
+	require.NotEqual(t, first.ID, second.ID)
+	require.Equal(t, 1, first.Version)
+	tasks, err := repo.GetAll(ctx, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 3)
+	require.Equal(t, []string{"Actualizar", "Nueva 1", "Nueva 2"}, []string{tasks[0].Title, tasks[1].Title, tasks[2].Title})
+
+	updated, err := repo.GetByID(ctx, toUpdate.ID)
+	require.NoError(t, err)
+	require.True(t, updated.Completed)
+	require.Equal(t, 2, updated.Version)
+	require.Equal(t, 2, toUpdate.Version)
+
+	_, err = repo.GetByID(ctx, toDelete.ID)
+	require.Error(t, err)
+
+	// Las revisiones se guardan con la tarea resultante
+	revisions, err := historyRepo.GetRevisions(ctx, first.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	revisions, err = historyRepo.GetRevisions(ctx, toUpdate.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	require.Equal(t, 2, revisions[0].Snapshot.Version)
+}
+
+func TestSQLiteTaskRepository_ApplyBatch_ConflictRollsBack(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	fresh, err := repo.Create(ctx, domain.NewTask("Vigente", "D"))
+	require.NoError(t, err)
+	stale, err := repo.Create(ctx, domain.NewTask("Obsoleta", "D"))
+	require.NoError(t, err)
+
+	// Otra escritura cambia la tarea después de leerla
+	copyOfStale := *stale
+	_, err = repo.Update(ctx, &copyOfStale)
+	require.NoError(t, err)
+
+	fresh.Title = "Cambiada"
+	stale.Title = "Cambiada"
+	err = repo.ApplyBatch(ctx, &domain.TaskBatch{
+		Creates: []domain.BatchWrite{{Task: domain.NewTask("Nueva", "D")}},
+		Updates: []domain.BatchWrite{{Task: fresh}, {Task: stale}},
+	})
+
+	var conflict *domain.BatchConflictError
+	require.ErrorAs(t, err, &conflict)
+	require.Equal(t, []int{stale.ID}, conflict.TaskIDs)
+	require.ErrorIs(t, err, domain.ErrVersionConflict)
+
+	// Nada del lote se aplicó
+	tasks, err := repo.GetAll(ctx, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Len(t, tasks, 2)
+	current, err := repo.GetByID(ctx, fresh.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Vigente", current.Title)
+	require.Equal(t, 1, current.Version)
+}
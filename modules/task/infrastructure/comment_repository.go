@@ -0,0 +1,237 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// commentColumns son las columnas que se leen de un comentario
+const commentColumns = `id, task_id, author, body, created_at, updated_at, edited_at, archived_at`
+
+// scanComment lee un comentario en el orden definido por commentColumns
+func scanComment(row rowScanner) (*domain.Comment, error) {
+	comment := &domain.Comment{}
+	var editedAt, archivedAt sql.NullTime
+	err := row.Scan(
+		&comment.ID,
+		&comment.TaskID,
+		&comment.Author,
+		&comment.Body,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+		&editedAt,
+		&archivedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if editedAt.Valid {
+		comment.EditedAt = &editedAt.Time
+	}
+	if archivedAt.Valid {
+		comment.ArchivedAt = &archivedAt.Time
+	}
+	return comment, nil
+}
+
+// SQLiteCommentRepository implementa CommentRepository usando SQLite
+type SQLiteCommentRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteCommentRepository crea una nueva instancia del repositorio de comentarios
+func NewSQLiteCommentRepository(db *database.SQLiteDB) domain.CommentRepository {
+	return &SQLiteCommentRepository{
+		db: db,
+	}
+}
+
+// Create inserta un nuevo comentario
+func (r *SQLiteCommentRepository) Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	query := `INSERT INTO task_comments (task_id, author, body, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
+	now := time.Now().UTC()
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, comment.TaskID, comment.Author, comment.Body, now, now)
+	if err != nil {
+		return nil, fmt.Errorf("error insertando comentario: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID del comentario insertado: %w", err)
+	}
+	comment.ID = int(id)
+	comment.CreatedAt = now
+	comment.UpdatedAt = now
+
+	return comment, nil
+}
+
+// GetByID obtiene un comentario por su ID
+func (r *SQLiteCommentRepository) GetByID(ctx context.Context, id int) (*domain.Comment, error) {
+	query := `SELECT ` + commentColumns + ` FROM task_comments WHERE id = ?`
+
+	comment, err := scanComment(r.db.Conn(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("comentario con ID %d no encontrado", id)
+		}
+		return nil, fmt.Errorf("error obteniendo comentario: %w", err)
+	}
+	return comment, nil
+}
+
+// ListByTask obtiene una página de comentarios no archivados de una tarea, del más antiguo al más reciente
+func (r *SQLiteCommentRepository) ListByTask(ctx context.Context, taskID, limit, offset int) ([]*domain.Comment, int, error) {
+	var total int
+	countQuery := `SELECT COUNT(*) FROM task_comments WHERE task_id = ? AND archived_at IS NULL`
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, countQuery, taskID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("error contando comentarios: %w", err)
+	}
+
+	query := `SELECT ` + commentColumns + ` FROM task_comments
+		WHERE task_id = ? AND archived_at IS NULL
+		ORDER BY created_at, id LIMIT ? OFFSET ?`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, taskID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error obteniendo comentarios: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*domain.Comment
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error escaneando comentario: %w", err)
+		}
+		comments = append(comments, comment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return comments, total, nil
+}
+
+// Update guarda el comentario editado y su revisión anterior en una transacción
+func (r *SQLiteCommentRepository) Update(ctx context.Context, comment *domain.Comment, revision *domain.CommentRevision) (*domain.Comment, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	revisionQuery := `INSERT INTO task_comment_revisions (comment_id, body, edited_at) VALUES (?, ?, ?)`
+	if _, err := tx.ExecContext(ctx, revisionQuery, comment.ID, revision.Body, revision.EditedAt); err != nil {
+		return nil, fmt.Errorf("error insertando revisión del comentario: %w", err)
+	}
+
+	updateQuery := `UPDATE task_comments SET body = ?, updated_at = ?, edited_at = ? WHERE id = ?`
+	result, err := tx.ExecContext(ctx, updateQuery, comment.Body, comment.UpdatedAt, comment.EditedAt, comment.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error actualizando comentario: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("error verificando filas afectadas: %w", err)
+	}
+	if rowsAffected == 0 {
+		return nil, fmt.Errorf("comentario con ID %d no encontrado", comment.ID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return comment, nil
+}
+
+// GetRevisions obtiene las versiones anteriores de un comentario, de la más antigua a la más reciente
+func (r *SQLiteCommentRepository) GetRevisions(ctx context.Context, commentID int) ([]*domain.CommentRevision, error) {
+	query := `SELECT id, comment_id, body, edited_at FROM task_comment_revisions WHERE comment_id = ? ORDER BY edited_at, id`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo revisiones: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.CommentRevision
+	for rows.Next() {
+		revision := &domain.CommentRevision{}
+		if err := rows.Scan(&revision.ID, &revision.CommentID, &revision.Body, &revision.EditedAt); err != nil {
+			return nil, fmt.Errorf("error escaneando revisión: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return revisions, nil
+}
+
+// Delete elimina un comentario y su historial
+func (r *SQLiteCommentRepository) Delete(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM task_comment_revisions WHERE comment_id = ?`, id); err != nil {
+		return fmt.Errorf("error eliminando revisiones del comentario: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM task_comments WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("error eliminando comentario: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando eliminacion: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("comentario con ID %d no encontrado", id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
+
+// DeleteByTask elimina todos los comentarios de una tarea y sus historiales
+func (r *SQLiteCommentRepository) DeleteByTask(ctx context.Context, taskID int) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	revisionsQuery := `DELETE FROM task_comment_revisions WHERE comment_id IN (SELECT id FROM task_comments WHERE task_id = ?)`
+	if _, err := tx.ExecContext(ctx, revisionsQuery, taskID); err != nil {
+		return fmt.Errorf("error eliminando revisiones de comentarios: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM task_comments WHERE task_id = ?`, taskID); err != nil {
+		return fmt.Errorf("error eliminando comentarios: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
+
+// ArchiveByTask archiva los comentarios de una tarea
+func (r *SQLiteCommentRepository) ArchiveByTask(ctx context.Context, taskID int) error {
+	query := `UPDATE task_comments SET archived_at = ? WHERE task_id = ? AND archived_at IS NULL`
+
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, time.Now().UTC(), taskID); err != nil {
+		return fmt.Errorf("error archivando comentarios: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormCommentModel es el modelo de GORM para la tabla task_comments
+type GormCommentModel struct {
+	ID         int       `gorm:"primaryKey;autoIncrement"`
+	TaskID     int       `gorm:"not null;index"`
+	Author     string    `gorm:"not null;size:255"`
+	Body       string    `gorm:"not null;type:text"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+	EditedAt   *time.Time
+	ArchivedAt *time.Time
+}
+
+// TableName especifica el nombre de la tabla
+func (GormCommentModel) TableName() string {
+	return "task_comments"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (g *GormCommentModel) ToDomain() *domain.Comment {
+	return &domain.Comment{
+		ID:         g.ID,
+		TaskID:     g.TaskID,
+		Author:     g.Author,
+		Body:       g.Body,
+		CreatedAt:  g.CreatedAt,
+		UpdatedAt:  g.UpdatedAt,
+		EditedAt:   g.EditedAt,
+		ArchivedAt: g.ArchivedAt,
+	}
+}
+
+// GormCommentRevisionModel es el modelo de GORM para la tabla task_comment_revisions
+type GormCommentRevisionModel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	CommentID int       `gorm:"not null;index"`
+	Body      string    `gorm:"not null;type:text"`
+	EditedAt  time.Time `gorm:"not null"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormCommentRevisionModel) TableName() string {
+	return "task_comment_revisions"
+}
+
+// GormCommentRepository implementa CommentRepository usando GORM
+type GormCommentRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCommentRepository crea una nueva instancia del repositorio de comentarios GORM
+func NewGormCommentRepository(db *gorm.DB) domain.CommentRepository {
+	return &GormCommentRepository{
+		db: db,
+	}
+}
+
+// Create inserta un nuevo comentario con GORM
+func (r *GormCommentRepository) Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	model := &GormCommentModel{
+		TaskID: comment.TaskID,
+		Author: comment.Author,
+		Body:   comment.Body,
+	}
+
+	if err := database.GormConn(ctx, r.db).Create(model).Error; err != nil {
+		return nil, fmt.Errorf("error creando comentario con GORM: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// GetByID obtiene un comentario por su ID usando GORM
+func (r *GormCommentRepository) GetByID(ctx context.Context, id int) (*domain.Comment, error) {
+	var model GormCommentModel
+
+	if err := database.GormConn(ctx, r.db).First(&model, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("comentario con ID %d no encontrado", id)
+		}
+		return nil, fmt.Errorf("error obteniendo comentario con GORM: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// ListByTask obtiene una página de comentarios no archivados de una tarea
+func (r *GormCommentRepository) ListByTask(ctx context.Context, taskID, limit, offset int) ([]*domain.Comment, int, error) {
+	query := database.GormConn(ctx, r.db).Model(&GormCommentModel{}).Where("task_id = ? AND archived_at IS NULL", taskID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error contando comentarios con GORM: %w", err)
+	}
+
+	var models []GormCommentModel
+	if err := query.Order("created_at, id").Limit(limit).Offset(offset).Find(&models).Error; err != nil {
+		return nil, 0, fmt.Errorf("error obteniendo comentarios con GORM: %w", err)
+	}
+
+	comments := make([]*domain.Comment, len(models))
+	for i, model := range models {
+		comments[i] = model.ToDomain()
+	}
+	return comments, int(total), nil
+}
+
+// Update guarda el comentario editado y su revisión anterior en una transacción
+func (r *GormCommentRepository) Update(ctx context.Context, comment *domain.Comment, revision *domain.CommentRevision) (*domain.Comment, error) {
+	err := database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		revisionModel := &GormCommentRevisionModel{
+			CommentID: comment.ID,
+			Body:      revision.Body,
+			EditedAt:  revision.EditedAt,
+		}
+		if err := tx.Create(revisionModel).Error; err != nil {
+			return fmt.Errorf("error creando revisión del comentario con GORM: %w", err)
+		}
+
+		result := tx.Model(&GormCommentModel{}).Where("id = ?", comment.ID).Updates(map[string]interface{}{
+			"body":      comment.Body,
+			"edited_at": comment.EditedAt,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("error actualizando comentario con GORM: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("comentario con id %d no encontrado", comment.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByID(ctx, comment.ID)
+}
+
+// GetRevisions obtiene las versiones anteriores de un comentario
+func (r *GormCommentRepository) GetRevisions(ctx context.Context, commentID int) ([]*domain.CommentRevision, error) {
+	var models []GormCommentRevisionModel
+	if err := database.GormConn(ctx, r.db).Where("comment_id = ?", commentID).Order("edited_at, id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo revisiones con GORM: %w", err)
+	}
+
+	revisions := make([]*domain.CommentRevision, len(models))
+	for i, model := range models {
+		revisions[i] = &domain.CommentRevision{
+			ID:        model.ID,
+			CommentID: model.CommentID,
+			Body:      model.Body,
+			EditedAt:  model.EditedAt,
+		}
+	}
+	return revisions, nil
+}
+
+// Delete elimina un comentario y su historial
+func (r *GormCommentRepository) Delete(ctx context.Context, id int) error {
+	return database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("comment_id = ?", id).Delete(&GormCommentRevisionModel{}).Error; err != nil {
+			return fmt.Errorf("error eliminando revisiones con GORM: %w", err)
+		}
+
+		result := tx.Delete(&GormCommentModel{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("error eliminando comentario con GORM: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("comentario con id %d no encontrado", id)
+		}
+		return nil
+	})
+}
+
+// DeleteByTask elimina todos los comentarios de una tarea y sus historiales
+func (r *GormCommentRepository) DeleteByTask(ctx context.Context, taskID int) error {
+	return database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		commentIDs := tx.Model(&GormCommentModel{}).Select("id").Where("task_id = ?", taskID)
+		if err := tx.Where("comment_id IN (?)", commentIDs).Delete(&GormCommentRevisionModel{}).Error; err != nil {
+			return fmt.Errorf("error eliminando revisiones con GORM: %w", err)
+		}
+		if err := tx.Where("task_id = ?", taskID).Delete(&GormCommentModel{}).Error; err != nil {
+			return fmt.Errorf("error eliminando comentarios con GORM: %w", err)
+		}
+		return nil
+	})
+}
+
+// ArchiveByTask archiva los comentarios de una tarea
+func (r *GormCommentRepository) ArchiveByTask(ctx context.Context, taskID int) error {
+	err := database.GormConn(ctx, r.db).Model(&GormCommentModel{}).
+		Where("task_id = ? AND archived_at IS NULL", taskID).
+		Update("archived_at", time.Now().UTC()).Error
+	if err != nil {
+		return fmt.Errorf("error archivando comentarios con GORM: %w", err)
+	}
+	return nil
+}
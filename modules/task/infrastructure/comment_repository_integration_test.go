@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteCommentRepository_PaginationAndHistory(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteCommentRepository(sqliteDB)
+
+	for _, body := range []string{"uno", "dos", "tres"} {
+		comment, err := domain.NewComment(1, "ana", body)
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, comment)
+		require.NoError(t, err)
+	}
+
+	page, total, err := repo.ListByTask(ctx, 1, 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, total)
+	require.Len(t, page, 2)
+	require.Equal(t, "dos", page[0].Body)
+	require.Equal(t, "tres", page[1].Body)
+
+	// Editar dos veces guarda ambas versiones anteriores
+	comment := page[0]
+	for _, body := range []string{"dos v2", "dos v3"} {
+		revision, err := comment.Edit(body)
+		require.NoError(t, err)
+		_, err = repo.Update(ctx, comment, revision)
+		require.NoError(t, err)
+	}
+
+	fetched, err := repo.GetByID(ctx, comment.ID)
+	require.NoError(t, err)
+	require.Equal(t, "dos v3", fetched.Body)
+	require.NotNil(t, fetched.EditedAt)
+
+	revisions, err := repo.GetRevisions(ctx, comment.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, "dos", revisions[0].Body)
+	require.Equal(t, "dos v2", revisions[1].Body)
+
+	require.NoError(t, repo.Delete(ctx, comment.ID))
+	revisions, err = repo.GetRevisions(ctx, comment.ID)
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+}
+
+func TestSQLiteCommentRepository_ArchiveAndDeleteByTask(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteCommentRepository(sqliteDB)
+
+	first, err := domain.NewComment(1, "ana", "archivado")
+	require.NoError(t, err)
+	first, err = repo.Create(ctx, first)
+	require.NoError(t, err)
+	second, err := domain.NewComment(2, "ana", "eliminado")
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, second)
+	require.NoError(t, err)
+
+	// Los comentarios archivados se conservan pero no se listan
+	require.NoError(t, repo.ArchiveByTask(ctx, 1))
+	comments, total, err := repo.ListByTask(ctx, 1, 10, 0)
+	require.NoError(t, err)
+	require.Zero(t, total)
+	require.Empty(t, comments)
+
+	archived, err := repo.GetByID(ctx, first.ID)
+	require.NoError(t, err)
+	require.NotNil(t, archived.ArchivedAt)
+
+	require.NoError(t, repo.DeleteByTask(ctx, 2))
+	_, total, err = repo.ListByTask(ctx, 2, 10, 0)
+	require.NoError(t, err)
+	require.Zero(t, total)
+}
@@ -0,0 +1,46 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTaskRepository_UpdateStaleVersion(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	created, err := repo.Create(ctx, &domain.Task{Title: "Original", Description: "D"})
+	require.NoError(t, err)
+	require.Equal(t, 1, created.Version)
+
+	// Dos lectores obtienen la misma versión
+	first, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	second, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+
+	first.Title = "Primera"
+	updated, err := repo.Update(ctx, first)
+	require.NoError(t, err)
+	require.Equal(t, 2, updated.Version)
+
+	// El segundo escribe con una versión obsoleta
+	second.Title = "Segunda"
+	_, err = repo.Update(ctx, second)
+	require.ErrorIs(t, err, domain.ErrVersionConflict)
+
+	current, err := repo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Primera", current.Title)
+	require.Equal(t, 2, current.Version)
+
+	// Una tarea inexistente no es un conflicto
+	_, err = repo.Update(ctx, &domain.Task{ID: 999, Title: "X", Description: "D", Version: 1})
+	require.Error(t, err)
+	require.NotErrorIs(t, err, domain.ErrVersionConflict)
+}
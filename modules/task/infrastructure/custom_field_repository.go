@@ -0,0 +1,216 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// customFieldColumns son las columnas que se leen de una definición de campo personalizado
+const customFieldColumns = `id, key, name, type, options, created_at`
+
+// upsertCustomValueQuery guarda el valor de un campo en una tarea, reemplazando el anterior
+const upsertCustomValueQuery = `INSERT INTO task_custom_values (task_id, field_id, value_text, value_number)
+	VALUES (?, ?, ?, ?)
+	ON CONFLICT(task_id, field_id) DO UPDATE SET value_text = excluded.value_text, value_number = excluded.value_number`
+
+// parseCustomFields convierte el objeto JSON de valores de una tarea en un mapa (nil si no tiene valores)
+func parseCustomFields(raw string) (map[string]any, error) {
+	if raw == "" || raw == "{}" {
+		return nil, nil
+	}
+	var values map[string]any
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		return nil, fmt.Errorf("error leyendo campos personalizados: %w", err)
+	}
+	return values, nil
+}
+
+// encodeCustomFieldOptions serializa las opciones de un campo enum
+func encodeCustomFieldOptions(options []string) (string, error) {
+	if options == nil {
+		options = []string{}
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		return "", fmt.Errorf("error serializando opciones del campo: %w", err)
+	}
+	return string(data), nil
+}
+
+// decodeCustomFieldOptions lee las opciones serializadas de un campo (nil si no tiene)
+func decodeCustomFieldOptions(raw string) ([]string, error) {
+	var options []string
+	if err := json.Unmarshal([]byte(raw), &options); err != nil {
+		return nil, fmt.Errorf("error leyendo opciones del campo: %w", err)
+	}
+	if len(options) == 0 {
+		return nil, nil
+	}
+	return options, nil
+}
+
+// scanCustomField lee una definición en el orden definido por customFieldColumns
+func scanCustomField(row rowScanner) (*domain.CustomFieldDefinition, error) {
+	field := &domain.CustomFieldDefinition{}
+	var options string
+	if err := row.Scan(&field.ID, &field.Key, &field.Name, &field.Type, &options, &field.CreatedAt); err != nil {
+		return nil, err
+	}
+	var err error
+	if field.Options, err = decodeCustomFieldOptions(options); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// SQLiteCustomFieldRepository implementa CustomFieldRepository usando SQLite; los valores
+// se guardan en filas de task_custom_values
+type SQLiteCustomFieldRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteCustomFieldRepository crea una nueva instancia del repositorio de campos personalizados
+func NewSQLiteCustomFieldRepository(db *database.SQLiteDB) domain.CustomFieldRepository {
+	return &SQLiteCustomFieldRepository{
+		db: db,
+	}
+}
+
+// CreateDefinition guarda una definición nueva
+func (r *SQLiteCustomFieldRepository) CreateDefinition(ctx context.Context, field *domain.CustomFieldDefinition) (*domain.CustomFieldDefinition, error) {
+	options, err := encodeCustomFieldOptions(field.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `INSERT INTO custom_fields (key, name, type, options, created_at) VALUES (?, ?, ?, ?, ?)`
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, field.Key, field.Name, field.Type, options, field.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("error insertando campo personalizado: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID del campo insertado: %w", err)
+	}
+	field.ID = int(id)
+	return field, nil
+}
+
+// ListDefinitions obtiene las definiciones ordenadas por clave
+func (r *SQLiteCustomFieldRepository) ListDefinitions(ctx context.Context) ([]*domain.CustomFieldDefinition, error) {
+	query := `SELECT ` + customFieldColumns + ` FROM custom_fields ORDER BY key`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo campos personalizados: %w", err)
+	}
+	defer rows.Close()
+
+	fields := []*domain.CustomFieldDefinition{}
+	for rows.Next() {
+		field, err := scanCustomField(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando campo personalizado: %w", err)
+		}
+		fields = append(fields, field)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando campos personalizados: %w", err)
+	}
+	return fields, nil
+}
+
+// GetDefinition obtiene una definición por su clave
+func (r *SQLiteCustomFieldRepository) GetDefinition(ctx context.Context, key string) (*domain.CustomFieldDefinition, error) {
+	query := `SELECT ` + customFieldColumns + ` FROM custom_fields WHERE key = ?`
+
+	field, err := scanCustomField(r.db.Conn(ctx).QueryRowContext(ctx, query, key))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: %s", domain.ErrCustomFieldNotFound, key)
+		}
+		return nil, fmt.Errorf("error obteniendo campo personalizado: %w", err)
+	}
+	return field, nil
+}
+
+// DeleteDefinition elimina una definición y sus valores en todas las tareas
+func (r *SQLiteCustomFieldRepository) DeleteDefinition(ctx context.Context, key string) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	// SQLite no aplica las claves foráneas por defecto: los valores se eliminan a mano
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM task_custom_values WHERE field_id IN (SELECT id FROM custom_fields WHERE key = ?)`, key); err != nil {
+		return fmt.Errorf("error eliminando valores del campo: %w", err)
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM custom_fields WHERE key = ?`, key)
+	if err != nil {
+		return fmt.Errorf("error eliminando campo personalizado: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando filas afectadas: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrCustomFieldNotFound, key)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
+
+// SetValues guarda y quita valores de una tarea e incrementa su versión en la misma transacción
+func (r *SQLiteCustomFieldRepository) SetValues(ctx context.Context, taskID int, set []domain.CustomFieldValue, remove []*domain.CustomFieldDefinition) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `UPDATE tasks SET updated_at = ?, version = version + 1
+		WHERE id = ? AND deleted_at IS NULL AND archived_at IS NULL`, time.Now().UTC(), taskID)
+	if err != nil {
+		return fmt.Errorf("error actualizando tarea: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando filas afectadas: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("tarea con ID %d no encontrada", taskID)
+	}
+
+	for _, value := range set {
+		var text, number any
+		if value.Field.Type.IsNumeric() {
+			number = value.Value
+		} else {
+			text = value.Value
+		}
+		if _, err := tx.ExecContext(ctx, upsertCustomValueQuery, taskID, value.Field.ID, text, number); err != nil {
+			return fmt.Errorf("error guardando el campo %s: %w", value.Field.Key, err)
+		}
+	}
+	for _, field := range remove {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM task_custom_values WHERE task_id = ? AND field_id = ?`, taskID, field.ID); err != nil {
+			return fmt.Errorf("error quitando el campo %s: %w", field.Key, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
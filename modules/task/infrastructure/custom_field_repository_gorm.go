@@ -0,0 +1,153 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormCustomFieldModel es el modelo de GORM para la tabla custom_fields
+type GormCustomFieldModel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	Key       string    `gorm:"not null;uniqueIndex;size:50"`
+	Name      string    `gorm:"not null;size:255"`
+	Type      string    `gorm:"not null;size:20"`
+	Options   string    `gorm:"not null;type:text;default:'[]'"` // Arreglo JSON de opciones (enum)
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormCustomFieldModel) TableName() string {
+	return "custom_fields"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (g *GormCustomFieldModel) ToDomain() (*domain.CustomFieldDefinition, error) {
+	options, err := decodeCustomFieldOptions(g.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.CustomFieldDefinition{
+		ID:        g.ID,
+		Key:       g.Key,
+		Name:      g.Name,
+		Type:      domain.CustomFieldType(g.Type),
+		Options:   options,
+		CreatedAt: g.CreatedAt,
+	}, nil
+}
+
+// GormCustomFieldRepository implementa CustomFieldRepository usando GORM; los valores
+// se guardan en la columna JSONB tasks.custom_fields
+type GormCustomFieldRepository struct {
+	db *gorm.DB
+}
+
+// NewGormCustomFieldRepository crea una nueva instancia del repositorio de campos personalizados con GORM
+func NewGormCustomFieldRepository(db *gorm.DB) domain.CustomFieldRepository {
+	return &GormCustomFieldRepository{
+		db: db,
+	}
+}
+
+// CreateDefinition guarda una definición nueva
+func (r *GormCustomFieldRepository) CreateDefinition(ctx context.Context, field *domain.CustomFieldDefinition) (*domain.CustomFieldDefinition, error) {
+	options, err := encodeCustomFieldOptions(field.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	model := GormCustomFieldModel{Key: field.Key, Name: field.Name, Type: string(field.Type), Options: options, CreatedAt: field.CreatedAt}
+	if err := database.GormConn(ctx, r.db).Create(&model).Error; err != nil {
+		return nil, fmt.Errorf("error insertando campo personalizado con GORM: %w", err)
+	}
+	field.ID = model.ID
+	return field, nil
+}
+
+// ListDefinitions obtiene las definiciones ordenadas por clave
+func (r *GormCustomFieldRepository) ListDefinitions(ctx context.Context) ([]*domain.CustomFieldDefinition, error) {
+	var models []GormCustomFieldModel
+	if err := database.GormConn(ctx, r.db).Order("key").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo campos personalizados con GORM: %w", err)
+	}
+
+	fields := make([]*domain.CustomFieldDefinition, len(models))
+	for i := range models {
+		field, err := models[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = field
+	}
+	return fields, nil
+}
+
+// GetDefinition obtiene una definición por su clave
+func (r *GormCustomFieldRepository) GetDefinition(ctx context.Context, key string) (*domain.CustomFieldDefinition, error) {
+	var model GormCustomFieldModel
+	if err := database.GormConn(ctx, r.db).Where("key = ?", key).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrCustomFieldNotFound, key)
+		}
+		return nil, fmt.Errorf("error obteniendo campo personalizado con GORM: %w", err)
+	}
+	return model.ToDomain()
+}
+
+// DeleteDefinition elimina una definición y quita su clave de todas las tareas
+func (r *GormCustomFieldRepository) DeleteDefinition(ctx context.Context, key string) error {
+	return database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("key = ?", key).Delete(&GormCustomFieldModel{})
+		if result.Error != nil {
+			return fmt.Errorf("error eliminando campo personalizado con GORM: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", domain.ErrCustomFieldNotFound, key)
+		}
+
+		err := tx.Exec(`UPDATE tasks SET custom_fields = custom_fields - CAST(? AS text)
+			WHERE custom_fields -> ? IS NOT NULL`, key, key).Error
+		if err != nil {
+			return fmt.Errorf("error eliminando valores del campo con GORM: %w", err)
+		}
+		return nil
+	})
+}
+
+// SetValues combina los valores nuevos con los de la tarea, quita los indicados e incrementa su versión
+func (r *GormCustomFieldRepository) SetValues(ctx context.Context, taskID int, set []domain.CustomFieldValue, remove []*domain.CustomFieldDefinition) error {
+	values := make(map[string]any, len(set))
+	for _, value := range set {
+		values[value.Field.Key] = value.Value
+	}
+	patch, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("error serializando campos personalizados: %w", err)
+	}
+
+	// (custom_fields - clave1 - clave2 ...) || nuevos valores
+	expr := "(custom_fields" + strings.Repeat(" - CAST(? AS text)", len(remove)) + ") || CAST(? AS jsonb)"
+	args := make([]any, 0, len(remove)+3)
+	for _, field := range remove {
+		args = append(args, field.Key)
+	}
+	args = append(args, string(patch), time.Now().UTC(), taskID)
+
+	result := database.GormConn(ctx, r.db).Exec(`UPDATE tasks SET custom_fields = `+expr+`, updated_at = ?, version = version + 1
+		WHERE id = ? AND deleted_at IS NULL AND archived_at IS NULL`, args...)
+	if result.Error != nil {
+		return fmt.Errorf("error guardando campos personalizados con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("tarea con ID %d no encontrada", taskID)
+	}
+	return nil
+}
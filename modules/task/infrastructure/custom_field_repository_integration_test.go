@@ -0,0 +1,84 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteCustomFieldRepository_ValuesFilterAndSort(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	tasks := NewSQLiteTaskRepository(sqliteDB)
+	repo := NewSQLiteCustomFieldRepository(sqliteDB)
+
+	priority, err := domain.NewCustomFieldDefinition("priority", "Prioridad", domain.CustomFieldEnum, []string{"alta", "baja"})
+	require.NoError(t, err)
+	_, err = repo.CreateDefinition(ctx, priority)
+	require.NoError(t, err)
+	size, err := domain.NewCustomFieldDefinition("size", "Tamaño", domain.CustomFieldNumber, nil)
+	require.NoError(t, err)
+	_, err = repo.CreateDefinition(ctx, size)
+	require.NoError(t, err)
+
+	fields, err := repo.ListDefinitions(ctx)
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	require.Equal(t, []string{"alta", "baja"}, fields[0].Options)
+
+	var ids []int
+	for _, v := range []struct {
+		priority string
+		size     float64
+	}{{"alta", 8}, {"baja", 2}, {"alta", 3}} {
+		task, err := tasks.Create(ctx, &domain.Task{Title: "T", Description: "D"})
+		require.NoError(t, err)
+		require.NoError(t, repo.SetValues(ctx, task.ID, []domain.CustomFieldValue{
+			{Field: priority, Value: v.priority},
+			{Field: size, Value: v.size},
+		}, nil))
+		ids = append(ids, task.ID)
+	}
+	// Una tarea sin valores queda al final al ordenar
+	plain, err := tasks.Create(ctx, &domain.Task{Title: "Sin campos", Description: "D"})
+	require.NoError(t, err)
+
+	got, err := tasks.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"priority": "alta", "size": 8.0}, got.CustomFields)
+	require.Equal(t, 2, got.Version)
+
+	// Filtro por enum y orden numérico descendente
+	filtered, err := tasks.GetAll(ctx, domain.TaskQueryOptions{
+		CustomFields: []domain.CustomFieldFilter{{Key: "priority", Type: domain.CustomFieldEnum, Value: "alta"}},
+		SortBy:       &domain.CustomFieldSort{Key: "size", Type: domain.CustomFieldNumber, Desc: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered, 2)
+	require.Equal(t, []int{ids[0], ids[2]}, []int{filtered[0].ID, filtered[1].ID})
+
+	sorted, err := tasks.GetAll(ctx, domain.TaskQueryOptions{
+		SortBy: &domain.CustomFieldSort{Key: "size", Type: domain.CustomFieldNumber},
+	})
+	require.NoError(t, err)
+	require.Len(t, sorted, 4)
+	require.Equal(t, []int{ids[1], ids[2], ids[0], plain.ID},
+		[]int{sorted[0].ID, sorted[1].ID, sorted[2].ID, sorted[3].ID})
+
+	// Quitar un valor y eliminar una definición borra sus valores
+	require.NoError(t, repo.SetValues(ctx, ids[0], nil, []*domain.CustomFieldDefinition{size}))
+	got, err = tasks.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"priority": "alta"}, got.CustomFields)
+
+	require.NoError(t, repo.DeleteDefinition(ctx, "priority"))
+	got, err = tasks.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	require.Nil(t, got.CustomFields)
+	require.ErrorIs(t, repo.DeleteDefinition(ctx, "priority"), domain.ErrCustomFieldNotFound)
+	_, err = repo.GetDefinition(ctx, "priority")
+	require.ErrorIs(t, err, domain.ErrCustomFieldNotFound)
+}
@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// SQLiteDependencyRepository implementa DependencyRepository usando SQLite
+type SQLiteDependencyRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteDependencyRepository crea una nueva instancia del repositorio de dependencias
+func NewSQLiteDependencyRepository(db *database.SQLiteDB) domain.DependencyRepository {
+	return &SQLiteDependencyRepository{
+		db: db,
+	}
+}
+
+// AddDependency registra que taskID está bloqueada por blockerID
+func (r *SQLiteDependencyRepository) AddDependency(ctx context.Context, taskID, blockerID int) error {
+	query := `INSERT OR IGNORE INTO task_dependencies (task_id, blocker_id, created_at) VALUES (?, ?, ?)`
+
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, taskID, blockerID, time.Now().UTC()); err != nil {
+		return fmt.Errorf("error insertando dependencia: %w", err)
+	}
+	return nil
+}
+
+// RemoveDependency elimina el bloqueo de blockerID sobre taskID
+func (r *SQLiteDependencyRepository) RemoveDependency(ctx context.Context, taskID, blockerID int) error {
+	query := `DELETE FROM task_dependencies WHERE task_id = ? AND blocker_id = ?`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, taskID, blockerID)
+	if err != nil {
+		return fmt.Errorf("error eliminando dependencia: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando eliminacion: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("la tarea %d no está bloqueada por la tarea %d", taskID, blockerID)
+	}
+	return nil
+}
+
+// GetBlockers obtiene las tareas que bloquean a taskID
+func (r *SQLiteDependencyRepository) GetBlockers(ctx context.Context, taskID int) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks
+		WHERE id IN (SELECT blocker_id FROM task_dependencies WHERE task_id = ?) AND deleted_at IS NULL
+		ORDER BY id`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo bloqueadores: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando tarea: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return tasks, nil
+}
+
+// GetAllDependencies obtiene todas las dependencias registradas
+func (r *SQLiteDependencyRepository) GetAllDependencies(ctx context.Context) ([]domain.Dependency, error) {
+	query := `SELECT task_id, blocker_id, created_at FROM task_dependencies`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo dependencias: %w", err)
+	}
+	defer rows.Close()
+
+	var deps []domain.Dependency
+	for rows.Next() {
+		var dep domain.Dependency
+		if err := rows.Scan(&dep.TaskID, &dep.BlockerID, &dep.CreatedAt); err != nil {
+			return nil, fmt.Errorf("error escaneando dependencia: %w", err)
+		}
+		deps = append(deps, dep)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return deps, nil
+}
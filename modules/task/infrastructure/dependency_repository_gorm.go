@@ -0,0 +1,90 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GormTaskDependencyModel es el modelo de GORM para la tabla task_dependencies
+type GormTaskDependencyModel struct {
+	TaskID    int       `gorm:"primaryKey;autoIncrement:false"`
+	BlockerID int       `gorm:"primaryKey;autoIncrement:false"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormTaskDependencyModel) TableName() string {
+	return "task_dependencies"
+}
+
+// GormDependencyRepository implementa DependencyRepository usando GORM
+type GormDependencyRepository struct {
+	db *gorm.DB
+}
+
+// NewGormDependencyRepository crea una nueva instancia del repositorio de dependencias GORM
+func NewGormDependencyRepository(db *gorm.DB) domain.DependencyRepository {
+	return &GormDependencyRepository{
+		db: db,
+	}
+}
+
+// AddDependency registra que taskID está bloqueada por blockerID
+func (r *GormDependencyRepository) AddDependency(ctx context.Context, taskID, blockerID int) error {
+	dep := &GormTaskDependencyModel{TaskID: taskID, BlockerID: blockerID}
+
+	if err := database.GormConn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(dep).Error; err != nil {
+		return fmt.Errorf("error creando dependencia con GORM: %w", err)
+	}
+	return nil
+}
+
+// RemoveDependency elimina el bloqueo de blockerID sobre taskID
+func (r *GormDependencyRepository) RemoveDependency(ctx context.Context, taskID, blockerID int) error {
+	result := database.GormConn(ctx, r.db).
+		Where("task_id = ? AND blocker_id = ?", taskID, blockerID).
+		Delete(&GormTaskDependencyModel{})
+	if result.Error != nil {
+		return fmt.Errorf("error eliminando dependencia con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("la tarea %d no está bloqueada por la tarea %d", taskID, blockerID)
+	}
+	return nil
+}
+
+// GetBlockers obtiene las tareas que bloquean a taskID
+func (r *GormDependencyRepository) GetBlockers(ctx context.Context, taskID int) ([]*domain.Task, error) {
+	var gormTasks []GormTaskModel
+
+	blockerIDs := r.db.Model(&GormTaskDependencyModel{}).Select("blocker_id").Where("task_id = ?", taskID)
+	if err := database.GormConn(ctx, r.db).Select(gormTaskSelect).Where("id IN (?)", blockerIDs).Order("id").Find(&gormTasks).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo bloqueadores con GORM: %w", err)
+	}
+
+	tasks := make([]*domain.Task, len(gormTasks))
+	for i, gormTask := range gormTasks {
+		tasks[i] = gormTask.ToDomain()
+	}
+	return tasks, nil
+}
+
+// GetAllDependencies obtiene todas las dependencias registradas
+func (r *GormDependencyRepository) GetAllDependencies(ctx context.Context) ([]domain.Dependency, error) {
+	var models []GormTaskDependencyModel
+	if err := database.GormConn(ctx, r.db).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo dependencias con GORM: %w", err)
+	}
+
+	deps := make([]domain.Dependency, len(models))
+	for i, m := range models {
+		deps[i] = domain.Dependency{TaskID: m.TaskID, BlockerID: m.BlockerID, CreatedAt: m.CreatedAt}
+	}
+	return deps, nil
+}
@@ -0,0 +1,77 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteDependencyRepository_BlockedIsComputed(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	deps := NewSQLiteDependencyRepository(sqliteDB)
+
+	blocker, err := repo.Create(ctx, &domain.Task{Title: "Bloqueador", Description: "D"})
+	require.NoError(t, err)
+	blocked, err := repo.Create(ctx, &domain.Task{Title: "Bloqueada", Description: "D"})
+	require.NoError(t, err)
+
+	require.NoError(t, deps.AddDependency(ctx, blocked.ID, blocker.ID))
+	// Agregar la misma dependencia dos veces no falla
+	require.NoError(t, deps.AddDependency(ctx, blocked.ID, blocker.ID))
+
+	fetched, err := repo.GetByID(ctx, blocked.ID)
+	require.NoError(t, err)
+	require.True(t, fetched.Blocked)
+
+	blockers, err := deps.GetBlockers(ctx, blocked.ID)
+	require.NoError(t, err)
+	require.Len(t, blockers, 1)
+	require.Equal(t, blocker.ID, blockers[0].ID)
+
+	// Completar el bloqueador desbloquea la tarea
+	blocker.Completed = true
+	_, err = repo.Update(ctx, blocker)
+	require.NoError(t, err)
+
+	fetched, err = repo.GetByID(ctx, blocked.ID)
+	require.NoError(t, err)
+	require.False(t, fetched.Blocked)
+}
+
+func TestSQLiteDependencyRepository_RemoveAndDeleteCleanup(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	deps := NewSQLiteDependencyRepository(sqliteDB)
+
+	a, err := repo.Create(ctx, &domain.Task{Title: "A", Description: "D"})
+	require.NoError(t, err)
+	b, err := repo.Create(ctx, &domain.Task{Title: "B", Description: "D"})
+	require.NoError(t, err)
+	c, err := repo.Create(ctx, &domain.Task{Title: "C", Description: "D"})
+	require.NoError(t, err)
+
+	require.NoError(t, deps.AddDependency(ctx, b.ID, a.ID))
+	require.NoError(t, deps.AddDependency(ctx, c.ID, a.ID))
+
+	require.NoError(t, deps.RemoveDependency(ctx, b.ID, a.ID))
+	require.Error(t, deps.RemoveDependency(ctx, b.ID, a.ID))
+
+	// Enviar la tarea a la papelera conserva sus dependencias para poder restaurarla
+	require.NoError(t, repo.Delete(ctx, a.ID))
+	all, err := deps.GetAllDependencies(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+
+	// Eliminarla definitivamente elimina sus dependencias
+	require.NoError(t, repo.DeletePermanently(ctx, a.ID))
+
+	all, err = deps.GetAllDependencies(ctx)
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// reportDayLayout es el formato de los días de las series de velocidad y burndown
+const reportDayLayout = "2006-01-02"
+
+// boardTasksFilter limita un reporte a las tareas con tarjeta en un tablero
+const boardTasksFilter = ` AND t.id IN (SELECT task_id FROM board_cards WHERE board_id = ?)`
+
+// sqliteVelocityQuery genera las semanas de [from, to) y suma las tareas completadas en cada una;
+// las fechas se guardan como texto UTC, así que se comparan con el día de inicio de cada semana
+const sqliteVelocityQuery = `WITH RECURSIVE weeks(week) AS (
+		SELECT date(?)
+		UNION ALL SELECT date(week, '+7 days') FROM weeks WHERE date(week, '+7 days') < date(?)
+	)
+	SELECT w.week, COALESCE(SUM(t.story_points), 0), COALESCE(SUM(t.estimated_hours), 0), COUNT(t.id)
+	FROM weeks w LEFT JOIN tasks t ON t.completed = TRUE AND t.deleted_at IS NULL
+		AND t.completed_at >= w.week AND t.completed_at < date(w.week, '+7 days') AND t.completed_at < ?%s
+	GROUP BY w.week ORDER BY w.week`
+
+// sqliteBurndownQuery genera los días de [from, to) y suma la estimación de las tareas
+// creadas y sin completar al terminar cada día. Las completadas sin completed_at usan updated_at
+const sqliteBurndownQuery = `WITH RECURSIVE days(day) AS (
+		SELECT date(?)
+		UNION ALL SELECT date(day, '+1 day') FROM days WHERE date(day, '+1 day') < date(?)
+	)
+	SELECT d.day, COALESCE(SUM(t.story_points), 0), COALESCE(SUM(t.estimated_hours), 0), COUNT(t.id)
+	FROM days d LEFT JOIN tasks t ON t.deleted_at IS NULL AND t.created_at < date(d.day, '+1 day')
+		AND (t.completed = FALSE OR COALESCE(t.completed_at, t.updated_at) >= date(d.day, '+1 day'))%s
+	GROUP BY d.day ORDER BY d.day`
+
+// reportQuery completa la consulta de un reporte con el filtro de tablero si se pidió
+func reportQuery(query string, boardID int, args ...any) (string, []any) {
+	if boardID == 0 {
+		return fmt.Sprintf(query, ""), args
+	}
+	return fmt.Sprintf(query, boardTasksFilter), append(args, boardID)
+}
+
+// scanVelocity lee las semanas agregadas del reporte de velocidad
+func scanVelocity(rows *sql.Rows) ([]domain.VelocityWeek, error) {
+	weeks := []domain.VelocityWeek{}
+	for rows.Next() {
+		var week domain.VelocityWeek
+		if err := rows.Scan(&week.WeekStart, &week.StoryPoints, &week.Hours, &week.Tasks); err != nil {
+			return nil, fmt.Errorf("error escaneando semana de velocidad: %w", err)
+		}
+		weeks = append(weeks, week)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando semanas de velocidad: %w", err)
+	}
+	return weeks, nil
+}
+
+// scanBurndown lee los días agregados del burndown
+func scanBurndown(rows *sql.Rows) ([]domain.BurndownDay, error) {
+	days := []domain.BurndownDay{}
+	for rows.Next() {
+		var day domain.BurndownDay
+		if err := rows.Scan(&day.Day, &day.RemainingPoints, &day.RemainingHours, &day.OpenTasks); err != nil {
+			return nil, fmt.Errorf("error escaneando día de burndown: %w", err)
+		}
+		days = append(days, day)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando días de burndown: %w", err)
+	}
+	return days, nil
+}
+
+// SQLiteEstimateRepository implementa EstimateRepository usando SQLite
+type SQLiteEstimateRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteEstimateRepository crea una nueva instancia del repositorio de reportes de estimación
+func NewSQLiteEstimateRepository(db *database.SQLiteDB) domain.EstimateRepository {
+	return &SQLiteEstimateRepository{
+		db: db,
+	}
+}
+
+// Velocity suma el trabajo completado en [from, to) por semanas que empiezan en from
+func (r *SQLiteEstimateRepository) Velocity(ctx context.Context, from, to time.Time, boardID int) ([]domain.VelocityWeek, error) {
+	query, args := reportQuery(sqliteVelocityQuery, boardID,
+		from.UTC().Format(reportDayLayout), to.UTC().Format(reportDayLayout), to.UTC())
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo velocidad: %w", err)
+	}
+	defer rows.Close()
+
+	return scanVelocity(rows)
+}
+
+// Burndown suma la estimación pendiente al terminar cada día de [from, to)
+func (r *SQLiteEstimateRepository) Burndown(ctx context.Context, from, to time.Time, boardID int) ([]domain.BurndownDay, error) {
+	query, args := reportQuery(sqliteBurndownQuery, boardID,
+		from.UTC().Format(reportDayLayout), to.UTC().Format(reportDayLayout))
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo burndown: %w", err)
+	}
+	defer rows.Close()
+
+	return scanBurndown(rows)
+}
@@ -0,0 +1,66 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// postgresVelocityQuery genera las semanas de [from, to) y suma las tareas completadas en cada una
+const postgresVelocityQuery = `SELECT to_char(w.week, 'YYYY-MM-DD'), COALESCE(SUM(t.story_points), 0),
+		COALESCE(SUM(t.estimated_hours), 0), COUNT(t.id)
+	FROM generate_series(CAST(? AS timestamp), CAST(? AS timestamp) - interval '1 second', interval '7 days') AS w(week)
+	LEFT JOIN tasks t ON t.completed = TRUE AND t.deleted_at IS NULL
+		AND t.completed_at >= w.week AND t.completed_at < w.week + interval '7 days' AND t.completed_at < ?%s
+	GROUP BY w.week ORDER BY w.week`
+
+// postgresBurndownQuery genera los días de [from, to) y suma la estimación de las tareas
+// creadas y sin completar al terminar cada día. Las completadas sin completed_at usan updated_at
+const postgresBurndownQuery = `SELECT to_char(d.day, 'YYYY-MM-DD'), COALESCE(SUM(t.story_points), 0),
+		COALESCE(SUM(t.estimated_hours), 0), COUNT(t.id)
+	FROM generate_series(CAST(? AS timestamp), CAST(? AS timestamp) - interval '1 second', interval '1 day') AS d(day)
+	LEFT JOIN tasks t ON t.deleted_at IS NULL AND t.created_at < d.day + interval '1 day'
+		AND (t.completed = FALSE OR COALESCE(t.completed_at, t.updated_at) >= d.day + interval '1 day')%s
+	GROUP BY d.day ORDER BY d.day`
+
+// GormEstimateRepository implementa EstimateRepository usando GORM
+type GormEstimateRepository struct {
+	db *gorm.DB
+}
+
+// NewGormEstimateRepository crea una nueva instancia del repositorio de reportes de estimación con GORM
+func NewGormEstimateRepository(db *gorm.DB) domain.EstimateRepository {
+	return &GormEstimateRepository{
+		db: db,
+	}
+}
+
+// Velocity suma el trabajo completado en [from, to) por semanas que empiezan en from
+func (r *GormEstimateRepository) Velocity(ctx context.Context, from, to time.Time, boardID int) ([]domain.VelocityWeek, error) {
+	query, args := reportQuery(postgresVelocityQuery, boardID, from.UTC(), to.UTC(), to.UTC())
+
+	rows, err := database.GormConn(ctx, r.db).Raw(query, args...).Rows()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo velocidad: %w", err)
+	}
+	defer rows.Close()
+
+	return scanVelocity(rows)
+}
+
+// Burndown suma la estimación pendiente al terminar cada día de [from, to)
+func (r *GormEstimateRepository) Burndown(ctx context.Context, from, to time.Time, boardID int) ([]domain.BurndownDay, error) {
+	query, args := reportQuery(postgresBurndownQuery, boardID, from.UTC(), to.UTC())
+
+	rows, err := database.GormConn(ctx, r.db).Raw(query, args...).Rows()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo burndown: %w", err)
+	}
+	defer rows.Close()
+
+	return scanBurndown(rows)
+}
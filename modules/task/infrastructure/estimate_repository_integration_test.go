@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTaskRepository_PersistsEstimates(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	task, err := repo.Create(ctx, &domain.Task{Title: "Estimada", Description: "D", StoryPoints: 5, EstimatedHours: 8, RemainingHours: 8})
+	require.NoError(t, err)
+
+	require.NoError(t, task.UpdateRemaining(2.5))
+	_, err = repo.Update(ctx, task)
+	require.NoError(t, err)
+
+	got, err := repo.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	require.Equal(t, 5, got.StoryPoints)
+	require.Equal(t, 8.0, got.EstimatedHours)
+	require.Equal(t, 2.5, got.RemainingHours)
+}
+
+func TestSQLiteEstimateRepository_VelocityAndBurndown(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	tasks := NewSQLiteTaskRepository(sqliteDB)
+	boards := NewSQLiteBoardRepository(sqliteDB)
+	repo := NewSQLiteEstimateRepository(sqliteDB)
+
+	// Semana del lunes 2 de marzo de 2026
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	history := []struct {
+		points    int
+		hours     float64
+		createdAt time.Time
+		doneAt    *time.Time
+	}{
+		{3, 4, monday, ptrTime(monday.Add(30 * time.Hour))},                 // Completada el martes
+		{5, 6, monday, ptrTime(monday.AddDate(0, 0, 8).Add(2 * time.Hour))}, // Completada el martes siguiente
+		{8, 10, monday.Add(26 * time.Hour), nil},                            // Creada el martes, pendiente
+	}
+	var ids []int
+	for _, h := range history {
+		task, err := tasks.Create(ctx, &domain.Task{Title: "T", Description: "D", StoryPoints: h.points, EstimatedHours: h.hours})
+		require.NoError(t, err)
+		_, err = sqliteDB.GetDB().ExecContext(ctx, `UPDATE tasks SET created_at = ?, completed = ?, completed_at = ? WHERE id = ?`,
+			h.createdAt, h.doneAt != nil, h.doneAt, task.ID)
+		require.NoError(t, err)
+		ids = append(ids, task.ID)
+	}
+
+	// Velocidad: dos semanas con una tarea completada cada una y una semana vacía
+	weeks, err := repo.Velocity(ctx, monday, monday.AddDate(0, 0, 21), 0)
+	require.NoError(t, err)
+	require.Equal(t, []domain.VelocityWeek{
+		{WeekStart: "2026-03-02", StoryPoints: 3, Hours: 4, Tasks: 1},
+		{WeekStart: "2026-03-09", StoryPoints: 5, Hours: 6, Tasks: 1},
+		{WeekStart: "2026-03-16", StoryPoints: 0, Hours: 0, Tasks: 0},
+	}, weeks)
+
+	// Burndown: el lunes hay dos tareas, el martes se completa una y se crea otra
+	days, err := repo.Burndown(ctx, monday, monday.AddDate(0, 0, 3), 0)
+	require.NoError(t, err)
+	require.Equal(t, []domain.BurndownDay{
+		{Day: "2026-03-02", RemainingPoints: 8, RemainingHours: 10, OpenTasks: 2},
+		{Day: "2026-03-03", RemainingPoints: 13, RemainingHours: 16, OpenTasks: 2},
+		{Day: "2026-03-04", RemainingPoints: 13, RemainingHours: 16, OpenTasks: 2},
+	}, days)
+
+	// Con tablero solo cuentan sus tarjetas
+	board, err := boards.Create(ctx, &domain.Board{Name: "Sprint", SwimlaneBy: domain.SwimlaneNone,
+		Columns: []domain.BoardColumn{{Name: "Todo", State: domain.ColumnStatePending}}})
+	require.NoError(t, err)
+	card := &domain.BoardCard{BoardID: board.ID, ColumnID: board.Columns[0].ID, TaskID: ids[2], Rank: domain.RankAfter("")}
+	require.NoError(t, boards.PutCard(ctx, card))
+
+	days, err = repo.Burndown(ctx, monday, monday.AddDate(0, 0, 2), board.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, days[0].OpenTasks)
+	require.Equal(t, 8, days[1].RemainingPoints)
+	weeks, err = repo.Velocity(ctx, monday, monday.AddDate(0, 0, 7), board.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, weeks[0].Tasks)
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,155 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// ErrEventBusClosed indica que el bus ya no acepta eventos
+var ErrEventBusClosed = errors.New("el bus de eventos está cerrado")
+
+// EventHandler procesa un evento publicado en el bus; un error solo se registra
+type EventHandler func(ctx context.Context, event domain.Event) error
+
+// eventSubscriber es un suscriptor del bus; sin types recibe todos los eventos
+type eventSubscriber struct {
+	name    string
+	types   []domain.EventType
+	handler EventHandler
+}
+
+// eventDelivery es un evento en la cola de un worker del bus asíncrono
+type eventDelivery struct {
+	ctx   context.Context
+	event domain.Event
+}
+
+// EventBus es un bus de eventos en memoria con varios suscriptores. En modo síncrono Publish
+// entrega los eventos antes de retornar; en modo asíncrono los entrega en segundo plano. En ambos
+// modos cada suscriptor recibe los eventos de una tarea en el orden en que se publicaron y el
+// pánico de un suscriptor no afecta a los demás
+type EventBus struct {
+	subscribersMu sync.RWMutex
+	subscribers   []eventSubscriber
+	// mu protege closed: Publish no encola después de que Close cerró las colas
+	mu      sync.RWMutex
+	queues  []chan eventDelivery
+	workers sync.WaitGroup
+	closed  bool
+}
+
+// EventBusOption configura el bus de eventos
+type EventBusOption func(*EventBus)
+
+// WithAsyncDelivery entrega los eventos con workers goroutines en segundo plano. Cada tarea se asigna
+// siempre al mismo worker para conservar su orden; buffer es la capacidad de la cola de cada worker
+func WithAsyncDelivery(workers, buffer int) EventBusOption {
+	return func(b *EventBus) {
+		b.queues = make([]chan eventDelivery, max(workers, 1))
+		for i := range b.queues {
+			b.queues[i] = make(chan eventDelivery, max(buffer, 0))
+		}
+	}
+}
+
+// NewEventBus crea un bus de eventos; por defecto entrega los eventos de forma síncrona
+func NewEventBus(opts ...EventBusOption) *EventBus {
+	b := &EventBus{}
+	for _, opt := range opts {
+		opt(b)
+	}
+	for _, queue := range b.queues {
+		b.workers.Add(1)
+		go b.work(queue)
+	}
+	return b
+}
+
+// Subscribe registra un suscriptor para los tipos de evento indicados, o para todos si no se indica ninguno
+func (b *EventBus) Subscribe(name string, handler EventHandler, types ...domain.EventType) {
+	b.subscribersMu.Lock()
+	defer b.subscribersMu.Unlock()
+	b.subscribers = append(b.subscribers, eventSubscriber{name: name, types: types, handler: handler})
+}
+
+// Publish entrega los eventos a los suscriptores. En modo asíncrono los encola y espera solo si la
+// cola del worker está llena; los suscriptores reciben un contexto que no se cancela con la petición
+func (b *EventBus) Publish(ctx context.Context, events []domain.Event) error {
+	b.mu.RLock()
+	if b.closed {
+		b.mu.RUnlock()
+		return ErrEventBusClosed
+	}
+	if b.queues == nil {
+		b.mu.RUnlock()
+		for _, event := range events {
+			b.dispatch(ctx, event)
+		}
+		return nil
+	}
+	defer b.mu.RUnlock()
+
+	for _, event := range events {
+		queue := b.queues[uint(event.TaskID)%uint(len(b.queues))]
+		select {
+		case queue <- eventDelivery{ctx: context.WithoutCancel(ctx), event: event}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close deja de aceptar eventos y espera a que los workers entreguen los que ya están en cola
+func (b *EventBus) Close() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+	b.closed = true
+	for _, queue := range b.queues {
+		close(queue)
+	}
+	b.mu.Unlock()
+	b.workers.Wait()
+}
+
+// work entrega en orden los eventos de una cola
+func (b *EventBus) work(queue chan eventDelivery) {
+	defer b.workers.Done()
+	for delivery := range queue {
+		b.dispatch(delivery.ctx, delivery.event)
+	}
+}
+
+// dispatch entrega un evento a cada suscriptor interesado, en el orden en que se suscribieron
+func (b *EventBus) dispatch(ctx context.Context, event domain.Event) {
+	b.subscribersMu.RLock()
+	subscribers := b.subscribers
+	b.subscribersMu.RUnlock()
+
+	for _, subscriber := range subscribers {
+		if len(subscriber.types) > 0 && !slices.Contains(subscriber.types, event.Type) {
+			continue
+		}
+		deliverEvent(ctx, subscriber, event)
+	}
+}
+
+// deliverEvent ejecuta el suscriptor aislando su pánico o error
+func deliverEvent(ctx context.Context, subscriber eventSubscriber, event domain.Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Pánico en el suscriptor %s con el evento %s de la tarea %d: %v", subscriber.name, event.Type, event.TaskID, r)
+		}
+	}()
+	if err := subscriber.handler(ctx, event); err != nil {
+		log.Printf("Error en el suscriptor %s con el evento %s de la tarea %d: %v", subscriber.name, event.Type, event.TaskID, err)
+	}
+}
@@ -0,0 +1,98 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestEventBus_SyncDeliversToSubscribers(t *testing.T) {
+	ctx := context.Background()
+	bus := NewEventBus()
+
+	var all, completed []domain.EventType
+	bus.Subscribe("todos", func(_ context.Context, event domain.Event) error {
+		all = append(all, event.Type)
+		return nil
+	})
+	bus.Subscribe("pánico", func(context.Context, domain.Event) error {
+		panic("falla el suscriptor")
+	})
+	bus.Subscribe("error", func(context.Context, domain.Event) error {
+		return errors.New("falla el suscriptor")
+	})
+	bus.Subscribe("completadas", func(_ context.Context, event domain.Event) error {
+		completed = append(completed, event.Type)
+		return nil
+	}, domain.EventTaskCompleted)
+
+	err := bus.Publish(ctx, []domain.Event{
+		{Type: domain.EventTaskCreated, TaskID: 1},
+		{Type: domain.EventTaskCompleted, TaskID: 1},
+	})
+	require.NoError(t, err)
+
+	// El pánico y el error de un suscriptor no impiden la entrega a los demás
+	require.Equal(t, []domain.EventType{domain.EventTaskCreated, domain.EventTaskCompleted}, all)
+	require.Equal(t, []domain.EventType{domain.EventTaskCompleted}, completed)
+}
+
+func TestEventBus_AsyncKeepsOrderPerTask(t *testing.T) {
+	ctx := context.Background()
+	bus := NewEventBus(WithAsyncDelivery(4, 8))
+
+	var mu sync.Mutex
+	received := map[int][]int{}
+	bus.Subscribe("orden", func(_ context.Context, event domain.Event) error {
+		if event.TaskID%2 == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		received[event.TaskID] = append(received[event.TaskID], event.Task.Version)
+		return nil
+	})
+	bus.Subscribe("pánico", func(context.Context, domain.Event) error {
+		panic("falla el suscriptor")
+	})
+
+	const versions = 20
+	for version := 1; version <= versions; version++ {
+		for taskID := 1; taskID <= 6; taskID++ {
+			event := domain.Event{Type: domain.EventTaskUpdated, TaskID: taskID, Task: &domain.Task{ID: taskID, Version: version}}
+			require.NoError(t, bus.Publish(ctx, []domain.Event{event}))
+		}
+	}
+	bus.Close()
+
+	for taskID := 1; taskID <= 6; taskID++ {
+		require.Len(t, received[taskID], versions)
+		for i, version := range received[taskID] {
+			require.Equal(t, i+1, version)
+		}
+	}
+	require.ErrorIs(t, bus.Publish(ctx, []domain.Event{{Type: domain.EventTaskCreated, TaskID: 1}}), ErrEventBusClosed)
+}
+
+func TestEventBus_AsyncIgnoresRequestCancellation(t *testing.T) {
+	bus := NewEventBus(WithAsyncDelivery(1, 1))
+
+	delivered := make(chan error, 1)
+	bus.Subscribe("contexto", func(ctx context.Context, _ domain.Event) error {
+		delivered <- ctx.Err()
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	require.NoError(t, bus.Publish(ctx, []domain.Event{{Type: domain.EventTaskCreated, TaskID: 1}}))
+	cancel()
+	bus.Close()
+
+	require.NoError(t, <-delivered)
+}
@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// eventLogSubscriberBuffer es la cantidad de eventos que puede tener pendientes un suscriptor en vivo
+const eventLogSubscriberBuffer = 64
+
+// eventLogSubscriber es un suscriptor en vivo del registro de eventos
+type eventLogSubscriber struct {
+	events chan application.StreamedEvent
+}
+
+// EventLog es un registro acotado en memoria de los últimos eventos de tareas, que implementa
+// EventStream. Los IDs parten del momento en que se creó el registro, así un ID de un proceso
+// anterior siempre queda fuera del registro y el cliente sabe que perdió eventos
+type EventLog struct {
+	mu          sync.Mutex
+	buffer      []application.StreamedEvent
+	firstID     uint64
+	lastID      uint64
+	subscribers map[*eventLogSubscriber]struct{}
+}
+
+// NewEventLog crea un registro que conserva los últimos capacity eventos
+func NewEventLog(capacity int) *EventLog {
+	start := uint64(time.Now().UnixMicro())
+	return &EventLog{
+		buffer:      make([]application.StreamedEvent, max(capacity, 1)),
+		firstID:     start + 1,
+		lastID:      start,
+		subscribers: make(map[*eventLogSubscriber]struct{}),
+	}
+}
+
+// Append registra el evento y lo entrega a los suscriptores; tiene la firma de EventHandler para
+// suscribirse al bus. Un suscriptor con la cola llena se desconecta para no frenar a los demás:
+// al reconectarse con su último ID recibe lo que falta desde el registro
+func (l *EventLog) Append(_ context.Context, event domain.Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lastID++
+	streamed := application.StreamedEvent{ID: l.lastID, Event: event}
+	l.buffer[l.lastID%uint64(len(l.buffer))] = streamed
+	if l.lastID-l.firstID >= uint64(len(l.buffer)) {
+		l.firstID = l.lastID - uint64(len(l.buffer)) + 1
+	}
+
+	for subscriber := range l.subscribers {
+		select {
+		case subscriber.events <- streamed:
+		default:
+			delete(l.subscribers, subscriber)
+			close(subscriber.events)
+		}
+	}
+	return nil
+}
+
+// Subscribe se suscribe a los eventos nuevos. Con lastID > 0 incluye los eventos registrados
+// después de ese ID, o marca Missed si el registro ya no los tiene todos
+func (l *EventLog) Subscribe(lastID uint64) *application.EventSubscription {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	subscription := &application.EventSubscription{LastID: l.lastID}
+	if lastID > 0 && lastID != l.lastID {
+		if lastID+1 < l.firstID || lastID > l.lastID {
+			subscription.Missed = true
+		} else {
+			for id := lastID + 1; id <= l.lastID; id++ {
+				subscription.Backlog = append(subscription.Backlog, l.buffer[id%uint64(len(l.buffer))])
+			}
+		}
+	}
+
+	subscriber := &eventLogSubscriber{events: make(chan application.StreamedEvent, eventLogSubscriberBuffer)}
+	l.subscribers[subscriber] = struct{}{}
+	subscription.Events = subscriber.events
+
+	var once sync.Once
+	subscription.Close = func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			if _, ok := l.subscribers[subscriber]; ok {
+				delete(l.subscribers, subscriber)
+				close(subscriber.events)
+			}
+		})
+	}
+	return subscription
+}
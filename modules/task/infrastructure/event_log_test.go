@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestEventLog_ResumesFromLastID(t *testing.T) {
+	ctx := context.Background()
+	eventLog := NewEventLog(3)
+
+	first := eventLog.Subscribe(0)
+	defer first.Close()
+	require.Empty(t, first.Backlog)
+	require.False(t, first.Missed)
+
+	for id := 1; id <= 4; id++ {
+		require.NoError(t, eventLog.Append(ctx, domain.Event{Type: domain.EventTaskUpdated, TaskID: id}))
+	}
+
+	// El suscriptor en vivo recibe los eventos con IDs consecutivos desde su posición
+	var received []domain.Event
+	var lastIDs []uint64
+	for range 4 {
+		streamed := <-first.Events
+		received = append(received, streamed.Event)
+		lastIDs = append(lastIDs, streamed.ID)
+	}
+	require.Equal(t, 4, received[3].TaskID)
+	require.Equal(t, first.LastID+1, lastIDs[0])
+	require.Equal(t, first.LastID+4, lastIDs[3])
+
+	// Reanudar desde el segundo evento trae los dos siguientes
+	resumed := eventLog.Subscribe(lastIDs[1])
+	defer resumed.Close()
+	require.False(t, resumed.Missed)
+	require.Len(t, resumed.Backlog, 2)
+	require.Equal(t, 3, resumed.Backlog[0].Event.TaskID)
+	require.Equal(t, lastIDs[3], resumed.LastID)
+
+	// Reanudar al día no trae nada
+	current := eventLog.Subscribe(lastIDs[3])
+	defer current.Close()
+	require.False(t, current.Missed)
+	require.Empty(t, current.Backlog)
+
+	// El primer evento ya salió del registro, y un ID futuro es de otro proceso
+	for _, lastID := range []uint64{lastIDs[0] - 1, lastIDs[3] + 10} {
+		subscription := eventLog.Subscribe(lastID)
+		require.True(t, subscription.Missed)
+		require.Empty(t, subscription.Backlog)
+		subscription.Close()
+	}
+}
+
+func TestEventLog_DropsSlowSubscriber(t *testing.T) {
+	ctx := context.Background()
+	eventLog := NewEventLog(10)
+
+	slow := eventLog.Subscribe(0)
+	for id := range eventLogSubscriberBuffer + 1 {
+		require.NoError(t, eventLog.Append(ctx, domain.Event{Type: domain.EventTaskCreated, TaskID: id}))
+	}
+
+	// El suscriptor se desconecta después de los eventos que alcanzó a encolar
+	count := 0
+	for range slow.Events {
+		count++
+	}
+	require.Equal(t, eventLogSubscriberBuffer, count)
+
+	// Cerrar una suscripción ya cortada no falla
+	slow.Close()
+	slow.Close()
+
+	closed := eventLog.Subscribe(0)
+	closed.Close()
+	_, ok := <-closed.Events
+	require.False(t, ok)
+	require.NoError(t, eventLog.Append(ctx, domain.Event{Type: domain.EventTaskCreated}))
+}
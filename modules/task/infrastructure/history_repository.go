@@ -0,0 +1,182 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+const revisionColumns = `id, task_id, action, actor, changes, snapshot, created_at`
+
+// SQLiteTaskHistoryRepository implementa TaskHistoryRepository usando SQLite
+type SQLiteTaskHistoryRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteTaskHistoryRepository crea una nueva instancia del repositorio de historial SQLite
+func NewSQLiteTaskHistoryRepository(db *database.SQLiteDB) domain.TaskHistoryRepository {
+	return &SQLiteTaskHistoryRepository{
+		db: db,
+	}
+}
+
+// CreateWithRevision inserta la tarea y su revisión en una transacción
+func (r *SQLiteTaskHistoryRepository) CreateWithRevision(ctx context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+	return r.withRevision(ctx, revision, func(tx *database.Tx) (*domain.Task, error) {
+		return insertTask(ctx, tx, task)
+	})
+}
+
+// UpdateWithRevision actualiza la tarea y registra su revisión en una transacción
+func (r *SQLiteTaskHistoryRepository) UpdateWithRevision(ctx context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+	return r.withRevision(ctx, revision, func(tx *database.Tx) (*domain.Task, error) {
+		return updateTask(ctx, tx, task)
+	})
+}
+
+// DeleteWithRevision mueve la tarea a la papelera y registra su revisión en una transacción
+func (r *SQLiteTaskHistoryRepository) DeleteWithRevision(ctx context.Context, id int, revision *domain.TaskRevision) error {
+	_, err := r.withRevision(ctx, revision, func(tx *database.Tx) (*domain.Task, error) {
+		return revision.Snapshot, softDeleteTask(ctx, tx, id)
+	})
+	return err
+}
+
+// withRevision ejecuta la escritura y guarda la revisión con la tarea resultante; si algo falla no se guarda nada
+func (r *SQLiteTaskHistoryRepository) withRevision(ctx context.Context, revision *domain.TaskRevision, write func(tx *database.Tx) (*domain.Task, error)) (*domain.Task, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	task, err := write(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertRevision(ctx, tx, task, revision); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return task, nil
+}
+
+// insertRevision guarda la revisión con la tarea resultante usando q, que suele ser una transacción
+func insertRevision(ctx context.Context, q sqlExecutor, task *domain.Task, revision *domain.TaskRevision) error {
+	revision.TaskID = task.ID
+	revision.Snapshot = task
+	changes, snapshot, err := marshalRevision(revision)
+	if err != nil {
+		return err
+	}
+	query := `INSERT INTO task_revisions (task_id, action, actor, changes, snapshot, created_at) VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := q.ExecContext(ctx, query, revision.TaskID, revision.Action, revision.Actor, changes, snapshot, revision.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("error insertando revisión de la tarea: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("error obteniendo ID de la revisión: %w", err)
+	}
+	revision.ID = int(id)
+	return nil
+}
+
+// GetRevisions obtiene el historial de una tarea, de la revisión más antigua a la más reciente
+func (r *SQLiteTaskHistoryRepository) GetRevisions(ctx context.Context, taskID int) ([]*domain.TaskRevision, error) {
+	query := `SELECT ` + revisionColumns + ` FROM task_revisions WHERE task_id = ? ORDER BY created_at, id`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo historial: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []*domain.TaskRevision{}
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetRevision obtiene una revisión de la tarea
+func (r *SQLiteTaskHistoryRepository) GetRevision(ctx context.Context, taskID, revisionID int) (*domain.TaskRevision, error) {
+	query := `SELECT ` + revisionColumns + ` FROM task_revisions WHERE task_id = ? AND id = ?`
+
+	revision, err := scanRevision(r.db.Conn(ctx).QueryRowContext(ctx, query, taskID, revisionID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: ID %d de la tarea %d", domain.ErrRevisionNotFound, revisionID, taskID)
+	}
+	return revision, err
+}
+
+// GetRevisionAt obtiene la última revisión registrada hasta at
+func (r *SQLiteTaskHistoryRepository) GetRevisionAt(ctx context.Context, taskID int, at time.Time) (*domain.TaskRevision, error) {
+	query := `SELECT ` + revisionColumns + ` FROM task_revisions WHERE task_id = ? AND created_at <= ?
+		ORDER BY created_at DESC, id DESC LIMIT 1`
+
+	revision, err := scanRevision(r.db.Conn(ctx).QueryRowContext(ctx, query, taskID, at.UTC()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: tarea %d, %s", domain.ErrNoRevisionAt, taskID, at.UTC().Format(time.RFC3339))
+	}
+	return revision, err
+}
+
+// scanRevision lee una fila de task_revisions en el orden de revisionColumns
+func scanRevision(row rowScanner) (*domain.TaskRevision, error) {
+	revision := &domain.TaskRevision{}
+	var changes, snapshot string
+	err := row.Scan(&revision.ID, &revision.TaskID, &revision.Action, &revision.Actor, &changes, &snapshot, &revision.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error escaneando revisión: %w", err)
+	}
+	if err := unmarshalRevision(revision, changes, snapshot); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// marshalRevision serializa las diferencias y la tarea resultante de una revisión
+func marshalRevision(revision *domain.TaskRevision) (string, string, error) {
+	changes, err := json.Marshal(revision.Changes)
+	if err != nil {
+		return "", "", fmt.Errorf("error serializando cambios de la revisión: %w", err)
+	}
+	snapshot, err := json.Marshal(revision.Snapshot)
+	if err != nil {
+		return "", "", fmt.Errorf("error serializando tarea de la revisión: %w", err)
+	}
+	return string(changes), string(snapshot), nil
+}
+
+// unmarshalRevision deserializa las diferencias y la tarea resultante de una revisión
+func unmarshalRevision(revision *domain.TaskRevision, changes, snapshot string) error {
+	if err := json.Unmarshal([]byte(changes), &revision.Changes); err != nil {
+		return fmt.Errorf("error leyendo cambios de la revisión: %w", err)
+	}
+	revision.Snapshot = &domain.Task{}
+	if err := json.Unmarshal([]byte(snapshot), revision.Snapshot); err != nil {
+		return fmt.Errorf("error leyendo tarea de la revisión: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormTaskRevisionModel es el modelo de GORM para la tabla task_revisions
+type GormTaskRevisionModel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	TaskID    int       `gorm:"not null;index"`
+	Action    string    `gorm:"not null;size:32"`
+	Actor     string    `gorm:"not null;size:255"`
+	Changes   string    `gorm:"not null;type:jsonb"`
+	Snapshot  string    `gorm:"not null;type:jsonb"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormTaskRevisionModel) TableName() string {
+	return "task_revisions"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (g *GormTaskRevisionModel) ToDomain() (*domain.TaskRevision, error) {
+	revision := &domain.TaskRevision{
+		ID:        g.ID,
+		TaskID:    g.TaskID,
+		Action:    domain.RevisionAction(g.Action),
+		Actor:     g.Actor,
+		CreatedAt: g.CreatedAt,
+	}
+	if err := unmarshalRevision(revision, g.Changes, g.Snapshot); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// GormTaskHistoryRepository implementa TaskHistoryRepository usando GORM
+type GormTaskHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTaskHistoryRepository crea una nueva instancia del repositorio de historial GORM
+func NewGormTaskHistoryRepository(db *gorm.DB) domain.TaskHistoryRepository {
+	return &GormTaskHistoryRepository{
+		db: db,
+	}
+}
+
+// CreateWithRevision inserta la tarea y su revisión en una transacción
+func (r *GormTaskHistoryRepository) CreateWithRevision(ctx context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+	return r.withRevision(ctx, revision, func(tx *gorm.DB) (*domain.Task, error) {
+		return gormCreateTask(tx, task)
+	})
+}
+
+// UpdateWithRevision actualiza la tarea y registra su revisión en una transacción
+func (r *GormTaskHistoryRepository) UpdateWithRevision(ctx context.Context, task *domain.Task, revision *domain.TaskRevision) (*domain.Task, error) {
+	return r.withRevision(ctx, revision, func(tx *gorm.DB) (*domain.Task, error) {
+		return gormUpdateTask(tx, task)
+	})
+}
+
+// DeleteWithRevision mueve la tarea a la papelera y registra su revisión en una transacción
+func (r *GormTaskHistoryRepository) DeleteWithRevision(ctx context.Context, id int, revision *domain.TaskRevision) error {
+	_, err := r.withRevision(ctx, revision, func(tx *gorm.DB) (*domain.Task, error) {
+		return revision.Snapshot, gormSoftDeleteTask(tx, id)
+	})
+	return err
+}
+
+// withRevision ejecuta la escritura y guarda la revisión con la tarea resultante; si algo falla no se guarda nada
+func (r *GormTaskHistoryRepository) withRevision(ctx context.Context, revision *domain.TaskRevision, write func(tx *gorm.DB) (*domain.Task, error)) (*domain.Task, error) {
+	var task *domain.Task
+	err := database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var err error
+		task, err = write(tx)
+		if err != nil {
+			return err
+		}
+
+		return gormCreateRevision(tx, task, revision)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// gormCreateRevision guarda la revisión con la tarea resultante usando tx
+func gormCreateRevision(tx *gorm.DB, task *domain.Task, revision *domain.TaskRevision) error {
+	revision.TaskID = task.ID
+	revision.Snapshot = task
+	changes, snapshot, err := marshalRevision(revision)
+	if err != nil {
+		return err
+	}
+	model := &GormTaskRevisionModel{
+		TaskID:    revision.TaskID,
+		Action:    string(revision.Action),
+		Actor:     revision.Actor,
+		Changes:   changes,
+		Snapshot:  snapshot,
+		CreatedAt: revision.CreatedAt,
+	}
+	if err := tx.Create(model).Error; err != nil {
+		return fmt.Errorf("error insertando revisión de la tarea con GORM: %w", err)
+	}
+	revision.ID = model.ID
+	return nil
+}
+
+// GetRevisions obtiene el historial de una tarea, de la revisión más antigua a la más reciente
+func (r *GormTaskHistoryRepository) GetRevisions(ctx context.Context, taskID int) ([]*domain.TaskRevision, error) {
+	var models []GormTaskRevisionModel
+	if err := database.GormConn(ctx, r.db).Where("task_id = ?", taskID).Order("created_at, id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo historial con GORM: %w", err)
+	}
+
+	revisions := make([]*domain.TaskRevision, 0, len(models))
+	for i := range models {
+		revision, err := models[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// GetRevision obtiene una revisión de la tarea
+func (r *GormTaskHistoryRepository) GetRevision(ctx context.Context, taskID, revisionID int) (*domain.TaskRevision, error) {
+	var model GormTaskRevisionModel
+	err := database.GormConn(ctx, r.db).Where("task_id = ? AND id = ?", taskID, revisionID).First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: ID %d de la tarea %d", domain.ErrRevisionNotFound, revisionID, taskID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo revisión con GORM: %w", err)
+	}
+	return model.ToDomain()
+}
+
+// GetRevisionAt obtiene la última revisión registrada hasta at
+func (r *GormTaskHistoryRepository) GetRevisionAt(ctx context.Context, taskID int, at time.Time) (*domain.TaskRevision, error) {
+	var model GormTaskRevisionModel
+	err := database.GormConn(ctx, r.db).Where("task_id = ? AND created_at <= ?", taskID, at.UTC()).
+		Order("created_at DESC, id DESC").First(&model).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: tarea %d, %s", domain.ErrNoRevisionAt, taskID, at.UTC().Format(time.RFC3339))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo revisión con GORM: %w", err)
+	}
+	return model.ToDomain()
+}
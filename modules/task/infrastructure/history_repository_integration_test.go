@@ -0,0 +1,92 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTaskHistoryRepository_RecordsRevisions(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	taskRepo := NewSQLiteTaskRepository(sqliteDB)
+	historyRepo := NewSQLiteTaskHistoryRepository(sqliteDB)
+
+	task := domain.NewTask("Original", "D")
+	created, err := historyRepo.CreateWithRevision(ctx, task,
+		domain.NewTaskRevision(domain.RevisionCreate, "ana", nil, task))
+	require.NoError(t, err)
+	afterCreate := time.Now().UTC()
+
+	before := *created
+	created.Update("Cambiado", "")
+	_, err = historyRepo.UpdateWithRevision(ctx, created,
+		domain.NewTaskRevision(domain.RevisionUpdate, "luis", &before, created))
+	require.NoError(t, err)
+
+	revisions, err := historyRepo.GetRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, domain.RevisionCreate, revisions[0].Action)
+	require.Equal(t, "ana", revisions[0].Actor)
+	require.Equal(t, domain.RevisionUpdate, revisions[1].Action)
+	require.Equal(t, "luis", revisions[1].Actor)
+	require.Equal(t, "Original", revisions[1].Changes["title"].From)
+	require.Equal(t, "Cambiado", revisions[1].Changes["title"].To)
+	require.Equal(t, 2, revisions[1].Snapshot.Version)
+
+	// La vista en el tiempo devuelve la tarea tal como estaba
+	asOf, err := historyRepo.GetRevisionAt(ctx, created.ID, afterCreate)
+	require.NoError(t, err)
+	require.Equal(t, "Original", asOf.Snapshot.Title)
+	_, err = historyRepo.GetRevisionAt(ctx, created.ID, afterCreate.Add(-time.Hour))
+	require.ErrorIs(t, err, domain.ErrNoRevisionAt)
+
+	revision, err := historyRepo.GetRevision(ctx, created.ID, revisions[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, "Original", revision.Snapshot.Title)
+	_, err = historyRepo.GetRevision(ctx, created.ID+1, revisions[0].ID)
+	require.ErrorIs(t, err, domain.ErrRevisionNotFound)
+
+	// Eliminar registra la revisión y mueve la tarea a la papelera
+	current, err := taskRepo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	deleted := *current
+	now := time.Now().UTC()
+	deleted.DeletedAt = &now
+	require.NoError(t, historyRepo.DeleteWithRevision(ctx, created.ID,
+		domain.NewTaskRevision(domain.RevisionDelete, "ana", current, &deleted)))
+	_, err = taskRepo.GetByID(ctx, created.ID)
+	require.Error(t, err)
+	revisions, err = historyRepo.GetRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	require.Contains(t, revisions[2].Changes, "deleted_at")
+}
+
+func TestSQLiteTaskHistoryRepository_FailedWriteRecordsNothing(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	historyRepo := NewSQLiteTaskHistoryRepository(sqliteDB)
+
+	task := domain.NewTask("Original", "D")
+	created, err := historyRepo.CreateWithRevision(ctx, task,
+		domain.NewTaskRevision(domain.RevisionCreate, "ana", nil, task))
+	require.NoError(t, err)
+
+	// Una versión obsoleta hace fallar la actualización y la revisión no se guarda
+	stale := *created
+	stale.Version = 99
+	stale.Title = "Perdido"
+	_, err = historyRepo.UpdateWithRevision(ctx, &stale,
+		domain.NewTaskRevision(domain.RevisionUpdate, "luis", created, &stale))
+	require.ErrorIs(t, err, domain.ErrVersionConflict)
+
+	revisions, err := historyRepo.GetRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+}
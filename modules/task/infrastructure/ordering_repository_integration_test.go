@@ -0,0 +1,123 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// taskTitles retorna los títulos de las tareas en el orden del listado
+func taskTitles(t *testing.T, repo domain.TaskRepository) []string {
+	t.Helper()
+	tasks, err := repo.GetAll(context.Background(), domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	titles := make([]string, len(tasks))
+	for i, task := range tasks {
+		titles[i] = task.Title
+	}
+	return titles
+}
+
+func TestSQLiteTaskRepository_MoveTask(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	historyRepo := NewSQLiteTaskHistoryRepository(sqliteDB)
+	service := application.NewTaskService(repo, application.WithHistory(historyRepo))
+
+	ids := map[string]int{}
+	for _, title := range []string{"A", "B", "C", "D"} {
+		task, err := service.CreateTask(ctx, title, "D")
+		require.NoError(t, err)
+		require.NotEmpty(t, task.Rank)
+		ids[title] = task.ID
+	}
+	require.Equal(t, []string{"A", "B", "C", "D"}, taskTitles(t, repo))
+
+	// Mover D antes de B
+	moved, err := service.MoveTask(ctx, ids["D"], ids["B"], 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, moved.Version)
+	require.Equal(t, []string{"A", "D", "B", "C"}, taskTitles(t, repo))
+	// El movimiento queda en el historial como una actualización de la posición
+	revisions, err := historyRepo.GetRevisions(ctx, ids["D"])
+	require.NoError(t, err)
+	require.Len(t, revisions, 2)
+	require.Equal(t, domain.RevisionUpdate, revisions[1].Action)
+	require.Contains(t, revisions[1].Changes, "rank")
+
+	// Mover A después de C (al final)
+	_, err = service.MoveTask(ctx, ids["A"], 0, ids["C"])
+	require.NoError(t, err)
+	require.Equal(t, []string{"D", "B", "C", "A"}, taskTitles(t, repo))
+
+	// Mover C entre D y B
+	_, err = service.MoveTask(ctx, ids["C"], ids["B"], ids["D"])
+	require.NoError(t, err)
+	require.Equal(t, []string{"D", "C", "B", "A"}, taskTitles(t, repo))
+
+	// Referencias desordenadas
+	_, err = service.MoveTask(ctx, ids["C"], ids["D"], ids["A"])
+	require.ErrorIs(t, err, domain.ErrInvalidMove)
+
+	// Insertar muchas veces en el mismo hueco obliga a reequilibrar sin perder el orden
+	for i := 0; i < 200; i++ {
+		_, err = service.MoveTask(ctx, ids["A"], ids["C"], ids["D"])
+		require.NoError(t, err)
+		_, err = service.MoveTask(ctx, ids["B"], ids["A"], ids["D"])
+		require.NoError(t, err)
+	}
+	require.Equal(t, []string{"D", "B", "A", "C"}, taskTitles(t, repo))
+}
+
+func TestSQLiteTaskRepository_MoveTask_UnrankedTasks(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+	service := application.NewTaskService(repo)
+
+	// Tareas creadas antes de existir el orden manual no tienen rank
+	for _, title := range []string{"A", "B", "C"} {
+		_, err := sqliteDB.GetDB().ExecContext(ctx,
+			`INSERT INTO tasks (title, description, created_at, updated_at) VALUES (?, 'D', datetime('now'), datetime('now'))`, title)
+		require.NoError(t, err)
+	}
+	tasks, err := repo.GetAll(ctx, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Empty(t, tasks[0].Rank)
+
+	_, err = service.MoveTask(ctx, tasks[2].ID, tasks[0].ID, 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"C", "A", "B"}, taskTitles(t, repo))
+}
+
+func TestSQLiteTaskRepository_Ranks_IgnoreTrash(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	var tasks []*domain.Task
+	for _, title := range []string{"A", "B", "C"} {
+		task, err := repo.Create(ctx, domain.NewTask(title, "D"))
+		require.NoError(t, err)
+		tasks = append(tasks, task)
+	}
+	require.NoError(t, repo.Delete(ctx, tasks[1].ID))
+
+	// La tarea de la papelera no es vecina de A
+	next, found, err := repo.GetAdjacentRank(ctx, tasks[0].Rank, tasks[0].ID, true)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, tasks[2].Rank, next)
+
+	// El reequilibrio no modifica su rank
+	require.NoError(t, repo.RebalanceRanks(ctx))
+	trash, err := repo.GetDeleted(ctx)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	require.Equal(t, tasks[1].Rank, trash[0].Rank)
+}
@@ -0,0 +1,254 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// Estados de una entrada del outbox
+const (
+	// OutboxPending es una entrada pendiente de entregar o a la espera de reintentarse
+	OutboxPending = "pending"
+	// OutboxDone es una entrada que todos los destinos recibieron
+	OutboxDone = "done"
+	// OutboxDead es una entrada que agotó los reintentos; solo se entrega de nuevo con un replay
+	OutboxDead = "dead"
+)
+
+// outboxPurgeInterval es cada cuánto el relay elimina las entradas entregadas más antiguas que la retención
+const outboxPurgeInterval = time.Hour
+
+// OutboxEntry es un evento guardado en el outbox
+type OutboxEntry struct {
+	ID        int64
+	Event     domain.Event
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// OutboxStats resume el estado del outbox
+type OutboxStats struct {
+	Pending int
+	Dead    int
+	// OldestPendingAt es la fecha de la entrada pendiente más antigua; nil si no hay pendientes
+	OldestPendingAt *time.Time
+}
+
+// Lag retorna cuánto lleva esperando la entrada pendiente más antigua
+func (s OutboxStats) Lag(now time.Time) time.Duration {
+	if s.OldestPendingAt == nil {
+		return 0
+	}
+	return max(now.Sub(*s.OldestPendingAt), 0)
+}
+
+// OutboxReplayFilter selecciona las entradas entregadas o agotadas que se vuelven a entregar
+type OutboxReplayFilter struct {
+	// FromID y ToID limitan el rango de IDs; 0 es sin límite
+	FromID int64
+	ToID   int64
+	// Since limita a las entradas creadas desde esa fecha; cero es sin límite
+	Since time.Time
+	// DeadOnly limita a las entradas que agotaron los reintentos
+	DeadOnly bool
+}
+
+// OutboxStore guarda y reparte las entradas del outbox
+type OutboxStore interface {
+	// Append guarda los eventos en la transacción del contexto
+	Append(ctx context.Context, events []domain.Event) error
+	// Claim reserva por lease hasta limit entradas listas para entregar, en orden de ID. Omite las
+	// entradas cuya tarea tiene una anterior reservada o a la espera de un reintento
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]OutboxEntry, error)
+	// MarkDone marca una entrada como entregada
+	MarkDone(ctx context.Context, id int64) error
+	// Retry libera una entrada para reintentarla desde nextAttemptAt
+	Retry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastErr string) error
+	// MarkDead marca una entrada que agotó los reintentos
+	MarkDead(ctx context.Context, id int64, attempts int, lastErr string) error
+	// Release libera la reserva de entradas que no se intentaron entregar
+	Release(ctx context.Context, ids []int64) error
+	// Replay vuelve a dejar pendientes las entradas del filtro y retorna cuántas
+	Replay(ctx context.Context, filter OutboxReplayFilter) (int, error)
+	// PurgeDone elimina las entradas entregadas antes de la fecha indicada
+	PurgeDone(ctx context.Context, before time.Time) (int, error)
+	// Stats retorna el estado del outbox
+	Stats(ctx context.Context) (OutboxStats, error)
+}
+
+// OutboxSink es un destino de los eventos del outbox; EventBus lo implementa
+type OutboxSink interface {
+	Publish(ctx context.Context, events []domain.Event) error
+}
+
+// OutboxRelayConfig configura el relay del outbox
+type OutboxRelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	// Lease es cuánto tiempo una entrada reservada no se entrega en otro relay
+	Lease       time.Duration
+	MaxAttempts int
+	// BackoffBase es la espera antes del primer reintento; se duplica en cada intento hasta BackoffMax
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// Retention es cuánto se conservan las entradas entregadas; 0 las conserva siempre
+	Retention time.Duration
+}
+
+// outboxSink es un destino registrado en el relay
+type outboxSink struct {
+	name string
+	sink OutboxSink
+}
+
+// OutboxRelay entrega las entradas del outbox a sus destinos con reintentos. La entrega es al menos
+// una vez: si un destino falla, la entrada se reintenta en todos, por eso los destinos deben tolerar
+// duplicados. Los eventos de una tarea se entregan en orden
+type OutboxRelay struct {
+	store OutboxStore
+	cfg   OutboxRelayConfig
+	sinks []outboxSink
+}
+
+// NewOutboxRelay crea el relay del outbox; los destinos se registran con AddSink antes de Run
+func NewOutboxRelay(store OutboxStore, cfg OutboxRelayConfig) *OutboxRelay {
+	return &OutboxRelay{store: store, cfg: cfg}
+}
+
+// AddSink registra un destino de los eventos
+func (r *OutboxRelay) AddSink(name string, sink OutboxSink) {
+	r.sinks = append(r.sinks, outboxSink{name: name, sink: sink})
+}
+
+// Run entrega las entradas pendientes cada PollInterval hasta que ctx se cancele, y elimina
+// periódicamente las entregadas más antiguas que la retención
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastPurge time.Time
+	for {
+		// Mientras los lotes salgan llenos hay más entradas esperando
+		for {
+			processed, err := r.ProcessBatch(ctx)
+			if err != nil {
+				log.Printf("Error entregando eventos del outbox: %v", err)
+				break
+			}
+			if processed < r.cfg.BatchSize {
+				break
+			}
+		}
+
+		if r.cfg.Retention > 0 && time.Since(lastPurge) >= outboxPurgeInterval {
+			lastPurge = time.Now()
+			if purged, err := r.store.PurgeDone(ctx, lastPurge.UTC().Add(-r.cfg.Retention)); err != nil {
+				log.Printf("Error purgando el outbox: %v", err)
+			} else if purged > 0 {
+				log.Printf("Outbox: %d entradas entregadas eliminadas", purged)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch reserva un lote de entradas, las entrega y retorna cuántas reservó. Si una entrada
+// falla, las siguientes de la misma tarea se liberan sin intentarse para no adelantarla
+func (r *OutboxRelay) ProcessBatch(ctx context.Context) (int, error) {
+	entries, err := r.store.Claim(ctx, r.cfg.BatchSize, r.cfg.Lease)
+	if err != nil {
+		return 0, err
+	}
+
+	failedTasks := make(map[int]bool)
+	var skipped []int64
+	for _, entry := range entries {
+		if failedTasks[entry.Event.TaskID] {
+			skipped = append(skipped, entry.ID)
+			continue
+		}
+
+		deliveryErr := r.deliver(ctx, entry.Event)
+		if deliveryErr == nil {
+			err = r.store.MarkDone(ctx, entry.ID)
+		} else {
+			attempts := entry.Attempts + 1
+			if attempts >= r.cfg.MaxAttempts {
+				log.Printf("Outbox: la entrada %d (%s de la tarea %d) agotó %d intentos: %v", entry.ID, entry.Event.Type, entry.Event.TaskID, attempts, deliveryErr)
+				err = r.store.MarkDead(ctx, entry.ID, attempts, deliveryErr.Error())
+			} else {
+				failedTasks[entry.Event.TaskID] = true
+				next := time.Now().UTC().Add(outboxBackoff(r.cfg.BackoffBase, r.cfg.BackoffMax, attempts))
+				err = r.store.Retry(ctx, entry.ID, attempts, next, deliveryErr.Error())
+			}
+		}
+		if err != nil {
+			return len(entries), err
+		}
+	}
+
+	if len(skipped) > 0 {
+		if err := r.store.Release(ctx, skipped); err != nil {
+			return len(entries), err
+		}
+	}
+	return len(entries), nil
+}
+
+// deliver entrega el evento a cada destino, aislando su pánico, y retorna los errores de los que fallan
+func (r *OutboxRelay) deliver(ctx context.Context, event domain.Event) error {
+	var errs []error
+	for _, sink := range r.sinks {
+		if err := publishToSink(ctx, sink.sink, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// publishToSink publica el evento en un destino convirtiendo su pánico en error
+func publishToSink(ctx context.Context, sink OutboxSink, event domain.Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("pánico: %v", r)
+		}
+	}()
+	return sink.Publish(ctx, []domain.Event{event})
+}
+
+// outboxBackoff retorna la espera antes del reintento número attempts: base, 2·base, 4·base… hasta maxWait
+func outboxBackoff(base, maxWait time.Duration, attempts int) time.Duration {
+	wait := base
+	for i := 1; i < attempts && wait < maxWait; i++ {
+		wait *= 2
+	}
+	return min(wait, maxWait)
+}
+
+// WriteOutboxMetrics escribe el estado del outbox en el formato de texto de Prometheus
+func WriteOutboxMetrics(w io.Writer, stats OutboxStats, now time.Time) error {
+	var b strings.Builder
+	fmt.Fprintln(&b, "# HELP task_outbox_lag_seconds Age of the oldest undelivered outbox entry.")
+	fmt.Fprintln(&b, "# TYPE task_outbox_lag_seconds gauge")
+	fmt.Fprintf(&b, "task_outbox_lag_seconds %g\n", stats.Lag(now).Seconds())
+	fmt.Fprintln(&b, "# HELP task_outbox_pending Outbox entries waiting to be delivered.")
+	fmt.Fprintln(&b, "# TYPE task_outbox_pending gauge")
+	fmt.Fprintf(&b, "task_outbox_pending %d\n", stats.Pending)
+	fmt.Fprintln(&b, "# HELP task_outbox_dead Outbox entries that exhausted their delivery attempts.")
+	fmt.Fprintln(&b, "# TYPE task_outbox_dead gauge")
+	fmt.Fprintf(&b, "task_outbox_dead %d\n", stats.Dead)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
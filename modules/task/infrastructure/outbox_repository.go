@@ -0,0 +1,231 @@
+package infrastructure
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// claimOutboxQuery reserva por lease las entradas listas para entregar. Una entrada espera si su tarea
+// tiene otra anterior reservada o esperando un reintento, así los eventos de una tarea no se adelantan.
+// %s es la cláusula de bloqueo del motor: PostgreSQL salta las filas que otro relay está reservando
+const claimOutboxQuery = `UPDATE outbox SET locked_until = ?
+	WHERE id IN (
+		SELECT o.id FROM outbox o
+		WHERE o.status = 'pending' AND o.next_attempt_at <= ? AND (o.locked_until IS NULL OR o.locked_until <= ?)
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox prev
+				WHERE prev.task_id = o.task_id AND prev.id < o.id AND prev.status = 'pending'
+					AND (prev.next_attempt_at > ? OR prev.locked_until > ?)
+			)
+		ORDER BY o.id
+		LIMIT ?%s
+	)
+	RETURNING id, payload, attempts, created_at`
+
+// claimOutboxArgs retorna los argumentos de claimOutboxQuery
+func claimOutboxArgs(now time.Time, limit int, lease time.Duration) []any {
+	return []any{now.Add(lease), now, now, now, now, limit}
+}
+
+// outboxReplayConditions arma el WHERE del replay
+func outboxReplayConditions(filter OutboxReplayFilter) (string, []any) {
+	conditions := []string{"status <> 'pending'"}
+	var args []any
+	if filter.DeadOnly {
+		conditions = append(conditions, "status = 'dead'")
+	}
+	if filter.FromID > 0 {
+		conditions = append(conditions, "id >= ?")
+		args = append(args, filter.FromID)
+	}
+	if filter.ToID > 0 {
+		conditions = append(conditions, "id <= ?")
+		args = append(args, filter.ToID)
+	}
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.Since.UTC())
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// encodeOutboxEvent serializa un evento para guardarlo en el outbox
+func encodeOutboxEvent(event domain.Event) (string, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("error serializando evento %s de la tarea %d: %w", event.Type, event.TaskID, err)
+	}
+	return string(payload), nil
+}
+
+// decodeOutboxEntry arma una entrada a partir de su fila
+func decodeOutboxEntry(id int64, payload string, attempts int, createdAt time.Time) (OutboxEntry, error) {
+	entry := OutboxEntry{ID: id, Attempts: attempts, CreatedAt: createdAt}
+	if err := json.Unmarshal([]byte(payload), &entry.Event); err != nil {
+		return OutboxEntry{}, fmt.Errorf("error leyendo la entrada %d del outbox: %w", id, err)
+	}
+	return entry, nil
+}
+
+// SQLiteOutboxRepository implementa OutboxStore usando SQLite
+type SQLiteOutboxRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteOutboxRepository crea una nueva instancia del repositorio del outbox
+func NewSQLiteOutboxRepository(db *database.SQLiteDB) *SQLiteOutboxRepository {
+	return &SQLiteOutboxRepository{
+		db: db,
+	}
+}
+
+// Append guarda los eventos en la transacción del contexto
+func (r *SQLiteOutboxRepository) Append(ctx context.Context, events []domain.Event) error {
+	now := time.Now().UTC()
+	query := `INSERT INTO outbox (event_type, task_id, payload, status, attempts, next_attempt_at, created_at)
+		VALUES (?, ?, ?, 'pending', 0, ?, ?)`
+	for _, event := range events {
+		payload, err := encodeOutboxEvent(event)
+		if err != nil {
+			return err
+		}
+		if _, err := r.db.Conn(ctx).ExecContext(ctx, query, event.Type, event.TaskID, payload, now, now); err != nil {
+			return fmt.Errorf("error guardando evento en el outbox: %w", err)
+		}
+	}
+	return nil
+}
+
+// Claim reserva por lease las entradas listas para entregar
+func (r *SQLiteOutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]OutboxEntry, error) {
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, fmt.Sprintf(claimOutboxQuery, ""), claimOutboxArgs(time.Now().UTC(), limit, lease)...)
+	if err != nil {
+		return nil, fmt.Errorf("error reservando entradas del outbox: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []OutboxEntry
+	for rows.Next() {
+		var id int64
+		var payload string
+		var attempts int
+		var createdAt time.Time
+		if err := rows.Scan(&id, &payload, &attempts, &createdAt); err != nil {
+			return nil, fmt.Errorf("error escaneando entrada del outbox: %w", err)
+		}
+		entry, err := decodeOutboxEntry(id, payload, attempts, createdAt)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando entradas del outbox: %w", err)
+	}
+
+	// RETURNING no garantiza el orden de las filas
+	slices.SortFunc(entries, func(a, b OutboxEntry) int { return cmp.Compare(a.ID, b.ID) })
+	return entries, nil
+}
+
+// MarkDone marca una entrada como entregada
+func (r *SQLiteOutboxRepository) MarkDone(ctx context.Context, id int64) error {
+	query := `UPDATE outbox SET status = 'done', locked_until = NULL, last_error = '', processed_at = ? WHERE id = ?`
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, time.Now().UTC(), id); err != nil {
+		return fmt.Errorf("error marcando entrada del outbox como entregada: %w", err)
+	}
+	return nil
+}
+
+// Retry libera una entrada para reintentarla desde nextAttemptAt
+func (r *SQLiteOutboxRepository) Retry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastErr string) error {
+	query := `UPDATE outbox SET attempts = ?, next_attempt_at = ?, locked_until = NULL, last_error = ? WHERE id = ?`
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, attempts, nextAttemptAt.UTC(), lastErr, id); err != nil {
+		return fmt.Errorf("error programando reintento de entrada del outbox: %w", err)
+	}
+	return nil
+}
+
+// MarkDead marca una entrada que agotó los reintentos
+func (r *SQLiteOutboxRepository) MarkDead(ctx context.Context, id int64, attempts int, lastErr string) error {
+	query := `UPDATE outbox SET status = 'dead', attempts = ?, locked_until = NULL, last_error = ?, processed_at = ? WHERE id = ?`
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, attempts, lastErr, time.Now().UTC(), id); err != nil {
+		return fmt.Errorf("error marcando entrada del outbox como agotada: %w", err)
+	}
+	return nil
+}
+
+// Release libera la reserva de entradas que no se intentaron entregar
+func (r *SQLiteOutboxRepository) Release(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	query := `UPDATE outbox SET locked_until = NULL WHERE id IN (?` + strings.Repeat(", ?", len(ids)-1) + `)`
+	if _, err := r.db.Conn(ctx).ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("error liberando entradas del outbox: %w", err)
+	}
+	return nil
+}
+
+// Replay vuelve a dejar pendientes las entradas del filtro
+func (r *SQLiteOutboxRepository) Replay(ctx context.Context, filter OutboxReplayFilter) (int, error) {
+	where, args := outboxReplayConditions(filter)
+	query := `UPDATE outbox SET status = 'pending', attempts = 0, next_attempt_at = ?, locked_until = NULL, last_error = '',
+		processed_at = NULL WHERE ` + where
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, append([]any{time.Now().UTC()}, args...)...)
+	if err != nil {
+		return 0, fmt.Errorf("error reprogramando entradas del outbox: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("error obteniendo filas afectadas: %w", err)
+	}
+	return int(rows), nil
+}
+
+// PurgeDone elimina las entradas entregadas antes de la fecha indicada
+func (r *SQLiteOutboxRepository) PurgeDone(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.Conn(ctx).ExecContext(ctx, `DELETE FROM outbox WHERE status = 'done' AND processed_at <= ?`, before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("error eliminando entradas entregadas del outbox: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("error obteniendo filas afectadas: %w", err)
+	}
+	return int(rows), nil
+}
+
+// Stats retorna el estado del outbox
+func (r *SQLiteOutboxRepository) Stats(ctx context.Context) (OutboxStats, error) {
+	var stats OutboxStats
+	query := `SELECT COALESCE(SUM(CASE WHEN status = 'pending' THEN 1 ELSE 0 END), 0),
+		COALESCE(SUM(CASE WHEN status = 'dead' THEN 1 ELSE 0 END), 0) FROM outbox`
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, query).Scan(&stats.Pending, &stats.Dead); err != nil {
+		return OutboxStats{}, fmt.Errorf("error contando entradas del outbox: %w", err)
+	}
+
+	var oldest time.Time
+	err := r.db.Conn(ctx).QueryRowContext(ctx, `SELECT created_at FROM outbox WHERE status = 'pending' ORDER BY id LIMIT 1`).Scan(&oldest)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return OutboxStats{}, fmt.Errorf("error obteniendo la entrada pendiente más antigua del outbox: %w", err)
+	}
+	if err == nil {
+		stats.OldestPendingAt = &oldest
+	}
+	return stats, nil
+}
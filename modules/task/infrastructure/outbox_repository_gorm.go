@@ -0,0 +1,189 @@
+package infrastructure
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormOutboxModel es el modelo de GORM para la tabla outbox (PostgreSQL)
+type GormOutboxModel struct {
+	ID            int64      `gorm:"primaryKey;autoIncrement"`
+	EventType     string     `gorm:"not null"`
+	TaskID        int        `gorm:"not null;index"`
+	Payload       string     `gorm:"type:text;not null"`
+	Status        string     `gorm:"not null;default:'pending'"`
+	Attempts      int        `gorm:"not null;default:0"`
+	NextAttemptAt time.Time  `gorm:"not null"`
+	LockedUntil   *time.Time `gorm:"null"`
+	LastError     string     `gorm:"not null;default:''"`
+	CreatedAt     time.Time  `gorm:"not null"`
+	ProcessedAt   *time.Time `gorm:"null"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormOutboxModel) TableName() string {
+	return "outbox"
+}
+
+// GormOutboxRepository implementa OutboxStore usando GORM
+type GormOutboxRepository struct {
+	db *gorm.DB
+}
+
+// NewGormOutboxRepository crea una nueva instancia del repositorio del outbox con GORM
+func NewGormOutboxRepository(db *gorm.DB) *GormOutboxRepository {
+	return &GormOutboxRepository{
+		db: db,
+	}
+}
+
+// Append guarda los eventos en la transacción del contexto
+func (r *GormOutboxRepository) Append(ctx context.Context, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	models := make([]GormOutboxModel, len(events))
+	for i, event := range events {
+		payload, err := encodeOutboxEvent(event)
+		if err != nil {
+			return err
+		}
+		models[i] = GormOutboxModel{
+			EventType:     string(event.Type),
+			TaskID:        event.TaskID,
+			Payload:       payload,
+			Status:        OutboxPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+		}
+	}
+	if err := database.GormConn(ctx, r.db).Create(&models).Error; err != nil {
+		return fmt.Errorf("error guardando eventos en el outbox con GORM: %w", err)
+	}
+	return nil
+}
+
+// Claim reserva por lease las entradas listas para entregar; con SKIP LOCKED dos relays no compiten
+// por las mismas filas
+func (r *GormOutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]OutboxEntry, error) {
+	var models []GormOutboxModel
+	query := fmt.Sprintf(claimOutboxQuery, " FOR UPDATE OF o SKIP LOCKED")
+	if err := database.GormConn(ctx, r.db).Raw(query, claimOutboxArgs(time.Now().UTC(), limit, lease)...).Scan(&models).Error; err != nil {
+		return nil, fmt.Errorf("error reservando entradas del outbox con GORM: %w", err)
+	}
+
+	entries := make([]OutboxEntry, 0, len(models))
+	for _, model := range models {
+		entry, err := decodeOutboxEntry(model.ID, model.Payload, model.Attempts, model.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b OutboxEntry) int { return cmp.Compare(a.ID, b.ID) })
+	return entries, nil
+}
+
+// MarkDone marca una entrada como entregada
+func (r *GormOutboxRepository) MarkDone(ctx context.Context, id int64) error {
+	err := database.GormConn(ctx, r.db).Model(&GormOutboxModel{}).Where("id = ?", id).
+		Updates(map[string]any{"status": OutboxDone, "locked_until": nil, "last_error": "", "processed_at": time.Now().UTC()}).Error
+	if err != nil {
+		return fmt.Errorf("error marcando entrada del outbox como entregada con GORM: %w", err)
+	}
+	return nil
+}
+
+// Retry libera una entrada para reintentarla desde nextAttemptAt
+func (r *GormOutboxRepository) Retry(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastErr string) error {
+	err := database.GormConn(ctx, r.db).Model(&GormOutboxModel{}).Where("id = ?", id).
+		Updates(map[string]any{"attempts": attempts, "next_attempt_at": nextAttemptAt.UTC(), "locked_until": nil, "last_error": lastErr}).Error
+	if err != nil {
+		return fmt.Errorf("error programando reintento de entrada del outbox con GORM: %w", err)
+	}
+	return nil
+}
+
+// MarkDead marca una entrada que agotó los reintentos
+func (r *GormOutboxRepository) MarkDead(ctx context.Context, id int64, attempts int, lastErr string) error {
+	err := database.GormConn(ctx, r.db).Model(&GormOutboxModel{}).Where("id = ?", id).
+		Updates(map[string]any{"status": OutboxDead, "attempts": attempts, "locked_until": nil, "last_error": lastErr, "processed_at": time.Now().UTC()}).Error
+	if err != nil {
+		return fmt.Errorf("error marcando entrada del outbox como agotada con GORM: %w", err)
+	}
+	return nil
+}
+
+// Release libera la reserva de entradas que no se intentaron entregar
+func (r *GormOutboxRepository) Release(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := database.GormConn(ctx, r.db).Model(&GormOutboxModel{}).Where("id IN ?", ids).Update("locked_until", nil).Error; err != nil {
+		return fmt.Errorf("error liberando entradas del outbox con GORM: %w", err)
+	}
+	return nil
+}
+
+// Replay vuelve a dejar pendientes las entradas del filtro
+func (r *GormOutboxRepository) Replay(ctx context.Context, filter OutboxReplayFilter) (int, error) {
+	where, args := outboxReplayConditions(filter)
+	result := database.GormConn(ctx, r.db).Model(&GormOutboxModel{}).Where(where, args...).Updates(map[string]any{
+		"status": OutboxPending, "attempts": 0, "next_attempt_at": time.Now().UTC(), "locked_until": nil, "last_error": "", "processed_at": nil,
+	})
+	if result.Error != nil {
+		return 0, fmt.Errorf("error reprogramando entradas del outbox con GORM: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// PurgeDone elimina las entradas entregadas antes de la fecha indicada
+func (r *GormOutboxRepository) PurgeDone(ctx context.Context, before time.Time) (int, error) {
+	result := database.GormConn(ctx, r.db).Where("status = ? AND processed_at <= ?", OutboxDone, before.UTC()).Delete(&GormOutboxModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("error eliminando entradas entregadas del outbox con GORM: %w", result.Error)
+	}
+	return int(result.RowsAffected), nil
+}
+
+// Stats retorna el estado del outbox
+func (r *GormOutboxRepository) Stats(ctx context.Context) (OutboxStats, error) {
+	var counts []struct {
+		Status string
+		Total  int
+	}
+	err := database.GormConn(ctx, r.db).Model(&GormOutboxModel{}).Select("status, COUNT(*) AS total").
+		Where("status IN ?", []string{OutboxPending, OutboxDead}).Group("status").Scan(&counts).Error
+	if err != nil {
+		return OutboxStats{}, fmt.Errorf("error contando entradas del outbox con GORM: %w", err)
+	}
+
+	var stats OutboxStats
+	for _, count := range counts {
+		if count.Status == OutboxPending {
+			stats.Pending = count.Total
+		} else {
+			stats.Dead = count.Total
+		}
+	}
+
+	var oldest GormOutboxModel
+	err = database.GormConn(ctx, r.db).Where("status = ?", OutboxPending).Order("id").First(&oldest).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return OutboxStats{}, fmt.Errorf("error obteniendo la entrada pendiente más antigua del outbox con GORM: %w", err)
+	}
+	if err == nil {
+		stats.OldestPendingAt = &oldest.CreatedAt
+	}
+	return stats, nil
+}
@@ -0,0 +1,234 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// failingOutbox falla al guardar eventos para probar que el cambio se revierte
+type failingOutbox struct{}
+
+func (failingOutbox) Append(context.Context, []domain.Event) error {
+	return errors.New("fallo al guardar en el outbox")
+}
+
+// recordingSink guarda los eventos recibidos y falla mientras fail retorne true
+type recordingSink struct {
+	events []domain.Event
+	fail   func(event domain.Event) bool
+}
+
+func (s *recordingSink) Publish(_ context.Context, events []domain.Event) error {
+	for _, event := range events {
+		if s.fail != nil && s.fail(event) {
+			return errors.New("destino no disponible")
+		}
+		s.events = append(s.events, event)
+	}
+	return nil
+}
+
+func testOutboxRelayConfig() OutboxRelayConfig {
+	return OutboxRelayConfig{
+		PollInterval: time.Second,
+		BatchSize:    10,
+		Lease:        time.Minute,
+		MaxAttempts:  3,
+		BackoffBase:  time.Hour,
+		BackoffMax:   time.Hour,
+	}
+}
+
+// expireOutboxBackoff adelanta los reintentos programados para no esperar el backoff
+func expireOutboxBackoff(t *testing.T, sqliteDB *database.SQLiteDB) {
+	t.Helper()
+	_, err := sqliteDB.DB.Exec(`UPDATE outbox SET next_attempt_at = ?`, time.Now().UTC().Add(-time.Second))
+	require.NoError(t, err)
+}
+
+func TestOutbox_WrittenInTransactionWithChange(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	taskRepo := NewSQLiteTaskRepository(sqliteDB)
+	outbox := NewSQLiteOutboxRepository(sqliteDB)
+	service := application.NewTaskService(taskRepo,
+		application.WithHistory(NewSQLiteTaskHistoryRepository(sqliteDB)),
+		application.WithTxManager(database.NewSQLiteTxManager(sqliteDB)),
+		application.WithEventOutbox(outbox),
+	)
+
+	created, err := service.CreateTask(application.WithActor(ctx, "ana"), "T", "D")
+	require.NoError(t, err)
+	_, err = service.MarkTaskAsCompleted(ctx, created.ID)
+	require.NoError(t, err)
+
+	entries, err := outbox.Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, domain.EventTaskCreated, entries[0].Event.Type)
+	require.Equal(t, created.ID, entries[0].Event.TaskID)
+	require.Equal(t, "ana", entries[0].Event.Actor)
+	require.Equal(t, domain.EventTaskCompleted, entries[1].Event.Type)
+	require.True(t, entries[1].Event.Task.Completed)
+}
+
+func TestOutbox_FailureRollsBackChange(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	taskRepo := NewSQLiteTaskRepository(sqliteDB)
+	service := application.NewTaskService(taskRepo,
+		application.WithTxManager(database.NewSQLiteTxManager(sqliteDB)),
+		application.WithEventOutbox(failingOutbox{}),
+	)
+
+	_, err := service.CreateTask(ctx, "T", "D")
+	require.Error(t, err)
+
+	tasks, err := taskRepo.GetAll(ctx, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+}
+
+func TestOutboxRelay_DeliversAndRetriesInOrder(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	outbox := NewSQLiteOutboxRepository(sqliteDB)
+
+	require.NoError(t, outbox.Append(ctx, []domain.Event{
+		{Type: domain.EventTaskCreated, TaskID: 1},
+		{Type: domain.EventTaskUpdated, TaskID: 1},
+		{Type: domain.EventTaskCreated, TaskID: 2},
+	}))
+
+	// El primer evento de la tarea 1 falla: el segundo no se adelanta y la tarea 2 no se ve afectada
+	failing := true
+	sink := &recordingSink{fail: func(event domain.Event) bool {
+		return failing && event.TaskID == 1 && event.Type == domain.EventTaskCreated
+	}}
+	relay := NewOutboxRelay(outbox, testOutboxRelayConfig())
+	relay.AddSink("prueba", sink)
+
+	processed, err := relay.ProcessBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, processed)
+	require.Len(t, sink.events, 1)
+	require.Equal(t, 2, sink.events[0].TaskID)
+
+	// Mientras espera el reintento, la tarea 1 queda detenida
+	processed, err = relay.ProcessBatch(ctx)
+	require.NoError(t, err)
+	require.Zero(t, processed)
+
+	stats, err := outbox.Stats(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.Pending)
+	require.NotNil(t, stats.OldestPendingAt)
+
+	failing = false
+	expireOutboxBackoff(t, sqliteDB)
+	processed, err = relay.ProcessBatch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, processed)
+	require.Len(t, sink.events, 3)
+	require.Equal(t, domain.EventTaskCreated, sink.events[1].Type)
+	require.Equal(t, domain.EventTaskUpdated, sink.events[2].Type)
+
+	stats, err = outbox.Stats(ctx)
+	require.NoError(t, err)
+	require.Zero(t, stats.Pending)
+	require.Nil(t, stats.OldestPendingAt)
+}
+
+func TestOutboxRelay_DeadAfterMaxAttemptsAndReplay(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	outbox := NewSQLiteOutboxRepository(sqliteDB)
+	require.NoError(t, outbox.Append(ctx, []domain.Event{{Type: domain.EventTaskDeleted, TaskID: 7}}))
+
+	failing := true
+	sink := &recordingSink{fail: func(domain.Event) bool { return failing }}
+	relay := NewOutboxRelay(outbox, testOutboxRelayConfig())
+	relay.AddSink("prueba", sink)
+
+	for range 3 {
+		_, err := relay.ProcessBatch(ctx)
+		require.NoError(t, err)
+		expireOutboxBackoff(t, sqliteDB)
+	}
+
+	stats, err := outbox.Stats(ctx)
+	require.NoError(t, err)
+	require.Zero(t, stats.Pending)
+	require.Equal(t, 1, stats.Dead)
+
+	// Una entrada agotada solo vuelve a entregarse con un replay
+	processed, err := relay.ProcessBatch(ctx)
+	require.NoError(t, err)
+	require.Zero(t, processed)
+
+	failing = false
+	replayed, err := outbox.Replay(ctx, OutboxReplayFilter{DeadOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, replayed)
+	_, err = relay.ProcessBatch(ctx)
+	require.NoError(t, err)
+	require.Len(t, sink.events, 1)
+
+	// Las entregadas también pueden repetirse; después se purgan
+	replayed, err = outbox.Replay(ctx, OutboxReplayFilter{FromID: 1, ToID: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, replayed)
+	_, err = relay.ProcessBatch(ctx)
+	require.NoError(t, err)
+	require.Len(t, sink.events, 2)
+
+	purged, err := outbox.PurgeDone(ctx, time.Now().UTC().Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, 1, purged)
+}
+
+func TestOutbox_ClaimRespectsLease(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	outbox := NewSQLiteOutboxRepository(sqliteDB)
+	require.NoError(t, outbox.Append(ctx, []domain.Event{{Type: domain.EventTaskCreated, TaskID: 1}}))
+
+	entries, err := outbox.Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// Otro relay no reserva la entrada mientras el lease siga vigente
+	entries, err = outbox.Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	// Si el relay que la reservó se detuvo, la entrada vuelve a estar disponible al vencer el lease
+	_, err = sqliteDB.DB.Exec(`UPDATE outbox SET locked_until = ?`, time.Now().UTC().Add(-time.Second))
+	require.NoError(t, err)
+	entries, err = outbox.Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func TestOutboxBackoffAndMetrics(t *testing.T) {
+	require.Equal(t, time.Second, outboxBackoff(time.Second, time.Minute, 1))
+	require.Equal(t, 4*time.Second, outboxBackoff(time.Second, time.Minute, 3))
+	require.Equal(t, time.Minute, outboxBackoff(time.Second, time.Minute, 40))
+
+	now := time.Now()
+	oldest := now.Add(-90 * time.Second)
+	var b strings.Builder
+	require.NoError(t, WriteOutboxMetrics(&b, OutboxStats{Pending: 3, Dead: 1, OldestPendingAt: &oldest}, now))
+	require.Contains(t, b.String(), "task_outbox_lag_seconds 90\n")
+	require.Contains(t, b.String(), "task_outbox_pending 3\n")
+	require.Contains(t, b.String(), "task_outbox_dead 1\n")
+}
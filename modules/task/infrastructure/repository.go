@@ -4,12 +4,123 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
 	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
 )
 
+// taskColumns son las columnas que se leen de una tarea, incluidos el estado bloqueado,
+// la lista de responsables y los campos personalizados calculados
+const taskColumns = `id, title, description, completed, due_date, recurrence, occurrence, completed_at, archived_at,
+	version, rank, story_points, estimated_hours, remaining_hours, created_at, updated_at, deleted_at,
+	EXISTS (SELECT 1 FROM task_dependencies d JOIN tasks b ON b.id = d.blocker_id
+		WHERE d.task_id = tasks.id AND b.completed = FALSE AND b.deleted_at IS NULL) AS blocked,
+	COALESCE((SELECT GROUP_CONCAT(a.user_id) FROM task_assignees a WHERE a.task_id = tasks.id), '') AS assignees,
+	(SELECT json_group_object(f.key, COALESCE(v.value_number, v.value_text)) FROM task_custom_values v
+		JOIN custom_fields f ON f.id = v.field_id WHERE v.task_id = tasks.id) AS custom_fields`
+
+// rowScanner abstrae *sql.Row y *sql.Rows para reutilizar el escaneo
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTask lee una tarea en el orden definido por taskColumns
+func scanTask(row rowScanner) (*domain.Task, error) {
+	task := &domain.Task{}
+	var dueDate, completedAt, archivedAt, deletedAt sql.NullTime
+	var assignees, customFields string
+	err := row.Scan(
+		&task.ID,
+		&task.Title,
+		&task.Description,
+		&task.Completed,
+		&dueDate,
+		&task.Recurrence,
+		&task.Occurrence,
+		&completedAt,
+		&archivedAt,
+		&task.Version,
+		&task.Rank,
+		&task.StoryPoints,
+		&task.EstimatedHours,
+		&task.RemainingHours,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+		&deletedAt,
+		&task.Blocked,
+		&assignees,
+		&customFields,
+	)
+	if err != nil {
+		return nil, err
+	}
+	task.Assignees = domain.ParseAssigneeIDs(assignees)
+	if task.CustomFields, err = parseCustomFields(customFields); err != nil {
+		return nil, err
+	}
+	if dueDate.Valid {
+		task.DueDate = &dueDate.Time
+	}
+	if completedAt.Valid {
+		task.CompletedAt = &completedAt.Time
+	}
+	if archivedAt.Valid {
+		task.ArchivedAt = &archivedAt.Time
+	}
+	if deletedAt.Valid {
+		task.DeletedAt = &deletedAt.Time
+	}
+	return task, nil
+}
+
+// archivedFilter excluye las tareas archivadas salvo que se pidan explícitamente
+func archivedFilter(opts domain.TaskQueryOptions) string {
+	if opts.IncludeArchived {
+		return ""
+	}
+	return ` AND archived_at IS NULL`
+}
+
+// customFieldValueColumn es la columna de task_custom_values donde se guarda un tipo de campo
+func customFieldValueColumn(fieldType domain.CustomFieldType) string {
+	if fieldType.IsNumeric() {
+		return "v.value_number"
+	}
+	return "v.value_text"
+}
+
+// customFieldClauses arma los filtros y el orden por campos personalizados de un listado de tareas;
+// sin orden personalizado se usa el orden manual
+func customFieldClauses(opts domain.TaskQueryOptions) (string, string, []any) {
+	var where strings.Builder
+	var args []any
+	for _, filter := range opts.CustomFields {
+		where.WriteString(` AND EXISTS (SELECT 1 FROM task_custom_values v JOIN custom_fields f ON f.id = v.field_id
+			WHERE v.task_id = tasks.id AND f.key = ? AND ` + customFieldValueColumn(filter.Type) + ` = ?)`)
+		args = append(args, filter.Key, filter.Value)
+	}
+
+	order := ` ORDER BY rank, id`
+	if sort := opts.SortBy; sort != nil {
+		direction := "ASC"
+		if sort.Desc {
+			direction = "DESC"
+		}
+		order = ` ORDER BY (SELECT ` + customFieldValueColumn(sort.Type) + ` FROM task_custom_values v JOIN custom_fields f ON f.id = v.field_id
+			WHERE v.task_id = tasks.id AND f.key = ?) ` + direction + ` NULLS LAST, rank, id`
+		args = append(args, sort.Key)
+	}
+	return where.String(), order, args
+}
+
+// sqlExecutor es la parte común de *sql.DB y *sql.Tx que usan las escrituras de tareas
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 // SQLiteTaskRepository implementa TaskRepository usando SQLite
 type SQLiteTaskRepository struct {
 	db *database.SQLiteDB
@@ -24,14 +135,37 @@ func NewSQLiteTaskRepository(db *database.SQLiteDB) domain.TaskRepository {
 
 // Create inserta una nueva tarea en la base de datos
 func (r *SQLiteTaskRepository) Create(ctx context.Context, task *domain.Task) (*domain.Task, error) {
-	query := `INSERT INTO tasks (title, description, completed, created_at, updated_at)
-			VALUES (?, ?, ?, ?, ?)
+	return insertTask(ctx, r.db.Conn(ctx), task)
+}
+
+// insertTask inserta la tarea con q, que puede ser la conexión o una transacción
+func insertTask(ctx context.Context, q sqlExecutor, task *domain.Task) (*domain.Task, error) {
+	// Las tareas nuevas van al final del orden manual
+	if task.Rank == "" {
+		var lastRank string
+		if err := q.QueryRowContext(ctx, `SELECT COALESCE(MAX(rank), '') FROM tasks`).Scan(&lastRank); err != nil {
+			return nil, fmt.Errorf("error obteniendo la última posición: %w", err)
+		}
+		task.Rank = domain.RankAfter(lastRank)
+	}
+
+	query := `INSERT INTO tasks (title, description, completed, due_date, recurrence, occurrence, completed_at, rank,
+			story_points, estimated_hours, remaining_hours, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 	now := time.Now().UTC()
-	result, err := r.db.GetDB().ExecContext(ctx, query,
+	result, err := q.ExecContext(ctx, query,
 		task.Title,
 		task.Description,
 		task.Completed,
+		task.DueDate,
+		task.Recurrence,
+		task.Occurrence,
+		task.CompletedAt,
+		task.Rank,
+		task.StoryPoints,
+		task.EstimatedHours,
+		task.RemainingHours,
 		now,
 		now,
 	)
@@ -44,8 +178,9 @@ func (r *SQLiteTaskRepository) Create(ctx context.Context, task *domain.Task) (*
 	if err != nil {
 		return nil, fmt.Errorf("error obteniendo ID de tarea insertada: %w", err)
 	}
-	// Actualizar la tarea con el ID y timestamps
+	// Actualizar la tarea con el ID, la versión inicial y timestamps
 	task.ID = int(id)
+	task.Version = 1
 	task.CreatedAt = now
 	task.UpdatedAt = now
 
@@ -54,19 +189,11 @@ func (r *SQLiteTaskRepository) Create(ctx context.Context, task *domain.Task) (*
 
 // GetByID obtiene una tarea por su ID
 func (r *SQLiteTaskRepository) GetByID(ctx context.Context, id int) (*domain.Task, error) {
-	query := `SELECT id, title, description, completed, created_at, updated_at FROM tasks WHERE id = ?`
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = ? AND deleted_at IS NULL`
 
-	row := r.db.GetDB().QueryRowContext(ctx, query, id)
+	row := r.db.Conn(ctx).QueryRowContext(ctx, query, id)
 
-	task := &domain.Task{}
-	err := row.Scan(
-		&task.ID,
-		&task.Title,
-		&task.Description,
-		&task.Completed,
-		&task.CreatedAt,
-		&task.UpdatedAt,
-	)
+	task, err := scanTask(row)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, fmt.Errorf("tarea con ID %d no encontrada", id)
@@ -77,11 +204,12 @@ func (r *SQLiteTaskRepository) GetByID(ctx context.Context, id int) (*domain.Tas
 }
 
 // GetAll obtiene todas las tareas
-func (r *SQLiteTaskRepository) GetAll(ctx context.Context) ([]*domain.Task, error) {
+func (r *SQLiteTaskRepository) GetAll(ctx context.Context, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
 	// Definir la consulta SQL
-	query := `SELECT id, title, description, completed, created_at, updated_at FROM tasks`
+	where, order, args := customFieldClauses(opts)
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE deleted_at IS NULL` + archivedFilter(opts) + where + order
 	// Obtener todas las filas
-	rows, err := r.db.GetDB().QueryContext(ctx, query)
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, args...)
 	// Manejar el error de la consulta
 	if err != nil {
 		return nil, fmt.Errorf("error obteniendo todas las tareas: %w", err)
@@ -91,15 +219,7 @@ func (r *SQLiteTaskRepository) GetAll(ctx context.Context) ([]*domain.Task, erro
 	var tasks []*domain.Task // Slice para almacenar las tareas
 
 	for rows.Next() { // Iterar sobre cada fila
-		task := &domain.Task{}
-		err := rows.Scan(
-			&task.ID,
-			&task.Title,
-			&task.Description,
-			&task.Completed,
-			&task.CreatedAt,
-			&task.UpdatedAt,
-		)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, fmt.Errorf("error escaneando tarea: %w", err) // Manejar error de escaneo
 		}
@@ -116,14 +236,29 @@ func (r *SQLiteTaskRepository) GetAll(ctx context.Context) ([]*domain.Task, erro
 
 // Update actualiza una tarea existente en la base de datos
 func (r *SQLiteTaskRepository) Update(ctx context.Context, task *domain.Task) (*domain.Task, error) {
-	query := `UPDATE tasks SET title = ?, description = ?, completed = ?, updated_at = ? WHERE id = ?`
+	return updateTask(ctx, r.db.Conn(ctx), task)
+}
+
+// updateTask actualiza la tarea con q si su versión sigue siendo task.Version
+func updateTask(ctx context.Context, q sqlExecutor, task *domain.Task) (*domain.Task, error) {
+	query := `UPDATE tasks SET title = ?, description = ?, completed = ?, due_date = ?, recurrence = ?, occurrence = ?, completed_at = ?,
+		story_points = ?, estimated_hours = ?, remaining_hours = ?, updated_at = ?, version = version + 1
+		WHERE id = ? AND version = ? AND deleted_at IS NULL AND archived_at IS NULL`
 	now := time.Now().UTC()
-	result, err := r.db.GetDB().ExecContext(ctx, query,
+	result, err := q.ExecContext(ctx, query,
 		task.Title,
 		task.Description,
 		task.Completed,
+		task.DueDate,
+		task.Recurrence,
+		task.Occurrence,
+		task.CompletedAt,
+		task.StoryPoints,
+		task.EstimatedHours,
+		task.RemainingHours,
 		now,
 		task.ID,
+		task.Version,
 	)
 
 	if err != nil {
@@ -135,20 +270,33 @@ func (r *SQLiteTaskRepository) Update(ctx context.Context, task *domain.Task) (*
 		return nil, fmt.Errorf("error verificando filas afectadas: %w", err)
 	}
 	if rowsAffected == 0 {
+		// Distinguir una versión obsoleta de una tarea inexistente
+		var exists bool
+		err := q.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM tasks WHERE id = ? AND deleted_at IS NULL AND archived_at IS NULL)`, task.ID).Scan(&exists)
+		if err == nil && exists {
+			return nil, fmt.Errorf("%w: ID %d, versión %d", domain.ErrVersionConflict, task.ID, task.Version)
+		}
 		return nil, fmt.Errorf("tarea con ID %d no encontrada", task.ID)
 	}
-	// Actualizar timestamp
+	// Actualizar timestamp y versión
 	task.UpdatedAt = now
+	task.Version++
 
 	return task, nil
 
 }
 
-// Delete elimina una tarea de la base de datos
+// Delete mueve una tarea a la papelera
 func (r *SQLiteTaskRepository) Delete(ctx context.Context, id int) error {
-	query := `DELETE FROM tasks WHERE id = ?`
+	return softDeleteTask(ctx, r.db.Conn(ctx), id)
+}
+
+// softDeleteTask mueve la tarea a la papelera con q
+func softDeleteTask(ctx context.Context, q sqlExecutor, id int) error {
+	query := `UPDATE tasks SET deleted_at = ?, version = version + 1 WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := r.db.GetDB().ExecContext(ctx, query, id)
+	result, err := q.ExecContext(ctx, query, time.Now().UTC(), id)
 	if err != nil {
 		return fmt.Errorf("error eliminando tarea: %w", err)
 	}
@@ -165,11 +313,146 @@ func (r *SQLiteTaskRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
+// Restore saca una tarea de la papelera
+func (r *SQLiteTaskRepository) Restore(ctx context.Context, id int) error {
+	query := `UPDATE tasks SET deleted_at = NULL, updated_at = ? WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("error restaurando tarea: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando restauracion: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrTaskNotInTrash, id)
+	}
+	return nil
+}
+
+// GetDeleted obtiene las tareas de la papelera, de la eliminada más recientemente a la más antigua
+func (r *SQLiteTaskRepository) GetDeleted(ctx context.Context) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE deleted_at IS NOT NULL ORDER BY deleted_at DESC`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo la papelera: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando tarea: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return tasks, nil
+}
+
+// GetDeletedByID obtiene una tarea de la papelera
+func (r *SQLiteTaskRepository) GetDeletedByID(ctx context.Context, id int) (*domain.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE id = ? AND deleted_at IS NOT NULL`
+
+	task, err := scanTask(r.db.Conn(ctx).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: ID %d", domain.ErrTaskNotInTrash, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo tarea de la papelera: %w", err)
+	}
+	return task, nil
+}
+
+// DeletePermanently elimina definitivamente una tarea que está en la papelera
+func (r *SQLiteTaskRepository) DeletePermanently(ctx context.Context, id int) error {
+	deleted, err := r.purge(ctx, `SELECT id FROM tasks WHERE id = ? AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return err
+	}
+	if len(deleted) == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrTaskNotInTrash, id)
+	}
+	return nil
+}
+
+// PurgeDeleted elimina definitivamente las tareas que están en la papelera desde antes de before
+func (r *SQLiteTaskRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]int, error) {
+	return r.purge(ctx, `SELECT id FROM tasks WHERE deleted_at IS NOT NULL AND deleted_at < ?`, before)
+}
+
+// purge elimina las tareas que retorna selectIDs junto con sus filas relacionadas
+func (r *SQLiteTaskRepository) purge(ctx context.Context, selectIDs string, args ...any) ([]int, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, selectIDs, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo tareas a eliminar: %w", err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("error escaneando ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+
+	// SQLite no aplica las claves foráneas por defecto: limpiar dependencias, asignaciones, historial, tarjetas, tiempos, campos personalizados y adjuntos a mano
+	for _, id := range ids {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM task_dependencies WHERE task_id = ? OR blocker_id = ?`, id, id); err != nil {
+			return nil, fmt.Errorf("error eliminando dependencias de la tarea: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM task_assignees WHERE task_id = ?`, id); err != nil {
+			return nil, fmt.Errorf("error eliminando asignaciones de la tarea: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM task_revisions WHERE task_id = ?`, id); err != nil {
+			return nil, fmt.Errorf("error eliminando historial de la tarea: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM board_cards WHERE task_id = ?`, id); err != nil {
+			return nil, fmt.Errorf("error eliminando tarjetas de la tarea: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM time_entries WHERE task_id = ?`, id); err != nil {
+			return nil, fmt.Errorf("error eliminando registros de tiempo de la tarea: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM task_custom_values WHERE task_id = ?`, id); err != nil {
+			return nil, fmt.Errorf("error eliminando campos personalizados de la tarea: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM task_attachments WHERE task_id = ?`, id); err != nil {
+			return nil, fmt.Errorf("error eliminando adjuntos de la tarea: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM tasks WHERE id = ?`, id); err != nil {
+			return nil, fmt.Errorf("error eliminando tarea: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return ids, nil
+}
+
 // GetByStatus obtiene tareas por su estado (completadas o no)
-func (r *SQLiteTaskRepository) GetByStatus(ctx context.Context, completed bool) ([]*domain.Task, error) {
-	query := `SELECT id, title, description, completed, created_at, updated_at FROM tasks WHERE completed = ? ORDER BY created_at DESC`
+func (r *SQLiteTaskRepository) GetByStatus(ctx context.Context, completed bool, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
+	where, order, args := customFieldClauses(opts)
+	query := `SELECT ` + taskColumns + ` FROM tasks WHERE completed = ? AND deleted_at IS NULL` + archivedFilter(opts) + where + order
 
-	rows, err := r.db.GetDB().QueryContext(ctx, query, completed)
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, append([]any{completed}, args...)...)
 	if err != nil {
 		return nil, fmt.Errorf("error obteniendo tareas por estado: %w", err)
 	}
@@ -177,15 +460,7 @@ func (r *SQLiteTaskRepository) GetByStatus(ctx context.Context, completed bool)
 
 	var tasks []*domain.Task
 	for rows.Next() {
-		task := &domain.Task{}
-		err := rows.Scan(
-			&task.ID,
-			&task.Title,
-			&task.Description,
-			&task.Completed,
-			&task.CreatedAt,
-			&task.UpdatedAt,
-		)
+		task, err := scanTask(rows)
 		if err != nil {
 			return nil, fmt.Errorf("error escaneando tarea: %w", err)
 		}
@@ -196,3 +471,147 @@ func (r *SQLiteTaskRepository) GetByStatus(ctx context.Context, completed bool)
 	}
 	return tasks, nil
 }
+
+// Archive archiva una tarea completada
+func (r *SQLiteTaskRepository) Archive(ctx context.Context, id int) error {
+	query := `UPDATE tasks SET archived_at = ?
+		WHERE id = ? AND completed = TRUE AND archived_at IS NULL AND deleted_at IS NULL`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("error archivando tarea: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando archivado: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrArchiveNotCompleted, id)
+	}
+	return nil
+}
+
+// Unarchive saca una tarea del archivo
+func (r *SQLiteTaskRepository) Unarchive(ctx context.Context, id int) error {
+	query := `UPDATE tasks SET archived_at = NULL, updated_at = ?
+		WHERE id = ? AND archived_at IS NOT NULL AND deleted_at IS NULL`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("error desarchivando tarea: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando desarchivado: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrTaskNotArchived, id)
+	}
+	return nil
+}
+
+// GetCompletedBefore obtiene las tareas completadas antes de before que no están archivadas.
+// Las tareas completadas sin completed_at usan updated_at como referencia.
+func (r *SQLiteTaskRepository) GetCompletedBefore(ctx context.Context, before time.Time) ([]*domain.Task, error) {
+	query := `SELECT ` + taskColumns + ` FROM tasks
+		WHERE completed = TRUE AND archived_at IS NULL AND deleted_at IS NULL AND COALESCE(completed_at, updated_at) < ?
+		ORDER BY id`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, before)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo tareas completadas: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*domain.Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando tarea: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+	return tasks, nil
+}
+
+// GetAdjacentRank obtiene el rank inmediatamente anterior (o siguiente si next) a rank, sin contar excludeID
+// ni las tareas de la papelera
+func (r *SQLiteTaskRepository) GetAdjacentRank(ctx context.Context, rank string, excludeID int, next bool) (string, bool, error) {
+	query := `SELECT rank FROM tasks WHERE rank < ? AND id <> ? AND deleted_at IS NULL ORDER BY rank DESC, id DESC LIMIT 1`
+	if next {
+		query = `SELECT rank FROM tasks WHERE rank > ? AND id <> ? AND deleted_at IS NULL ORDER BY rank, id LIMIT 1`
+	}
+
+	var adjacent string
+	err := r.db.Conn(ctx).QueryRowContext(ctx, query, rank, excludeID).Scan(&adjacent)
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("error obteniendo posición vecina: %w", err)
+	}
+	return adjacent, true, nil
+}
+
+// UpdateRank cambia la posición de una tarea en el orden manual
+func (r *SQLiteTaskRepository) UpdateRank(ctx context.Context, id int, rank string) error {
+	query := `UPDATE tasks SET rank = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL AND archived_at IS NULL`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, rank, time.Now().UTC(), id)
+	if err != nil {
+		return fmt.Errorf("error actualizando posición: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando filas afectadas: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("tarea con ID %d no encontrada", id)
+	}
+	return nil
+}
+
+// RebalanceRanks reparte ranks equidistantes entre las tareas que no están en la papelera manteniendo
+// su orden. El orden relativo no cambia, por eso no se incrementa la versión de las tareas.
+func (r *SQLiteTaskRepository) RebalanceRanks(ctx context.Context) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id FROM tasks WHERE deleted_at IS NULL ORDER BY rank, id`)
+	if err != nil {
+		return fmt.Errorf("error obteniendo tareas a reequilibrar: %w", err)
+	}
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("error escaneando ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterando sobre filas: %w", err)
+	}
+
+	for i, rank := range domain.EvenRanks(len(ids)) {
+		if _, err := tx.ExecContext(ctx, `UPDATE tasks SET rank = ? WHERE id = ?`, rank, ids[i]); err != nil {
+			return fmt.Errorf("error reequilibrando posición: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
@@ -2,21 +2,38 @@ package infrastructure
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GormTaskModel es el modelo de GORM para la tabla tasks (PostgreSQL)
 type GormTaskModel struct {
-	ID          int       `gorm:"primaryKey;autoIncrement" json:"id"`    // SERIAL en PostgreSQL
-	Title       string    `gorm:"not null;size:255" json:"title"`        // VARCHAR(255)
-	Description string    `gorm:"not null;type:text" json:"description"` // TEXT
-	Completed   bool      `gorm:"default:false" json:"completed"`
-	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID             int            `gorm:"primaryKey;autoIncrement" json:"id"`    // SERIAL en PostgreSQL
+	Title          string         `gorm:"not null;size:255" json:"title"`        // VARCHAR(255)
+	Description    string         `gorm:"not null;type:text" json:"description"` // TEXT
+	Completed      bool           `gorm:"default:false" json:"completed"`
+	Blocked        bool           `gorm:"->;-:migration" json:"blocked"` // Solo lectura, calculado en la consulta
+	DueDate        *time.Time     `json:"due_date"`
+	Recurrence     string         `gorm:"not null;default:''" json:"recurrence"`
+	Occurrence     int            `gorm:"not null;default:0" json:"occurrence"`
+	Assignees      string         `gorm:"->;-:migration" json:"-"` // Solo lectura, IDs separados por coma
+	CompletedAt    *time.Time     `json:"completed_at"`
+	Version        int            `gorm:"not null;default:1" json:"version"`
+	Rank           string         `gorm:"not null;default:''" json:"rank"`
+	StoryPoints    int            `gorm:"not null;default:0" json:"story_points"`
+	EstimatedHours float64        `gorm:"not null;default:0" json:"estimated_hours"`
+	RemainingHours float64        `gorm:"not null;default:0" json:"remaining_hours"`
+	CustomFields   string         `gorm:"->;-:migration" json:"-"` // Solo lectura, JSONB que se escribe con el repositorio de campos personalizados
+	ArchivedAt     *time.Time     `gorm:"index" json:"archived_at"`
+	CreatedAt      time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"deleted_at"` // Borrado lógico: GORM excluye estas filas por defecto
 }
 
 // TableName especifica el nombre de la tabla
@@ -26,26 +43,97 @@ func (GormTaskModel) TableName() string {
 
 // ToDomain convierte el modelo GORM a entidad de dominio
 func (g *GormTaskModel) ToDomain() *domain.Task {
+	// La columna JSONB siempre contiene un objeto válido
+	customFields, _ := parseCustomFields(g.CustomFields)
 	return &domain.Task{
-		ID:          g.ID,
-		Title:       g.Title,
-		Description: g.Description,
-		Completed:   g.Completed,
-		CreatedAt:   g.CreatedAt,
-		UpdatedAt:   g.UpdatedAt,
+		ID:             g.ID,
+		Title:          g.Title,
+		Description:    g.Description,
+		Completed:      g.Completed,
+		Blocked:        g.Blocked,
+		DueDate:        g.DueDate,
+		Recurrence:     g.Recurrence,
+		Occurrence:     g.Occurrence,
+		Assignees:      domain.ParseAssigneeIDs(g.Assignees),
+		CompletedAt:    g.CompletedAt,
+		ArchivedAt:     g.ArchivedAt,
+		Version:        g.Version,
+		Rank:           g.Rank,
+		StoryPoints:    g.StoryPoints,
+		EstimatedHours: g.EstimatedHours,
+		RemainingHours: g.RemainingHours,
+		CustomFields:   customFields,
+		CreatedAt:      g.CreatedAt,
+		UpdatedAt:      g.UpdatedAt,
+		DeletedAt:      deletedAtPtr(g.DeletedAt),
 	}
 }
 
+// deletedAtPtr convierte gorm.DeletedAt al puntero usado por el dominio
+func deletedAtPtr(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
+
 // FromDomain convierte entidad de dominio a modelo GORM
 func (g *GormTaskModel) FromDomain(task *domain.Task) {
 	g.ID = task.ID
 	g.Title = task.Title
 	g.Description = task.Description
 	g.Completed = task.Completed
+	g.DueDate = task.DueDate
+	g.Recurrence = task.Recurrence
+	g.Occurrence = task.Occurrence
+	g.CompletedAt = task.CompletedAt
+	g.ArchivedAt = task.ArchivedAt
+	g.Version = task.Version
+	g.Rank = task.Rank
+	g.StoryPoints = task.StoryPoints
+	g.EstimatedHours = task.EstimatedHours
+	g.RemainingHours = task.RemainingHours
 	g.CreatedAt = task.CreatedAt
 	g.UpdatedAt = task.UpdatedAt
 }
 
+// gormTaskSelect agrega el estado bloqueado y los responsables calculados a las consultas de tareas
+const gormTaskSelect = `tasks.*, EXISTS (SELECT 1 FROM task_dependencies d JOIN tasks b ON b.id = d.blocker_id
+	WHERE d.task_id = tasks.id AND b.completed = FALSE AND b.deleted_at IS NULL) AS blocked,
+	COALESCE((SELECT string_agg(a.user_id::text, ',') FROM task_assignees a WHERE a.task_id = tasks.id), '') AS assignees`
+
+// archivedScope excluye las tareas archivadas salvo que se pidan explícitamente
+func archivedScope(opts domain.TaskQueryOptions) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if opts.IncludeArchived {
+			return db
+		}
+		return db.Where("tasks.archived_at IS NULL")
+	}
+}
+
+// customFieldScope aplica los filtros y el orden por campos personalizados sobre la columna JSONB;
+// sin orden personalizado se usa el orden manual
+func customFieldScope(opts domain.TaskQueryOptions) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, filter := range opts.CustomFields {
+			value, _ := json.Marshal(filter.Value)
+			db = db.Where("tasks.custom_fields -> ? = CAST(? AS jsonb)", filter.Key, string(value))
+		}
+		if sort := opts.SortBy; sort != nil {
+			direction := "ASC"
+			if sort.Desc {
+				direction = "DESC"
+			}
+			return db.Order(clause.OrderBy{Expression: clause.Expr{
+				SQL:  "tasks.custom_fields -> ? " + direction + " NULLS LAST, rank, id",
+				Vars: []any{sort.Key},
+			}})
+		}
+		return db.Order("rank, id")
+	}
+}
+
 // GormTaskRepository implementa TaskRepository usando GORM
 type GormTaskRepository struct {
 	db *gorm.DB
@@ -60,21 +148,35 @@ func NewGormTaskRepository(db *gorm.DB) domain.TaskRepository {
 
 // Create inserta una nueva tarea con Gorm
 func (r *GormTaskRepository) Create(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	return gormCreateTask(database.GormConn(ctx, r.db), task)
+}
 
+// gormCreateTask inserta la tarea con db, que puede ser la conexión o una transacción
+func gormCreateTask(db *gorm.DB, task *domain.Task) (*domain.Task, error) {
 	gormTask := &GormTaskModel{} // Inicializa el modelo GORM
 	gormTask.FromDomain(task)    // Convierte la entidad de dominio a modelo GORM
+	gormTask.Version = 1         // Toda tarea nueva empieza en la versión 1
+
+	// Las tareas nuevas van al final del orden manual
+	if gormTask.Rank == "" {
+		var lastRank string
+		if err := db.Model(&GormTaskModel{}).Unscoped().Select("COALESCE(MAX(rank), '')").Scan(&lastRank).Error; err != nil {
+			return nil, fmt.Errorf("error obteniendo la última posición con GORM: %w", err)
+		}
+		gormTask.Rank = domain.RankAfter(lastRank)
+	}
 
-	if err := r.db.WithContext(ctx).Create(gormTask).Error; err != nil {
+	if err := db.Create(gormTask).Error; err != nil {
 		return nil, fmt.Errorf("error creando tarea con GORM: %w", err)
 	}
 
 	return gormTask.ToDomain(), nil
 }
 
-func (r *GormTaskRepository) GetAll(ctx context.Context) ([]*domain.Task, error) {
+func (r *GormTaskRepository) GetAll(ctx context.Context, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
 	var gormTasks []GormTaskModel
 
-	if err := r.db.WithContext(ctx).Find(&gormTasks).Error; err != nil {
+	if err := database.GormConn(ctx, r.db).Scopes(archivedScope(opts), customFieldScope(opts)).Select(gormTaskSelect).Find(&gormTasks).Error; err != nil {
 		return nil, fmt.Errorf("error obteniendo todas las tareas con GORM: %w", err)
 	}
 
@@ -91,7 +193,7 @@ func (r *GormTaskRepository) GetAll(ctx context.Context) ([]*domain.Task, error)
 func (r *GormTaskRepository) GetByID(ctx context.Context, id int) (*domain.Task, error) {
 	var gormTask GormTaskModel
 
-	if err := r.db.WithContext(ctx).First(&gormTask, id).Error; err != nil {
+	if err := database.GormConn(ctx, r.db).Select(gormTaskSelect).First(&gormTask, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("tarea con ID %d no encontrada", id)
 		}
@@ -102,27 +204,54 @@ func (r *GormTaskRepository) GetByID(ctx context.Context, id int) (*domain.Task,
 }
 
 func (r *GormTaskRepository) Update(ctx context.Context, task *domain.Task) (*domain.Task, error) {
+	return gormUpdateTask(database.GormConn(ctx, r.db), task)
+}
+
+// gormUpdateTask actualiza la tarea con db si su versión sigue siendo task.Version
+func gormUpdateTask(db *gorm.DB, task *domain.Task) (*domain.Task, error) {
 	gormTask := &GormTaskModel{}
 	gormTask.FromDomain(task)
 
-	result := r.db.WithContext(ctx).Model(&GormTaskModel{}).Where("id = ?", task.ID).Updates(gormTask)
+	// Solo se actualiza si nadie más escribió desde que se leyó la versión task.Version
+	gormTask.Version = task.Version + 1
+
+	// Select explícito para que también se persistan valores cero (completed=false, recurrence vacía)
+	result := db.Model(&GormTaskModel{}).
+		Where("id = ? AND version = ? AND archived_at IS NULL", task.ID, task.Version).
+		Select("title", "description", "completed", "due_date", "recurrence", "occurrence", "completed_at",
+			"story_points", "estimated_hours", "remaining_hours", "updated_at", "version").
+		Updates(gormTask)
 	if result.Error != nil {
-		return nil, fmt.Errorf("error actualizando tarea con GORM: %w", &result.Error)
+		return nil, fmt.Errorf("error actualizando tarea con GORM: %w", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		// Distinguir una versión obsoleta de una tarea inexistente
+		var count int64
+		db.Model(&GormTaskModel{}).Where("id = ? AND archived_at IS NULL", task.ID).Count(&count)
+		if count > 0 {
+			return nil, fmt.Errorf("%w: ID %d, versión %d", domain.ErrVersionConflict, task.ID, task.Version)
+		}
 		return nil, fmt.Errorf("tarea con id %d no encontrada", task.ID)
 	}
 
 	var updatedTask GormTaskModel
-	if err := r.db.WithContext(ctx).First(&updatedTask, task.ID).Error; err != nil {
+	if err := db.Select(gormTaskSelect).First(&updatedTask, task.ID).Error; err != nil {
 		return nil, fmt.Errorf("error obteniendo tarea actualizada: %w", err)
 	}
 
 	return updatedTask.ToDomain(), nil
 }
 
+// Delete mueve una tarea a la papelera (borrado lógico de GORM)
 func (r *GormTaskRepository) Delete(ctx context.Context, id int) error {
-	result := r.db.WithContext(ctx).Delete(&GormTaskModel{}, id)
+	return gormSoftDeleteTask(database.GormConn(ctx, r.db), id)
+}
+
+// gormSoftDeleteTask mueve la tarea a la papelera con db
+func gormSoftDeleteTask(db *gorm.DB, id int) error {
+	// Soft delete manual para incrementar también la versión (el scope excluye las ya eliminadas)
+	result := db.Model(&GormTaskModel{}).Where("id = ?", id).
+		UpdateColumns(map[string]any{"deleted_at": time.Now().UTC(), "version": gorm.Expr("version + 1")})
 	if result.Error != nil {
 		return fmt.Errorf("error eliminando tarea con GORM: %w", result.Error)
 	}
@@ -132,9 +261,82 @@ func (r *GormTaskRepository) Delete(ctx context.Context, id int) error {
 	return nil
 }
 
-func (r *GormTaskRepository) GetByStatus(ctx context.Context, completed bool) ([]*domain.Task, error) {
+// Restore saca una tarea de la papelera
+func (r *GormTaskRepository) Restore(ctx context.Context, id int) error {
+	result := database.GormConn(ctx, r.db).Unscoped().Model(&GormTaskModel{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{"deleted_at": nil, "updated_at": time.Now().UTC()})
+	if result.Error != nil {
+		return fmt.Errorf("error restaurando tarea con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrTaskNotInTrash, id)
+	}
+	return nil
+}
+
+// GetDeleted obtiene las tareas de la papelera, de la eliminada más recientemente a la más antigua
+func (r *GormTaskRepository) GetDeleted(ctx context.Context) ([]*domain.Task, error) {
 	var gormTasks []GormTaskModel
-	if err := r.db.WithContext(ctx).Where("completed = ?", completed).Order("created_at DESC").Find(&gormTasks).Error; err != nil {
+	if err := database.GormConn(ctx, r.db).Unscoped().Select(gormTaskSelect).
+		Where("deleted_at IS NOT NULL").Order("deleted_at DESC").Find(&gormTasks).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo la papelera con GORM: %w", err)
+	}
+
+	tasks := make([]*domain.Task, len(gormTasks))
+	for i, gormTask := range gormTasks {
+		tasks[i] = gormTask.ToDomain()
+	}
+	return tasks, nil
+}
+
+// GetDeletedByID obtiene una tarea de la papelera
+func (r *GormTaskRepository) GetDeletedByID(ctx context.Context, id int) (*domain.Task, error) {
+	var gormTask GormTaskModel
+	err := database.GormConn(ctx, r.db).Unscoped().Select(gormTaskSelect).
+		Where("deleted_at IS NOT NULL").First(&gormTask, id).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, fmt.Errorf("%w: ID %d", domain.ErrTaskNotInTrash, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo tarea de la papelera con GORM: %w", err)
+	}
+	return gormTask.ToDomain(), nil
+}
+
+// DeletePermanently elimina definitivamente una tarea que está en la papelera.
+// Las dependencias, asignaciones y metadatos de adjuntos se eliminan por ON DELETE CASCADE.
+func (r *GormTaskRepository) DeletePermanently(ctx context.Context, id int) error {
+	result := database.GormConn(ctx, r.db).Unscoped().Where("deleted_at IS NOT NULL").Delete(&GormTaskModel{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("error eliminando tarea con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrTaskNotInTrash, id)
+	}
+	return nil
+}
+
+// PurgeDeleted elimina definitivamente las tareas enviadas a la papelera antes de before
+func (r *GormTaskRepository) PurgeDeleted(ctx context.Context, before time.Time) ([]int, error) {
+	var purged []GormTaskModel
+	err := database.GormConn(ctx, r.db).Unscoped().Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Delete(&purged).Error
+	if err != nil {
+		return nil, fmt.Errorf("error purgando la papelera con GORM: %w", err)
+	}
+
+	ids := make([]int, len(purged))
+	for i, task := range purged {
+		ids[i] = task.ID
+	}
+	return ids, nil
+}
+
+func (r *GormTaskRepository) GetByStatus(ctx context.Context, completed bool, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
+	var gormTasks []GormTaskModel
+	if err := database.GormConn(ctx, r.db).Scopes(archivedScope(opts), customFieldScope(opts)).Select(gormTaskSelect).Where("completed = ?", completed).Find(&gormTasks).Error; err != nil {
 		return nil, fmt.Errorf("error obteniendo tareas por estado con GORM: %w", err)
 	}
 
@@ -144,3 +346,98 @@ func (r *GormTaskRepository) GetByStatus(ctx context.Context, completed bool) ([
 	}
 	return tasks, nil
 }
+
+// Archive archiva una tarea completada
+func (r *GormTaskRepository) Archive(ctx context.Context, id int) error {
+	result := database.GormConn(ctx, r.db).Model(&GormTaskModel{}).
+		Where("id = ? AND completed = TRUE AND archived_at IS NULL", id).
+		UpdateColumn("archived_at", time.Now().UTC())
+	if result.Error != nil {
+		return fmt.Errorf("error archivando tarea con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrArchiveNotCompleted, id)
+	}
+	return nil
+}
+
+// Unarchive saca una tarea del archivo
+func (r *GormTaskRepository) Unarchive(ctx context.Context, id int) error {
+	result := database.GormConn(ctx, r.db).Model(&GormTaskModel{}).
+		Where("id = ? AND archived_at IS NOT NULL", id).
+		Updates(map[string]any{"archived_at": nil, "updated_at": time.Now().UTC()})
+	if result.Error != nil {
+		return fmt.Errorf("error desarchivando tarea con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: ID %d", domain.ErrTaskNotArchived, id)
+	}
+	return nil
+}
+
+// GetCompletedBefore obtiene las tareas completadas antes de before que no están archivadas.
+// Las tareas completadas sin completed_at usan updated_at como referencia.
+func (r *GormTaskRepository) GetCompletedBefore(ctx context.Context, before time.Time) ([]*domain.Task, error) {
+	var gormTasks []GormTaskModel
+	if err := database.GormConn(ctx, r.db).Select(gormTaskSelect).
+		Where("completed = TRUE AND archived_at IS NULL AND COALESCE(completed_at, updated_at) < ?", before).
+		Order("id").Find(&gormTasks).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo tareas completadas con GORM: %w", err)
+	}
+
+	tasks := make([]*domain.Task, len(gormTasks))
+	for i, gormTask := range gormTasks {
+		tasks[i] = gormTask.ToDomain()
+	}
+	return tasks, nil
+}
+
+// GetAdjacentRank obtiene el rank inmediatamente anterior (o siguiente si next) a rank, sin contar excludeID
+func (r *GormTaskRepository) GetAdjacentRank(ctx context.Context, rank string, excludeID int, next bool) (string, bool, error) {
+	// Sin Unscoped, las tareas de la papelera no cuentan como vecinas
+	query := database.GormConn(ctx, r.db).Model(&GormTaskModel{}).Where("id <> ?", excludeID)
+	if next {
+		query = query.Where("rank > ?", rank).Order("rank, id")
+	} else {
+		query = query.Where("rank < ?", rank).Order("rank DESC, id DESC")
+	}
+
+	var ranks []string
+	if err := query.Limit(1).Pluck("rank", &ranks).Error; err != nil {
+		return "", false, fmt.Errorf("error obteniendo posición vecina con GORM: %w", err)
+	}
+	if len(ranks) == 0 {
+		return "", false, nil
+	}
+	return ranks[0], true, nil
+}
+
+// UpdateRank cambia la posición de una tarea en el orden manual
+func (r *GormTaskRepository) UpdateRank(ctx context.Context, id int, rank string) error {
+	result := database.GormConn(ctx, r.db).Model(&GormTaskModel{}).Where("id = ? AND archived_at IS NULL", id).
+		UpdateColumns(map[string]any{"rank": rank, "updated_at": time.Now().UTC()})
+	if result.Error != nil {
+		return fmt.Errorf("error actualizando posición con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("tarea con id %d no encontrada", id)
+	}
+	return nil
+}
+
+// RebalanceRanks reparte ranks equidistantes entre las tareas que no están en la papelera manteniendo
+// su orden. El orden relativo no cambia, por eso no se incrementa la versión de las tareas.
+func (r *GormTaskRepository) RebalanceRanks(ctx context.Context) error {
+	return database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var ids []int
+		if err := tx.Model(&GormTaskModel{}).Order("rank, id").Pluck("id", &ids).Error; err != nil {
+			return fmt.Errorf("error obteniendo tareas a reequilibrar con GORM: %w", err)
+		}
+		for i, rank := range domain.EvenRanks(len(ids)) {
+			if err := tx.Model(&GormTaskModel{}).Where("id = ?", ids[i]).UpdateColumn("rank", rank).Error; err != nil {
+				return fmt.Errorf("error reequilibrando posición con GORM: %w", err)
+			}
+		}
+		return nil
+	})
+}
@@ -67,15 +67,15 @@ func TestSQLiteTaskRepository_GetAllAndGetByStatus(t *testing.T) {
     _, err = repo.Create(ctx, &domain.Task{Title: "T3", Description: "D3", Completed: false})
     require.NoError(t, err)
 
-    all, err := repo.GetAll(ctx)
+    all, err := repo.GetAll(ctx, domain.TaskQueryOptions{})
     require.NoError(t, err)
     require.Len(t, all, 3)
 
-    completed, err := repo.GetByStatus(ctx, true)
+    completed, err := repo.GetByStatus(ctx, true, domain.TaskQueryOptions{})
     require.NoError(t, err)
     require.Len(t, completed, 1)
 
-    pending, err := repo.GetByStatus(ctx, false)
+    pending, err := repo.GetByStatus(ctx, false, domain.TaskQueryOptions{})
     require.NoError(t, err)
     require.Len(t, pending, 2)
 }
@@ -120,4 +120,33 @@ func TestSQLiteTaskRepository_Delete(t *testing.T) {
 
     _, err = repo.GetByID(ctx, created.ID)
     require.Error(t, err)
-}
\ No newline at end of file
+}
+
+// Verifica que la fecha de vencimiento y la recurrencia se guardan, se leen y se pueden quitar
+func TestSQLiteTaskRepository_ScheduleFieldsRoundTrip(t *testing.T) {
+    ctx := context.Background()
+    sqliteDB, _ := newTestSQLiteDB(t)
+    repo := NewSQLiteTaskRepository(sqliteDB)
+
+    due := time.Date(2026, 5, 4, 9, 30, 0, 0, time.UTC)
+    created, err := repo.Create(ctx, &domain.Task{Title: "Recurrente", Description: "Desc", DueDate: &due, Recurrence: "FREQ=WEEKLY", Occurrence: 1})
+    require.NoError(t, err)
+
+    fetched, err := repo.GetByID(ctx, created.ID)
+    require.NoError(t, err)
+    require.NotNil(t, fetched.DueDate)
+    require.True(t, due.Equal(*fetched.DueDate))
+    require.Equal(t, "FREQ=WEEKLY", fetched.Recurrence)
+    require.Equal(t, 1, fetched.Occurrence)
+
+    // Quitar la programación
+    fetched.DueDate = nil
+    fetched.Recurrence = ""
+    _, err = repo.Update(ctx, fetched)
+    require.NoError(t, err)
+
+    fetched, err = repo.GetByID(ctx, created.ID)
+    require.NoError(t, err)
+    require.Nil(t, fetched.DueDate)
+    require.Empty(t, fetched.Recurrence)
+}
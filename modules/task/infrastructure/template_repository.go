@@ -0,0 +1,183 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// templateColumns son las columnas que se leen de una plantilla
+const templateColumns = `id, name, description, tasks, created_at, updated_at`
+
+// encodeTemplateTasks serializa el árbol de tareas de una plantilla
+func encodeTemplateTasks(tasks []domain.TemplateTask) (string, error) {
+	data, err := json.Marshal(tasks)
+	if err != nil {
+		return "", fmt.Errorf("error serializando tareas de la plantilla: %w", err)
+	}
+	return string(data), nil
+}
+
+// decodeTemplateTasks lee el árbol de tareas serializado y recalcula las variables
+func decodeTemplateTasks(template *domain.TaskTemplate, raw string) error {
+	if err := json.Unmarshal([]byte(raw), &template.Tasks); err != nil {
+		return fmt.Errorf("error leyendo tareas de la plantilla: %w", err)
+	}
+	template.Refresh()
+	return nil
+}
+
+// scanTemplate lee una plantilla en el orden definido por templateColumns
+func scanTemplate(row rowScanner) (*domain.TaskTemplate, error) {
+	template := &domain.TaskTemplate{}
+	var tasks string
+	if err := row.Scan(&template.ID, &template.Name, &template.Description, &tasks, &template.CreatedAt, &template.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := decodeTemplateTasks(template, tasks); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// SQLiteTemplateRepository implementa TemplateRepository usando SQLite
+type SQLiteTemplateRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteTemplateRepository crea una nueva instancia del repositorio de plantillas
+func NewSQLiteTemplateRepository(db *database.SQLiteDB) domain.TemplateRepository {
+	return &SQLiteTemplateRepository{
+		db: db,
+	}
+}
+
+// Create guarda una plantilla nueva
+func (r *SQLiteTemplateRepository) Create(ctx context.Context, template *domain.TaskTemplate) (*domain.TaskTemplate, error) {
+	tasks, err := encodeTemplateTasks(template.Tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `INSERT INTO task_templates (name, description, tasks, created_at, updated_at) VALUES (?, ?, ?, ?, ?)`
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, template.Name, template.Description, tasks, template.CreatedAt, template.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("error insertando plantilla: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID de la plantilla insertada: %w", err)
+	}
+	template.ID = int(id)
+	return template, nil
+}
+
+// GetByID obtiene una plantilla por su ID
+func (r *SQLiteTemplateRepository) GetByID(ctx context.Context, id int) (*domain.TaskTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM task_templates WHERE id = ?`
+
+	template, err := scanTemplate(r.db.Conn(ctx).QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, fmt.Errorf("%w: %d", domain.ErrTemplateNotFound, id)
+		}
+		return nil, fmt.Errorf("error obteniendo plantilla: %w", err)
+	}
+	return template, nil
+}
+
+// List obtiene las plantillas ordenadas por nombre
+func (r *SQLiteTemplateRepository) List(ctx context.Context) ([]*domain.TaskTemplate, error) {
+	query := `SELECT ` + templateColumns + ` FROM task_templates ORDER BY name, id`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo plantillas: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []*domain.TaskTemplate{}
+	for rows.Next() {
+		template, err := scanTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando plantilla: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando plantillas: %w", err)
+	}
+	return templates, nil
+}
+
+// Delete elimina una plantilla
+func (r *SQLiteTemplateRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.Conn(ctx).ExecContext(ctx, `DELETE FROM task_templates WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("error eliminando plantilla: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando filas afectadas: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: %d", domain.ErrTemplateNotFound, id)
+	}
+	return nil
+}
+
+// Instantiate crea las tareas del plan, sus dependencias, campos personalizados y revisiones en una transacción
+func (r *SQLiteTemplateRepository) Instantiate(ctx context.Context, plan []*domain.PlannedTask) ([]*domain.Task, error) {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	tasks := make([]*domain.Task, len(plan))
+	for i, planned := range plan {
+		task, err := insertTask(ctx, tx, planned.Task)
+		if err != nil {
+			return nil, err
+		}
+		tasks[i] = task
+
+		// La tarea hija bloquea a su padre hasta completarse
+		if planned.Parent >= 0 {
+			parent := tasks[planned.Parent]
+			if _, err := tx.ExecContext(ctx, `INSERT INTO task_dependencies (task_id, blocker_id, created_at) VALUES (?, ?, ?)`,
+				parent.ID, task.ID, time.Now().UTC()); err != nil {
+				return nil, fmt.Errorf("error insertando dependencia: %w", err)
+			}
+			parent.Blocked = true
+		}
+
+		for _, value := range planned.Values {
+			var text, number any
+			if value.Field.Type.IsNumeric() {
+				number = value.Value
+			} else {
+				text = value.Value
+			}
+			if _, err := tx.ExecContext(ctx, upsertCustomValueQuery, task.ID, value.Field.ID, text, number); err != nil {
+				return nil, fmt.Errorf("error guardando el campo %s: %w", value.Field.Key, err)
+			}
+		}
+
+		if planned.Revision != nil {
+			if err := insertRevision(ctx, tx, task, planned.Revision); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return tasks, nil
+}
@@ -0,0 +1,167 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormTemplateModel es el modelo de GORM para la tabla task_templates
+type GormTemplateModel struct {
+	ID          int       `gorm:"primaryKey;autoIncrement"`
+	Name        string    `gorm:"not null;size:255"`
+	Description string    `gorm:"not null;type:text;default:''"`
+	Tasks       string    `gorm:"not null;type:jsonb"` // Árbol de tareas serializado
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormTemplateModel) TableName() string {
+	return "task_templates"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (g *GormTemplateModel) ToDomain() (*domain.TaskTemplate, error) {
+	template := &domain.TaskTemplate{
+		ID:          g.ID,
+		Name:        g.Name,
+		Description: g.Description,
+		CreatedAt:   g.CreatedAt,
+		UpdatedAt:   g.UpdatedAt,
+	}
+	if err := decodeTemplateTasks(template, g.Tasks); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// GormTemplateRepository implementa TemplateRepository usando GORM
+type GormTemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTemplateRepository crea una nueva instancia del repositorio de plantillas con GORM
+func NewGormTemplateRepository(db *gorm.DB) domain.TemplateRepository {
+	return &GormTemplateRepository{
+		db: db,
+	}
+}
+
+// Create guarda una plantilla nueva
+func (r *GormTemplateRepository) Create(ctx context.Context, template *domain.TaskTemplate) (*domain.TaskTemplate, error) {
+	tasks, err := encodeTemplateTasks(template.Tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	model := GormTemplateModel{
+		Name:        template.Name,
+		Description: template.Description,
+		Tasks:       tasks,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+	if err := database.GormConn(ctx, r.db).Create(&model).Error; err != nil {
+		return nil, fmt.Errorf("error insertando plantilla con GORM: %w", err)
+	}
+	template.ID = model.ID
+	return template, nil
+}
+
+// GetByID obtiene una plantilla por su ID
+func (r *GormTemplateRepository) GetByID(ctx context.Context, id int) (*domain.TaskTemplate, error) {
+	var model GormTemplateModel
+	if err := database.GormConn(ctx, r.db).First(&model, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %d", domain.ErrTemplateNotFound, id)
+		}
+		return nil, fmt.Errorf("error obteniendo plantilla con GORM: %w", err)
+	}
+	return model.ToDomain()
+}
+
+// List obtiene las plantillas ordenadas por nombre
+func (r *GormTemplateRepository) List(ctx context.Context) ([]*domain.TaskTemplate, error) {
+	var models []GormTemplateModel
+	if err := database.GormConn(ctx, r.db).Order("name, id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo plantillas con GORM: %w", err)
+	}
+
+	templates := make([]*domain.TaskTemplate, len(models))
+	for i := range models {
+		template, err := models[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		templates[i] = template
+	}
+	return templates, nil
+}
+
+// Delete elimina una plantilla
+func (r *GormTemplateRepository) Delete(ctx context.Context, id int) error {
+	result := database.GormConn(ctx, r.db).Delete(&GormTemplateModel{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("error eliminando plantilla con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("%w: %d", domain.ErrTemplateNotFound, id)
+	}
+	return nil
+}
+
+// Instantiate crea las tareas del plan, sus dependencias, campos personalizados y revisiones en una transacción
+func (r *GormTemplateRepository) Instantiate(ctx context.Context, plan []*domain.PlannedTask) ([]*domain.Task, error) {
+	tasks := make([]*domain.Task, len(plan))
+	err := database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for i, planned := range plan {
+			task, err := gormCreateTask(tx, planned.Task)
+			if err != nil {
+				return err
+			}
+			task.CustomFields = planned.Task.CustomFields
+			tasks[i] = task
+
+			// La tarea hija bloquea a su padre hasta completarse
+			if planned.Parent >= 0 {
+				parent := tasks[planned.Parent]
+				if err := tx.Create(&GormTaskDependencyModel{TaskID: parent.ID, BlockerID: task.ID}).Error; err != nil {
+					return fmt.Errorf("error creando dependencia con GORM: %w", err)
+				}
+				parent.Blocked = true
+			}
+
+			if len(planned.Values) > 0 {
+				values := make(map[string]any, len(planned.Values))
+				for _, value := range planned.Values {
+					values[value.Field.Key] = value.Value
+				}
+				data, err := json.Marshal(values)
+				if err != nil {
+					return fmt.Errorf("error serializando campos personalizados: %w", err)
+				}
+				if err := tx.Exec(`UPDATE tasks SET custom_fields = CAST(? AS jsonb) WHERE id = ?`, string(data), task.ID).Error; err != nil {
+					return fmt.Errorf("error guardando campos personalizados con GORM: %w", err)
+				}
+			}
+
+			if planned.Revision != nil {
+				if err := gormCreateRevision(tx, task, planned.Revision); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
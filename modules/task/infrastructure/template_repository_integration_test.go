@@ -0,0 +1,101 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTemplateRepository_InstantiateCreatesTree(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	tasks := NewSQLiteTaskRepository(sqliteDB)
+	dependencies := NewSQLiteDependencyRepository(sqliteDB)
+	fields := NewSQLiteCustomFieldRepository(sqliteDB)
+	history := NewSQLiteTaskHistoryRepository(sqliteDB)
+	repo := NewSQLiteTemplateRepository(sqliteDB)
+
+	size, err := domain.NewCustomFieldDefinition("size", "Tamaño", domain.CustomFieldNumber, nil)
+	require.NoError(t, err)
+	_, err = fields.CreateDefinition(ctx, size)
+	require.NoError(t, err)
+
+	template, err := domain.NewTaskTemplate("Onboarding", "Alta de personas", []domain.TemplateTask{{
+		Title:       "Onboarding de {{name}}",
+		Description: "Bienvenida",
+		Children:    []domain.TemplateTask{{Title: "Cuenta de {{name}}", Description: "Correo"}},
+	}})
+	require.NoError(t, err)
+	_, err = repo.Create(ctx, template)
+	require.NoError(t, err)
+
+	got, err := repo.GetByID(ctx, template.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Onboarding", got.Name)
+	require.Equal(t, []string{"name"}, got.Variables)
+	require.Len(t, got.Tasks[0].Children, 1)
+
+	plan, err := got.Plan(map[string]string{"name": "Ana"}, time.Now())
+	require.NoError(t, err)
+	plan[1].Values = []domain.CustomFieldValue{{Field: size, Value: 2.0}}
+	for _, planned := range plan {
+		planned.Revision = domain.NewTaskRevision(domain.RevisionCreate, "ana", nil, planned.Task)
+	}
+
+	created, err := repo.Instantiate(ctx, plan)
+	require.NoError(t, err)
+	require.Len(t, created, 2)
+	require.True(t, created[0].Blocked)
+
+	// La hija bloquea al padre, tiene sus campos y ambas tienen revisión de creación
+	blockers, err := dependencies.GetBlockers(ctx, created[0].ID)
+	require.NoError(t, err)
+	require.Len(t, blockers, 1)
+	require.Equal(t, created[1].ID, blockers[0].ID)
+
+	child, err := tasks.GetByID(ctx, created[1].ID)
+	require.NoError(t, err)
+	require.Equal(t, "Cuenta de Ana", child.Title)
+	require.Equal(t, map[string]any{"size": 2.0}, child.CustomFields)
+
+	revisions, err := history.GetRevisions(ctx, created[1].ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	require.Equal(t, "ana", revisions[0].Actor)
+
+	require.NoError(t, repo.Delete(ctx, template.ID))
+	_, err = repo.GetByID(ctx, template.ID)
+	require.ErrorIs(t, err, domain.ErrTemplateNotFound)
+}
+
+func TestSQLiteTemplateRepository_InstantiateIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	tasks := NewSQLiteTaskRepository(sqliteDB)
+	repo := NewSQLiteTemplateRepository(sqliteDB)
+
+	template, err := domain.NewTaskTemplate("Dos tareas", "", []domain.TemplateTask{
+		{Title: "Primera", Description: "D"},
+		{Title: "Segunda", Description: "D"},
+	})
+	require.NoError(t, err)
+	plan, err := template.Plan(nil, time.Now())
+	require.NoError(t, err)
+
+	// Un trigger hace fallar el campo personalizado de la segunda tarea, después de crear la primera
+	plan[1].Values = []domain.CustomFieldValue{{Field: &domain.CustomFieldDefinition{ID: 99, Key: "missing", Type: domain.CustomFieldText}, Value: "x"}}
+	_, err = sqliteDB.GetDB().ExecContext(ctx, `CREATE TRIGGER fail_custom_values BEFORE INSERT ON task_custom_values
+		BEGIN SELECT RAISE(ABORT, 'campo inexistente'); END`)
+	require.NoError(t, err)
+
+	_, err = repo.Instantiate(ctx, plan)
+	require.Error(t, err)
+
+	all, err := tasks.GetAll(ctx, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
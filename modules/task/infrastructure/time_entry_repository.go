@@ -0,0 +1,244 @@
+package infrastructure
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// timeEntryColumns son las columnas que se leen de un registro de tiempo
+const timeEntryColumns = `id, task_id, user_id, started_at, ended_at, duration_seconds, note, created_at`
+
+// startTimerQuery inserta el registro solo si el usuario no tiene otro en curso
+const startTimerQuery = `INSERT INTO time_entries (task_id, user_id, started_at, duration_seconds, note, created_at)
+	SELECT ?, ?, ?, 0, '', ?
+	WHERE NOT EXISTS (SELECT 1 FROM time_entries WHERE user_id = ? AND ended_at IS NULL)`
+
+// boardTotalQuery suma los segundos de las tareas que tienen tarjeta en el tablero
+const boardTotalQuery = `SELECT COALESCE(SUM(e.duration_seconds), 0) FROM time_entries e
+	JOIN board_cards bc ON bc.task_id = e.task_id
+	WHERE bc.board_id = ? AND e.ended_at IS NOT NULL`
+
+// projectTotalQuery suma los segundos de las tareas con ese valor de texto en el campo project
+const projectTotalQuery = `SELECT COALESCE(SUM(e.duration_seconds), 0) FROM time_entries e
+	JOIN task_custom_values v ON v.task_id = e.task_id
+	JOIN custom_fields f ON f.id = v.field_id
+	WHERE f.key = ? AND v.value_text = ? AND e.ended_at IS NOT NULL`
+
+// sqliteProjectKey es el valor del campo project de la tarea de cada registro, vacío si no tiene
+const sqliteProjectKey = `COALESCE((SELECT v.value_text FROM task_custom_values v
+	JOIN custom_fields f ON f.id = v.field_id
+	WHERE v.task_id = time_entries.task_id AND f.key = '` + domain.ProjectCustomField + `'), '')`
+
+// timeReportQuery agrega los registros cerrados de un rango; recibe la clave y la expresión de agrupación
+const timeReportQuery = `SELECT %s, SUM(duration_seconds), COUNT(*) FROM time_entries
+	WHERE ended_at IS NOT NULL AND started_at >= ? AND started_at < ?
+	GROUP BY %[2]s ORDER BY %[2]s`
+
+// timeReportGrouping es la clave del reporte y la columna por la que se agrupa y ordena
+type timeReportGrouping struct {
+	key, group string
+}
+
+// sqliteTimeReport son las agrupaciones del reporte en SQLite; los días se guardan como texto UTC
+var sqliteTimeReport = map[domain.TimeReportGroup]timeReportGrouping{
+	domain.TimeReportByDay:     {"substr(started_at, 1, 10)", "substr(started_at, 1, 10)"},
+	domain.TimeReportByUser:    {"CAST(user_id AS TEXT)", "user_id"},
+	domain.TimeReportByTask:    {"CAST(task_id AS TEXT)", "task_id"},
+	domain.TimeReportByProject: {sqliteProjectKey, sqliteProjectKey},
+}
+
+// scanTimeEntry lee un registro en el orden definido por timeEntryColumns
+func scanTimeEntry(row rowScanner) (*domain.TimeEntry, error) {
+	entry := &domain.TimeEntry{}
+	var endedAt sql.NullTime
+	err := row.Scan(
+		&entry.ID,
+		&entry.TaskID,
+		&entry.UserID,
+		&entry.StartedAt,
+		&endedAt,
+		&entry.DurationSeconds,
+		&entry.Note,
+		&entry.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if endedAt.Valid {
+		entry.EndedAt = &endedAt.Time
+	}
+	return entry, nil
+}
+
+// scanTimeReport lee las filas agregadas del reporte
+func scanTimeReport(rows *sql.Rows) ([]domain.TimeReportRow, error) {
+	result := []domain.TimeReportRow{}
+	for rows.Next() {
+		var row domain.TimeReportRow
+		if err := rows.Scan(&row.Key, &row.Seconds, &row.Entries); err != nil {
+			return nil, fmt.Errorf("error escaneando reporte de tiempo: %w", err)
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando reporte de tiempo: %w", err)
+	}
+	return result, nil
+}
+
+// SQLiteTimeEntryRepository implementa TimeEntryRepository usando SQLite
+type SQLiteTimeEntryRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteTimeEntryRepository crea una nueva instancia del repositorio de registros de tiempo
+func NewSQLiteTimeEntryRepository(db *database.SQLiteDB) domain.TimeEntryRepository {
+	return &SQLiteTimeEntryRepository{
+		db: db,
+	}
+}
+
+// Create inserta un registro de tiempo manual
+func (r *SQLiteTimeEntryRepository) Create(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	query := `INSERT INTO time_entries (task_id, user_id, started_at, ended_at, duration_seconds, note, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query,
+		entry.TaskID, entry.UserID, entry.StartedAt, entry.EndedAt, entry.DurationSeconds, entry.Note, entry.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("error insertando registro de tiempo: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID del registro insertado: %w", err)
+	}
+	entry.ID = int(id)
+	return entry, nil
+}
+
+// StartTimer inserta un registro en curso si el usuario no tiene otro
+func (r *SQLiteTimeEntryRepository) StartTimer(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	result, err := r.db.Conn(ctx).ExecContext(ctx, startTimerQuery,
+		entry.TaskID, entry.UserID, entry.StartedAt, entry.CreatedAt, entry.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error iniciando temporizador: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("error verificando temporizador iniciado: %w", err)
+	}
+	if rowsAffected == 0 {
+		return nil, domain.ErrTimerAlreadyRunning
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID del registro insertado: %w", err)
+	}
+	entry.ID = int(id)
+	return entry, nil
+}
+
+// GetRunning obtiene el registro en curso del usuario
+func (r *SQLiteTimeEntryRepository) GetRunning(ctx context.Context, userID int) (*domain.TimeEntry, error) {
+	query := `SELECT ` + timeEntryColumns + ` FROM time_entries WHERE user_id = ? AND ended_at IS NULL`
+
+	entry, err := scanTimeEntry(r.db.Conn(ctx).QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrNoRunningTimer
+		}
+		return nil, fmt.Errorf("error obteniendo temporizador en curso: %w", err)
+	}
+	return entry, nil
+}
+
+// Finish cierra el registro si sigue en curso
+func (r *SQLiteTimeEntryRepository) Finish(ctx context.Context, entry *domain.TimeEntry) error {
+	query := `UPDATE time_entries SET ended_at = ?, duration_seconds = ? WHERE id = ? AND ended_at IS NULL`
+
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, entry.EndedAt, entry.DurationSeconds, entry.ID)
+	if err != nil {
+		return fmt.Errorf("error deteniendo temporizador: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error verificando temporizador detenido: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrNoRunningTimer
+	}
+	return nil
+}
+
+// ListByTask obtiene los registros de una tarea del más reciente al más antiguo
+func (r *SQLiteTimeEntryRepository) ListByTask(ctx context.Context, taskID int) ([]*domain.TimeEntry, error) {
+	query := `SELECT ` + timeEntryColumns + ` FROM time_entries WHERE task_id = ? ORDER BY started_at DESC, id DESC`
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo registros de tiempo: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*domain.TimeEntry{}
+	for rows.Next() {
+		entry, err := scanTimeEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando registro de tiempo: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando registros de tiempo: %w", err)
+	}
+	return entries, nil
+}
+
+// TaskTotal suma los segundos de los registros cerrados de una tarea
+func (r *SQLiteTimeEntryRepository) TaskTotal(ctx context.Context, taskID int) (int64, error) {
+	var total int64
+	query := `SELECT COALESCE(SUM(duration_seconds), 0) FROM time_entries WHERE task_id = ? AND ended_at IS NOT NULL`
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, query, taskID).Scan(&total); err != nil {
+		return 0, fmt.Errorf("error sumando tiempo de la tarea: %w", err)
+	}
+	return total, nil
+}
+
+// BoardTotal suma los segundos de los registros cerrados de las tareas de un tablero
+func (r *SQLiteTimeEntryRepository) BoardTotal(ctx context.Context, boardID int) (int64, error) {
+	var total int64
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, boardTotalQuery, boardID).Scan(&total); err != nil {
+		return 0, fmt.Errorf("error sumando tiempo del tablero: %w", err)
+	}
+	return total, nil
+}
+
+// ProjectTotal suma los segundos de los registros cerrados de las tareas de un proyecto
+func (r *SQLiteTimeEntryRepository) ProjectTotal(ctx context.Context, project string) (int64, error) {
+	var total int64
+	if err := r.db.Conn(ctx).QueryRowContext(ctx, projectTotalQuery, domain.ProjectCustomField, project).Scan(&total); err != nil {
+		return 0, fmt.Errorf("error sumando tiempo del proyecto: %w", err)
+	}
+	return total, nil
+}
+
+// Report agrega los registros cerrados que empezaron en [from, to)
+func (r *SQLiteTimeEntryRepository) Report(ctx context.Context, from, to time.Time, groupBy domain.TimeReportGroup) ([]domain.TimeReportRow, error) {
+	grouping, ok := sqliteTimeReport[groupBy]
+	if !ok {
+		return nil, domain.ErrInvalidTimeReport
+	}
+
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, fmt.Sprintf(timeReportQuery, grouping.key, grouping.group), from.UTC(), to.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo reporte de tiempo: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTimeReport(rows)
+}
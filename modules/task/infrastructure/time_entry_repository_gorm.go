@@ -0,0 +1,207 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// postgresTimeReport son las agrupaciones del reporte en PostgreSQL
+var postgresTimeReport = map[domain.TimeReportGroup]timeReportGrouping{
+	domain.TimeReportByDay:     {"to_char(started_at, 'YYYY-MM-DD')", "to_char(started_at, 'YYYY-MM-DD')"},
+	domain.TimeReportByUser:    {"CAST(user_id AS TEXT)", "user_id"},
+	domain.TimeReportByTask:    {"CAST(task_id AS TEXT)", "task_id"},
+	domain.TimeReportByProject: {postgresProjectKey, postgresProjectKey},
+}
+
+// postgresProjectKey es el valor del campo project de la tarea de cada registro, vacío si no tiene
+const postgresProjectKey = `COALESCE((SELECT t.custom_fields ->> '` + domain.ProjectCustomField + `' FROM tasks t
+	WHERE t.id = time_entries.task_id), '')`
+
+// postgresProjectTotalQuery suma los segundos de las tareas con ese valor en el campo project
+const postgresProjectTotalQuery = `SELECT COALESCE(SUM(e.duration_seconds), 0) FROM time_entries e
+	JOIN tasks t ON t.id = e.task_id
+	WHERE t.custom_fields ->> ? = ? AND e.ended_at IS NOT NULL`
+
+// GormTimeEntryModel es el modelo de GORM para la tabla time_entries
+type GormTimeEntryModel struct {
+	ID              int       `gorm:"primaryKey;autoIncrement"`
+	TaskID          int       `gorm:"not null;index"`
+	UserID          int       `gorm:"not null"`
+	StartedAt       time.Time `gorm:"not null"`
+	EndedAt         *time.Time
+	DurationSeconds int64     `gorm:"not null"`
+	Note            string    `gorm:"not null;type:text"`
+	CreatedAt       time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormTimeEntryModel) TableName() string {
+	return "time_entries"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (g *GormTimeEntryModel) ToDomain() *domain.TimeEntry {
+	return &domain.TimeEntry{
+		ID:              g.ID,
+		TaskID:          g.TaskID,
+		UserID:          g.UserID,
+		StartedAt:       g.StartedAt,
+		EndedAt:         g.EndedAt,
+		DurationSeconds: g.DurationSeconds,
+		Note:            g.Note,
+		CreatedAt:       g.CreatedAt,
+	}
+}
+
+// FromDomain convierte la entidad de dominio al modelo GORM
+func (g *GormTimeEntryModel) FromDomain(entry *domain.TimeEntry) {
+	g.ID = entry.ID
+	g.TaskID = entry.TaskID
+	g.UserID = entry.UserID
+	g.StartedAt = entry.StartedAt
+	g.EndedAt = entry.EndedAt
+	g.DurationSeconds = entry.DurationSeconds
+	g.Note = entry.Note
+	g.CreatedAt = entry.CreatedAt
+}
+
+// GormTimeEntryRepository implementa TimeEntryRepository usando GORM
+type GormTimeEntryRepository struct {
+	db *gorm.DB
+}
+
+// NewGormTimeEntryRepository crea una nueva instancia del repositorio de registros de tiempo con GORM
+func NewGormTimeEntryRepository(db *gorm.DB) domain.TimeEntryRepository {
+	return &GormTimeEntryRepository{
+		db: db,
+	}
+}
+
+// Create inserta un registro de tiempo manual
+func (r *GormTimeEntryRepository) Create(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	var model GormTimeEntryModel
+	model.FromDomain(entry)
+	if err := database.GormConn(ctx, r.db).Create(&model).Error; err != nil {
+		return nil, fmt.Errorf("error insertando registro de tiempo: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// StartTimer inserta un registro en curso si el usuario no tiene otro; ante peticiones
+// concurrentes el índice único parcial rechaza el segundo temporizador
+func (r *GormTimeEntryRepository) StartTimer(ctx context.Context, entry *domain.TimeEntry) (*domain.TimeEntry, error) {
+	err := database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var running int64
+		if err := tx.Model(&GormTimeEntryModel{}).Where("user_id = ? AND ended_at IS NULL", entry.UserID).Count(&running).Error; err != nil {
+			return fmt.Errorf("error verificando temporizador en curso: %w", err)
+		}
+		if running > 0 {
+			return domain.ErrTimerAlreadyRunning
+		}
+
+		var model GormTimeEntryModel
+		model.FromDomain(entry)
+		if err := tx.Create(&model).Error; err != nil {
+			return fmt.Errorf("error iniciando temporizador: %w", err)
+		}
+		entry.ID = model.ID
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// GetRunning obtiene el registro en curso del usuario
+func (r *GormTimeEntryRepository) GetRunning(ctx context.Context, userID int) (*domain.TimeEntry, error) {
+	var model GormTimeEntryModel
+	if err := database.GormConn(ctx, r.db).Where("user_id = ? AND ended_at IS NULL", userID).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNoRunningTimer
+		}
+		return nil, fmt.Errorf("error obteniendo temporizador en curso: %w", err)
+	}
+	return model.ToDomain(), nil
+}
+
+// Finish cierra el registro si sigue en curso
+func (r *GormTimeEntryRepository) Finish(ctx context.Context, entry *domain.TimeEntry) error {
+	result := database.GormConn(ctx, r.db).Model(&GormTimeEntryModel{}).
+		Where("id = ? AND ended_at IS NULL", entry.ID).
+		Updates(map[string]interface{}{"ended_at": entry.EndedAt, "duration_seconds": entry.DurationSeconds})
+	if result.Error != nil {
+		return fmt.Errorf("error deteniendo temporizador: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrNoRunningTimer
+	}
+	return nil
+}
+
+// ListByTask obtiene los registros de una tarea del más reciente al más antiguo
+func (r *GormTimeEntryRepository) ListByTask(ctx context.Context, taskID int) ([]*domain.TimeEntry, error) {
+	var models []GormTimeEntryModel
+	if err := database.GormConn(ctx, r.db).Where("task_id = ?", taskID).Order("started_at DESC, id DESC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error obteniendo registros de tiempo: %w", err)
+	}
+
+	entries := make([]*domain.TimeEntry, len(models))
+	for i := range models {
+		entries[i] = models[i].ToDomain()
+	}
+	return entries, nil
+}
+
+// TaskTotal suma los segundos de los registros cerrados de una tarea
+func (r *GormTimeEntryRepository) TaskTotal(ctx context.Context, taskID int) (int64, error) {
+	var total int64
+	err := database.GormConn(ctx, r.db).Model(&GormTimeEntryModel{}).
+		Where("task_id = ? AND ended_at IS NOT NULL", taskID).
+		Select("COALESCE(SUM(duration_seconds), 0)").
+		Scan(&total).Error
+	if err != nil {
+		return 0, fmt.Errorf("error sumando tiempo de la tarea: %w", err)
+	}
+	return total, nil
+}
+
+// BoardTotal suma los segundos de los registros cerrados de las tareas de un tablero
+func (r *GormTimeEntryRepository) BoardTotal(ctx context.Context, boardID int) (int64, error) {
+	var total int64
+	if err := database.GormConn(ctx, r.db).Raw(boardTotalQuery, boardID).Scan(&total).Error; err != nil {
+		return 0, fmt.Errorf("error sumando tiempo del tablero: %w", err)
+	}
+	return total, nil
+}
+
+// ProjectTotal suma los segundos de los registros cerrados de las tareas de un proyecto
+func (r *GormTimeEntryRepository) ProjectTotal(ctx context.Context, project string) (int64, error) {
+	var total int64
+	if err := database.GormConn(ctx, r.db).Raw(postgresProjectTotalQuery, domain.ProjectCustomField, project).Scan(&total).Error; err != nil {
+		return 0, fmt.Errorf("error sumando tiempo del proyecto: %w", err)
+	}
+	return total, nil
+}
+
+// Report agrega los registros cerrados que empezaron en [from, to)
+func (r *GormTimeEntryRepository) Report(ctx context.Context, from, to time.Time, groupBy domain.TimeReportGroup) ([]domain.TimeReportRow, error) {
+	grouping, ok := postgresTimeReport[groupBy]
+	if !ok {
+		return nil, domain.ErrInvalidTimeReport
+	}
+
+	rows, err := database.GormConn(ctx, r.db).Raw(fmt.Sprintf(timeReportQuery, grouping.key, grouping.group), from.UTC(), to.UTC()).Rows()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo reporte de tiempo: %w", err)
+	}
+	defer rows.Close()
+
+	return scanTimeReport(rows)
+}
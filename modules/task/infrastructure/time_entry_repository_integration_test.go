@@ -0,0 +1,140 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTimeEntryRepository_OneRunningTimerPerUser(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	tasks := NewSQLiteTaskRepository(sqliteDB)
+	repo := NewSQLiteTimeEntryRepository(sqliteDB)
+
+	task, err := tasks.Create(ctx, &domain.Task{Title: "Facturable", Description: "D"})
+	require.NoError(t, err)
+
+	entry, err := domain.StartTimeEntry(task.ID, 3)
+	require.NoError(t, err)
+	entry, err = repo.StartTimer(ctx, entry)
+	require.NoError(t, err)
+	require.NotZero(t, entry.ID)
+
+	// Un segundo temporizador del mismo usuario se rechaza; otro usuario sí puede
+	second, _ := domain.StartTimeEntry(task.ID, 3)
+	_, err = repo.StartTimer(ctx, second)
+	require.ErrorIs(t, err, domain.ErrTimerAlreadyRunning)
+	other, _ := domain.StartTimeEntry(task.ID, 4)
+	_, err = repo.StartTimer(ctx, other)
+	require.NoError(t, err)
+
+	running, err := repo.GetRunning(ctx, 3)
+	require.NoError(t, err)
+	require.Equal(t, entry.ID, running.ID)
+	require.NoError(t, running.Stop(running.StartedAt.Add(10*time.Minute)))
+	require.NoError(t, repo.Finish(ctx, running))
+	require.ErrorIs(t, repo.Finish(ctx, running), domain.ErrNoRunningTimer)
+	_, err = repo.GetRunning(ctx, 3)
+	require.ErrorIs(t, err, domain.ErrNoRunningTimer)
+
+	// Tras detenerlo puede iniciar otro
+	again, _ := domain.StartTimeEntry(task.ID, 3)
+	_, err = repo.StartTimer(ctx, again)
+	require.NoError(t, err)
+
+	// Los temporizadores en curso no suman al total
+	total, err := repo.TaskTotal(ctx, task.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(600), total)
+	entries, err := repo.ListByTask(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+}
+
+func TestSQLiteTimeEntryRepository_TotalsAndReport(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	tasks := NewSQLiteTaskRepository(sqliteDB)
+	boards := NewSQLiteBoardRepository(sqliteDB)
+	repo := NewSQLiteTimeEntryRepository(sqliteDB)
+
+	a, err := tasks.Create(ctx, &domain.Task{Title: "A", Description: "D"})
+	require.NoError(t, err)
+	b, err := tasks.Create(ctx, &domain.Task{Title: "B", Description: "D"})
+	require.NoError(t, err)
+
+	day := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	logs := []struct {
+		taskID, userID int
+		start          time.Time
+		duration       time.Duration
+	}{
+		{a.ID, 1, day, time.Hour},
+		{a.ID, 2, day.Add(3 * time.Hour), 30 * time.Minute},
+		{b.ID, 1, day.AddDate(0, 0, 1), 2 * time.Hour},
+		{b.ID, 1, day.AddDate(0, 0, 7), time.Hour}, // Fuera del rango
+	}
+	for _, log := range logs {
+		entry, err := domain.NewManualTimeEntry(log.taskID, log.userID, log.start, log.duration, "")
+		require.NoError(t, err)
+		_, err = repo.Create(ctx, entry)
+		require.NoError(t, err)
+	}
+
+	total, err := repo.TaskTotal(ctx, a.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(5400), total)
+
+	board, err := domain.NewBoard("Cliente", domain.SwimlaneNone, []domain.BoardColumn{{Name: "Todo", State: domain.ColumnStatePending}})
+	require.NoError(t, err)
+	board, err = boards.Create(ctx, board)
+	require.NoError(t, err)
+	require.NoError(t, boards.PutCard(ctx, &domain.BoardCard{BoardID: board.ID, ColumnID: board.Columns[0].ID, TaskID: b.ID, Rank: "i"}))
+	boardTotal, err := repo.BoardTotal(ctx, board.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(3*3600), boardTotal)
+
+	// El proyecto es el campo personalizado project; a no tiene proyecto
+	fields := NewSQLiteCustomFieldRepository(sqliteDB)
+	project, err := domain.NewCustomFieldDefinition(domain.ProjectCustomField, "Proyecto", domain.CustomFieldText, nil)
+	require.NoError(t, err)
+	project, err = fields.CreateDefinition(ctx, project)
+	require.NoError(t, err)
+	require.NoError(t, fields.SetValues(ctx, b.ID, []domain.CustomFieldValue{{Field: project, Value: "web"}}, nil))
+	projectTotal, err := repo.ProjectTotal(ctx, "web")
+	require.NoError(t, err)
+	require.Equal(t, int64(3*3600), projectTotal)
+	otherTotal, err := repo.ProjectTotal(ctx, "movil")
+	require.NoError(t, err)
+	require.Zero(t, otherTotal)
+
+	from, to := day.Truncate(24*time.Hour), day.AddDate(0, 0, 2)
+	byDay, err := repo.Report(ctx, from, to, domain.TimeReportByDay)
+	require.NoError(t, err)
+	require.Equal(t, []domain.TimeReportRow{
+		{Key: "2026-03-02", Seconds: 5400, Entries: 2},
+		{Key: "2026-03-03", Seconds: 7200, Entries: 1},
+	}, byDay)
+
+	byUser, err := repo.Report(ctx, from, to, domain.TimeReportByUser)
+	require.NoError(t, err)
+	require.Equal(t, []domain.TimeReportRow{
+		{Key: "1", Seconds: 3600 + 7200, Entries: 2},
+		{Key: "2", Seconds: 1800, Entries: 1},
+	}, byUser)
+
+	byProject, err := repo.Report(ctx, from, to, domain.TimeReportByProject)
+	require.NoError(t, err)
+	require.Equal(t, []domain.TimeReportRow{
+		{Key: "", Seconds: 5400, Entries: 2},
+		{Key: "web", Seconds: 7200, Entries: 1},
+	}, byProject)
+
+	_, err = repo.Report(ctx, from, to, "week")
+	require.ErrorIs(t, err, domain.ErrInvalidTimeReport)
+}
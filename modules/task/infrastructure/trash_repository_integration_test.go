@@ -0,0 +1,95 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+func TestSQLiteTaskRepository_TrashAndRestore(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	task, err := repo.Create(ctx, &domain.Task{Title: "A", Description: "D"})
+	require.NoError(t, err)
+
+	// Restaurar una tarea que no está en la papelera falla
+	require.ErrorIs(t, repo.Restore(ctx, task.ID), domain.ErrTaskNotInTrash)
+
+	require.NoError(t, repo.Delete(ctx, task.ID))
+	// Una tarea en la papelera no se puede volver a eliminar ni consultar
+	require.Error(t, repo.Delete(ctx, task.ID))
+	_, err = repo.GetByID(ctx, task.ID)
+	require.Error(t, err)
+	all, err := repo.GetAll(ctx, domain.TaskQueryOptions{})
+	require.NoError(t, err)
+	require.Empty(t, all)
+
+	trash, err := repo.GetDeleted(ctx)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	require.NotNil(t, trash[0].DeletedAt)
+	trashed, err := repo.GetDeletedByID(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, trashed.DeletedAt)
+
+	require.NoError(t, repo.Restore(ctx, task.ID))
+	restored, err := repo.GetByID(ctx, task.ID)
+	require.NoError(t, err)
+	require.Nil(t, restored.DeletedAt)
+	// Una tarea fuera de la papelera no se obtiene como eliminada
+	_, err = repo.GetDeletedByID(ctx, task.ID)
+	require.ErrorIs(t, err, domain.ErrTaskNotInTrash)
+
+	trash, err = repo.GetDeleted(ctx)
+	require.NoError(t, err)
+	require.Empty(t, trash)
+}
+
+func TestSQLiteTaskRepository_PurgeDeleted(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	repo := NewSQLiteTaskRepository(sqliteDB)
+
+	old, err := repo.Create(ctx, &domain.Task{Title: "Vieja", Description: "D"})
+	require.NoError(t, err)
+	recent, err := repo.Create(ctx, &domain.Task{Title: "Reciente", Description: "D"})
+	require.NoError(t, err)
+	active, err := repo.Create(ctx, &domain.Task{Title: "Activa", Description: "D"})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Delete(ctx, old.ID))
+	require.NoError(t, repo.Delete(ctx, recent.ID))
+	_, err = sqliteDB.GetDB().ExecContext(ctx, `UPDATE tasks SET deleted_at = ? WHERE id = ?`,
+		time.Now().UTC().Add(-48*time.Hour), old.ID)
+	require.NoError(t, err)
+
+	attachments := NewSQLiteAttachmentRepository(sqliteDB)
+	_, err = attachments.Create(ctx, &domain.Attachment{
+		TaskID: old.ID, FileName: "a.png", ContentType: "image/png", Size: 10, SHA256: "abcdef", StorageKey: "ab/abcdef",
+	})
+	require.NoError(t, err)
+
+	purged, err := repo.PurgeDeleted(ctx, time.Now().UTC().Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []int{old.ID}, purged)
+
+	// Los metadatos de los adjuntos se eliminan con la tarea
+	remaining, err := attachments.ListByTask(ctx, old.ID)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+
+	trash, err := repo.GetDeleted(ctx)
+	require.NoError(t, err)
+	require.Len(t, trash, 1)
+	require.Equal(t, recent.ID, trash[0].ID)
+
+	// Solo se eliminan definitivamente las tareas de la papelera
+	require.ErrorIs(t, repo.DeletePermanently(ctx, active.ID), domain.ErrTaskNotInTrash)
+	require.ErrorIs(t, repo.Restore(ctx, old.ID), domain.ErrTaskNotInTrash)
+}
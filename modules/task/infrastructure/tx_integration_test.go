@@ -0,0 +1,165 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// failingHistoryRepository falla al crear tareas para probar la reversión de la transacción
+type failingHistoryRepository struct {
+	domain.TaskHistoryRepository
+}
+
+func (failingHistoryRepository) CreateWithRevision(context.Context, *domain.Task, *domain.TaskRevision) (*domain.Task, error) {
+	return nil, errors.New("fallo al crear la tarea")
+}
+
+func TestSQLiteTxManager_RollsBackOnError(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	taskRepo := NewSQLiteTaskRepository(sqliteDB)
+	historyRepo := NewSQLiteTaskHistoryRepository(sqliteDB)
+	txManager := database.NewSQLiteTxManager(sqliteDB)
+
+	created, err := taskRepo.Create(ctx, domain.NewTask("Original", "D"))
+	require.NoError(t, err)
+	other, err := taskRepo.Create(ctx, domain.NewTask("Otra", "D"))
+	require.NoError(t, err)
+
+	errStep := errors.New("falla el segundo paso")
+	err = txManager.WithinTx(ctx, func(ctx context.Context) error {
+		task, err := taskRepo.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+		before := *task
+		task.Update("Cambiado", "")
+		// La escritura con revisión abre su propia transacción, que se une a la del contexto
+		if _, err := historyRepo.UpdateWithRevision(ctx, task, domain.NewTaskRevision(domain.RevisionUpdate, "ana", &before, task)); err != nil {
+			return err
+		}
+		if err := taskRepo.Delete(ctx, other.ID); err != nil {
+			return err
+		}
+		return errStep
+	})
+	require.ErrorIs(t, err, errStep)
+
+	// Ninguna de las escrituras quedó guardada
+	task, err := taskRepo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Original", task.Title)
+	require.Equal(t, 1, task.Version)
+	_, err = taskRepo.GetByID(ctx, other.ID)
+	require.NoError(t, err)
+	revisions, err := historyRepo.GetRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+}
+
+func TestSQLiteTxManager_Commits(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	taskRepo := NewSQLiteTaskRepository(sqliteDB)
+	historyRepo := NewSQLiteTaskHistoryRepository(sqliteDB)
+	txManager := database.NewSQLiteTxManager(sqliteDB)
+
+	created, err := taskRepo.Create(ctx, domain.NewTask("Original", "D"))
+	require.NoError(t, err)
+
+	err = txManager.WithinTx(ctx, func(ctx context.Context) error {
+		task, err := taskRepo.GetByID(ctx, created.ID)
+		require.NoError(t, err)
+
+		// Una escritura que falla revierte solo su savepoint y la transacción sigue usable
+		stale := *task
+		stale.Version = 5
+		_, err = historyRepo.UpdateWithRevision(ctx, &stale, domain.NewTaskRevision(domain.RevisionUpdate, "ana", task, &stale))
+		require.ErrorIs(t, err, domain.ErrVersionConflict)
+
+		before := *task
+		task.Update("Cambiado", "")
+		_, err = historyRepo.UpdateWithRevision(ctx, task, domain.NewTaskRevision(domain.RevisionUpdate, "ana", &before, task))
+		return err
+	})
+	require.NoError(t, err)
+
+	task, err := taskRepo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Cambiado", task.Title)
+	revisions, err := historyRepo.GetRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+}
+
+func TestTaskService_MarkTaskAsCompleted_RollsBackWhenNextOccurrenceFails(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	taskRepo := NewSQLiteTaskRepository(sqliteDB)
+	historyRepo := NewSQLiteTaskHistoryRepository(sqliteDB)
+	service := application.NewTaskService(taskRepo,
+		application.WithHistory(failingHistoryRepository{historyRepo}),
+		application.WithTxManager(database.NewSQLiteTxManager(sqliteDB)),
+	)
+
+	due := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	task := domain.NewTask("Diaria", "D")
+	task.DueDate = &due
+	task.Recurrence = "FREQ=DAILY"
+	created, err := taskRepo.Create(ctx, task)
+	require.NoError(t, err)
+
+	// Completar guarda la tarea, pero crear la siguiente ocurrencia falla
+	_, err = service.MarkTaskAsCompleted(ctx, created.ID)
+	require.Error(t, err)
+
+	stored, err := taskRepo.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	require.False(t, stored.Completed)
+	require.Equal(t, 1, stored.Version)
+	revisions, err := historyRepo.GetRevisions(ctx, created.ID)
+	require.NoError(t, err)
+	require.Empty(t, revisions)
+}
+
+// failingRankRepository falla al cambiar el rank para probar que el reequilibrio se revierte
+type failingRankRepository struct {
+	domain.TaskRepository
+}
+
+func (failingRankRepository) UpdateRank(context.Context, int, string) error {
+	return errors.New("fallo al mover la tarea")
+}
+
+func TestTaskService_MoveTask_RollsBackRebalanceWhenUpdateFails(t *testing.T) {
+	ctx := context.Background()
+	sqliteDB, _ := newTestSQLiteDB(t)
+	taskRepo := NewSQLiteTaskRepository(sqliteDB)
+	service := application.NewTaskService(failingRankRepository{taskRepo},
+		application.WithTxManager(database.NewSQLiteTxManager(sqliteDB)),
+	)
+
+	first, err := taskRepo.Create(ctx, domain.NewTask("Primera", "D"))
+	require.NoError(t, err)
+	second, err := taskRepo.Create(ctx, domain.NewTask("Segunda", "D"))
+	require.NoError(t, err)
+	// Sin ranks, el movimiento tiene que reequilibrar antes de calcular la posición
+	_, err = sqliteDB.GetDB().ExecContext(ctx, `UPDATE tasks SET rank = ''`)
+	require.NoError(t, err)
+
+	_, err = service.MoveTask(ctx, first.ID, 0, second.ID)
+	require.Error(t, err)
+
+	// El reequilibrio se revirtió junto con el movimiento
+	for _, id := range []int{first.ID, second.ID} {
+		stored, err := taskRepo.GetByID(ctx, id)
+		require.NoError(t, err)
+		require.Empty(t, stored.Rank)
+	}
+}
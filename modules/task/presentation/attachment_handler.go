@@ -0,0 +1,244 @@
+package presentation
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// attachmentFormField es el campo del formulario multipart que contiene el archivo
+const attachmentFormField = "file"
+
+// AttachmentHandler maneja las peticiones HTTP de archivos adjuntos
+type AttachmentHandler struct {
+	attachmentService application.AttachmentServiceInterface
+}
+
+// NewAttachmentHandler crea una nueva instancia del handler de adjuntos
+func NewAttachmentHandler(attachmentService application.AttachmentServiceInterface) *AttachmentHandler {
+	return &AttachmentHandler{
+		attachmentService: attachmentService,
+	}
+}
+
+// attachmentErrorStatus traduce los errores de validación de adjuntos a códigos HTTP
+func attachmentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrAttachmentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, domain.ErrAttachmentTypeNotAllowed):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, domain.ErrBlobNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// attachmentDisposition construye la cabecera Content-Disposition para descargar el archivo
+func attachmentDisposition(fileName string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": fileName})
+}
+
+// parseAttachmentIDs obtiene los IDs de tarea y adjunto de la URL
+func parseAttachmentIDs(c *gin.Context) (int, int, bool) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return 0, 0, false
+	}
+	attachmentID, err := strconv.ParseUint(c.Param("attachmentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid attachment ID",
+			"message": "attachment ID must be a positive integer",
+		})
+		return 0, 0, false
+	}
+	return int(taskID), int(attachmentID), true
+}
+
+// UploadAttachment sube un archivo adjunto a una tarea
+// @Summary Sube un adjunto
+// @Description Recibe un formulario multipart con el campo "file" y lo procesa en streaming
+// @Tags adjuntos
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param file formData file true "Archivo"
+// @Success 201 {object} entities.Attachment
+// @Failure 400 {object} gin.H
+// @Failure 413 {object} gin.H
+// @Failure 415 {object} gin.H
+// @Router /tasks/{id}/attachments [post]
+func (h *AttachmentHandler) UploadAttachment(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	// Leer las partes sin ParseMultipartForm para no cargar el archivo en memoria
+	reader, err := c.Request.MultipartReader()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": "request must be multipart/form-data",
+		})
+		return
+	}
+
+	part, err := nextAttachmentPart(reader)
+	if err == io.EOF {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": "file field is required",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+	defer part.Close()
+
+	attachment, err := h.attachmentService.UploadAttachment(c.Request.Context(), int(taskID), part.FileName(), part)
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), gin.H{
+			"error":   "Error uploading attachment",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Attachment uploaded successfully",
+		"data":    attachment,
+	})
+}
+
+// nextAttachmentPart avanza por el formulario hasta la parte con el archivo, sin leer su contenido;
+// retorna io.EOF si el formulario no trae el campo file
+func nextAttachmentPart(reader *multipart.Reader) (*multipart.Part, error) {
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == attachmentFormField && part.FileName() != "" {
+			return part, nil
+		}
+		part.Close()
+	}
+}
+
+// ListAttachments obtiene los adjuntos de una tarea
+// @Summary Lista los adjuntos de una tarea
+// @Tags adjuntos
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} []entities.Attachment
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/attachments [get]
+func (h *AttachmentHandler) ListAttachments(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	attachments, err := h.attachmentService.ListAttachments(c.Request.Context(), int(taskID))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Error getting attachments",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Attachments retrieved successfully",
+		"data":    attachments,
+		"count":   len(attachments),
+	})
+}
+
+// DownloadAttachment descarga el contenido de un adjunto en streaming
+// @Summary Descarga un adjunto
+// @Tags adjuntos
+// @Produce octet-stream
+// @Param id path int true "ID de la tarea"
+// @Param attachmentId path int true "ID del adjunto"
+// @Success 200 {file} file
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /tasks/{id}/attachments/{attachmentId} [get]
+func (h *AttachmentHandler) DownloadAttachment(c *gin.Context) {
+	taskID, attachmentID, ok := parseAttachmentIDs(c)
+	if !ok {
+		return
+	}
+
+	attachment, content, err := h.attachmentService.OpenAttachment(c.Request.Context(), taskID, attachmentID)
+	if err != nil {
+		c.JSON(attachmentErrorStatus(err), gin.H{
+			"error":   "Error getting attachment",
+			"message": err.Error(),
+		})
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+		"Content-Disposition":    attachmentDisposition(attachment.FileName),
+		"ETag":                   `"` + attachment.SHA256 + `"`,
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// DeleteAttachment elimina un adjunto
+// @Summary Elimina un adjunto
+// @Tags adjuntos
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param attachmentId path int true "ID del adjunto"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/attachments/{attachmentId} [delete]
+func (h *AttachmentHandler) DeleteAttachment(c *gin.Context) {
+	taskID, attachmentID, ok := parseAttachmentIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.attachmentService.DeleteAttachment(c.Request.Context(), taskID, attachmentID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Error deleting attachment",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Attachment deleted successfully",
+	})
+}
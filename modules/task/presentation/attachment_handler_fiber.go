@@ -0,0 +1,200 @@
+package presentation
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberAttachmentHandler maneja las peticiones HTTP de archivos adjuntos con Fiber
+type FiberAttachmentHandler struct {
+	attachmentService application.AttachmentServiceInterface
+}
+
+// NewFiberAttachmentHandler crea una nueva instancia del handler de adjuntos con Fiber
+func NewFiberAttachmentHandler(attachmentService application.AttachmentServiceInterface) *FiberAttachmentHandler {
+	return &FiberAttachmentHandler{
+		attachmentService: attachmentService,
+	}
+}
+
+// parseFiberAttachmentIDs obtiene los IDs de tarea y adjunto de la URL;
+// retorna el cuerpo del error cuando alguno no es válido
+func parseFiberAttachmentIDs(c *fiber.Ctx) (int, int, fiber.Map) {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return 0, 0, fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		}
+	}
+	attachmentID, err := strconv.ParseUint(c.Params("attachmentId"), 10, 32)
+	if err != nil {
+		return 0, 0, fiber.Map{
+			"error":   "Invalid attachment ID",
+			"message": "attachment ID must be a positive integer",
+		}
+	}
+	return int(taskID), int(attachmentID), nil
+}
+
+// NewFiberBodyLimitMiddleware rechaza con 413 los cuerpos de más de limit bytes, salvo los
+// formularios multipart. Con StreamRequestBody, fasthttp entrega en streaming los cuerpos que superan
+// BodyLimit en lugar de rechazarlos, y c.Body() los cargaría completos en memoria; los formularios
+// multipart solo los lee la subida de adjuntos, que limita el tamaño del archivo mientras lo procesa
+func NewFiberBodyLimitMiddleware(limit int) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if isMultipartForm(string(c.Request().Header.ContentType())) {
+			return c.Next()
+		}
+		// -1 es un cuerpo chunked, cuyo tamaño no se conoce hasta leerlo
+		length := c.Request().Header.ContentLength()
+		if length > limit || length == -1 {
+			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+				"error":   "Request body too large",
+				"message": "request body must be sent with Content-Length and be at most " + strconv.Itoa(limit) + " bytes",
+			})
+		}
+		return c.Next()
+	}
+}
+
+// isMultipartForm indica si el Content-Type es un formulario multipart
+func isMultipartForm(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "multipart/form-data"
+}
+
+// multipartReader lee el formulario desde el cuerpo en streaming, sin cargar el archivo en memoria.
+// Si el servidor no tiene StreamRequestBody, lee el cuerpo ya recibido
+func multipartReader(c *fiber.Ctx) (*multipart.Reader, bool) {
+	mediaType, params, err := mime.ParseMediaType(string(c.Request().Header.ContentType()))
+	if err != nil || mediaType != "multipart/form-data" || params["boundary"] == "" {
+		return nil, false
+	}
+	var body io.Reader = c.Context().RequestBodyStream()
+	if body == nil {
+		body = bytes.NewReader(c.Body())
+	}
+	return multipart.NewReader(body, params["boundary"]), true
+}
+
+// UploadAttachment sube un archivo adjunto a una tarea con Fiber; el archivo se procesa en
+// streaming desde el cuerpo de la petición
+func (h *FiberAttachmentHandler) UploadAttachment(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	reader, ok := multipartReader(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": "request must be multipart/form-data",
+		})
+	}
+
+	part, err := nextAttachmentPart(reader)
+	if err == io.EOF {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": "file field is required",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	defer part.Close()
+
+	attachment, err := h.attachmentService.UploadAttachment(c.Context(), int(taskID), part.FileName(), part)
+	if err != nil {
+		return c.Status(attachmentErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error uploading attachment",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Attachment uploaded successfully",
+		"data":    attachment,
+	})
+}
+
+// ListAttachments obtiene los adjuntos de una tarea con Fiber
+func (h *FiberAttachmentHandler) ListAttachments(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	attachments, err := h.attachmentService.ListAttachments(c.Context(), int(taskID))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Error getting attachments",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Attachments retrieved successfully",
+		"data":    attachments,
+		"count":   len(attachments),
+	})
+}
+
+// DownloadAttachment descarga el contenido de un adjunto en streaming con Fiber
+func (h *FiberAttachmentHandler) DownloadAttachment(c *fiber.Ctx) error {
+	taskID, attachmentID, invalid := parseFiberAttachmentIDs(c)
+	if invalid != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(invalid)
+	}
+
+	attachment, content, err := h.attachmentService.OpenAttachment(c.Context(), taskID, attachmentID)
+	if err != nil {
+		return c.Status(attachmentErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting attachment",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, attachment.ContentType)
+	c.Set(fiber.HeaderContentDisposition, attachmentDisposition(attachment.FileName))
+	c.Set(fiber.HeaderETag, `"`+attachment.SHA256+`"`)
+	c.Set(fiber.HeaderXContentTypeOptions, "nosniff")
+	// fasthttp cierra el stream cuando termina de enviarlo
+	return c.SendStream(content, int(attachment.Size))
+}
+
+// DeleteAttachment elimina un adjunto con Fiber
+func (h *FiberAttachmentHandler) DeleteAttachment(c *fiber.Ctx) error {
+	taskID, attachmentID, invalid := parseFiberAttachmentIDs(c)
+	if invalid != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(invalid)
+	}
+
+	if err := h.attachmentService.DeleteAttachment(c.Context(), taskID, attachmentID); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Error deleting attachment",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Attachment deleted successfully",
+	})
+}
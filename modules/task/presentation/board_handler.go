@@ -0,0 +1,233 @@
+package presentation
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// BoardHandler maneja las peticiones HTTP de tableros kanban
+type BoardHandler struct {
+	boardService application.BoardServiceInterface
+}
+
+// NewBoardHandler crea una nueva instancia del handler de tableros
+func NewBoardHandler(boardService application.BoardServiceInterface) *BoardHandler {
+	return &BoardHandler{
+		boardService: boardService,
+	}
+}
+
+// BoardColumnRequest representa una columna en la petición de creación de un tablero
+type BoardColumnRequest struct {
+	Name     string `json:"name"`
+	State    string `json:"state"`
+	WIPLimit int    `json:"wip_limit"`
+}
+
+// CreateBoardRequest representa la estructura de la petición para crear un tablero
+type CreateBoardRequest struct {
+	Name       string               `json:"name" binding:"required"`
+	SwimlaneBy string               `json:"swimlane_by"`
+	Columns    []BoardColumnRequest `json:"columns" binding:"required"`
+}
+
+// MoveCardRequest representa la estructura de la petición para ubicar una tarea en una columna
+type MoveCardRequest struct {
+	ColumnID int `json:"column_id" binding:"required"`
+}
+
+// toBoardColumns convierte las columnas de la petición a entidades de dominio
+func toBoardColumns(columns []BoardColumnRequest) []domain.BoardColumn {
+	result := make([]domain.BoardColumn, len(columns))
+	for i, column := range columns {
+		result[i] = domain.BoardColumn{
+			Name:     column.Name,
+			State:    domain.ColumnState(column.State),
+			WIPLimit: column.WIPLimit,
+		}
+	}
+	return result
+}
+
+// boardErrorStatus traduce los errores de tableros a códigos HTTP
+func boardErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrBoardNotFound),
+		errors.Is(err, domain.ErrBoardColumnNotFound),
+		errors.Is(err, domain.ErrBoardCardNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrWIPLimitExceeded), errors.Is(err, domain.ErrTaskBlocked):
+		return http.StatusConflict
+	}
+	return archivedErrorStatus(err, http.StatusBadRequest)
+}
+
+// parseBoardIDs obtiene los IDs de tablero y tarea de la URL
+func parseBoardIDs(c *gin.Context) (int, int, bool) {
+	boardID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return 0, 0, false
+	}
+	taskID, err := strconv.ParseUint(c.Param("taskId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid task ID",
+			"message": "task ID must be a positive integer",
+		})
+		return 0, 0, false
+	}
+	return int(boardID), int(taskID), true
+}
+
+// CreateBoard crea un tablero kanban
+// @Summary Crea un tablero
+// @Description Crea un tablero con columnas ordenadas asociadas a un estado de tarea y límite WIP opcional
+// @Tags tableros
+// @Accept json
+// @Produce json
+// @Param board body CreateBoardRequest true "Tablero"
+// @Success 201 {object} entities.Board
+// @Failure 400 {object} gin.H
+// @Router /boards [post]
+func (h *BoardHandler) CreateBoard(c *gin.Context) {
+	var req CreateBoardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	board, err := h.boardService.CreateBoard(c.Request.Context(), req.Name, domain.SwimlaneMode(req.SwimlaneBy), toBoardColumns(req.Columns))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Error creating board",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Board created successfully",
+		"data":    board,
+	})
+}
+
+// GetBoard obtiene la vista completa de un tablero
+// @Summary Obtiene un tablero
+// @Description Retorna columnas, conteos y tarjetas agrupadas por fila (swimlane)
+// @Tags tableros
+// @Produce json
+// @Param id path int true "ID del tablero"
+// @Success 200 {object} entities.BoardSnapshot
+// @Failure 404 {object} gin.H
+// @Router /boards/{id} [get]
+func (h *BoardHandler) GetBoard(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	snapshot, err := h.boardService.GetBoard(c.Request.Context(), int(id))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, domain.ErrBoardNotFound) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{
+			"error":   "Error getting board",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Board retrieved successfully",
+		"data":    snapshot,
+	})
+}
+
+// MoveCard ubica una tarea en una columna del tablero
+// @Summary Mueve una tarjeta
+// @Description Agrega la tarea al tablero o la mueve al final de la columna; completa o reabre la tarea según la columna
+// @Tags tableros
+// @Accept json
+// @Produce json
+// @Param id path int true "ID del tablero"
+// @Param taskId path int true "ID de la tarea"
+// @Param card body MoveCardRequest true "Columna de destino"
+// @Success 200 {object} entities.BoardCard
+// @Failure 404 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Router /boards/{id}/cards/{taskId} [put]
+func (h *BoardHandler) MoveCard(c *gin.Context) {
+	boardID, taskID, ok := parseBoardIDs(c)
+	if !ok {
+		return
+	}
+
+	var req MoveCardRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	card, err := h.boardService.MoveCard(ctx, boardID, taskID, req.ColumnID)
+	if err != nil {
+		c.JSON(boardErrorStatus(err), gin.H{
+			"error":   "Error moving card",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Card moved successfully",
+		"data":    card,
+	})
+}
+
+// RemoveCard quita una tarea del tablero
+// @Summary Quita una tarjeta
+// @Tags tableros
+// @Param id path int true "ID del tablero"
+// @Param taskId path int true "ID de la tarea"
+// @Success 200 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /boards/{id}/cards/{taskId} [delete]
+func (h *BoardHandler) RemoveCard(c *gin.Context) {
+	boardID, taskID, ok := parseBoardIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.boardService.RemoveCard(c.Request.Context(), boardID, taskID); err != nil {
+		c.JSON(boardErrorStatus(err), gin.H{
+			"error":   "Error removing card",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Card removed successfully",
+	})
+}
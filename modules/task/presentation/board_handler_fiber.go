@@ -0,0 +1,161 @@
+package presentation
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberBoardHandler maneja las peticiones HTTP de tableros kanban con Fiber
+type FiberBoardHandler struct {
+	boardService application.BoardServiceInterface
+}
+
+// NewFiberBoardHandler crea una nueva instancia del handler de tableros con Fiber
+func NewFiberBoardHandler(boardService application.BoardServiceInterface) *FiberBoardHandler {
+	return &FiberBoardHandler{
+		boardService: boardService,
+	}
+}
+
+// FiberCreateBoardRequest representa la estructura de la petición para crear un tablero
+type FiberCreateBoardRequest struct {
+	Name       string               `json:"name"`
+	SwimlaneBy string               `json:"swimlane_by"`
+	Columns    []BoardColumnRequest `json:"columns"`
+}
+
+// FiberMoveCardRequest representa la estructura de la petición para ubicar una tarea en una columna
+type FiberMoveCardRequest struct {
+	ColumnID int `json:"column_id"`
+}
+
+// parseFiberBoardIDs obtiene los IDs de tablero y tarea de la URL;
+// retorna el cuerpo del error cuando alguno no es válido
+func parseFiberBoardIDs(c *fiber.Ctx) (int, int, fiber.Map) {
+	boardID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return 0, 0, fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		}
+	}
+	taskID, err := strconv.ParseUint(c.Params("taskId"), 10, 32)
+	if err != nil {
+		return 0, 0, fiber.Map{
+			"error":   "Invalid task ID",
+			"message": "task ID must be a positive integer",
+		}
+	}
+	return int(boardID), int(taskID), nil
+}
+
+// CreateBoard crea un tablero kanban con Fiber
+func (h *FiberBoardHandler) CreateBoard(c *fiber.Ctx) error {
+	var req FiberCreateBoardRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	board, err := h.boardService.CreateBoard(c.Context(), req.Name, domain.SwimlaneMode(req.SwimlaneBy), toBoardColumns(req.Columns))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Error creating board",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Board created successfully",
+		"data":    board,
+	})
+}
+
+// GetBoard obtiene la vista completa de un tablero con Fiber
+func (h *FiberBoardHandler) GetBoard(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	snapshot, err := h.boardService.GetBoard(c.Context(), int(id))
+	if err != nil {
+		status := fiber.StatusInternalServerError
+		if errors.Is(err, domain.ErrBoardNotFound) {
+			status = fiber.StatusNotFound
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"error":   "Error getting board",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Board retrieved successfully",
+		"data":    snapshot,
+	})
+}
+
+// MoveCard ubica una tarea en una columna del tablero con Fiber
+func (h *FiberBoardHandler) MoveCard(c *fiber.Ctx) error {
+	boardID, taskID, invalid := parseFiberBoardIDs(c)
+	if invalid != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(invalid)
+	}
+
+	var req FiberMoveCardRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	if req.ColumnID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Validation failed",
+			"message": "column_id is required",
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	card, err := h.boardService.MoveCard(ctx, boardID, taskID, req.ColumnID)
+	if err != nil {
+		return c.Status(boardErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error moving card",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Card moved successfully",
+		"data":    card,
+	})
+}
+
+// RemoveCard quita una tarea del tablero con Fiber
+func (h *FiberBoardHandler) RemoveCard(c *fiber.Ctx) error {
+	boardID, taskID, invalid := parseFiberBoardIDs(c)
+	if invalid != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(invalid)
+	}
+
+	if err := h.boardService.RemoveCard(c.Context(), boardID, taskID); err != nil {
+		return c.Status(boardErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error removing card",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Card removed successfully",
+	})
+}
@@ -0,0 +1,130 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// BulkOperationRequest es una operación de POST /tasks/bulk. title y description son para create;
+// patch es un merge patch (RFC 7396) de los campos editables para update; version es opcional y
+// exige que la tarea siga en esa versión
+type BulkOperationRequest struct {
+	Op          string         `json:"op"`
+	ID          int            `json:"id"`
+	Version     int            `json:"version"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Patch       map[string]any `json:"patch"`
+}
+
+// BulkResultResponse es el resultado de una operación del lote
+type BulkResultResponse struct {
+	Index  int          `json:"index"`
+	Op     string       `json:"op"`
+	ID     int          `json:"id,omitempty"`
+	Status int          `json:"status"`
+	Task   *domain.Task `json:"task,omitempty"`
+	Error  string       `json:"error,omitempty"`
+}
+
+// bulkOperations convierte las operaciones recibidas al dominio; un patch inválido rechaza el lote
+func bulkOperations(requests []BulkOperationRequest) ([]domain.BulkOperation, error) {
+	ops := make([]domain.BulkOperation, len(requests))
+	for i, req := range requests {
+		ops[i] = domain.BulkOperation{
+			Action:      domain.BulkAction(req.Op),
+			TaskID:      req.ID,
+			Title:       req.Title,
+			Description: req.Description,
+			Version:     req.Version,
+		}
+		if req.Patch == nil {
+			continue
+		}
+		if ops[i].Action != domain.BulkUpdate {
+			return nil, fmt.Errorf("operation %d: only update accepts patch", i)
+		}
+		patch, err := fieldsPatch(req.Patch)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+		ops[i].Patch = patch
+	}
+	return ops, nil
+}
+
+// bulkMode retorna el modo pedido; por defecto el lote es atómico
+func bulkMode(mode string) domain.BulkMode {
+	if mode == "" {
+		return domain.BulkAtomic
+	}
+	return domain.BulkMode(mode)
+}
+
+// bulkRequestErrorStatus traduce los errores que rechazan el lote completo
+func bulkRequestErrorStatus(err error) int {
+	if errors.Is(err, domain.ErrInvalidBulkRequest) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// bulkErrorStatus traduce el error de una operación del lote a un código HTTP
+func bulkErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrBulkAborted):
+		return http.StatusFailedDependency
+	case errors.Is(err, domain.ErrBulkTaskNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidBulkOperation):
+		return http.StatusBadRequest
+	}
+	return taskPatchErrorStatus(err)
+}
+
+// bulkResponse arma la respuesta del lote. Un lote atómico que falla responde con el código de la
+// primera operación que falló; en best_effort responde 207 si alguna operación falló
+func bulkResponse(mode domain.BulkMode, results []*domain.BulkResult) (int, map[string]any) {
+	responses := make([]BulkResultResponse, len(results))
+	status, failed := http.StatusOK, 0
+	for i, result := range results {
+		response := BulkResultResponse{Index: result.Index, Op: string(result.Action), ID: result.TaskID, Task: result.Task}
+		switch {
+		case result.Err != nil:
+			response.Status = bulkErrorStatus(result.Err)
+			response.Error = result.Err.Error()
+			response.Task = nil
+			failed++
+			if status == http.StatusOK && response.Status != http.StatusFailedDependency {
+				status = response.Status
+			}
+		case result.Action == domain.BulkCreate:
+			response.Status = http.StatusCreated
+		default:
+			response.Status = http.StatusOK
+		}
+		responses[i] = response
+	}
+
+	message := "Bulk operations applied successfully"
+	if failed > 0 {
+		message = "Some bulk operations failed"
+		if mode == domain.BulkBestEffort {
+			status = http.StatusMultiStatus
+		} else {
+			message = "Bulk operations were not applied"
+		}
+	}
+	return status, map[string]any{
+		"message": message,
+		"data": map[string]any{
+			"mode":      mode,
+			"succeeded": len(results) - failed,
+			"failed":    failed,
+			"results":   responses,
+		},
+	}
+}
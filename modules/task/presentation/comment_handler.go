@@ -0,0 +1,253 @@
+package presentation
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/gin-gonic/gin"
+)
+
+// CommentHandler maneja las peticiones HTTP de comentarios de tareas
+type CommentHandler struct {
+	commentService application.CommentServiceInterface
+}
+
+// NewCommentHandler crea una nueva instancia del handler de comentarios
+func NewCommentHandler(commentService application.CommentServiceInterface) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+	}
+}
+
+// CreateCommentRequest representa la estructura de la peticion para crear un comentario
+type CreateCommentRequest struct {
+	Author string `json:"author" binding:"required"`
+	Body   string `json:"body" binding:"required"`
+}
+
+// EditCommentRequest representa la estructura de la peticion para editar un comentario
+type EditCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// parseCommentIDs obtiene los IDs de tarea y comentario de la URL
+func parseCommentIDs(c *gin.Context) (int, int, bool) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return 0, 0, false
+	}
+	commentID, err := strconv.ParseUint(c.Param("commentId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid comment ID",
+			"message": "comment ID must be a positive integer",
+		})
+		return 0, 0, false
+	}
+	return int(taskID), int(commentID), true
+}
+
+// CreateComment agrega un comentario a una tarea
+// @Summary Agrega un comentario
+// @Description Agrega un comentario en Markdown a la tarea
+// @Tags comentarios
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param comment body CreateCommentRequest true "Comentario"
+// @Success 201 {object} entities.Comment
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/comments [post]
+func (h *CommentHandler) CreateComment(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	comment, err := h.commentService.AddComment(c.Request.Context(), int(taskID), req.Author, req.Body)
+	if err != nil {
+		c.JSON(archivedErrorStatus(err, http.StatusBadRequest), gin.H{
+			"error":   "Error creating comment",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Comment created successfully",
+		"data":    comment,
+	})
+}
+
+// ListComments obtiene los comentarios de una tarea paginados
+// @Summary Lista los comentarios de una tarea
+// @Tags comentarios
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param limit query int false "Cantidad por pagina (max 100)"
+// @Param offset query int false "Desplazamiento"
+// @Success 200 {object} []entities.Comment
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/comments [get]
+func (h *CommentHandler) ListComments(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid limit parameter",
+			"message": "limit must be an integer",
+		})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid offset parameter",
+			"message": "offset must be an integer",
+		})
+		return
+	}
+
+	comments, total, err := h.commentService.ListComments(c.Request.Context(), int(taskID), limit, offset)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Error getting comments",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comments retrieved successfully",
+		"data":    comments,
+		"count":   len(comments),
+		"total":   total,
+	})
+}
+
+// EditComment edita el contenido de un comentario
+// @Summary Edita un comentario
+// @Description Reemplaza el contenido y guarda la version anterior en el historial
+// @Tags comentarios
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param commentId path int true "ID del comentario"
+// @Param comment body EditCommentRequest true "Nuevo contenido"
+// @Success 200 {object} entities.Comment
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/comments/{commentId} [put]
+func (h *CommentHandler) EditComment(c *gin.Context) {
+	taskID, commentID, ok := parseCommentIDs(c)
+	if !ok {
+		return
+	}
+
+	var req EditCommentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	comment, err := h.commentService.EditComment(c.Request.Context(), taskID, commentID, req.Body)
+	if err != nil {
+		c.JSON(archivedErrorStatus(err, http.StatusBadRequest), gin.H{
+			"error":   "Error updating comment",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment updated successfully",
+		"data":    comment,
+	})
+}
+
+// GetCommentHistory obtiene el historial de ediciones de un comentario
+// @Summary Historial de un comentario
+// @Tags comentarios
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param commentId path int true "ID del comentario"
+// @Success 200 {object} []entities.CommentRevision
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/comments/{commentId}/history [get]
+func (h *CommentHandler) GetCommentHistory(c *gin.Context) {
+	taskID, commentID, ok := parseCommentIDs(c)
+	if !ok {
+		return
+	}
+
+	revisions, err := h.commentService.GetCommentHistory(c.Request.Context(), taskID, commentID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Error getting comment history",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment history retrieved successfully",
+		"data":    revisions,
+		"count":   len(revisions),
+	})
+}
+
+// DeleteComment elimina un comentario
+// @Summary Elimina un comentario
+// @Tags comentarios
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param commentId path int true "ID del comentario"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/comments/{commentId} [delete]
+func (h *CommentHandler) DeleteComment(c *gin.Context) {
+	taskID, commentID, ok := parseCommentIDs(c)
+	if !ok {
+		return
+	}
+
+	if err := h.commentService.DeleteComment(c.Request.Context(), taskID, commentID); err != nil {
+		c.JSON(archivedErrorStatus(err, http.StatusBadRequest), gin.H{
+			"error":   "Error deleting comment",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Comment deleted successfully",
+	})
+}
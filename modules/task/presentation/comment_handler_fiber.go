@@ -0,0 +1,201 @@
+package presentation
+
+import (
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberCommentHandler maneja las peticiones HTTP de comentarios con Fiber
+type FiberCommentHandler struct {
+	commentService application.CommentServiceInterface
+}
+
+// NewFiberCommentHandler crea una nueva instancia del handler de comentarios con Fiber
+func NewFiberCommentHandler(commentService application.CommentServiceInterface) *FiberCommentHandler {
+	return &FiberCommentHandler{
+		commentService: commentService,
+	}
+}
+
+// FiberCreateCommentRequest representa la estructura de la petición para crear un comentario
+type FiberCreateCommentRequest struct {
+	Author string `json:"author"`
+	Body   string `json:"body"`
+}
+
+// FiberEditCommentRequest representa la estructura de la petición para editar un comentario
+type FiberEditCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// parseFiberCommentIDs obtiene los IDs de tarea y comentario de la URL;
+// retorna el cuerpo del error cuando alguno no es válido
+func parseFiberCommentIDs(c *fiber.Ctx) (int, int, fiber.Map) {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return 0, 0, fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		}
+	}
+	commentID, err := strconv.ParseUint(c.Params("commentId"), 10, 32)
+	if err != nil {
+		return 0, 0, fiber.Map{
+			"error":   "Invalid comment ID",
+			"message": "comment ID must be a positive integer",
+		}
+	}
+	return int(taskID), int(commentID), nil
+}
+
+// CreateComment agrega un comentario a una tarea con Fiber
+func (h *FiberCommentHandler) CreateComment(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberCreateCommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	if req.Author == "" || req.Body == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Validation failed",
+			"message": "author and body are required",
+		})
+	}
+
+	comment, err := h.commentService.AddComment(c.Context(), int(taskID), req.Author, req.Body)
+	if err != nil {
+		return c.Status(archivedErrorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":   "Error creating comment",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Comment created successfully",
+		"data":    comment,
+	})
+}
+
+// ListComments obtiene los comentarios de una tarea paginados con Fiber
+func (h *FiberCommentHandler) ListComments(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	limit, err := strconv.Atoi(c.Query("limit", "0"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid limit parameter",
+			"message": "limit must be an integer",
+		})
+	}
+	offset, err := strconv.Atoi(c.Query("offset", "0"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid offset parameter",
+			"message": "offset must be an integer",
+		})
+	}
+
+	comments, total, err := h.commentService.ListComments(c.Context(), int(taskID), limit, offset)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Error getting comments",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Comments retrieved successfully",
+		"data":    comments,
+		"count":   len(comments),
+		"total":   total,
+	})
+}
+
+// EditComment edita el contenido de un comentario con Fiber
+func (h *FiberCommentHandler) EditComment(c *fiber.Ctx) error {
+	taskID, commentID, invalid := parseFiberCommentIDs(c)
+	if invalid != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(invalid)
+	}
+
+	var req FiberEditCommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	comment, err := h.commentService.EditComment(c.Context(), taskID, commentID, req.Body)
+	if err != nil {
+		return c.Status(archivedErrorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":   "Error updating comment",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Comment updated successfully",
+		"data":    comment,
+	})
+}
+
+// GetCommentHistory obtiene el historial de ediciones de un comentario con Fiber
+func (h *FiberCommentHandler) GetCommentHistory(c *fiber.Ctx) error {
+	taskID, commentID, invalid := parseFiberCommentIDs(c)
+	if invalid != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(invalid)
+	}
+
+	revisions, err := h.commentService.GetCommentHistory(c.Context(), taskID, commentID)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Error getting comment history",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Comment history retrieved successfully",
+		"data":    revisions,
+		"count":   len(revisions),
+	})
+}
+
+// DeleteComment elimina un comentario con Fiber
+func (h *FiberCommentHandler) DeleteComment(c *fiber.Ctx) error {
+	taskID, commentID, invalid := parseFiberCommentIDs(c)
+	if invalid != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(invalid)
+	}
+
+	if err := h.commentService.DeleteComment(c.Context(), taskID, commentID); err != nil {
+		return c.Status(archivedErrorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":   "Error deleting comment",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Comment deleted successfully",
+	})
+}
@@ -0,0 +1,81 @@
+package presentation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+var (
+	// errInvalidIfMatch indica una cabecera If-Match que no corresponde a ningún ETag emitido por la API
+	errInvalidIfMatch = errors.New("If-Match must be \"*\" or a list of ETags returned by this API")
+	// errWeakIfMatch indica una cabecera If-Match con solo ETags débiles, que nunca coinciden porque
+	// If-Match exige comparación fuerte
+	errWeakIfMatch = errors.New("If-Match requires strong comparison; weak ETags never match")
+)
+
+// taskETag construye el ETag de una tarea a partir de su versión
+func taskETag(task *domain.Task) string {
+	return `"` + strconv.Itoa(task.Version) + `"`
+}
+
+// parseIfMatch interpreta la cabecera If-Match, que puede ser "*" o una lista de ETags separados por
+// comas; retorna ok=false si no exige ninguna versión. Los ETags débiles (W/) se ignoran porque nunca
+// coinciden con comparación fuerte; si todos lo son, ninguna versión cumple la condición
+func parseIfMatch(header string) (versions []int, ok bool, err error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil, false, nil
+	}
+	weak := false
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if strings.HasPrefix(tag, "W/") {
+			weak = true
+			continue
+		}
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			return nil, false, errInvalidIfMatch
+		}
+		version, err := strconv.Atoi(tag[1 : len(tag)-1])
+		if err != nil || version <= 0 {
+			return nil, false, errInvalidIfMatch
+		}
+		versions = append(versions, version)
+	}
+	if len(versions) == 0 {
+		if weak {
+			return nil, false, errWeakIfMatch
+		}
+		return nil, false, errInvalidIfMatch
+	}
+	return versions, true, nil
+}
+
+// withIfMatch agrega al contexto las versiones exigidas por la cabecera If-Match
+func withIfMatch(ctx context.Context, header string) (context.Context, error) {
+	versions, ok, err := parseIfMatch(header)
+	if err != nil || !ok {
+		return ctx, err
+	}
+	return application.WithExpectedVersion(ctx, versions...), nil
+}
+
+// concurrencyErrorStatus retorna 412 si no se cumplió If-Match y 409 si otra escritura ganó la carrera
+func concurrencyErrorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, domain.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, domain.ErrVersionConflict):
+		return http.StatusConflict
+	}
+	return fallback
+}
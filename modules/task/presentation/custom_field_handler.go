@@ -0,0 +1,235 @@
+package presentation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// customFieldQueryPrefix es el prefijo de los parámetros que filtran u ordenan por campos personalizados
+const customFieldQueryPrefix = "cf."
+
+// CustomFieldHandler maneja las peticiones HTTP de campos personalizados
+type CustomFieldHandler struct {
+	taskService        application.TaskServiceInterface
+	customFieldService application.CustomFieldServiceInterface
+}
+
+// NewCustomFieldHandler crea una nueva instancia del handler de campos personalizados
+func NewCustomFieldHandler(taskService application.TaskServiceInterface, customFieldService application.CustomFieldServiceInterface) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		taskService:        taskService,
+		customFieldService: customFieldService,
+	}
+}
+
+// CreateCustomFieldRequest representa la estructura de la petición para definir un campo personalizado
+type CreateCustomFieldRequest struct {
+	Key     string   `json:"key" binding:"required"`
+	Name    string   `json:"name" binding:"required"`
+	Type    string   `json:"type" binding:"required"`
+	Options []string `json:"options"`
+}
+
+// SetCustomFieldsRequest representa la estructura de la petición para guardar valores en una tarea
+type SetCustomFieldsRequest struct {
+	Values map[string]any `json:"values" binding:"required"`
+}
+
+// customFieldErrorStatus traduce los errores de campos personalizados a códigos HTTP
+func customFieldErrorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidCustomField), errors.Is(err, domain.ErrInvalidCustomFieldValue):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrCustomFieldNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrCustomFieldExists):
+		return http.StatusConflict
+	}
+	return fallback
+}
+
+// listErrorStatus traduce los errores de los listados: un filtro u orden por un campo
+// inexistente o con un valor inválido es un error del cliente
+func listErrorStatus(err error) int {
+	if errors.Is(err, domain.ErrCustomFieldNotFound) || errors.Is(err, domain.ErrInvalidCustomFieldValue) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// parseCustomFieldQuery agrega a opts los filtros cf.<clave>=<valor> y el orden sort=[-]cf.<clave>
+func parseCustomFieldQuery(opts *domain.TaskQueryOptions, query url.Values) error {
+	keys := make([]string, 0, len(query))
+	for param := range query {
+		if key, ok := strings.CutPrefix(param, customFieldQueryPrefix); ok {
+			if key == "" {
+				return errors.New("custom field filters must use cf.<key>=<value>")
+			}
+			keys = append(keys, key)
+		}
+	}
+	// Orden estable para que la consulta generada no dependa del orden del mapa
+	slices.Sort(keys)
+	for _, key := range keys {
+		opts.CustomFields = append(opts.CustomFields, domain.CustomFieldFilter{
+			Key: key,
+			Raw: query.Get(customFieldQueryPrefix + key),
+		})
+	}
+
+	if sort := query.Get("sort"); sort != "" {
+		field, desc := strings.CutPrefix(sort, "-")
+		key, ok := strings.CutPrefix(field, customFieldQueryPrefix)
+		if !ok || key == "" {
+			return fmt.Errorf("sort must be cf.<key> or -cf.<key>, got %q", sort)
+		}
+		opts.SortBy = &domain.CustomFieldSort{Key: key, Desc: desc}
+	}
+	return nil
+}
+
+// CreateField define un campo personalizado nuevo
+// @Summary Define un campo personalizado
+// @Description Los tipos válidos son text, number, date, enum (requiere options) y user
+// @Tags campos personalizados
+// @Accept json
+// @Produce json
+// @Param field body CreateCustomFieldRequest true "Definición del campo"
+// @Success 201 {object} entities.CustomFieldDefinition
+// @Failure 400 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Router /custom-fields [post]
+func (h *CustomFieldHandler) CreateField(c *gin.Context) {
+	var req CreateCustomFieldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	field, err := h.customFieldService.CreateField(c.Request.Context(), req.Key, req.Name, domain.CustomFieldType(req.Type), req.Options)
+	if err != nil {
+		c.JSON(customFieldErrorStatus(err, http.StatusInternalServerError), gin.H{
+			"error":   "Error creating custom field",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Custom field created successfully",
+		"data":    field,
+	})
+}
+
+// ListFields obtiene las definiciones de campos personalizados
+// @Summary Lista los campos personalizados
+// @Tags campos personalizados
+// @Produce json
+// @Success 200 {object} []entities.CustomFieldDefinition
+// @Failure 500 {object} gin.H
+// @Router /custom-fields [get]
+func (h *CustomFieldHandler) ListFields(c *gin.Context) {
+	fields, err := h.customFieldService.ListFields(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error getting custom fields",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Custom fields retrieved successfully",
+		"data":    fields,
+		"count":   len(fields),
+	})
+}
+
+// DeleteField elimina un campo personalizado y sus valores en todas las tareas
+// @Summary Elimina un campo personalizado
+// @Tags campos personalizados
+// @Produce json
+// @Param key path string true "Clave del campo"
+// @Success 200 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /custom-fields/{key} [delete]
+func (h *CustomFieldHandler) DeleteField(c *gin.Context) {
+	if err := h.customFieldService.DeleteField(c.Request.Context(), c.Param("key")); err != nil {
+		c.JSON(customFieldErrorStatus(err, http.StatusInternalServerError), gin.H{
+			"error":   "Error deleting custom field",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Custom field deleted successfully",
+	})
+}
+
+// SetTaskCustomFields guarda valores de campos personalizados en una tarea
+// @Summary Guarda campos personalizados de una tarea
+// @Description Los campos que no se envían se conservan; un valor null quita el campo
+// @Tags campos personalizados
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param values body SetCustomFieldsRequest true "Valores por clave"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /tasks/{id}/custom-fields [put]
+func (h *CustomFieldHandler) SetTaskCustomFields(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req SetCustomFieldsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	task, err := h.taskService.SetTaskCustomFields(ctx, int(id), req.Values)
+	if err != nil {
+		c.JSON(customFieldErrorStatus(err, concurrencyErrorStatus(err, archivedErrorStatus(err, http.StatusBadRequest))), gin.H{
+			"error":   "Error setting custom fields",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.Header("ETag", taskETag(task))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Custom fields updated successfully",
+		"data":    task,
+	})
+}
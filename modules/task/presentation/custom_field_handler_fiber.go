@@ -0,0 +1,144 @@
+package presentation
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberCustomFieldHandler maneja las peticiones HTTP de campos personalizados con Fiber
+type FiberCustomFieldHandler struct {
+	taskService        application.TaskServiceInterface
+	customFieldService application.CustomFieldServiceInterface
+}
+
+// NewFiberCustomFieldHandler crea una nueva instancia del handler de campos personalizados con Fiber
+func NewFiberCustomFieldHandler(taskService application.TaskServiceInterface, customFieldService application.CustomFieldServiceInterface) *FiberCustomFieldHandler {
+	return &FiberCustomFieldHandler{
+		taskService:        taskService,
+		customFieldService: customFieldService,
+	}
+}
+
+// FiberCreateCustomFieldRequest representa la estructura de la petición para definir un campo personalizado
+type FiberCreateCustomFieldRequest struct {
+	Key     string   `json:"key"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Options []string `json:"options"`
+}
+
+// FiberSetCustomFieldsRequest representa la estructura de la petición para guardar valores en una tarea
+type FiberSetCustomFieldsRequest struct {
+	Values map[string]any `json:"values"`
+}
+
+// fiberQueryValues obtiene los parámetros de la URL de una petición Fiber
+func fiberQueryValues(c *fiber.Ctx) (url.Values, error) {
+	return url.ParseQuery(string(c.Request().URI().QueryString()))
+}
+
+// CreateField define un campo personalizado nuevo con Fiber
+func (h *FiberCustomFieldHandler) CreateField(c *fiber.Ctx) error {
+	var req FiberCreateCustomFieldRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	field, err := h.customFieldService.CreateField(c.Context(), req.Key, req.Name, domain.CustomFieldType(req.Type), req.Options)
+	if err != nil {
+		return c.Status(customFieldErrorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error":   "Error creating custom field",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Custom field created successfully",
+		"data":    field,
+	})
+}
+
+// ListFields obtiene las definiciones de campos personalizados con Fiber
+func (h *FiberCustomFieldHandler) ListFields(c *fiber.Ctx) error {
+	fields, err := h.customFieldService.ListFields(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Error getting custom fields",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Custom fields retrieved successfully",
+		"data":    fields,
+		"count":   len(fields),
+	})
+}
+
+// DeleteField elimina un campo personalizado y sus valores con Fiber
+func (h *FiberCustomFieldHandler) DeleteField(c *fiber.Ctx) error {
+	if err := h.customFieldService.DeleteField(c.Context(), c.Params("key")); err != nil {
+		return c.Status(customFieldErrorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error":   "Error deleting custom field",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Custom field deleted successfully",
+	})
+}
+
+// SetTaskCustomFields guarda valores de campos personalizados en una tarea con Fiber
+func (h *FiberCustomFieldHandler) SetTaskCustomFields(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberSetCustomFieldsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	if req.Values == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": "values is required",
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+
+	task, err := h.taskService.SetTaskCustomFields(ctx, int(id), req.Values)
+	if err != nil {
+		return c.Status(customFieldErrorStatus(err, concurrencyErrorStatus(err, archivedErrorStatus(err, fiber.StatusBadRequest)))).JSON(fiber.Map{
+			"error":   "Error setting custom fields",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set("ETag", taskETag(task))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Custom fields updated successfully",
+		"data":    task,
+	})
+}
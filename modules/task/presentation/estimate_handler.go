@@ -0,0 +1,253 @@
+package presentation
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// EstimateHandler maneja las peticiones HTTP de estimaciones, velocidad y burndown
+type EstimateHandler struct {
+	taskService   application.TaskServiceInterface
+	reportService application.EstimateReportServiceInterface
+}
+
+// NewEstimateHandler crea una nueva instancia del handler de estimaciones
+func NewEstimateHandler(taskService application.TaskServiceInterface, reportService application.EstimateReportServiceInterface) *EstimateHandler {
+	return &EstimateHandler{
+		taskService:   taskService,
+		reportService: reportService,
+	}
+}
+
+// EstimateTaskRequest representa la estructura de la petición para estimar una tarea
+type EstimateTaskRequest struct {
+	StoryPoints    int     `json:"story_points"`
+	EstimatedHours float64 `json:"estimated_hours"`
+}
+
+// RemainingWorkRequest representa la estructura de la petición para actualizar el trabajo restante
+type RemainingWorkRequest struct {
+	RemainingHours *float64 `json:"remaining_hours" binding:"required"`
+}
+
+// estimateErrorStatus traduce los errores de estimaciones y reportes a códigos HTTP
+func estimateErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidEstimate), errors.Is(err, domain.ErrInvalidReportRange):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrBoardNotFound):
+		return http.StatusNotFound
+	}
+	return concurrencyErrorStatus(err, archivedErrorStatus(err, http.StatusBadRequest))
+}
+
+// parseBoardFilter interpreta el filtro opcional board_id (0 si no se indica)
+func parseBoardFilter(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		return 0, errors.New("board_id must be a positive integer")
+	}
+	return int(id), nil
+}
+
+// EstimateTask asigna puntos y horas estimadas a una tarea
+// @Summary Estima una tarea
+// @Description Asigna story points y horas estimadas; el trabajo restante vuelve a las horas estimadas
+// @Tags estimaciones
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param estimate body EstimateTaskRequest true "Estimación de la tarea"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/estimate [put]
+func (h *EstimateHandler) EstimateTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req EstimateTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	task, err := h.taskService.SetTaskEstimate(ctx, int(id), req.StoryPoints, req.EstimatedHours)
+	if err != nil {
+		c.JSON(estimateErrorStatus(err), gin.H{
+			"error":   "Error estimating task",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.Header("ETag", taskETag(task))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task estimated successfully",
+		"data":    task,
+	})
+}
+
+// UpdateRemainingWork actualiza las horas que faltan para terminar una tarea
+// @Summary Actualiza el trabajo restante
+// @Tags estimaciones
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param remaining body RemainingWorkRequest true "Horas restantes"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/remaining [put]
+func (h *EstimateHandler) UpdateRemainingWork(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req RemainingWorkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	task, err := h.taskService.UpdateRemainingWork(ctx, int(id), *req.RemainingHours)
+	if err != nil {
+		c.JSON(estimateErrorStatus(err), gin.H{
+			"error":   "Error updating remaining work",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.Header("ETag", taskETag(task))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Remaining work updated successfully",
+		"data":    task,
+	})
+}
+
+// GetVelocity obtiene el trabajo completado por semana
+// @Summary Velocidad por semana
+// @Description Suma story points y horas estimadas de las tareas completadas en cada semana (lunes a domingo, UTC)
+// @Tags estimaciones
+// @Produce json
+// @Param from query string true "Inicio (YYYY-MM-DD o RFC 3339)"
+// @Param to query string true "Fin (YYYY-MM-DD inclusive o RFC 3339 exclusivo)"
+// @Param board_id query int false "Limitar a las tareas de un tablero"
+// @Success 200 {object} entities.Velocity
+// @Failure 400 {object} gin.H
+// @Router /reports/velocity [get]
+func (h *EstimateHandler) GetVelocity(c *gin.Context) {
+	from, to, err := parseReportRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid range",
+			"message": err.Error(),
+		})
+		return
+	}
+	boardID, err := parseBoardFilter(c.Query("board_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid board",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	velocity, err := h.reportService.GetVelocity(c.Request.Context(), from, to, boardID)
+	if err != nil {
+		c.JSON(estimateErrorStatus(err), gin.H{
+			"error":   "Error getting velocity",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Velocity retrieved successfully",
+		"data":    velocity,
+	})
+}
+
+// GetBurndown obtiene el trabajo estimado pendiente al terminar cada día
+// @Summary Burndown diario
+// @Tags estimaciones
+// @Produce json
+// @Param from query string true "Inicio (YYYY-MM-DD o RFC 3339)"
+// @Param to query string true "Fin (YYYY-MM-DD inclusive o RFC 3339 exclusivo)"
+// @Param board_id query int false "Limitar a las tareas de un tablero"
+// @Success 200 {object} entities.Burndown
+// @Failure 400 {object} gin.H
+// @Router /reports/burndown [get]
+func (h *EstimateHandler) GetBurndown(c *gin.Context) {
+	from, to, err := parseReportRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid range",
+			"message": err.Error(),
+		})
+		return
+	}
+	boardID, err := parseBoardFilter(c.Query("board_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid board",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	burndown, err := h.reportService.GetBurndown(c.Request.Context(), from, to, boardID)
+	if err != nil {
+		c.JSON(estimateErrorStatus(err), gin.H{
+			"error":   "Error getting burndown",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Burndown retrieved successfully",
+		"data":    burndown,
+	})
+}
@@ -0,0 +1,183 @@
+package presentation
+
+import (
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberEstimateHandler maneja las peticiones HTTP de estimaciones, velocidad y burndown con Fiber
+type FiberEstimateHandler struct {
+	taskService   application.TaskServiceInterface
+	reportService application.EstimateReportServiceInterface
+}
+
+// NewFiberEstimateHandler crea una nueva instancia del handler de estimaciones con Fiber
+func NewFiberEstimateHandler(taskService application.TaskServiceInterface, reportService application.EstimateReportServiceInterface) *FiberEstimateHandler {
+	return &FiberEstimateHandler{
+		taskService:   taskService,
+		reportService: reportService,
+	}
+}
+
+// FiberEstimateTaskRequest representa la estructura de la petición para estimar una tarea
+type FiberEstimateTaskRequest struct {
+	StoryPoints    int     `json:"story_points"`
+	EstimatedHours float64 `json:"estimated_hours"`
+}
+
+// FiberRemainingWorkRequest representa la estructura de la petición para actualizar el trabajo restante
+type FiberRemainingWorkRequest struct {
+	RemainingHours *float64 `json:"remaining_hours"`
+}
+
+// EstimateTask asigna puntos y horas estimadas a una tarea con Fiber
+func (h *FiberEstimateHandler) EstimateTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberEstimateTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+
+	task, err := h.taskService.SetTaskEstimate(ctx, int(id), req.StoryPoints, req.EstimatedHours)
+	if err != nil {
+		return c.Status(estimateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error estimating task",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set("ETag", taskETag(task))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task estimated successfully",
+		"data":    task,
+	})
+}
+
+// UpdateRemainingWork actualiza las horas que faltan para terminar una tarea con Fiber
+func (h *FiberEstimateHandler) UpdateRemainingWork(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberRemainingWorkRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	if req.RemainingHours == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": "remaining_hours is required",
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+
+	task, err := h.taskService.UpdateRemainingWork(ctx, int(id), *req.RemainingHours)
+	if err != nil {
+		return c.Status(estimateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error updating remaining work",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set("ETag", taskETag(task))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Remaining work updated successfully",
+		"data":    task,
+	})
+}
+
+// GetVelocity obtiene el trabajo completado por semana con Fiber
+func (h *FiberEstimateHandler) GetVelocity(c *fiber.Ctx) error {
+	from, to, err := parseReportRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid range",
+			"message": err.Error(),
+		})
+	}
+	boardID, err := parseBoardFilter(c.Query("board_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid board",
+			"message": err.Error(),
+		})
+	}
+
+	velocity, err := h.reportService.GetVelocity(c.Context(), from, to, boardID)
+	if err != nil {
+		return c.Status(estimateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting velocity",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Velocity retrieved successfully",
+		"data":    velocity,
+	})
+}
+
+// GetBurndown obtiene el trabajo estimado pendiente al terminar cada día con Fiber
+func (h *FiberEstimateHandler) GetBurndown(c *fiber.Ctx) error {
+	from, to, err := parseReportRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid range",
+			"message": err.Error(),
+		})
+	}
+	boardID, err := parseBoardFilter(c.Query("board_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid board",
+			"message": err.Error(),
+		})
+	}
+
+	burndown, err := h.reportService.GetBurndown(c.Context(), from, to, boardID)
+	if err != nil {
+		return c.Status(estimateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting burndown",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Burndown retrieved successfully",
+		"data":    burndown,
+	})
+}
@@ -1,10 +1,13 @@
 package presentation
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
 	"github.com/gin-gonic/gin"
 )
 
@@ -26,12 +29,17 @@ type CreateTaskRequest struct {
 	Description string `json:"description" binding:"required"`
 }
 
-// UpdateTaskRequest representa la estructura de la peticion para actualizar una tarea
-
+// UpdateTaskRequest representa la estructura de la peticion para reemplazar una tarea (PUT);
+// los campos opcionales que no se envían vuelven a su valor vacío
 type UpdateTaskRequest struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   bool   `json:"completed,omitempty"`
+	Title          string     `json:"title" binding:"required"`
+	Description    string     `json:"description" binding:"required"`
+	Completed      *bool      `json:"completed" binding:"required"`
+	DueDate        *time.Time `json:"due_date"`
+	Recurrence     string     `json:"recurrence"`
+	StoryPoints    int        `json:"story_points"`
+	EstimatedHours float64    `json:"estimated_hours"`
+	RemainingHours *float64   `json:"remaining_hours"` // Si se omite, igual a estimated_hours
 }
 
 // CreateTask maneja la creacion de una nueva tarea
@@ -57,7 +65,8 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 	}
 
 	// Crear la tarea usando el servicio
-	task, err := h.taskService.CreateTask(c.Request.Context(), req.Title, req.Description)
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	task, err := h.taskService.CreateTask(ctx, req.Title, req.Description)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Error creating task",
@@ -65,6 +74,7 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 		})
 		return
 	}
+	c.Header("ETag", taskETag(task))
 	// Respuesta exitosa
 	c.JSON(http.StatusCreated, gin.H{
 		"message": "Task created successfully",
@@ -77,14 +87,48 @@ func (h *TaskHandler) CreateTask(c *gin.Context) {
 // @Description Obtiene todas las tareas almacenadas en el sistema
 // @Tags tareas
 // @Produce json
+// @Param assignee query string false "me o ID del usuario responsable"
+// @Param cf.{key} query string false "Filtra por el valor de un campo personalizado"
+// @Param sort query string false "cf.<clave> o -cf.<clave> para ordenar por un campo personalizado"
 // @Success 200 {object} []entities.Task
+// @Failure 400 {object} gin.H
 // @Failure 500 {object} gin.H
 // @Router /tasks [get]
 func (h *TaskHandler) GetAllTasks(c *gin.Context) {
+	// ?assignee=me|<id> filtra por responsable
+	if assignee := c.Query("assignee"); assignee != "" {
+		userID, status, err := resolveAssignee(assignee, c.GetHeader(CurrentUserHeader))
+		if err != nil {
+			c.JSON(status, gin.H{
+				"error":   "Invalid assignee",
+				"message": err.Error(),
+			})
+			return
+		}
+		h.respondTasksByAssignee(c, userID)
+		return
+	}
+
+	opts, err := parseTaskQueryOptions(c.Query("include_archived"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid include_archived parameter",
+			"message": err.Error(),
+		})
+		return
+	}
+	if err := parseCustomFieldQuery(&opts, c.Request.URL.Query()); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid custom field query",
+			"message": err.Error(),
+		})
+		return
+	}
+
 	// Obtener todas las tareas usando el servicio
-	tasks, err := h.taskService.GetAllTasks(c.Request.Context())
+	tasks, err := h.taskService.GetAllTasks(c.Request.Context(), opts)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(listErrorStatus(err), gin.H{
 			"error":   "Error getting tasks",
 			"message": err.Error(),
 		})
@@ -121,6 +165,11 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 		})
 		return
 	}
+	// Con as_of se responde la tarea tal como estaba en esa fecha
+	if asOf := c.Query("as_of"); asOf != "" {
+		h.respondTaskAsOf(c, int(id), asOf)
+		return
+	}
 	// Obtener la tarea usando el servicio
 	task, err := h.taskService.GetTaskByID(c.Request.Context(), int(id))
 	if err != nil {
@@ -130,6 +179,7 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 		})
 		return
 	}
+	c.Header("ETag", taskETag(task))
 	// Respuesta exitosa
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Task retrieved successfully",
@@ -138,9 +188,9 @@ func (h *TaskHandler) GetTask(c *gin.Context) {
 
 }
 
-// UpdateTask actualiza una tarea existente
-// @Summary Actualiza una tarea existente
-// @Description Actualiza los detalles de una tarea especifica por su ID
+// UpdateTask reemplaza una tarea existente
+// @Summary Reemplaza una tarea existente
+// @Description Reemplaza todos los campos editables de la tarea; para cambios parciales usar PATCH
 // @Tags tareas
 // @Accept json
 // @Produce json
@@ -165,7 +215,7 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 	}
 
 	var req UpdateTaskRequest
-	// Bindear el JSON (sin validacion required porque son campos opcionales)
+	// PUT reemplaza la tarea completa: title, description y completed son obligatorios
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error":   "Invalid request",
@@ -173,16 +223,103 @@ func (h *TaskHandler) UpdateTask(c *gin.Context) {
 		})
 		return
 	}
-	// Actualizar la tarea usando el servicio
-	task, err := h.taskService.UpdateTask(c.Request.Context(), int(id), req.Title, req.Description, &req.Completed)
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	// Reemplazar la tarea usando el servicio
+	patch := replacementPatch(req.Title, req.Description, *req.Completed, req.DueDate, req.Recurrence,
+		req.StoryPoints, req.EstimatedHours, req.RemainingHours)
+	task, err := h.taskService.PatchTask(ctx, int(id), patch)
+	if err != nil {
+		c.JSON(taskPatchErrorStatus(err), gin.H{
 			"error":   "Error updating task",
 			"message": err.Error(),
 		})
 		return
 	}
 	// Respuesta exitosa
+	c.Header("ETag", taskETag(task))
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task updated successfully",
+		"data":    task,
+	})
+}
+
+// PatchTask aplica cambios parciales a una tarea
+// @Summary Modifica parcialmente una tarea
+// @Description Acepta JSON Merge Patch (application/merge-patch+json) o JSON Patch (application/json-patch+json, con operaciones test) sobre title, description, completed, due_date, recurrence, story_points, estimated_hours y remaining_hours
+// @Tags tareas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Failure 415 {object} gin.H
+// @Router /tasks/{id} [patch]
+func (h *TaskHandler) PatchTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	body, err := c.GetRawData()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	// El parche se evalúa sobre la versión actual de la tarea
+	current, err := h.taskService.GetTaskByID(ctx, int(id))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Task not found",
+			"message": err.Error(),
+		})
+		return
+	}
+	patch, err := buildTaskPatch(current, c.GetHeader("Content-Type"), body)
+	if err != nil {
+		c.JSON(patchErrorStatus(err), gin.H{
+			"error":   "Invalid patch",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx, implicitVersion := withPatchVersion(ctx, c.GetHeader("If-Match"), current)
+	task, err := h.taskService.PatchTask(ctx, int(id), patch)
+	if err != nil {
+		c.JSON(patchSaveErrorStatus(err, implicitVersion), gin.H{
+			"error":   "Error updating task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.Header("ETag", taskETag(task))
 	c.JSON(http.StatusOK, gin.H{
 		"message": "Task updated successfully",
 		"data":    task,
@@ -211,10 +348,18 @@ func (h *TaskHandler) DeleteTask(c *gin.Context) {
 		})
 		return
 	}
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
 	// Eliminar la tarea usando el servicio
-	err = h.taskService.DeleteTask(c.Request.Context(), int(id))
+	err = h.taskService.DeleteTask(ctx, int(id))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(concurrencyErrorStatus(err, archivedErrorStatus(err, http.StatusBadRequest)), gin.H{
 			"error":   "Error deleting task",
 			"message": err.Error(),
 		})
@@ -249,9 +394,24 @@ func (h *TaskHandler) GetTaskByStatus(c *gin.Context) {
 		return
 	}
 	// Obtener todas las tareas usando el servicio
-	tasks, err := h.taskService.GetTasksByStatus(c.Request.Context(), completed)
+	opts, err := parseTaskQueryOptions(c.Query("include_archived"))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid include_archived parameter",
+			"message": err.Error(),
+		})
+		return
+	}
+	if err := parseCustomFieldQuery(&opts, c.Request.URL.Query()); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid custom field query",
+			"message": err.Error(),
+		})
+		return
+	}
+	tasks, err := h.taskService.GetTasksByStatus(c.Request.Context(), completed, opts)
+	if err != nil {
+		c.JSON(listErrorStatus(err), gin.H{
 			"error":   "Error getting tasks",
 			"message": err.Error(),
 		})
@@ -263,3 +423,852 @@ func (h *TaskHandler) GetTaskByStatus(c *gin.Context) {
 		"data":    tasks,
 	})
 }
+
+// AddDependencyRequest representa la estructura de la peticion para bloquear una tarea
+type AddDependencyRequest struct {
+	BlockerID int `json:"blocker_id" binding:"required"`
+}
+
+// AddDependency registra que una tarea esta bloqueada por otra
+// @Summary Agrega un bloqueador a una tarea
+// @Description Registra que la tarea {id} esta bloqueada por la tarea blocker_id. Rechaza ciclos.
+// @Tags tareas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param dependency body AddDependencyRequest true "Tarea bloqueadora"
+// @Success 201 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Router /tasks/{id}/dependencies [post]
+func (h *TaskHandler) AddDependency(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req AddDependencyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := h.taskService.AddDependency(c.Request.Context(), int(id), req.BlockerID); err != nil {
+		status := archivedErrorStatus(err, http.StatusBadRequest)
+		if errors.Is(err, domain.ErrDependencyCycle) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{
+			"error":   "Error adding dependency",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Dependency added successfully",
+	})
+}
+
+// RemoveDependency elimina el bloqueo de una tarea sobre otra
+// @Summary Elimina un bloqueador de una tarea
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param blockerId path int true "ID de la tarea bloqueadora"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/dependencies/{blockerId} [delete]
+func (h *TaskHandler) RemoveDependency(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+	blockerID, err := strconv.ParseUint(c.Param("blockerId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid blocker ID",
+			"message": "blocker ID must be a positive integer",
+		})
+		return
+	}
+
+	if err := h.taskService.RemoveDependency(c.Request.Context(), int(id), int(blockerID)); err != nil {
+		c.JSON(archivedErrorStatus(err, http.StatusBadRequest), gin.H{
+			"error":   "Error removing dependency",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Dependency removed successfully",
+	})
+}
+
+// GetTaskBlockers obtiene las tareas que bloquean a una tarea
+// @Summary Obtiene los bloqueadores de una tarea
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} []entities.Task
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/dependencies [get]
+func (h *TaskHandler) GetTaskBlockers(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	blockers, err := h.taskService.GetTaskBlockers(c.Request.Context(), int(id))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Error getting blockers",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Blockers retrieved successfully",
+		"data":    blockers,
+		"count":   len(blockers),
+	})
+}
+
+// GetTasksInDependencyOrder obtiene las tareas en orden de ejecucion
+// @Summary Obtiene las tareas en orden topologico
+// @Description Cada tarea aparece despues de todas las tareas que la bloquean
+// @Tags tareas
+// @Produce json
+// @Success 200 {object} []entities.Task
+// @Failure 500 {object} gin.H
+// @Router /tasks/order [get]
+func (h *TaskHandler) GetTasksInDependencyOrder(c *gin.Context) {
+	tasks, err := h.taskService.GetTasksInDependencyOrder(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error getting tasks",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tasks retrieved successfully",
+		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
+
+// ScheduleTaskRequest representa la estructura de la peticion para programar una tarea
+type ScheduleTaskRequest struct {
+	DueDate    *time.Time `json:"due_date"`
+	Recurrence string     `json:"recurrence"`
+}
+
+// ScheduleTask asigna fecha de vencimiento y recurrencia a una tarea
+// @Summary Programa una tarea
+// @Description Asigna la fecha de vencimiento y una regla RRULE (FREQ=DAILY|WEEKLY|MONTHLY). Al completar la tarea se crea la siguiente ocurrencia.
+// @Tags tareas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param schedule body ScheduleTaskRequest true "Programacion de la tarea"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/schedule [put]
+func (h *TaskHandler) ScheduleTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req ScheduleTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	task, err := h.taskService.SetTaskSchedule(ctx, int(id), req.DueDate, req.Recurrence)
+	if err != nil {
+		c.JSON(concurrencyErrorStatus(err, archivedErrorStatus(err, http.StatusBadRequest)), gin.H{
+			"error":   "Error scheduling task",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.Header("ETag", taskETag(task))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task scheduled successfully",
+		"data":    task,
+	})
+}
+
+// CurrentUserHeader identifica al usuario que hace la petición.
+// Mientras la API no tenga autenticación, "me" se resuelve con esta cabecera.
+const CurrentUserHeader = "X-User-ID"
+
+// resolveAssignee interpreta el filtro assignee ("me" o un ID) y retorna el código HTTP si no es válido
+func resolveAssignee(value, currentUser string) (int, int, error) {
+	if value == "me" {
+		if currentUser == "" {
+			return 0, http.StatusUnauthorized, errors.New("assignee=me requires the " + CurrentUserHeader + " header")
+		}
+		value = currentUser
+	}
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		return 0, http.StatusBadRequest, errors.New("assignee must be 'me' or a positive integer")
+	}
+	return int(id), http.StatusOK, nil
+}
+
+// assignmentErrorStatus traduce los errores de asignación a códigos HTTP
+func assignmentErrorStatus(err error) int {
+	if errors.Is(err, domain.ErrAssigneeNotFound) || errors.Is(err, domain.ErrAssigneeInactive) {
+		return http.StatusUnprocessableEntity
+	}
+	return archivedErrorStatus(err, http.StatusBadRequest)
+}
+
+// archivedErrorStatus retorna 409 si la operación falló porque la tarea está archivada
+func archivedErrorStatus(err error, fallback int) int {
+	if errors.Is(err, domain.ErrTaskArchived) {
+		return http.StatusConflict
+	}
+	return fallback
+}
+
+// parseTaskQueryOptions interpreta el parámetro include_archived de los listados
+func parseTaskQueryOptions(includeArchived string) (domain.TaskQueryOptions, error) {
+	if includeArchived == "" {
+		return domain.TaskQueryOptions{}, nil
+	}
+	include, err := strconv.ParseBool(includeArchived)
+	if err != nil {
+		return domain.TaskQueryOptions{}, errors.New("include_archived must be a boolean value")
+	}
+	return domain.TaskQueryOptions{IncludeArchived: include}, nil
+}
+
+// respondTasksByAssignee responde con las tareas asignadas a un usuario
+func (h *TaskHandler) respondTasksByAssignee(c *gin.Context, userID int) {
+	tasks, err := h.taskService.GetTasksByAssignee(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error getting tasks",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Tasks retrieved successfully",
+		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
+
+// AssignTaskRequest representa la estructura de la peticion para asignar responsables
+type AssignTaskRequest struct {
+	UserIDs []int `json:"user_ids" binding:"required,min=1"`
+}
+
+// AssignTask asigna usuarios a una tarea
+// @Summary Asigna responsables a una tarea
+// @Description Agrega los usuarios indicados como responsables; deben existir y estar activos
+// @Tags tareas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param assignees body AssignTaskRequest true "Usuarios"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 422 {object} gin.H
+// @Router /tasks/{id}/assignees [post]
+func (h *TaskHandler) AssignTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req AssignTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	task, err := h.taskService.AssignTask(ctx, int(id), req.UserIDs)
+	if err != nil {
+		c.JSON(assignmentErrorStatus(err), gin.H{
+			"error":   "Error assigning task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task assigned successfully",
+		"data":    task,
+	})
+}
+
+// UnassignTask quita a un usuario de los responsables de una tarea
+// @Summary Quita un responsable de una tarea
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param userId path int true "ID del usuario"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/assignees/{userId} [delete]
+func (h *TaskHandler) UnassignTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+	userID, err := strconv.ParseUint(c.Param("userId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid user ID",
+			"message": "user ID must be a positive integer",
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	if err := h.taskService.UnassignTask(ctx, int(id), int(userID)); err != nil {
+		c.JSON(archivedErrorStatus(err, http.StatusBadRequest), gin.H{
+			"error":   "Error unassigning task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task unassigned successfully",
+	})
+}
+
+// GetUserTasks obtiene las tareas asignadas a un usuario
+// @Summary Tareas de un usuario
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID del usuario"
+// @Success 200 {object} []entities.Task
+// @Failure 400 {object} gin.H
+// @Router /users/{id}/tasks [get]
+func (h *TaskHandler) GetUserTasks(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil || userID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	h.respondTasksByAssignee(c, int(userID))
+}
+
+// trashErrorStatus traduce los errores de la papelera a códigos HTTP
+func trashErrorStatus(err error) int {
+	if errors.Is(err, domain.ErrTaskNotInTrash) {
+		return http.StatusNotFound
+	}
+	return http.StatusBadRequest
+}
+
+// GetTrash obtiene las tareas de la papelera
+// @Summary Papelera de tareas
+// @Description Obtiene las tareas eliminadas que todavía se pueden restaurar
+// @Tags tareas
+// @Produce json
+// @Success 200 {object} []entities.Task
+// @Failure 500 {object} gin.H
+// @Router /tasks/trash [get]
+func (h *TaskHandler) GetTrash(c *gin.Context) {
+	tasks, err := h.taskService.GetTrash(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error getting trash",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Trash retrieved successfully",
+		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
+
+// RestoreTask saca una tarea de la papelera
+// @Summary Restaura una tarea eliminada
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /tasks/{id}/restore [post]
+func (h *TaskHandler) RestoreTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	task, err := h.taskService.RestoreTask(ctx, int(id))
+	if err != nil {
+		c.JSON(trashErrorStatus(err), gin.H{
+			"error":   "Error restoring task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task restored successfully",
+		"data":    task,
+	})
+}
+
+// PermanentlyDeleteTask elimina definitivamente una tarea de la papelera
+// @Summary Elimina definitivamente una tarea
+// @Description Solo aplica a tareas que ya están en la papelera
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /tasks/trash/{id} [delete]
+func (h *TaskHandler) PermanentlyDeleteTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	if err := h.taskService.PermanentlyDeleteTask(c.Request.Context(), int(id)); err != nil {
+		c.JSON(trashErrorStatus(err), gin.H{
+			"error":   "Error deleting task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task permanently deleted",
+	})
+}
+
+// ArchiveTask archiva una tarea completada
+// @Summary Archiva una tarea completada
+// @Description La tarea archivada no aparece en los listados y es de solo lectura hasta desarchivarla
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Router /tasks/{id}/archive [post]
+func (h *TaskHandler) ArchiveTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	task, err := h.taskService.ArchiveTask(ctx, int(id))
+	if err != nil {
+		status := archivedErrorStatus(err, http.StatusBadRequest)
+		if errors.Is(err, domain.ErrArchiveNotCompleted) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{
+			"error":   "Error archiving task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task archived successfully",
+		"data":    task,
+	})
+}
+
+// UnarchiveTask saca una tarea del archivo
+// @Summary Desarchiva una tarea
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /tasks/{id}/unarchive [post]
+func (h *TaskHandler) UnarchiveTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	task, err := h.taskService.UnarchiveTask(ctx, int(id))
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, domain.ErrTaskNotArchived) {
+			status = http.StatusNotFound
+		}
+		c.JSON(status, gin.H{
+			"error":   "Error unarchiving task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task unarchived successfully",
+		"data":    task,
+	})
+}
+
+// ArchiveCompletedTasksRequest representa la estructura de la peticion para archivar en lote
+type ArchiveCompletedTasksRequest struct {
+	OlderThanDays *int `json:"older_than_days" binding:"required,min=0"`
+}
+
+// ArchiveCompletedTasks archiva en lote las tareas completadas hace más de N días
+// @Summary Archiva tareas completadas antiguas
+// @Tags tareas
+// @Accept json
+// @Produce json
+// @Param request body ArchiveCompletedTasksRequest true "Antigüedad mínima en días"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Router /tasks/archive [post]
+func (h *TaskHandler) ArchiveCompletedTasks(c *gin.Context) {
+	var req ArchiveCompletedTasksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	archived, err := h.taskService.ArchiveCompletedTasks(ctx, *req.OlderThanDays)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error archiving tasks",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Tasks archived successfully",
+		"archived": archived,
+	})
+}
+
+// BulkTasksRequest representa la estructura de la petición de operaciones en lote
+type BulkTasksRequest struct {
+	Mode       string                 `json:"mode"`
+	Operations []BulkOperationRequest `json:"operations" binding:"required"`
+}
+
+// BulkTasks crea, actualiza, completa, reabre o elimina varias tareas en una petición
+// @Summary Operaciones en lote sobre tareas
+// @Description Aplica hasta 500 operaciones (create, update, complete, uncomplete, delete). En modo atomic (por defecto) se aplican todas o ninguna; en best_effort se aplican las válidas y se informa el resultado de cada una
+// @Tags tareas
+// @Accept json
+// @Produce json
+// @Param request body BulkTasksRequest true "Modo y operaciones"
+// @Success 200 {object} gin.H
+// @Success 207 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Router /tasks/bulk [post]
+func (h *TaskHandler) BulkTasks(c *gin.Context) {
+	var req BulkTasksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+	ops, err := bulkOperations(req.Operations)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	mode := bulkMode(req.Mode)
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	results, err := h.taskService.BulkTasks(ctx, mode, ops)
+	if err != nil {
+		c.JSON(bulkRequestErrorStatus(err), gin.H{
+			"error":   "Error applying bulk operations",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	status, body := bulkResponse(mode, results)
+	c.JSON(status, body)
+}
+
+// respondTaskAsOf responde con la tarea tal como estaba en la fecha asOf
+func (h *TaskHandler) respondTaskAsOf(c *gin.Context, id int, asOf string) {
+	at, err := parseAsOf(asOf)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid as_of",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	task, err := h.taskService.GetTaskAsOf(c.Request.Context(), id, at)
+	if err != nil {
+		c.JSON(historyErrorStatus(err, http.StatusBadRequest), gin.H{
+			"error":   "Error getting task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task retrieved successfully",
+		"data":    task,
+	})
+}
+
+// GetTaskHistory obtiene el historial de cambios de una tarea
+// @Summary Obtiene el historial de cambios de una tarea
+// @Description Cada revisión incluye la acción, el usuario, la fecha y los campos que cambiaron
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} []domain.TaskRevision
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/history [get]
+func (h *TaskHandler) GetTaskHistory(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	revisions, err := h.taskService.GetTaskHistory(c.Request.Context(), int(id))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Error getting task history",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task history retrieved successfully",
+		"data":    revisions,
+		"count":   len(revisions),
+	})
+}
+
+// RevertTask restaura una tarea a una revisión anterior
+// @Summary Revierte una tarea a una revisión anterior
+// @Description Restaura titulo, descripcion, estado y programacion; la reversion queda en el historial
+// @Tags tareas
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param revisionId path int true "ID de la revision"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Router /tasks/{id}/history/{revisionId}/revert [post]
+func (h *TaskHandler) RevertTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+	revisionID, err := strconv.ParseUint(c.Param("revisionId"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid revision ID",
+			"message": "Revision ID must be a positive integer",
+		})
+		return
+	}
+
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	task, err := h.taskService.RevertTask(ctx, int(id), int(revisionID))
+	if err != nil {
+		status := historyErrorStatus(err, concurrencyErrorStatus(err, archivedErrorStatus(err, http.StatusBadRequest)))
+		if errors.Is(err, domain.ErrTaskBlocked) {
+			status = http.StatusConflict
+		}
+		c.JSON(status, gin.H{
+			"error":   "Error reverting task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.Header("ETag", taskETag(task))
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task reverted successfully",
+		"data":    task,
+	})
+}
+
+// MoveTaskRequest representa la estructura de la peticion para mover una tarea en el orden manual
+type MoveTaskRequest struct {
+	Before int `json:"before"` // Ubicar la tarea antes de esta tarea
+	After  int `json:"after"`  // Ubicar la tarea después de esta tarea
+}
+
+// MoveTask cambia la posición de una tarea en el orden manual
+// @Summary Mueve una tarea en el orden manual
+// @Description Ubica la tarea antes de "before" y/o después de "after"
+// @Tags tareas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param move body MoveTaskRequest true "Tareas de referencia"
+// @Success 200 {object} entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Router /tasks/{id}/move [post]
+func (h *TaskHandler) MoveTask(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	var req MoveTaskRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+	if req.Before < 0 || req.After < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": "before and after must be positive task IDs",
+		})
+		return
+	}
+
+	ctx, err := withIfMatch(withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader)), c.GetHeader("If-Match"))
+	if err != nil {
+		c.JSON(http.StatusPreconditionFailed, gin.H{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+		return
+	}
+	task, err := h.taskService.MoveTask(ctx, int(id), req.Before, req.After)
+	if err != nil {
+		c.JSON(concurrencyErrorStatus(err, archivedErrorStatus(err, http.StatusBadRequest)), gin.H{
+			"error":   "Error moving task",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.Header("ETag", taskETag(task))
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Task moved successfully",
+		"data":    task,
+	})
+}
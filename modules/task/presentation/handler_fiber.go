@@ -1,9 +1,12 @@
 package presentation
 
 import (
+	"errors"
 	"strconv"
+	"time"
 
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -25,11 +28,17 @@ type FiberCreateTaskRequest struct {
 	Description string `json:"description"`
 }
 
-// UpdateTaskRequest representa la estructura de la petición para actualizar una tarea
+// FiberUpdateTaskRequest representa la estructura de la petición para reemplazar una tarea (PUT);
+// los campos opcionales que no se envían vuelven a su valor vacío
 type FiberUpdateTaskRequest struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Completed   *bool  `json:"completed,omitempty"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	Completed      *bool      `json:"completed"`
+	DueDate        *time.Time `json:"due_date"`
+	Recurrence     string     `json:"recurrence"`
+	StoryPoints    int        `json:"story_points"`
+	EstimatedHours float64    `json:"estimated_hours"`
+	RemainingHours *float64   `json:"remaining_hours"`
 }
 
 // CreateTask maneja la creación de una nueva tarea con Fiber
@@ -51,7 +60,8 @@ func (h *FiberTaskHandler) CreateTask(c *fiber.Ctx) error {
 		})
 	}
 
-	task, err := h.taskService.CreateTask(c.Context(), req.Title, req.Description)
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	task, err := h.taskService.CreateTask(ctx, req.Title, req.Description)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error":   "Error creating task",
@@ -59,6 +69,7 @@ func (h *FiberTaskHandler) CreateTask(c *fiber.Ctx) error {
 		})
 	}
 
+	c.Set("ETag", taskETag(task))
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
 		"message": "Task created successfully",
 		"data":    task,
@@ -67,9 +78,39 @@ func (h *FiberTaskHandler) CreateTask(c *fiber.Ctx) error {
 
 // GetAllTasks obtiene todas las tareas con Fiber
 func (h *FiberTaskHandler) GetAllTasks(c *fiber.Ctx) error {
-	tasks, err := h.taskService.GetAllTasks(c.Context())
+	// ?assignee=me|<id> filtra por responsable
+	if assignee := c.Query("assignee"); assignee != "" {
+		userID, status, err := resolveAssignee(assignee, c.Get(CurrentUserHeader))
+		if err != nil {
+			return c.Status(status).JSON(fiber.Map{
+				"error":   "Invalid assignee",
+				"message": err.Error(),
+			})
+		}
+		return h.respondTasksByAssignee(c, userID)
+	}
+
+	opts, err := parseTaskQueryOptions(c.Query("include_archived"))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid include_archived parameter",
+			"message": err.Error(),
+		})
+	}
+	query, err := fiberQueryValues(c)
+	if err == nil {
+		err = parseCustomFieldQuery(&opts, query)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid custom field query",
+			"message": err.Error(),
+		})
+	}
+
+	tasks, err := h.taskService.GetAllTasks(c.Context(), opts)
+	if err != nil {
+		return c.Status(listErrorStatus(err)).JSON(fiber.Map{
 			"error":   "Error getting tasks",
 			"message": err.Error(),
 		})
@@ -94,6 +135,11 @@ func (h *FiberTaskHandler) GetTask(c *fiber.Ctx) error {
 		})
 	}
 
+	// Con as_of se responde la tarea tal como estaba en esa fecha
+	if asOf := c.Query("as_of"); asOf != "" {
+		return h.respondTaskAsOf(c, int(id), asOf)
+	}
+
 	task, err := h.taskService.GetTaskByID(c.Context(), int(id))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -102,13 +148,14 @@ func (h *FiberTaskHandler) GetTask(c *fiber.Ctx) error {
 		})
 	}
 
+	c.Set("ETag", taskETag(task))
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Task retrieved successfully",
 		"data":    task,
 	})
 }
 
-// UpdateTask actualiza una tarea existente con Fiber
+// UpdateTask reemplaza una tarea existente con Fiber
 func (h *FiberTaskHandler) UpdateTask(c *fiber.Ctx) error {
 	idStr := c.Params("id")
 
@@ -127,15 +174,83 @@ func (h *FiberTaskHandler) UpdateTask(c *fiber.Ctx) error {
 			"message": err.Error(),
 		})
 	}
+	// PUT reemplaza la tarea completa: title, description y completed son obligatorios
+	if req.Title == "" || req.Description == "" || req.Completed == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": "title, description and completed are required",
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+
+	patch := replacementPatch(req.Title, req.Description, *req.Completed, req.DueDate, req.Recurrence,
+		req.StoryPoints, req.EstimatedHours, req.RemainingHours)
+	task, err := h.taskService.PatchTask(ctx, int(id), patch)
+	if err != nil {
+		return c.Status(taskPatchErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error updating task",
+			"message": err.Error(),
+		})
+	}
 
-	task, err := h.taskService.UpdateTask(c.Context(), int(id), req.Title, req.Description, req.Completed)
+	c.Set("ETag", taskETag(task))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task updated successfully",
+		"data":    task,
+	})
+}
+
+// PatchTask aplica cambios parciales a una tarea con Fiber (JSON Merge Patch o JSON Patch)
+func (h *FiberTaskHandler) PatchTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+
+	// El parche se evalúa sobre la versión actual de la tarea
+	current, err := h.taskService.GetTaskByID(ctx, int(id))
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "Task not found",
+			"message": err.Error(),
+		})
+	}
+	patch, err := buildTaskPatch(current, c.Get(fiber.HeaderContentType), c.Body())
+	if err != nil {
+		return c.Status(patchErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Invalid patch",
+			"message": err.Error(),
+		})
+	}
+
+	ctx, implicitVersion := withPatchVersion(ctx, c.Get("If-Match"), current)
+	task, err := h.taskService.PatchTask(ctx, int(id), patch)
+	if err != nil {
+		return c.Status(patchSaveErrorStatus(err, implicitVersion)).JSON(fiber.Map{
 			"error":   "Error updating task",
 			"message": err.Error(),
 		})
 	}
 
+	c.Set("ETag", taskETag(task))
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Task updated successfully",
 		"data":    task,
@@ -154,9 +269,17 @@ func (h *FiberTaskHandler) DeleteTask(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.taskService.DeleteTask(c.Context(), int(id))
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+
+	err = h.taskService.DeleteTask(ctx, int(id))
+	if err != nil {
+		return c.Status(concurrencyErrorStatus(err, archivedErrorStatus(err, fiber.StatusBadRequest))).JSON(fiber.Map{
 			"error":   "Error deleting task",
 			"message": err.Error(),
 		})
@@ -178,7 +301,141 @@ func (h *FiberTaskHandler) GetTaskByStatus(c *fiber.Ctx) error {
 		})
 	}
 
-	tasks, err := h.taskService.GetTasksByStatus(c.Context(), completed)
+	opts, err := parseTaskQueryOptions(c.Query("include_archived"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid include_archived parameter",
+			"message": err.Error(),
+		})
+	}
+	query, err := fiberQueryValues(c)
+	if err == nil {
+		err = parseCustomFieldQuery(&opts, query)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid custom field query",
+			"message": err.Error(),
+		})
+	}
+
+	tasks, err := h.taskService.GetTasksByStatus(c.Context(), completed, opts)
+	if err != nil {
+		return c.Status(listErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting tasks",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Tasks retrieved successfully",
+		"data":    tasks,
+	})
+}
+
+// FiberAddDependencyRequest representa la estructura de la petición para bloquear una tarea
+type FiberAddDependencyRequest struct {
+	BlockerID int `json:"blocker_id"`
+}
+
+// AddDependency registra que una tarea está bloqueada por otra con Fiber
+func (h *FiberTaskHandler) AddDependency(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberAddDependencyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	if req.BlockerID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Validation failed",
+			"message": "blocker_id is required",
+		})
+	}
+
+	if err := h.taskService.AddDependency(c.Context(), int(id), req.BlockerID); err != nil {
+		status := archivedErrorStatus(err, fiber.StatusBadRequest)
+		if errors.Is(err, domain.ErrDependencyCycle) {
+			status = fiber.StatusConflict
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"error":   "Error adding dependency",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Dependency added successfully",
+	})
+}
+
+// RemoveDependency elimina el bloqueo de una tarea sobre otra con Fiber
+func (h *FiberTaskHandler) RemoveDependency(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+	blockerID, err := strconv.ParseUint(c.Params("blockerId"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid blocker ID",
+			"message": "blocker ID must be a positive integer",
+		})
+	}
+
+	if err := h.taskService.RemoveDependency(c.Context(), int(id), int(blockerID)); err != nil {
+		return c.Status(archivedErrorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":   "Error removing dependency",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Dependency removed successfully",
+	})
+}
+
+// GetTaskBlockers obtiene las tareas que bloquean a una tarea con Fiber
+func (h *FiberTaskHandler) GetTaskBlockers(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	blockers, err := h.taskService.GetTaskBlockers(c.Context(), int(id))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Error getting blockers",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Blockers retrieved successfully",
+		"data":    blockers,
+		"count":   len(blockers),
+	})
+}
+
+// GetTasksInDependencyOrder obtiene las tareas en orden de dependencias con Fiber
+func (h *FiberTaskHandler) GetTasksInDependencyOrder(c *fiber.Ctx) error {
+	tasks, err := h.taskService.GetTasksInDependencyOrder(c.Context())
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "Error getting tasks",
@@ -189,5 +446,497 @@ func (h *FiberTaskHandler) GetTaskByStatus(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Tasks retrieved successfully",
 		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
+
+// FiberScheduleTaskRequest representa la estructura de la petición para programar una tarea
+type FiberScheduleTaskRequest struct {
+	DueDate    *time.Time `json:"due_date"`
+	Recurrence string     `json:"recurrence"`
+}
+
+// ScheduleTask asigna fecha de vencimiento y recurrencia a una tarea con Fiber
+func (h *FiberTaskHandler) ScheduleTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberScheduleTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+
+	task, err := h.taskService.SetTaskSchedule(ctx, int(id), req.DueDate, req.Recurrence)
+	if err != nil {
+		return c.Status(concurrencyErrorStatus(err, archivedErrorStatus(err, fiber.StatusBadRequest))).JSON(fiber.Map{
+			"error":   "Error scheduling task",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set("ETag", taskETag(task))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task scheduled successfully",
+		"data":    task,
+	})
+}
+
+// respondTasksByAssignee responde con las tareas asignadas a un usuario con Fiber
+func (h *FiberTaskHandler) respondTasksByAssignee(c *fiber.Ctx, userID int) error {
+	tasks, err := h.taskService.GetTasksByAssignee(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Error getting tasks",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Tasks retrieved successfully",
+		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
+
+// FiberAssignTaskRequest representa la estructura de la petición para asignar responsables
+type FiberAssignTaskRequest struct {
+	UserIDs []int `json:"user_ids"`
+}
+
+// AssignTask asigna usuarios a una tarea con Fiber
+func (h *FiberTaskHandler) AssignTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberAssignTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	if len(req.UserIDs) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Validation failed",
+			"message": "user_ids is required",
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	task, err := h.taskService.AssignTask(ctx, int(id), req.UserIDs)
+	if err != nil {
+		return c.Status(assignmentErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error assigning task",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task assigned successfully",
+		"data":    task,
+	})
+}
+
+// UnassignTask quita a un usuario de los responsables de una tarea con Fiber
+func (h *FiberTaskHandler) UnassignTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+	userID, err := strconv.ParseUint(c.Params("userId"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid user ID",
+			"message": "user ID must be a positive integer",
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	if err := h.taskService.UnassignTask(ctx, int(id), int(userID)); err != nil {
+		return c.Status(archivedErrorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":   "Error unassigning task",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task unassigned successfully",
+	})
+}
+
+// GetUserTasks obtiene las tareas asignadas a un usuario con Fiber
+func (h *FiberTaskHandler) GetUserTasks(c *fiber.Ctx) error {
+	userID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil || userID == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	return h.respondTasksByAssignee(c, int(userID))
+}
+
+// GetTrash obtiene las tareas de la papelera con Fiber
+func (h *FiberTaskHandler) GetTrash(c *fiber.Ctx) error {
+	tasks, err := h.taskService.GetTrash(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Error getting trash",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Trash retrieved successfully",
+		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
+
+// RestoreTask saca una tarea de la papelera con Fiber
+func (h *FiberTaskHandler) RestoreTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	task, err := h.taskService.RestoreTask(ctx, int(id))
+	if err != nil {
+		return c.Status(trashErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error restoring task",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task restored successfully",
+		"data":    task,
+	})
+}
+
+// PermanentlyDeleteTask elimina definitivamente una tarea de la papelera con Fiber
+func (h *FiberTaskHandler) PermanentlyDeleteTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	if err := h.taskService.PermanentlyDeleteTask(c.Context(), int(id)); err != nil {
+		return c.Status(trashErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error deleting task",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task permanently deleted",
+	})
+}
+
+// ArchiveTask archiva una tarea completada con Fiber
+func (h *FiberTaskHandler) ArchiveTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	task, err := h.taskService.ArchiveTask(ctx, int(id))
+	if err != nil {
+		status := archivedErrorStatus(err, fiber.StatusBadRequest)
+		if errors.Is(err, domain.ErrArchiveNotCompleted) {
+			status = fiber.StatusConflict
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"error":   "Error archiving task",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task archived successfully",
+		"data":    task,
+	})
+}
+
+// UnarchiveTask saca una tarea del archivo con Fiber
+func (h *FiberTaskHandler) UnarchiveTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	task, err := h.taskService.UnarchiveTask(ctx, int(id))
+	if err != nil {
+		status := fiber.StatusBadRequest
+		if errors.Is(err, domain.ErrTaskNotArchived) {
+			status = fiber.StatusNotFound
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"error":   "Error unarchiving task",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task unarchived successfully",
+		"data":    task,
+	})
+}
+
+// FiberArchiveCompletedTasksRequest representa la estructura de la petición para archivar en lote
+type FiberArchiveCompletedTasksRequest struct {
+	OlderThanDays *int `json:"older_than_days"`
+}
+
+// ArchiveCompletedTasks archiva en lote las tareas completadas hace más de N días con Fiber
+func (h *FiberTaskHandler) ArchiveCompletedTasks(c *fiber.Ctx) error {
+	var req FiberArchiveCompletedTasksRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	if req.OlderThanDays == nil || *req.OlderThanDays < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Validation failed",
+			"message": "older_than_days must be a non-negative integer",
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	archived, err := h.taskService.ArchiveCompletedTasks(ctx, *req.OlderThanDays)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Error archiving tasks",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":  "Tasks archived successfully",
+		"archived": archived,
+	})
+}
+
+// FiberBulkTasksRequest representa la estructura de la petición de operaciones en lote
+type FiberBulkTasksRequest struct {
+	Mode       string                 `json:"mode"`
+	Operations []BulkOperationRequest `json:"operations"`
+}
+
+// BulkTasks crea, actualiza, completa, reabre o elimina varias tareas en una petición con Fiber
+func (h *FiberTaskHandler) BulkTasks(c *fiber.Ctx) error {
+	var req FiberBulkTasksRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	ops, err := bulkOperations(req.Operations)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	mode := bulkMode(req.Mode)
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	results, err := h.taskService.BulkTasks(ctx, mode, ops)
+	if err != nil {
+		return c.Status(bulkRequestErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error applying bulk operations",
+			"message": err.Error(),
+		})
+	}
+
+	status, body := bulkResponse(mode, results)
+	return c.Status(status).JSON(body)
+}
+
+// respondTaskAsOf responde con la tarea tal como estaba en la fecha asOf con Fiber
+func (h *FiberTaskHandler) respondTaskAsOf(c *fiber.Ctx, id int, asOf string) error {
+	at, err := parseAsOf(asOf)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid as_of",
+			"message": err.Error(),
+		})
+	}
+
+	task, err := h.taskService.GetTaskAsOf(c.Context(), id, at)
+	if err != nil {
+		return c.Status(historyErrorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":   "Error getting task",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task retrieved successfully",
+		"data":    task,
+	})
+}
+
+// GetTaskHistory obtiene el historial de cambios de una tarea con Fiber
+func (h *FiberTaskHandler) GetTaskHistory(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	revisions, err := h.taskService.GetTaskHistory(c.Context(), int(id))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Error getting task history",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task history retrieved successfully",
+		"data":    revisions,
+		"count":   len(revisions),
+	})
+}
+
+// RevertTask restaura una tarea a una revisión anterior con Fiber
+func (h *FiberTaskHandler) RevertTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+	revisionID, err := strconv.ParseUint(c.Params("revisionId"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid revision ID",
+			"message": "Revision ID must be a positive integer",
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+	task, err := h.taskService.RevertTask(ctx, int(id), int(revisionID))
+	if err != nil {
+		status := historyErrorStatus(err, concurrencyErrorStatus(err, archivedErrorStatus(err, fiber.StatusBadRequest)))
+		if errors.Is(err, domain.ErrTaskBlocked) {
+			status = fiber.StatusConflict
+		}
+		return c.Status(status).JSON(fiber.Map{
+			"error":   "Error reverting task",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set("ETag", taskETag(task))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task reverted successfully",
+		"data":    task,
+	})
+}
+
+// FiberMoveTaskRequest representa la estructura de la petición para mover una tarea en el orden manual
+type FiberMoveTaskRequest struct {
+	Before int `json:"before"` // Ubicar la tarea antes de esta tarea
+	After  int `json:"after"`  // Ubicar la tarea después de esta tarea
+}
+
+// MoveTask cambia la posición de una tarea en el orden manual con Fiber
+func (h *FiberTaskHandler) MoveTask(c *fiber.Ctx) error {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	var req FiberMoveTaskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	if req.Before < 0 || req.After < 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": "before and after must be positive task IDs",
+		})
+	}
+
+	ctx, err := withIfMatch(withActor(c.Context(), c.Get(CurrentUserHeader)), c.Get("If-Match"))
+	if err != nil {
+		return c.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+			"error":   "Precondition failed",
+			"message": err.Error(),
+		})
+	}
+	task, err := h.taskService.MoveTask(ctx, int(id), req.Before, req.After)
+	if err != nil {
+		return c.Status(concurrencyErrorStatus(err, archivedErrorStatus(err, fiber.StatusBadRequest))).JSON(fiber.Map{
+			"error":   "Error moving task",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set("ETag", taskETag(task))
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Task moved successfully",
+		"data":    task,
 	})
 }
@@ -0,0 +1,33 @@
+package presentation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+// withActor registra en el contexto al usuario de la cabecera CurrentUserHeader para el historial
+func withActor(ctx context.Context, currentUser string) context.Context {
+	return application.WithActor(ctx, currentUser)
+}
+
+// parseAsOf interpreta el parámetro as_of (RFC 3339) de la consulta de una tarea
+func parseAsOf(value string) (time.Time, error) {
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.New("as_of must be an RFC 3339 timestamp")
+	}
+	return at, nil
+}
+
+// historyErrorStatus retorna 404 si no existe la revisión o la tarea no tenía revisiones en la fecha pedida
+func historyErrorStatus(err error, fallback int) int {
+	if errors.Is(err, domain.ErrRevisionNotFound) || errors.Is(err, domain.ErrNoRevisionAt) {
+		return http.StatusNotFound
+	}
+	return fallback
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stream.go
+//
+// Generated by this command:
+//
+//	mockgen -source=stream.go -destination=../presentation/mocks/mock_event_stream.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	application "github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventStream is a mock of EventStream interface.
+type MockEventStream struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventStreamMockRecorder
+	isgomock struct{}
+}
+
+// MockEventStreamMockRecorder is the mock recorder for MockEventStream.
+type MockEventStreamMockRecorder struct {
+	mock *MockEventStream
+}
+
+// NewMockEventStream creates a new mock instance.
+func NewMockEventStream(ctrl *gomock.Controller) *MockEventStream {
+	mock := &MockEventStream{ctrl: ctrl}
+	mock.recorder = &MockEventStreamMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventStream) EXPECT() *MockEventStreamMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockEventStream) Subscribe(lastID uint64) *application.EventSubscription {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", lastID)
+	ret0, _ := ret[0].(*application.EventSubscription)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventStreamMockRecorder) Subscribe(lastID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventStream)(nil).Subscribe), lastID)
+}
@@ -11,7 +11,9 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +43,80 @@ func (m *MockTaskServiceInterface) EXPECT() *MockTaskServiceInterfaceMockRecorde
 	return m.recorder
 }
 
+// AddDependency mocks base method.
+func (m *MockTaskServiceInterface) AddDependency(ctx context.Context, taskID, blockerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDependency", ctx, taskID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDependency indicates an expected call of AddDependency.
+func (mr *MockTaskServiceInterfaceMockRecorder) AddDependency(ctx, taskID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDependency", reflect.TypeOf((*MockTaskServiceInterface)(nil).AddDependency), ctx, taskID, blockerID)
+}
+
+// ArchiveCompletedTasks mocks base method.
+func (m *MockTaskServiceInterface) ArchiveCompletedTasks(ctx context.Context, days int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveCompletedTasks", ctx, days)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveCompletedTasks indicates an expected call of ArchiveCompletedTasks.
+func (mr *MockTaskServiceInterfaceMockRecorder) ArchiveCompletedTasks(ctx, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveCompletedTasks", reflect.TypeOf((*MockTaskServiceInterface)(nil).ArchiveCompletedTasks), ctx, days)
+}
+
+// ArchiveTask mocks base method.
+func (m *MockTaskServiceInterface) ArchiveTask(ctx context.Context, id int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTask", ctx, id)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTask indicates an expected call of ArchiveTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) ArchiveTask(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).ArchiveTask), ctx, id)
+}
+
+// AssignTask mocks base method.
+func (m *MockTaskServiceInterface) AssignTask(ctx context.Context, taskID int, userIDs []int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTask", ctx, taskID, userIDs)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignTask indicates an expected call of AssignTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) AssignTask(ctx, taskID, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).AssignTask), ctx, taskID, userIDs)
+}
+
+// BulkTasks mocks base method.
+func (m *MockTaskServiceInterface) BulkTasks(ctx context.Context, mode domain.BulkMode, ops []domain.BulkOperation) ([]*domain.BulkResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkTasks", ctx, mode, ops)
+	ret0, _ := ret[0].([]*domain.BulkResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkTasks indicates an expected call of BulkTasks.
+func (mr *MockTaskServiceInterfaceMockRecorder) BulkTasks(ctx, mode, ops any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkTasks", reflect.TypeOf((*MockTaskServiceInterface)(nil).BulkTasks), ctx, mode, ops)
+}
+
 // CreateTask mocks base method.
 func (m *MockTaskServiceInterface) CreateTask(ctx context.Context, title, description string) (*domain.Task, error) {
 	m.ctrl.T.Helper()
@@ -71,18 +147,48 @@ func (mr *MockTaskServiceInterfaceMockRecorder) DeleteTask(ctx, id any) *gomock.
 }
 
 // GetAllTasks mocks base method.
-func (m *MockTaskServiceInterface) GetAllTasks(ctx context.Context) ([]*domain.Task, error) {
+func (m *MockTaskServiceInterface) GetAllTasks(ctx context.Context, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllTasks", ctx)
+	ret := m.ctrl.Call(m, "GetAllTasks", ctx, opts)
 	ret0, _ := ret[0].([]*domain.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllTasks indicates an expected call of GetAllTasks.
-func (mr *MockTaskServiceInterfaceMockRecorder) GetAllTasks(ctx any) *gomock.Call {
+func (mr *MockTaskServiceInterfaceMockRecorder) GetAllTasks(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTasks", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetAllTasks), ctx, opts)
+}
+
+// GetTaskAsOf mocks base method.
+func (m *MockTaskServiceInterface) GetTaskAsOf(ctx context.Context, id int, at time.Time) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskAsOf", ctx, id, at)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskAsOf indicates an expected call of GetTaskAsOf.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTaskAsOf(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskAsOf", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTaskAsOf), ctx, id, at)
+}
+
+// GetTaskBlockers mocks base method.
+func (m *MockTaskServiceInterface) GetTaskBlockers(ctx context.Context, taskID int) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskBlockers", ctx, taskID)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskBlockers indicates an expected call of GetTaskBlockers.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTaskBlockers(ctx, taskID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTasks", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetAllTasks), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskBlockers", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTaskBlockers), ctx, taskID)
 }
 
 // GetTaskByID mocks base method.
@@ -100,19 +206,79 @@ func (mr *MockTaskServiceInterfaceMockRecorder) GetTaskByID(ctx, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskByID", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTaskByID), ctx, id)
 }
 
+// GetTaskHistory mocks base method.
+func (m *MockTaskServiceInterface) GetTaskHistory(ctx context.Context, id int) ([]*domain.TaskRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskHistory", ctx, id)
+	ret0, _ := ret[0].([]*domain.TaskRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskHistory indicates an expected call of GetTaskHistory.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTaskHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskHistory", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTaskHistory), ctx, id)
+}
+
+// GetTasksByAssignee mocks base method.
+func (m *MockTaskServiceInterface) GetTasksByAssignee(ctx context.Context, userID int) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasksByAssignee", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasksByAssignee indicates an expected call of GetTasksByAssignee.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTasksByAssignee(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByAssignee", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTasksByAssignee), ctx, userID)
+}
+
 // GetTasksByStatus mocks base method.
-func (m *MockTaskServiceInterface) GetTasksByStatus(ctx context.Context, completed bool) ([]*domain.Task, error) {
+func (m *MockTaskServiceInterface) GetTasksByStatus(ctx context.Context, completed bool, opts domain.TaskQueryOptions) ([]*domain.Task, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTasksByStatus", ctx, completed)
+	ret := m.ctrl.Call(m, "GetTasksByStatus", ctx, completed, opts)
 	ret0, _ := ret[0].([]*domain.Task)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTasksByStatus indicates an expected call of GetTasksByStatus.
-func (mr *MockTaskServiceInterfaceMockRecorder) GetTasksByStatus(ctx, completed any) *gomock.Call {
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTasksByStatus(ctx, completed, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByStatus", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTasksByStatus), ctx, completed, opts)
+}
+
+// GetTasksInDependencyOrder mocks base method.
+func (m *MockTaskServiceInterface) GetTasksInDependencyOrder(ctx context.Context) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTasksInDependencyOrder", ctx)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTasksInDependencyOrder indicates an expected call of GetTasksInDependencyOrder.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTasksInDependencyOrder(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksInDependencyOrder", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTasksInDependencyOrder), ctx)
+}
+
+// GetTrash mocks base method.
+func (m *MockTaskServiceInterface) GetTrash(ctx context.Context) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", ctx)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockTaskServiceInterfaceMockRecorder) GetTrash(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasksByStatus", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTasksByStatus), ctx, completed)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockTaskServiceInterface)(nil).GetTrash), ctx)
 }
 
 // MarkTaskAsCompleted mocks base method.
@@ -145,6 +311,183 @@ func (mr *MockTaskServiceInterfaceMockRecorder) MarkTaskAsUncompleted(ctx, id an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkTaskAsUncompleted", reflect.TypeOf((*MockTaskServiceInterface)(nil).MarkTaskAsUncompleted), ctx, id)
 }
 
+// MoveTask mocks base method.
+func (m *MockTaskServiceInterface) MoveTask(ctx context.Context, id, beforeID, afterID int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTask", ctx, id, beforeID, afterID)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTask indicates an expected call of MoveTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) MoveTask(ctx, id, beforeID, afterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).MoveTask), ctx, id, beforeID, afterID)
+}
+
+// PatchTask mocks base method.
+func (m *MockTaskServiceInterface) PatchTask(ctx context.Context, id int, patch domain.TaskPatch) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchTask", ctx, id, patch)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchTask indicates an expected call of PatchTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) PatchTask(ctx, id, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).PatchTask), ctx, id, patch)
+}
+
+// PermanentlyDeleteTask mocks base method.
+func (m *MockTaskServiceInterface) PermanentlyDeleteTask(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PermanentlyDeleteTask", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PermanentlyDeleteTask indicates an expected call of PermanentlyDeleteTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) PermanentlyDeleteTask(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PermanentlyDeleteTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).PermanentlyDeleteTask), ctx, id)
+}
+
+// RemoveDependency mocks base method.
+func (m *MockTaskServiceInterface) RemoveDependency(ctx context.Context, taskID, blockerID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDependency", ctx, taskID, blockerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDependency indicates an expected call of RemoveDependency.
+func (mr *MockTaskServiceInterfaceMockRecorder) RemoveDependency(ctx, taskID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDependency", reflect.TypeOf((*MockTaskServiceInterface)(nil).RemoveDependency), ctx, taskID, blockerID)
+}
+
+// RestoreTask mocks base method.
+func (m *MockTaskServiceInterface) RestoreTask(ctx context.Context, id int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTask", ctx, id)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreTask indicates an expected call of RestoreTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) RestoreTask(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).RestoreTask), ctx, id)
+}
+
+// RevertTask mocks base method.
+func (m *MockTaskServiceInterface) RevertTask(ctx context.Context, id, revisionID int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertTask", ctx, id, revisionID)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertTask indicates an expected call of RevertTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) RevertTask(ctx, id, revisionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).RevertTask), ctx, id, revisionID)
+}
+
+// SetTaskCustomFields mocks base method.
+func (m *MockTaskServiceInterface) SetTaskCustomFields(ctx context.Context, id int, values map[string]any) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskCustomFields", ctx, id, values)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTaskCustomFields indicates an expected call of SetTaskCustomFields.
+func (mr *MockTaskServiceInterfaceMockRecorder) SetTaskCustomFields(ctx, id, values any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskCustomFields", reflect.TypeOf((*MockTaskServiceInterface)(nil).SetTaskCustomFields), ctx, id, values)
+}
+
+// SetTaskEstimate mocks base method.
+func (m *MockTaskServiceInterface) SetTaskEstimate(ctx context.Context, id, storyPoints int, estimatedHours float64) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskEstimate", ctx, id, storyPoints, estimatedHours)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTaskEstimate indicates an expected call of SetTaskEstimate.
+func (mr *MockTaskServiceInterfaceMockRecorder) SetTaskEstimate(ctx, id, storyPoints, estimatedHours any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskEstimate", reflect.TypeOf((*MockTaskServiceInterface)(nil).SetTaskEstimate), ctx, id, storyPoints, estimatedHours)
+}
+
+// SetTaskSchedule mocks base method.
+func (m *MockTaskServiceInterface) SetTaskSchedule(ctx context.Context, id int, dueDate *time.Time, recurrence string) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTaskSchedule", ctx, id, dueDate, recurrence)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTaskSchedule indicates an expected call of SetTaskSchedule.
+func (mr *MockTaskServiceInterfaceMockRecorder) SetTaskSchedule(ctx, id, dueDate, recurrence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTaskSchedule", reflect.TypeOf((*MockTaskServiceInterface)(nil).SetTaskSchedule), ctx, id, dueDate, recurrence)
+}
+
+// UnarchiveTask mocks base method.
+func (m *MockTaskServiceInterface) UnarchiveTask(ctx context.Context, id int) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnarchiveTask", ctx, id)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnarchiveTask indicates an expected call of UnarchiveTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) UnarchiveTask(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnarchiveTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).UnarchiveTask), ctx, id)
+}
+
+// UnassignTask mocks base method.
+func (m *MockTaskServiceInterface) UnassignTask(ctx context.Context, taskID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignTask", ctx, taskID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignTask indicates an expected call of UnassignTask.
+func (mr *MockTaskServiceInterfaceMockRecorder) UnassignTask(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).UnassignTask), ctx, taskID, userID)
+}
+
+// UpdateRemainingWork mocks base method.
+func (m *MockTaskServiceInterface) UpdateRemainingWork(ctx context.Context, id int, remainingHours float64) (*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemainingWork", ctx, id, remainingHours)
+	ret0, _ := ret[0].(*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRemainingWork indicates an expected call of UpdateRemainingWork.
+func (mr *MockTaskServiceInterfaceMockRecorder) UpdateRemainingWork(ctx, id, remainingHours any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemainingWork", reflect.TypeOf((*MockTaskServiceInterface)(nil).UpdateRemainingWork), ctx, id, remainingHours)
+}
+
 // UpdateTask mocks base method.
 func (m *MockTaskServiceInterface) UpdateTask(ctx context.Context, id int, title, description string, completed *bool) (*domain.Task, error) {
 	m.ctrl.T.Helper()
@@ -159,3 +502,619 @@ func (mr *MockTaskServiceInterfaceMockRecorder) UpdateTask(ctx, id, title, descr
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTask", reflect.TypeOf((*MockTaskServiceInterface)(nil).UpdateTask), ctx, id, title, description, completed)
 }
+
+// MockCommentServiceInterface is a mock of CommentServiceInterface interface.
+type MockCommentServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentServiceInterfaceMockRecorder is the mock recorder for MockCommentServiceInterface.
+type MockCommentServiceInterfaceMockRecorder struct {
+	mock *MockCommentServiceInterface
+}
+
+// NewMockCommentServiceInterface creates a new mock instance.
+func NewMockCommentServiceInterface(ctrl *gomock.Controller) *MockCommentServiceInterface {
+	mock := &MockCommentServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCommentServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentServiceInterface) EXPECT() *MockCommentServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AddComment mocks base method.
+func (m *MockCommentServiceInterface) AddComment(ctx context.Context, taskID int, author, body string) (*domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", ctx, taskID, author, body)
+	ret0, _ := ret[0].(*domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockCommentServiceInterfaceMockRecorder) AddComment(ctx, taskID, author, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockCommentServiceInterface)(nil).AddComment), ctx, taskID, author, body)
+}
+
+// DeleteComment mocks base method.
+func (m *MockCommentServiceInterface) DeleteComment(ctx context.Context, taskID, commentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, taskID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommentServiceInterfaceMockRecorder) DeleteComment(ctx, taskID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentServiceInterface)(nil).DeleteComment), ctx, taskID, commentID)
+}
+
+// EditComment mocks base method.
+func (m *MockCommentServiceInterface) EditComment(ctx context.Context, taskID, commentID int, body string) (*domain.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditComment", ctx, taskID, commentID, body)
+	ret0, _ := ret[0].(*domain.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditComment indicates an expected call of EditComment.
+func (mr *MockCommentServiceInterfaceMockRecorder) EditComment(ctx, taskID, commentID, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditComment", reflect.TypeOf((*MockCommentServiceInterface)(nil).EditComment), ctx, taskID, commentID, body)
+}
+
+// GetCommentHistory mocks base method.
+func (m *MockCommentServiceInterface) GetCommentHistory(ctx context.Context, taskID, commentID int) ([]*domain.CommentRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentHistory", ctx, taskID, commentID)
+	ret0, _ := ret[0].([]*domain.CommentRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentHistory indicates an expected call of GetCommentHistory.
+func (mr *MockCommentServiceInterfaceMockRecorder) GetCommentHistory(ctx, taskID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentHistory", reflect.TypeOf((*MockCommentServiceInterface)(nil).GetCommentHistory), ctx, taskID, commentID)
+}
+
+// ListComments mocks base method.
+func (m *MockCommentServiceInterface) ListComments(ctx context.Context, taskID, limit, offset int) ([]*domain.Comment, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, taskID, limit, offset)
+	ret0, _ := ret[0].([]*domain.Comment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockCommentServiceInterfaceMockRecorder) ListComments(ctx, taskID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockCommentServiceInterface)(nil).ListComments), ctx, taskID, limit, offset)
+}
+
+// MockAttachmentServiceInterface is a mock of AttachmentServiceInterface interface.
+type MockAttachmentServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentServiceInterfaceMockRecorder is the mock recorder for MockAttachmentServiceInterface.
+type MockAttachmentServiceInterfaceMockRecorder struct {
+	mock *MockAttachmentServiceInterface
+}
+
+// NewMockAttachmentServiceInterface creates a new mock instance.
+func NewMockAttachmentServiceInterface(ctrl *gomock.Controller) *MockAttachmentServiceInterface {
+	mock := &MockAttachmentServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockAttachmentServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentServiceInterface) EXPECT() *MockAttachmentServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAttachmentServiceInterface) DeleteAttachment(ctx context.Context, taskID, attachmentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, taskID, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) DeleteAttachment(ctx, taskID, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).DeleteAttachment), ctx, taskID, attachmentID)
+}
+
+// ListAttachments mocks base method.
+func (m *MockAttachmentServiceInterface) ListAttachments(ctx context.Context, taskID int) ([]*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, taskID)
+	ret0, _ := ret[0].([]*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) ListAttachments(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).ListAttachments), ctx, taskID)
+}
+
+// OpenAttachment mocks base method.
+func (m *MockAttachmentServiceInterface) OpenAttachment(ctx context.Context, taskID, attachmentID int) (*domain.Attachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAttachment", ctx, taskID, attachmentID)
+	ret0, _ := ret[0].(*domain.Attachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenAttachment indicates an expected call of OpenAttachment.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) OpenAttachment(ctx, taskID, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAttachment", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).OpenAttachment), ctx, taskID, attachmentID)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockAttachmentServiceInterface) UploadAttachment(ctx context.Context, taskID int, fileName string, content io.Reader) (*domain.Attachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, taskID, fileName, content)
+	ret0, _ := ret[0].(*domain.Attachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockAttachmentServiceInterfaceMockRecorder) UploadAttachment(ctx, taskID, fileName, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockAttachmentServiceInterface)(nil).UploadAttachment), ctx, taskID, fileName, content)
+}
+
+// MockBoardServiceInterface is a mock of BoardServiceInterface interface.
+type MockBoardServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockBoardServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockBoardServiceInterfaceMockRecorder is the mock recorder for MockBoardServiceInterface.
+type MockBoardServiceInterfaceMockRecorder struct {
+	mock *MockBoardServiceInterface
+}
+
+// NewMockBoardServiceInterface creates a new mock instance.
+func NewMockBoardServiceInterface(ctrl *gomock.Controller) *MockBoardServiceInterface {
+	mock := &MockBoardServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockBoardServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBoardServiceInterface) EXPECT() *MockBoardServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateBoard mocks base method.
+func (m *MockBoardServiceInterface) CreateBoard(ctx context.Context, name string, swimlaneBy domain.SwimlaneMode, columns []domain.BoardColumn) (*domain.Board, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBoard", ctx, name, swimlaneBy, columns)
+	ret0, _ := ret[0].(*domain.Board)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBoard indicates an expected call of CreateBoard.
+func (mr *MockBoardServiceInterfaceMockRecorder) CreateBoard(ctx, name, swimlaneBy, columns any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBoard", reflect.TypeOf((*MockBoardServiceInterface)(nil).CreateBoard), ctx, name, swimlaneBy, columns)
+}
+
+// GetBoard mocks base method.
+func (m *MockBoardServiceInterface) GetBoard(ctx context.Context, id int) (*domain.BoardSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoard", ctx, id)
+	ret0, _ := ret[0].(*domain.BoardSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoard indicates an expected call of GetBoard.
+func (mr *MockBoardServiceInterfaceMockRecorder) GetBoard(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoard", reflect.TypeOf((*MockBoardServiceInterface)(nil).GetBoard), ctx, id)
+}
+
+// MoveCard mocks base method.
+func (m *MockBoardServiceInterface) MoveCard(ctx context.Context, boardID, taskID, columnID int) (*domain.BoardCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCard", ctx, boardID, taskID, columnID)
+	ret0, _ := ret[0].(*domain.BoardCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveCard indicates an expected call of MoveCard.
+func (mr *MockBoardServiceInterfaceMockRecorder) MoveCard(ctx, boardID, taskID, columnID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCard", reflect.TypeOf((*MockBoardServiceInterface)(nil).MoveCard), ctx, boardID, taskID, columnID)
+}
+
+// RemoveCard mocks base method.
+func (m *MockBoardServiceInterface) RemoveCard(ctx context.Context, boardID, taskID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCard", ctx, boardID, taskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCard indicates an expected call of RemoveCard.
+func (mr *MockBoardServiceInterfaceMockRecorder) RemoveCard(ctx, boardID, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCard", reflect.TypeOf((*MockBoardServiceInterface)(nil).RemoveCard), ctx, boardID, taskID)
+}
+
+// MockTimeTrackingServiceInterface is a mock of TimeTrackingServiceInterface interface.
+type MockTimeTrackingServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeTrackingServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTimeTrackingServiceInterfaceMockRecorder is the mock recorder for MockTimeTrackingServiceInterface.
+type MockTimeTrackingServiceInterfaceMockRecorder struct {
+	mock *MockTimeTrackingServiceInterface
+}
+
+// NewMockTimeTrackingServiceInterface creates a new mock instance.
+func NewMockTimeTrackingServiceInterface(ctrl *gomock.Controller) *MockTimeTrackingServiceInterface {
+	mock := &MockTimeTrackingServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTimeTrackingServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeTrackingServiceInterface) EXPECT() *MockTimeTrackingServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetBoardTime mocks base method.
+func (m *MockTimeTrackingServiceInterface) GetBoardTime(ctx context.Context, boardID int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBoardTime", ctx, boardID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBoardTime indicates an expected call of GetBoardTime.
+func (mr *MockTimeTrackingServiceInterfaceMockRecorder) GetBoardTime(ctx, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBoardTime", reflect.TypeOf((*MockTimeTrackingServiceInterface)(nil).GetBoardTime), ctx, boardID)
+}
+
+// GetProjectTime mocks base method.
+func (m *MockTimeTrackingServiceInterface) GetProjectTime(ctx context.Context, project string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectTime", ctx, project)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectTime indicates an expected call of GetProjectTime.
+func (mr *MockTimeTrackingServiceInterfaceMockRecorder) GetProjectTime(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTime", reflect.TypeOf((*MockTimeTrackingServiceInterface)(nil).GetProjectTime), ctx, project)
+}
+
+// GetTimeReport mocks base method.
+func (m *MockTimeTrackingServiceInterface) GetTimeReport(ctx context.Context, from, to time.Time, groupBy domain.TimeReportGroup) (*domain.TimeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeReport", ctx, from, to, groupBy)
+	ret0, _ := ret[0].(*domain.TimeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeReport indicates an expected call of GetTimeReport.
+func (mr *MockTimeTrackingServiceInterfaceMockRecorder) GetTimeReport(ctx, from, to, groupBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeReport", reflect.TypeOf((*MockTimeTrackingServiceInterface)(nil).GetTimeReport), ctx, from, to, groupBy)
+}
+
+// ListTimeEntries mocks base method.
+func (m *MockTimeTrackingServiceInterface) ListTimeEntries(ctx context.Context, taskID int) ([]*domain.TimeEntry, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTimeEntries", ctx, taskID)
+	ret0, _ := ret[0].([]*domain.TimeEntry)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTimeEntries indicates an expected call of ListTimeEntries.
+func (mr *MockTimeTrackingServiceInterfaceMockRecorder) ListTimeEntries(ctx, taskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTimeEntries", reflect.TypeOf((*MockTimeTrackingServiceInterface)(nil).ListTimeEntries), ctx, taskID)
+}
+
+// LogTime mocks base method.
+func (m *MockTimeTrackingServiceInterface) LogTime(ctx context.Context, taskID, userID int, startedAt *time.Time, duration time.Duration, note string) (*domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogTime", ctx, taskID, userID, startedAt, duration, note)
+	ret0, _ := ret[0].(*domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogTime indicates an expected call of LogTime.
+func (mr *MockTimeTrackingServiceInterfaceMockRecorder) LogTime(ctx, taskID, userID, startedAt, duration, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogTime", reflect.TypeOf((*MockTimeTrackingServiceInterface)(nil).LogTime), ctx, taskID, userID, startedAt, duration, note)
+}
+
+// StartTimer mocks base method.
+func (m *MockTimeTrackingServiceInterface) StartTimer(ctx context.Context, taskID, userID int) (*domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTimer", ctx, taskID, userID)
+	ret0, _ := ret[0].(*domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTimer indicates an expected call of StartTimer.
+func (mr *MockTimeTrackingServiceInterfaceMockRecorder) StartTimer(ctx, taskID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTimer", reflect.TypeOf((*MockTimeTrackingServiceInterface)(nil).StartTimer), ctx, taskID, userID)
+}
+
+// StopTimer mocks base method.
+func (m *MockTimeTrackingServiceInterface) StopTimer(ctx context.Context, userID int) (*domain.TimeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopTimer", ctx, userID)
+	ret0, _ := ret[0].(*domain.TimeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopTimer indicates an expected call of StopTimer.
+func (mr *MockTimeTrackingServiceInterfaceMockRecorder) StopTimer(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopTimer", reflect.TypeOf((*MockTimeTrackingServiceInterface)(nil).StopTimer), ctx, userID)
+}
+
+// MockEstimateReportServiceInterface is a mock of EstimateReportServiceInterface interface.
+type MockEstimateReportServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEstimateReportServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEstimateReportServiceInterfaceMockRecorder is the mock recorder for MockEstimateReportServiceInterface.
+type MockEstimateReportServiceInterfaceMockRecorder struct {
+	mock *MockEstimateReportServiceInterface
+}
+
+// NewMockEstimateReportServiceInterface creates a new mock instance.
+func NewMockEstimateReportServiceInterface(ctrl *gomock.Controller) *MockEstimateReportServiceInterface {
+	mock := &MockEstimateReportServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockEstimateReportServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEstimateReportServiceInterface) EXPECT() *MockEstimateReportServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// GetBurndown mocks base method.
+func (m *MockEstimateReportServiceInterface) GetBurndown(ctx context.Context, from, to time.Time, boardID int) (*domain.Burndown, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBurndown", ctx, from, to, boardID)
+	ret0, _ := ret[0].(*domain.Burndown)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBurndown indicates an expected call of GetBurndown.
+func (mr *MockEstimateReportServiceInterfaceMockRecorder) GetBurndown(ctx, from, to, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBurndown", reflect.TypeOf((*MockEstimateReportServiceInterface)(nil).GetBurndown), ctx, from, to, boardID)
+}
+
+// GetVelocity mocks base method.
+func (m *MockEstimateReportServiceInterface) GetVelocity(ctx context.Context, from, to time.Time, boardID int) (*domain.Velocity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVelocity", ctx, from, to, boardID)
+	ret0, _ := ret[0].(*domain.Velocity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVelocity indicates an expected call of GetVelocity.
+func (mr *MockEstimateReportServiceInterfaceMockRecorder) GetVelocity(ctx, from, to, boardID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVelocity", reflect.TypeOf((*MockEstimateReportServiceInterface)(nil).GetVelocity), ctx, from, to, boardID)
+}
+
+// MockCustomFieldServiceInterface is a mock of CustomFieldServiceInterface interface.
+type MockCustomFieldServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomFieldServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCustomFieldServiceInterfaceMockRecorder is the mock recorder for MockCustomFieldServiceInterface.
+type MockCustomFieldServiceInterfaceMockRecorder struct {
+	mock *MockCustomFieldServiceInterface
+}
+
+// NewMockCustomFieldServiceInterface creates a new mock instance.
+func NewMockCustomFieldServiceInterface(ctrl *gomock.Controller) *MockCustomFieldServiceInterface {
+	mock := &MockCustomFieldServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockCustomFieldServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomFieldServiceInterface) EXPECT() *MockCustomFieldServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateField mocks base method.
+func (m *MockCustomFieldServiceInterface) CreateField(ctx context.Context, key, name string, fieldType domain.CustomFieldType, options []string) (*domain.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateField", ctx, key, name, fieldType, options)
+	ret0, _ := ret[0].(*domain.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateField indicates an expected call of CreateField.
+func (mr *MockCustomFieldServiceInterfaceMockRecorder) CreateField(ctx, key, name, fieldType, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateField", reflect.TypeOf((*MockCustomFieldServiceInterface)(nil).CreateField), ctx, key, name, fieldType, options)
+}
+
+// DeleteField mocks base method.
+func (m *MockCustomFieldServiceInterface) DeleteField(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteField", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteField indicates an expected call of DeleteField.
+func (mr *MockCustomFieldServiceInterfaceMockRecorder) DeleteField(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteField", reflect.TypeOf((*MockCustomFieldServiceInterface)(nil).DeleteField), ctx, key)
+}
+
+// ListFields mocks base method.
+func (m *MockCustomFieldServiceInterface) ListFields(ctx context.Context) ([]*domain.CustomFieldDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFields", ctx)
+	ret0, _ := ret[0].([]*domain.CustomFieldDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFields indicates an expected call of ListFields.
+func (mr *MockCustomFieldServiceInterfaceMockRecorder) ListFields(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFields", reflect.TypeOf((*MockCustomFieldServiceInterface)(nil).ListFields), ctx)
+}
+
+// MockTemplateServiceInterface is a mock of TemplateServiceInterface interface.
+type MockTemplateServiceInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateServiceInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockTemplateServiceInterfaceMockRecorder is the mock recorder for MockTemplateServiceInterface.
+type MockTemplateServiceInterfaceMockRecorder struct {
+	mock *MockTemplateServiceInterface
+}
+
+// NewMockTemplateServiceInterface creates a new mock instance.
+func NewMockTemplateServiceInterface(ctrl *gomock.Controller) *MockTemplateServiceInterface {
+	mock := &MockTemplateServiceInterface{ctrl: ctrl}
+	mock.recorder = &MockTemplateServiceInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTemplateServiceInterface) EXPECT() *MockTemplateServiceInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateTemplate mocks base method.
+func (m *MockTemplateServiceInterface) CreateTemplate(ctx context.Context, name, description string, tasks []domain.TemplateTask) (*domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, name, description, tasks)
+	ret0, _ := ret[0].(*domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockTemplateServiceInterfaceMockRecorder) CreateTemplate(ctx, name, description, tasks any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockTemplateServiceInterface)(nil).CreateTemplate), ctx, name, description, tasks)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockTemplateServiceInterface) DeleteTemplate(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockTemplateServiceInterfaceMockRecorder) DeleteTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockTemplateServiceInterface)(nil).DeleteTemplate), ctx, id)
+}
+
+// GetTemplate mocks base method.
+func (m *MockTemplateServiceInterface) GetTemplate(ctx context.Context, id int) (*domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, id)
+	ret0, _ := ret[0].(*domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockTemplateServiceInterfaceMockRecorder) GetTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockTemplateServiceInterface)(nil).GetTemplate), ctx, id)
+}
+
+// InstantiateTemplate mocks base method.
+func (m *MockTemplateServiceInterface) InstantiateTemplate(ctx context.Context, id int, variables map[string]string, start time.Time) ([]*domain.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstantiateTemplate", ctx, id, variables, start)
+	ret0, _ := ret[0].([]*domain.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstantiateTemplate indicates an expected call of InstantiateTemplate.
+func (mr *MockTemplateServiceInterfaceMockRecorder) InstantiateTemplate(ctx, id, variables, start any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstantiateTemplate", reflect.TypeOf((*MockTemplateServiceInterface)(nil).InstantiateTemplate), ctx, id, variables, start)
+}
+
+// ListTemplates mocks base method.
+func (m *MockTemplateServiceInterface) ListTemplates(ctx context.Context) ([]*domain.TaskTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx)
+	ret0, _ := ret[0].([]*domain.TaskTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockTemplateServiceInterfaceMockRecorder) ListTemplates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockTemplateServiceInterface)(nil).ListTemplates), ctx)
+}
@@ -0,0 +1,455 @@
+package presentation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+const (
+	// MergePatchContentType es el tipo de contenido de JSON Merge Patch (RFC 7396)
+	MergePatchContentType = "application/merge-patch+json"
+	// JSONPatchContentType es el tipo de contenido de JSON Patch (RFC 6902)
+	JSONPatchContentType = "application/json-patch+json"
+)
+
+// errUnsupportedPatch indica un tipo de contenido que no es un formato de parche soportado
+var errUnsupportedPatch = errors.New("PATCH requires Content-Type application/merge-patch+json or application/json-patch+json")
+
+// patchableFields son los campos del documento de la tarea que acepta un PATCH
+var patchableFields = []string{"title", "description", "completed", "due_date", "recurrence",
+	"story_points", "estimated_hours", "remaining_hours"}
+
+// patchOperation es una operación de JSON Patch
+type patchOperation struct {
+	Op    string           `json:"op"`
+	Path  string           `json:"path"`
+	From  string           `json:"from"`
+	Value *json.RawMessage `json:"value"`
+}
+
+// patchErrorStatus traduce los errores de PATCH a códigos HTTP
+func patchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errUnsupportedPatch):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, domain.ErrPatchTestFailed):
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
+
+// taskPatchErrorStatus traduce los errores al guardar un parche o un reemplazo de la tarea
+func taskPatchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrTaskBlocked):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidPatch), errors.Is(err, domain.ErrInvalidRecurrence), errors.Is(err, domain.ErrInvalidEstimate):
+		return http.StatusBadRequest
+	}
+	return concurrencyErrorStatus(err, archivedErrorStatus(err, http.StatusBadRequest))
+}
+
+// taskDocument construye la representación editable de la tarea sobre la que se aplican los parches
+func taskDocument(task *domain.Task) map[string]any {
+	var dueDate any
+	if task.DueDate != nil {
+		dueDate = task.DueDate.UTC().Format(time.RFC3339)
+	}
+	return map[string]any{
+		"title":           task.Title,
+		"description":     task.Description,
+		"completed":       task.Completed,
+		"due_date":        dueDate,
+		"recurrence":      task.Recurrence,
+		"story_points":    float64(task.StoryPoints),
+		"estimated_hours": task.EstimatedHours,
+		"remaining_hours": task.RemainingHours,
+	}
+}
+
+// buildTaskPatch aplica el cuerpo de un PATCH al documento de la tarea según su tipo de contenido
+// y retorna los campos que cambiaron
+func buildTaskPatch(task *domain.Task, contentType string, body []byte) (domain.TaskPatch, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return domain.TaskPatch{}, errUnsupportedPatch
+	}
+
+	original := taskDocument(task)
+	var patched any
+	switch mediaType {
+	case MergePatchContentType:
+		var patch any
+		if err := decodePatchJSON(body, &patch); err != nil {
+			return domain.TaskPatch{}, err
+		}
+		patched = mergePatch(taskDocument(task), patch)
+	case JSONPatchContentType:
+		var ops []patchOperation
+		if err := decodePatchJSON(body, &ops); err != nil {
+			return domain.TaskPatch{}, err
+		}
+		if patched, err = applyJSONPatch(taskDocument(task), ops); err != nil {
+			return domain.TaskPatch{}, err
+		}
+	default:
+		return domain.TaskPatch{}, errUnsupportedPatch
+	}
+
+	document, ok := patched.(map[string]any)
+	if !ok {
+		return domain.TaskPatch{}, fmt.Errorf("%w: la tarea modificada debe ser un objeto JSON", domain.ErrInvalidPatch)
+	}
+	return diffTaskDocument(original, document)
+}
+
+// decodePatchJSON decodifica el cuerpo conservando los números como float64
+func decodePatchJSON(body []byte, target any) error {
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidPatch, err)
+	}
+	return nil
+}
+
+// mergePatch aplica un JSON Merge Patch (RFC 7396) a target
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
+
+// applyJSONPatch aplica las operaciones de JSON Patch (RFC 6902) en orden; si una falla no se aplica ninguna
+func applyJSONPatch(document any, ops []patchOperation) (any, error) {
+	for i, op := range ops {
+		var value any
+		if op.Value != nil {
+			if err := json.Unmarshal(*op.Value, &value); err != nil {
+				return nil, fmt.Errorf("%w: operación %d: %v", domain.ErrInvalidPatch, i, err)
+			}
+		}
+		path, err := parsePointer(op.Path)
+		if err != nil {
+			return nil, fmt.Errorf("%w: operación %d: %v", domain.ErrInvalidPatch, i, err)
+		}
+
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return nil, fmt.Errorf("%w: operación %d: %s requiere value", domain.ErrInvalidPatch, i, op.Op)
+			}
+		}
+
+		switch op.Op {
+		case "add":
+			document, err = pointerAdd(document, path, value)
+		case "remove":
+			document, _, err = pointerRemove(document, path)
+		case "replace":
+			if document, _, err = pointerRemove(document, path); err == nil {
+				document, err = pointerAdd(document, path, value)
+			}
+		case "move", "copy":
+			var from []string
+			if from, err = parsePointer(op.From); err != nil {
+				break
+			}
+			if op.Op == "move" && len(from) < len(path) && slices.Equal(from, path[:len(from)]) {
+				err = errors.New("no se puede mover un valor dentro de uno de sus hijos")
+				break
+			}
+			var moved any
+			if op.Op == "move" {
+				document, moved, err = pointerRemove(document, from)
+			} else {
+				moved, err = pointerGet(document, from)
+				moved = cloneJSON(moved)
+			}
+			if err == nil {
+				document, err = pointerAdd(document, path, moved)
+			}
+		case "test":
+			var current any
+			if current, err = pointerGet(document, path); err == nil && !reflect.DeepEqual(current, value) {
+				return nil, fmt.Errorf("%w: operación %d en %s", domain.ErrPatchTestFailed, i, op.Path)
+			}
+		default:
+			err = fmt.Errorf("op desconocida %q", op.Op)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: operación %d: %v", domain.ErrInvalidPatch, i, err)
+		}
+	}
+	return document, nil
+}
+
+// parsePointer divide un JSON Pointer (RFC 6901) en sus tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("puntero JSON inválido %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// pointerGet obtiene el valor en path
+func pointerGet(document any, path []string) (any, error) {
+	current := document
+	for _, token := range path {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("la ruta /%s no existe", strings.Join(path, "/"))
+			}
+			current = value
+		case []any:
+			index, err := arrayIndex(token, len(node)-1)
+			if err != nil {
+				return nil, err
+			}
+			current = node[index]
+		default:
+			return nil, fmt.Errorf("la ruta /%s no existe", strings.Join(path, "/"))
+		}
+	}
+	return current, nil
+}
+
+// pointerAdd agrega o reemplaza el valor en path y retorna el documento resultante
+func pointerAdd(document any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	parent, err := pointerGet(document, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := path[len(path)-1]
+	switch node := parent.(type) {
+	case map[string]any:
+		node[last] = value
+		return document, nil
+	case []any:
+		index := len(node)
+		if last != "-" {
+			if index, err = arrayIndex(last, len(node)); err != nil {
+				return nil, err
+			}
+		}
+		return pointerAdd(document, path[:len(path)-1], slices.Insert(node, index, value))
+	}
+	return nil, fmt.Errorf("la ruta /%s no existe", strings.Join(path, "/"))
+}
+
+// pointerRemove quita el valor en path y retorna el documento resultante y el valor quitado
+func pointerRemove(document any, path []string) (any, any, error) {
+	if len(path) == 0 {
+		return nil, nil, errors.New("no se puede eliminar el documento completo")
+	}
+	removed, err := pointerGet(document, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	parent, _ := pointerGet(document, path[:len(path)-1])
+	last := path[len(path)-1]
+	switch node := parent.(type) {
+	case map[string]any:
+		delete(node, last)
+		return document, removed, nil
+	case []any:
+		index, _ := arrayIndex(last, len(node)-1)
+		document, err = pointerAdd(document, path[:len(path)-1], slices.Delete(node, index, index+1))
+		return document, removed, err
+	}
+	return nil, nil, fmt.Errorf("la ruta /%s no existe", strings.Join(path, "/"))
+}
+
+// arrayIndex interpreta un índice de arreglo entre 0 y maxIndex
+func arrayIndex(token string, maxIndex int) (int, error) {
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index > maxIndex || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("índice de arreglo inválido %q", token)
+	}
+	return index, nil
+}
+
+// cloneJSON copia un valor JSON para que copy no comparta mapas ni arreglos con el origen
+func cloneJSON(value any) any {
+	data, _ := json.Marshal(value)
+	var clone any
+	_ = json.Unmarshal(data, &clone)
+	return clone
+}
+
+// diffTaskDocument compara el documento original con el parcheado y construye el parche del dominio.
+// Un campo quitado o en null vuelve a su valor vacío; title, description y completed no se pueden quitar.
+func diffTaskDocument(original, patched map[string]any) (domain.TaskPatch, error) {
+	var patch domain.TaskPatch
+	for key := range patched {
+		if !slices.Contains(patchableFields, key) {
+			return patch, fmt.Errorf("%w: el campo %q no se puede modificar", domain.ErrInvalidPatch, key)
+		}
+	}
+
+	for _, key := range patchableFields {
+		value, present := patched[key]
+		if present && reflect.DeepEqual(value, original[key]) {
+			continue
+		}
+		if !present && original[key] == nil {
+			continue
+		}
+		if err := setPatchField(&patch, key, value); err != nil {
+			return patch, err
+		}
+	}
+	return patch, nil
+}
+
+// fieldsPatch construye el parche del dominio a partir de un objeto de merge patch sin leer la tarea:
+// cada campo presente cambia y null vacía los opcionales
+func fieldsPatch(fields map[string]any) (domain.TaskPatch, error) {
+	var patch domain.TaskPatch
+	for key := range fields {
+		if !slices.Contains(patchableFields, key) {
+			return patch, fmt.Errorf("%w: el campo %q no se puede modificar", domain.ErrInvalidPatch, key)
+		}
+	}
+	for _, key := range patchableFields {
+		if value, present := fields[key]; present {
+			if err := setPatchField(&patch, key, value); err != nil {
+				return patch, err
+			}
+		}
+	}
+	return patch, nil
+}
+
+// setPatchField traduce el valor JSON de un campo editable al parche del dominio; null vacía
+// los campos opcionales
+func setPatchField(patch *domain.TaskPatch, key string, value any) error {
+	invalid := func(expected string) error {
+		return fmt.Errorf("%w: %s debe ser %s", domain.ErrInvalidPatch, key, expected)
+	}
+
+	switch key {
+	case "title", "description":
+		text, ok := value.(string)
+		if !ok {
+			return invalid("un texto no vacío")
+		}
+		if key == "title" {
+			patch.Title = &text
+		} else {
+			patch.Description = &text
+		}
+	case "completed":
+		completed, ok := value.(bool)
+		if !ok {
+			return invalid("un booleano")
+		}
+		patch.Completed = &completed
+	case "due_date":
+		if value == nil {
+			patch.ClearDueDate = true
+			return nil
+		}
+		text, _ := value.(string)
+		due, err := time.Parse(time.RFC3339, text)
+		if err != nil {
+			return invalid("una fecha RFC 3339 o null")
+		}
+		patch.DueDate = &due
+	case "recurrence":
+		text, ok := value.(string)
+		if value != nil && !ok {
+			return invalid("un texto o null")
+		}
+		patch.Recurrence = &text
+	case "story_points":
+		number, ok := value.(float64)
+		if value != nil && (!ok || number != float64(int(number))) {
+			return invalid("un entero o null")
+		}
+		points := int(number)
+		patch.StoryPoints = &points
+	case "estimated_hours", "remaining_hours":
+		number, ok := value.(float64)
+		if value != nil && !ok {
+			return invalid("un número o null")
+		}
+		if key == "estimated_hours" {
+			patch.EstimatedHours = &number
+		} else {
+			patch.RemainingHours = &number
+		}
+	}
+	return nil
+}
+
+// replacementPatch construye el parche de un PUT: se reemplazan todos los campos editables y los
+// opcionales que no se envían vuelven a su valor vacío
+func replacementPatch(title, description string, completed bool, dueDate *time.Time, recurrence string,
+	storyPoints int, estimatedHours float64, remainingHours *float64) domain.TaskPatch {
+	return domain.TaskPatch{
+		Title:          &title,
+		Description:    &description,
+		Completed:      &completed,
+		DueDate:        dueDate,
+		ClearDueDate:   dueDate == nil,
+		Recurrence:     &recurrence,
+		StoryPoints:    &storyPoints,
+		EstimatedHours: &estimatedHours,
+		RemainingHours: remainingHours,
+	}
+}
+
+// withPatchVersion exige que la tarea siga en la versión sobre la que se evaluó el parche cuando
+// el cliente no envió If-Match; retorna true si la versión la fijó el servidor
+func withPatchVersion(ctx context.Context, ifMatch string, task *domain.Task) (context.Context, bool) {
+	if _, ok, _ := parseIfMatch(ifMatch); ok {
+		return ctx, false
+	}
+	return application.WithExpectedVersion(ctx, task.Version), true
+}
+
+// patchSaveErrorStatus traduce los errores al guardar un parche; si la versión la fijó el servidor,
+// una escritura concurrente es un conflicto (409) y no una precondición del cliente
+func patchSaveErrorStatus(err error, implicitVersion bool) int {
+	status := taskPatchErrorStatus(err)
+	if implicitVersion && status == http.StatusPreconditionFailed {
+		return http.StatusConflict
+	}
+	return status
+}
@@ -18,17 +18,209 @@ func SetupTaskRoutes(router *gin.Engine, taskHandler *TaskHandler) {
 		// GET /api/v1/tasks/:id - Obtener tarea por ID
 		taskGroup.GET("/:id", taskHandler.GetTask)
 
-		// PUT /api/v1/tasks/:id - Actualizar tarea
+		// PUT /api/v1/tasks/:id - Reemplazar tarea
 		taskGroup.PUT("/:id", taskHandler.UpdateTask)
 
+		// PATCH /api/v1/tasks/:id - Modificar tarea (merge-patch+json o json-patch+json)
+		taskGroup.PATCH("/:id", taskHandler.PatchTask)
+
 		// DELETE /api/v1/tasks/:id - Eliminar tarea
 		taskGroup.DELETE("/:id", taskHandler.DeleteTask)
 
 		// GET /api/v1/tasks/status/:status - Obtener tareas por estado
 		taskGroup.GET("/status/:status", taskHandler.GetTaskByStatus)
+
+		// GET /api/v1/tasks/order - Obtener tareas en orden de dependencias
+		taskGroup.GET("/order", taskHandler.GetTasksInDependencyOrder)
+
+		// GET /api/v1/tasks/trash - Obtener tareas de la papelera
+		taskGroup.GET("/trash", taskHandler.GetTrash)
+
+		// DELETE /api/v1/tasks/trash/:id - Eliminar definitivamente una tarea de la papelera
+		taskGroup.DELETE("/trash/:id", taskHandler.PermanentlyDeleteTask)
+
+		// POST /api/v1/tasks/archive - Archivar tareas completadas hace más de N días
+		taskGroup.POST("/archive", taskHandler.ArchiveCompletedTasks)
+
+		// POST /api/v1/tasks/bulk - Crear, actualizar, completar, reabrir o eliminar varias tareas
+		taskGroup.POST("/bulk", taskHandler.BulkTasks)
+
+		// POST /api/v1/tasks/:id/archive - Archivar tarea completada
+		taskGroup.POST("/:id/archive", taskHandler.ArchiveTask)
+
+		// POST /api/v1/tasks/:id/unarchive - Desarchivar tarea
+		taskGroup.POST("/:id/unarchive", taskHandler.UnarchiveTask)
+
+		// POST /api/v1/tasks/:id/move - Mover la tarea en el orden manual
+		taskGroup.POST("/:id/move", taskHandler.MoveTask)
+
+		// GET /api/v1/tasks/:id/history - Historial de cambios de la tarea
+		taskGroup.GET("/:id/history", taskHandler.GetTaskHistory)
+
+		// POST /api/v1/tasks/:id/history/:revisionId/revert - Revertir la tarea a una revisión
+		taskGroup.POST("/:id/history/:revisionId/revert", taskHandler.RevertTask)
+
+		// POST /api/v1/tasks/:id/restore - Restaurar tarea de la papelera
+		taskGroup.POST("/:id/restore", taskHandler.RestoreTask)
+
+		// GET /api/v1/tasks/:id/dependencies - Obtener bloqueadores de una tarea
+		taskGroup.GET("/:id/dependencies", taskHandler.GetTaskBlockers)
+
+		// POST /api/v1/tasks/:id/dependencies - Agregar bloqueador
+		taskGroup.POST("/:id/dependencies", taskHandler.AddDependency)
+
+		// DELETE /api/v1/tasks/:id/dependencies/:blockerId - Eliminar bloqueador
+		taskGroup.DELETE("/:id/dependencies/:blockerId", taskHandler.RemoveDependency)
+
+		// PUT /api/v1/tasks/:id/schedule - Programar vencimiento y recurrencia
+		taskGroup.PUT("/:id/schedule", taskHandler.ScheduleTask)
+
+		// POST /api/v1/tasks/:id/assignees - Asignar responsables
+		taskGroup.POST("/:id/assignees", taskHandler.AssignTask)
+
+		// DELETE /api/v1/tasks/:id/assignees/:userId - Quitar responsable
+		taskGroup.DELETE("/:id/assignees/:userId", taskHandler.UnassignTask)
+	}
+
+	// GET /api/v1/users/:id/tasks - Tareas asignadas a un usuario
+	router.GET("/api/v1/users/:id/tasks", taskHandler.GetUserTasks)
+}
+
+// SetupCommentRoutes configura las rutas de comentarios de tareas
+func SetupCommentRoutes(router *gin.Engine, commentHandler *CommentHandler) {
+	commentGroup := router.Group("/api/v1/tasks/:id/comments")
+	{
+		// GET /api/v1/tasks/:id/comments - Listar comentarios (limit, offset)
+		commentGroup.GET("", commentHandler.ListComments)
+
+		// POST /api/v1/tasks/:id/comments - Agregar comentario
+		commentGroup.POST("", commentHandler.CreateComment)
+
+		// PUT /api/v1/tasks/:id/comments/:commentId - Editar comentario
+		commentGroup.PUT("/:commentId", commentHandler.EditComment)
+
+		// DELETE /api/v1/tasks/:id/comments/:commentId - Eliminar comentario
+		commentGroup.DELETE("/:commentId", commentHandler.DeleteComment)
+
+		// GET /api/v1/tasks/:id/comments/:commentId/history - Historial de ediciones
+		commentGroup.GET("/:commentId/history", commentHandler.GetCommentHistory)
+	}
+}
+
+// SetupAttachmentRoutes configura las rutas de archivos adjuntos de tareas
+func SetupAttachmentRoutes(router *gin.Engine, attachmentHandler *AttachmentHandler) {
+	attachmentGroup := router.Group("/api/v1/tasks/:id/attachments")
+	{
+		// GET /api/v1/tasks/:id/attachments - Listar adjuntos
+		attachmentGroup.GET("", attachmentHandler.ListAttachments)
+
+		// POST /api/v1/tasks/:id/attachments - Subir adjunto (multipart, campo "file")
+		attachmentGroup.POST("", attachmentHandler.UploadAttachment)
+
+		// GET /api/v1/tasks/:id/attachments/:attachmentId - Descargar adjunto
+		attachmentGroup.GET("/:attachmentId", attachmentHandler.DownloadAttachment)
+
+		// DELETE /api/v1/tasks/:id/attachments/:attachmentId - Eliminar adjunto
+		attachmentGroup.DELETE("/:attachmentId", attachmentHandler.DeleteAttachment)
 	}
 }
 
+// SetupBoardRoutes configura las rutas de tableros kanban
+func SetupBoardRoutes(router *gin.Engine, boardHandler *BoardHandler) {
+	boardGroup := router.Group("/api/v1/boards")
+	{
+		// POST /api/v1/boards - Crear tablero con sus columnas
+		boardGroup.POST("", boardHandler.CreateBoard)
+
+		// GET /api/v1/boards/:id - Vista completa del tablero
+		boardGroup.GET("/:id", boardHandler.GetBoard)
+
+		// PUT /api/v1/boards/:id/cards/:taskId - Agregar o mover una tarea a una columna
+		boardGroup.PUT("/:id/cards/:taskId", boardHandler.MoveCard)
+
+		// DELETE /api/v1/boards/:id/cards/:taskId - Quitar una tarea del tablero
+		boardGroup.DELETE("/:id/cards/:taskId", boardHandler.RemoveCard)
+	}
+}
+
+// SetupTimeRoutes configura las rutas de registro de tiempo
+func SetupTimeRoutes(router *gin.Engine, timeHandler *TimeHandler) {
+	// POST /api/v1/tasks/:id/timer - Iniciar temporizador del usuario actual
+	router.POST("/api/v1/tasks/:id/timer", timeHandler.StartTimer)
+
+	// POST /api/v1/timer/stop - Detener el temporizador del usuario actual
+	router.POST("/api/v1/timer/stop", timeHandler.StopTimer)
+
+	// GET /api/v1/tasks/:id/time-entries - Registros de tiempo y total de la tarea
+	router.GET("/api/v1/tasks/:id/time-entries", timeHandler.ListTimeEntries)
+
+	// POST /api/v1/tasks/:id/time-entries - Registrar tiempo manual
+	router.POST("/api/v1/tasks/:id/time-entries", timeHandler.LogTime)
+
+	// GET /api/v1/boards/:id/time - Tiempo total de las tareas del tablero
+	router.GET("/api/v1/boards/:id/time", timeHandler.GetBoardTime)
+
+	// GET /api/v1/projects/:project/time - Tiempo total de las tareas con ese valor en el campo project
+	router.GET("/api/v1/projects/:project/time", timeHandler.GetProjectTime)
+
+	// GET /api/v1/time-entries/report - Reporte por día, usuario, tarea o proyecto (from, to, group_by)
+	router.GET("/api/v1/time-entries/report", timeHandler.GetTimeReport)
+}
+
+// SetupEstimateRoutes configura las rutas de estimaciones, velocidad y burndown
+func SetupEstimateRoutes(router *gin.Engine, estimateHandler *EstimateHandler) {
+	// PUT /api/v1/tasks/:id/estimate - Asignar story points y horas estimadas
+	router.PUT("/api/v1/tasks/:id/estimate", estimateHandler.EstimateTask)
+
+	// PUT /api/v1/tasks/:id/remaining - Actualizar las horas restantes
+	router.PUT("/api/v1/tasks/:id/remaining", estimateHandler.UpdateRemainingWork)
+
+	// GET /api/v1/reports/velocity - Trabajo completado por semana (from, to, board_id)
+	router.GET("/api/v1/reports/velocity", estimateHandler.GetVelocity)
+
+	// GET /api/v1/reports/burndown - Trabajo pendiente por día (from, to, board_id)
+	router.GET("/api/v1/reports/burndown", estimateHandler.GetBurndown)
+}
+
+// SetupCustomFieldRoutes configura las rutas de campos personalizados
+func SetupCustomFieldRoutes(router *gin.Engine, customFieldHandler *CustomFieldHandler) {
+	// POST /api/v1/custom-fields - Definir un campo personalizado
+	router.POST("/api/v1/custom-fields", customFieldHandler.CreateField)
+
+	// GET /api/v1/custom-fields - Listar los campos personalizados
+	router.GET("/api/v1/custom-fields", customFieldHandler.ListFields)
+
+	// DELETE /api/v1/custom-fields/:key - Eliminar un campo y sus valores
+	router.DELETE("/api/v1/custom-fields/:key", customFieldHandler.DeleteField)
+
+	// PUT /api/v1/tasks/:id/custom-fields - Guardar valores en una tarea
+	router.PUT("/api/v1/tasks/:id/custom-fields", customFieldHandler.SetTaskCustomFields)
+}
+
+// SetupTemplateRoutes configura las rutas de plantillas de tareas
+func SetupTemplateRoutes(router *gin.Engine, templateHandler *TemplateHandler) {
+	// POST /api/v1/templates - Crear una plantilla
+	router.POST("/api/v1/templates", templateHandler.CreateTemplate)
+
+	// GET /api/v1/templates - Listar las plantillas
+	router.GET("/api/v1/templates", templateHandler.ListTemplates)
+
+	// GET /api/v1/templates/:id - Obtener una plantilla
+	router.GET("/api/v1/templates/:id", templateHandler.GetTemplate)
+
+	// DELETE /api/v1/templates/:id - Eliminar una plantilla
+	router.DELETE("/api/v1/templates/:id", templateHandler.DeleteTemplate)
+
+	// POST /api/v1/templates/:id/instantiate - Crear las tareas de la plantilla
+	router.POST("/api/v1/templates/:id/instantiate", templateHandler.InstantiateTemplate)
+}
+
+// SetupTaskStreamRoutes configura el stream de eventos de tareas en vivo
+func SetupTaskStreamRoutes(router *gin.Engine, streamHandler *TaskStreamHandler) {
+	// GET /api/v1/tasks/events - Server-Sent Events con los cambios de tareas
+	router.GET("/api/v1/tasks/events", streamHandler.StreamEvents)
+}
+
 // SetupRoutes configura todas las rutas de la aplicación
 func SetupRoutes(router *gin.Engine, taskHandler *TaskHandler) {
     // Configurar rutas de tareas
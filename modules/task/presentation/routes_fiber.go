@@ -9,13 +9,121 @@ func SetupTaskRoutesFiber(app *fiber.App, handler *FiberTaskHandler) {
 	// Grupo de rutas para tareas
 	tasks := app.Group("/tasks")
 
+	// Rutas estáticas antes de /:id para que no sean capturadas por el parámetro
+	tasks.Get("/order", handler.GetTasksInDependencyOrder)
+	tasks.Get("/trash", handler.GetTrash)
+	tasks.Delete("/trash/:id", handler.PermanentlyDeleteTask)
+	tasks.Post("/archive", handler.ArchiveCompletedTasks)
+	tasks.Post("/bulk", handler.BulkTasks)
+
 	// CRUD básico
 	tasks.Post("/", handler.CreateTask)
 	tasks.Get("/", handler.GetAllTasks)
 	tasks.Get("/:id", handler.GetTask)
 	tasks.Put("/:id", handler.UpdateTask)
+	tasks.Patch("/:id", handler.PatchTask)
 	tasks.Delete("/:id", handler.DeleteTask)
 
 	// Rutas adicionales
 	tasks.Get("/status", handler.GetTaskByStatus)
+
+	// Papelera
+	tasks.Post("/:id/restore", handler.RestoreTask)
+
+	// Archivo
+	tasks.Post("/:id/archive", handler.ArchiveTask)
+	tasks.Post("/:id/unarchive", handler.UnarchiveTask)
+
+	// Orden manual
+	tasks.Post("/:id/move", handler.MoveTask)
+
+	// Historial de cambios
+	tasks.Get("/:id/history", handler.GetTaskHistory)
+	tasks.Post("/:id/history/:revisionId/revert", handler.RevertTask)
+
+	// Dependencias entre tareas
+	tasks.Get("/:id/dependencies", handler.GetTaskBlockers)
+	tasks.Post("/:id/dependencies", handler.AddDependency)
+	tasks.Delete("/:id/dependencies/:blockerId", handler.RemoveDependency)
+
+	// Programación y recurrencia
+	tasks.Put("/:id/schedule", handler.ScheduleTask)
+
+	// Responsables
+	tasks.Post("/:id/assignees", handler.AssignTask)
+	tasks.Delete("/:id/assignees/:userId", handler.UnassignTask)
+	app.Get("/users/:id/tasks", handler.GetUserTasks)
+}
+
+// SetupCommentRoutesFiber configura las rutas de comentarios de tareas para Fiber
+func SetupCommentRoutesFiber(app *fiber.App, handler *FiberCommentHandler) {
+	comments := app.Group("/tasks/:id/comments")
+
+	comments.Get("/", handler.ListComments)
+	comments.Post("/", handler.CreateComment)
+	comments.Put("/:commentId", handler.EditComment)
+	comments.Delete("/:commentId", handler.DeleteComment)
+	comments.Get("/:commentId/history", handler.GetCommentHistory)
+}
+
+// SetupAttachmentRoutesFiber configura las rutas de archivos adjuntos para Fiber
+func SetupAttachmentRoutesFiber(app *fiber.App, handler *FiberAttachmentHandler) {
+	attachments := app.Group("/tasks/:id/attachments")
+
+	attachments.Get("/", handler.ListAttachments)
+	attachments.Post("/", handler.UploadAttachment)
+	attachments.Get("/:attachmentId", handler.DownloadAttachment)
+	attachments.Delete("/:attachmentId", handler.DeleteAttachment)
+}
+
+// SetupBoardRoutesFiber configura las rutas de tableros kanban para Fiber
+func SetupBoardRoutesFiber(app *fiber.App, handler *FiberBoardHandler) {
+	boards := app.Group("/boards")
+
+	boards.Post("/", handler.CreateBoard)
+	boards.Get("/:id", handler.GetBoard)
+	boards.Put("/:id/cards/:taskId", handler.MoveCard)
+	boards.Delete("/:id/cards/:taskId", handler.RemoveCard)
+}
+
+// SetupTimeRoutesFiber configura las rutas de registro de tiempo para Fiber
+func SetupTimeRoutesFiber(app *fiber.App, handler *FiberTimeHandler) {
+	app.Post("/tasks/:id/timer", handler.StartTimer)
+	app.Post("/timer/stop", handler.StopTimer)
+	app.Get("/tasks/:id/time-entries", handler.ListTimeEntries)
+	app.Post("/tasks/:id/time-entries", handler.LogTime)
+	app.Get("/boards/:id/time", handler.GetBoardTime)
+	app.Get("/projects/:project/time", handler.GetProjectTime)
+	app.Get("/time-entries/report", handler.GetTimeReport)
+}
+
+// SetupEstimateRoutesFiber configura las rutas de estimaciones, velocidad y burndown para Fiber
+func SetupEstimateRoutesFiber(app *fiber.App, handler *FiberEstimateHandler) {
+	app.Put("/tasks/:id/estimate", handler.EstimateTask)
+	app.Put("/tasks/:id/remaining", handler.UpdateRemainingWork)
+	app.Get("/reports/velocity", handler.GetVelocity)
+	app.Get("/reports/burndown", handler.GetBurndown)
+}
+
+// SetupCustomFieldRoutesFiber configura las rutas de campos personalizados para Fiber
+func SetupCustomFieldRoutesFiber(app *fiber.App, handler *FiberCustomFieldHandler) {
+	app.Post("/custom-fields", handler.CreateField)
+	app.Get("/custom-fields", handler.ListFields)
+	app.Delete("/custom-fields/:key", handler.DeleteField)
+	app.Put("/tasks/:id/custom-fields", handler.SetTaskCustomFields)
+}
+
+// SetupTemplateRoutesFiber configura las rutas de plantillas de tareas para Fiber
+func SetupTemplateRoutesFiber(app *fiber.App, handler *FiberTemplateHandler) {
+	app.Post("/templates", handler.CreateTemplate)
+	app.Get("/templates", handler.ListTemplates)
+	app.Get("/templates/:id", handler.GetTemplate)
+	app.Delete("/templates/:id", handler.DeleteTemplate)
+	app.Post("/templates/:id/instantiate", handler.InstantiateTemplate)
+}
+
+// SetupTaskStreamRoutesFiber configura el stream de eventos de tareas en vivo para Fiber; debe
+// registrarse antes que las rutas de tareas para que /tasks/:id no capture /tasks/events
+func SetupTaskStreamRoutesFiber(app *fiber.App, handler *FiberTaskStreamHandler) {
+	app.Get("/tasks/events", handler.StreamEvents)
 }
@@ -0,0 +1,177 @@
+package presentation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// LastEventIDHeader es la cabecera con la que EventSource reanuda el stream al reconectarse
+	LastEventIDHeader = "Last-Event-ID"
+	// streamRetry es la espera que se sugiere al cliente antes de reconectarse
+	streamRetry = 3 * time.Second
+)
+
+// TaskStreamHandler maneja el stream de eventos de tareas en vivo (Server-Sent Events)
+type TaskStreamHandler struct {
+	stream    application.EventStream
+	heartbeat time.Duration
+}
+
+// NewTaskStreamHandler crea una nueva instancia del handler del stream; cada heartbeat sin eventos
+// se envía un comentario para mantener la conexión abierta
+func NewTaskStreamHandler(stream application.EventStream, heartbeat time.Duration) *TaskStreamHandler {
+	return &TaskStreamHandler{
+		stream:    stream,
+		heartbeat: heartbeat,
+	}
+}
+
+// parseEventStreamFilter interpreta los filtros del stream
+func parseEventStreamFilter(status, project string) (application.EventStreamFilter, error) {
+	if status != "" && status != "pending" && status != "completed" {
+		return application.EventStreamFilter{}, errors.New("status must be pending or completed")
+	}
+	return application.EventStreamFilter{Status: status, Project: project}, nil
+}
+
+// parseLastEventID interpreta el ID desde el que se reanuda el stream; la cabecera tiene prioridad
+// sobre el parámetro last_event_id, que sirve para clientes que no pueden enviar cabeceras
+func parseLastEventID(header, query string) (uint64, error) {
+	value := header
+	if value == "" {
+		value = query
+	}
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, errors.New("Last-Event-ID must be a non-negative integer")
+	}
+	return id, nil
+}
+
+// writeEventStream escribe la suscripción en formato SSE hasta que se cierre done, falle la
+// escritura o la suscripción se corte. Los eventos que el filtro descarta no se envían, pero el
+// heartbeat lleva el último ID procesado para que el cliente no los vuelva a pedir al reanudar
+func writeEventStream(done <-chan struct{}, w io.Writer, flush func() error, subscription *application.EventSubscription,
+	filter application.EventStreamFilter, heartbeat time.Duration) error {
+	send := func(frame string) error {
+		if _, err := io.WriteString(w, frame); err != nil {
+			return err
+		}
+		return flush()
+	}
+
+	lastID := subscription.LastID
+	if err := send(fmt.Sprintf("retry: %d\n\n", streamRetry.Milliseconds())); err != nil {
+		return err
+	}
+	// Si el registro ya no tiene los eventos perdidos, el cliente debe volver a cargar las tareas
+	if subscription.Missed {
+		if err := send(fmt.Sprintf("id: %d\nevent: reset\ndata: {}\n\n", lastID)); err != nil {
+			return err
+		}
+	}
+	for _, streamed := range subscription.Backlog {
+		if err := sendStreamedEvent(send, streamed, filter); err != nil {
+			return err
+		}
+	}
+	if !subscription.Missed {
+		if err := send(fmt.Sprintf("id: %d\n\n", lastID)); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return nil
+		case streamed, ok := <-subscription.Events:
+			if !ok {
+				return nil
+			}
+			lastID = streamed.ID
+			if err := sendStreamedEvent(send, streamed, filter); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := send(fmt.Sprintf(": heartbeat\nid: %d\n\n", lastID)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendStreamedEvent envía un evento si pasa el filtro
+func sendStreamedEvent(send func(string) error, streamed application.StreamedEvent, filter application.EventStreamFilter) error {
+	if !filter.Matches(streamed.Event) {
+		return nil
+	}
+	data, err := json.Marshal(streamed.Event)
+	if err != nil {
+		return err
+	}
+	return send(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", streamed.ID, streamed.Event.Type, data))
+}
+
+// setEventStreamHeaders define las cabeceras de una respuesta SSE
+func setEventStreamHeaders(set func(key, value string)) {
+	set("Content-Type", "text/event-stream")
+	set("Cache-Control", "no-cache")
+	set("Connection", "keep-alive")
+	// Evita que un proxy como nginx acumule los eventos
+	set("X-Accel-Buffering", "no")
+}
+
+// StreamEvents envía los cambios de tareas en vivo
+// @Summary Stream de cambios de tareas
+// @Description Server-Sent Events con los eventos task.*; reanuda con Last-Event-ID y envía reset si se perdieron eventos
+// @Tags tareas
+// @Produce text/event-stream
+// @Param status query string false "pending o completed"
+// @Param project query string false "Valor del campo personalizado project"
+// @Param last_event_id query int false "ID desde el que se reanuda si no se envía Last-Event-ID"
+// @Success 200 {string} string
+// @Failure 400 {object} gin.H
+// @Router /tasks/events [get]
+func (h *TaskStreamHandler) StreamEvents(c *gin.Context) {
+	filter, err := parseEventStreamFilter(c.Query("status"), c.Query("project"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid filter",
+			"message": err.Error(),
+		})
+		return
+	}
+	lastID, err := parseLastEventID(c.GetHeader(LastEventIDHeader), c.Query("last_event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid Last-Event-ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	subscription := h.stream.Subscribe(lastID)
+	defer subscription.Close()
+
+	setEventStreamHeaders(c.Header)
+	c.Status(http.StatusOK)
+	_ = writeEventStream(c.Request.Context().Done(), c.Writer, func() error {
+		c.Writer.Flush()
+		return nil
+	}, subscription, filter, h.heartbeat)
+}
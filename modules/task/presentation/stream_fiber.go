@@ -0,0 +1,51 @@
+package presentation
+
+import (
+	"bufio"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberTaskStreamHandler maneja el stream de eventos de tareas en vivo (Server-Sent Events) con Fiber
+type FiberTaskStreamHandler struct {
+	stream    application.EventStream
+	heartbeat time.Duration
+}
+
+// NewFiberTaskStreamHandler crea una nueva instancia del handler del stream con Fiber
+func NewFiberTaskStreamHandler(stream application.EventStream, heartbeat time.Duration) *FiberTaskStreamHandler {
+	return &FiberTaskStreamHandler{
+		stream:    stream,
+		heartbeat: heartbeat,
+	}
+}
+
+// StreamEvents envía los cambios de tareas en vivo con Fiber. fasthttp no avisa cuando el cliente
+// se desconecta: el stream termina cuando falla una escritura, a más tardar en el siguiente heartbeat
+func (h *FiberTaskStreamHandler) StreamEvents(c *fiber.Ctx) error {
+	filter, err := parseEventStreamFilter(c.Query("status"), c.Query("project"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid filter",
+			"message": err.Error(),
+		})
+	}
+	lastID, err := parseLastEventID(c.Get(LastEventIDHeader), c.Query("last_event_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid Last-Event-ID",
+			"message": err.Error(),
+		})
+	}
+
+	setEventStreamHeaders(c.Set)
+	c.Status(fiber.StatusOK)
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		subscription := h.stream.Subscribe(lastID)
+		defer subscription.Close()
+		_ = writeEventStream(nil, w, w.Flush, subscription, filter, h.heartbeat)
+	})
+	return nil
+}
@@ -0,0 +1,253 @@
+package presentation
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// TemplateHandler maneja las peticiones HTTP de plantillas de tareas
+type TemplateHandler struct {
+	templateService application.TemplateServiceInterface
+}
+
+// NewTemplateHandler crea una nueva instancia del handler de plantillas
+func NewTemplateHandler(templateService application.TemplateServiceInterface) *TemplateHandler {
+	return &TemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// CreateTemplateRequest representa la estructura de la petición para crear una plantilla
+type CreateTemplateRequest struct {
+	Name        string                `json:"name" binding:"required"`
+	Description string                `json:"description"`
+	Tasks       []domain.TemplateTask `json:"tasks" binding:"required"`
+}
+
+// InstantiateTemplateRequest representa la estructura de la petición para instanciar una plantilla
+type InstantiateTemplateRequest struct {
+	Variables map[string]string `json:"variables"`
+	StartDate string            `json:"start_date"` // YYYY-MM-DD; hoy (UTC) si se omite
+}
+
+// templateErrorStatus traduce los errores de plantillas a códigos HTTP
+func templateErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrTemplateNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidTemplate), errors.Is(err, domain.ErrMissingTemplateVariable),
+		errors.Is(err, domain.ErrInvalidEstimate), errors.Is(err, domain.ErrInvalidCustomFieldValue):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// parseTemplateStart interpreta la fecha de inicio de una instanciación (hoy si está vacía)
+func parseTemplateStart(value string) (time.Time, error) {
+	if value == "" {
+		return time.Now().UTC(), nil
+	}
+	start, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errors.New("start_date must use the YYYY-MM-DD format")
+	}
+	return start, nil
+}
+
+// parseTemplateID interpreta el ID de plantilla de la ruta
+func parseTemplateID(value string) (int, error) {
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		return 0, errors.New("ID must be a positive integer")
+	}
+	return int(id), nil
+}
+
+// CreateTemplate crea una plantilla de tareas
+// @Summary Crea una plantilla
+// @Description Los textos admiten variables {{nombre}}; las tareas hijas bloquean a su padre al instanciarse
+// @Tags plantillas
+// @Accept json
+// @Produce json
+// @Param template body CreateTemplateRequest true "Plantilla"
+// @Success 201 {object} entities.TaskTemplate
+// @Failure 400 {object} gin.H
+// @Router /templates [post]
+func (h *TemplateHandler) CreateTemplate(c *gin.Context) {
+	var req CreateTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	template, err := h.templateService.CreateTemplate(c.Request.Context(), req.Name, req.Description, req.Tasks)
+	if err != nil {
+		c.JSON(templateErrorStatus(err), gin.H{
+			"error":   "Error creating template",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Template created successfully",
+		"data":    template,
+	})
+}
+
+// ListTemplates obtiene todas las plantillas
+// @Summary Lista las plantillas
+// @Tags plantillas
+// @Produce json
+// @Success 200 {object} []entities.TaskTemplate
+// @Failure 500 {object} gin.H
+// @Router /templates [get]
+func (h *TemplateHandler) ListTemplates(c *gin.Context) {
+	templates, err := h.templateService.ListTemplates(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error getting templates",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Templates retrieved successfully",
+		"data":    templates,
+		"count":   len(templates),
+	})
+}
+
+// GetTemplate obtiene una plantilla por su ID
+// @Summary Obtiene una plantilla
+// @Tags plantillas
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Success 200 {object} entities.TaskTemplate
+// @Failure 404 {object} gin.H
+// @Router /templates/{id} [get]
+func (h *TemplateHandler) GetTemplate(c *gin.Context) {
+	id, err := parseTemplateID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	template, err := h.templateService.GetTemplate(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(templateErrorStatus(err), gin.H{
+			"error":   "Error getting template",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Template retrieved successfully",
+		"data":    template,
+	})
+}
+
+// DeleteTemplate elimina una plantilla
+// @Summary Elimina una plantilla
+// @Tags plantillas
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Success 200 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /templates/{id} [delete]
+func (h *TemplateHandler) DeleteTemplate(c *gin.Context) {
+	id, err := parseTemplateID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := h.templateService.DeleteTemplate(c.Request.Context(), id); err != nil {
+		c.JSON(templateErrorStatus(err), gin.H{
+			"error":   "Error deleting template",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Template deleted successfully",
+	})
+}
+
+// InstantiateTemplate crea las tareas de una plantilla
+// @Summary Instancia una plantilla
+// @Description Crea todas las tareas en una transacción, reemplaza las variables y calcula los vencimientos desde start_date
+// @Tags plantillas
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la plantilla"
+// @Param instantiate body InstantiateTemplateRequest true "Variables y fecha de inicio"
+// @Success 201 {object} []entities.Task
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /templates/{id}/instantiate [post]
+func (h *TemplateHandler) InstantiateTemplate(c *gin.Context) {
+	id, err := parseTemplateID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	// El cuerpo es opcional: sin variables ni fecha se usa el día de hoy
+	var req InstantiateTemplateRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Invalid request",
+				"message": err.Error(),
+			})
+			return
+		}
+	}
+	start, err := parseTemplateStart(req.StartDate)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid start date",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	ctx := withActor(c.Request.Context(), c.GetHeader(CurrentUserHeader))
+	tasks, err := h.templateService.InstantiateTemplate(ctx, id, req.Variables, start)
+	if err != nil {
+		c.JSON(templateErrorStatus(err), gin.H{
+			"error":   "Error instantiating template",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Template instantiated successfully",
+		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
@@ -0,0 +1,162 @@
+package presentation
+
+import (
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberTemplateHandler maneja las peticiones HTTP de plantillas de tareas con Fiber
+type FiberTemplateHandler struct {
+	templateService application.TemplateServiceInterface
+}
+
+// NewFiberTemplateHandler crea una nueva instancia del handler de plantillas con Fiber
+func NewFiberTemplateHandler(templateService application.TemplateServiceInterface) *FiberTemplateHandler {
+	return &FiberTemplateHandler{
+		templateService: templateService,
+	}
+}
+
+// FiberCreateTemplateRequest representa la estructura de la petición para crear una plantilla
+type FiberCreateTemplateRequest struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Tasks       []domain.TemplateTask `json:"tasks"`
+}
+
+// FiberInstantiateTemplateRequest representa la estructura de la petición para instanciar una plantilla
+type FiberInstantiateTemplateRequest struct {
+	Variables map[string]string `json:"variables"`
+	StartDate string            `json:"start_date"`
+}
+
+// CreateTemplate crea una plantilla de tareas con Fiber
+func (h *FiberTemplateHandler) CreateTemplate(c *fiber.Ctx) error {
+	var req FiberCreateTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	template, err := h.templateService.CreateTemplate(c.Context(), req.Name, req.Description, req.Tasks)
+	if err != nil {
+		return c.Status(templateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error creating template",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Template created successfully",
+		"data":    template,
+	})
+}
+
+// ListTemplates obtiene todas las plantillas con Fiber
+func (h *FiberTemplateHandler) ListTemplates(c *fiber.Ctx) error {
+	templates, err := h.templateService.ListTemplates(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Error getting templates",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Templates retrieved successfully",
+		"data":    templates,
+		"count":   len(templates),
+	})
+}
+
+// GetTemplate obtiene una plantilla por su ID con Fiber
+func (h *FiberTemplateHandler) GetTemplate(c *fiber.Ctx) error {
+	id, err := parseTemplateID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+
+	template, err := h.templateService.GetTemplate(c.Context(), id)
+	if err != nil {
+		return c.Status(templateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting template",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Template retrieved successfully",
+		"data":    template,
+	})
+}
+
+// DeleteTemplate elimina una plantilla con Fiber
+func (h *FiberTemplateHandler) DeleteTemplate(c *fiber.Ctx) error {
+	id, err := parseTemplateID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.templateService.DeleteTemplate(c.Context(), id); err != nil {
+		return c.Status(templateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error deleting template",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Template deleted successfully",
+	})
+}
+
+// InstantiateTemplate crea las tareas de una plantilla con Fiber
+func (h *FiberTemplateHandler) InstantiateTemplate(c *fiber.Ctx) error {
+	id, err := parseTemplateID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+
+	var req FiberInstantiateTemplateRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "Invalid request",
+				"message": err.Error(),
+			})
+		}
+	}
+	start, err := parseTemplateStart(req.StartDate)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid start date",
+			"message": err.Error(),
+		})
+	}
+
+	ctx := withActor(c.Context(), c.Get(CurrentUserHeader))
+	tasks, err := h.templateService.InstantiateTemplate(ctx, id, req.Variables, start)
+	if err != nil {
+		return c.Status(templateErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error instantiating template",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Template instantiated successfully",
+		"data":    tasks,
+		"count":   len(tasks),
+	})
+}
@@ -0,0 +1,157 @@
+package presentation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskHandler_GetAllTasks_IncludeArchived verifica que include_archived llega al servicio
+func TestTaskHandler_GetAllTasks_IncludeArchived(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		GetAllTasks(gomock.Any(), domain.TaskQueryOptions{IncludeArchived: true}).
+		Return([]*domain.Task{}, nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks", handler.GetAllTasks)
+
+	req, _ := http.NewRequest("GET", "/tasks?include_archived=true", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestTaskHandler_GetAllTasks_InvalidIncludeArchived verifica que un valor no booleano responde 400
+func TestTaskHandler_GetAllTasks_InvalidIncludeArchived(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks", handler.GetAllTasks)
+
+	req, _ := http.NewRequest("GET", "/tasks?include_archived=quizas", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestTaskHandler_UpdateTask_Archived verifica que modificar una tarea archivada responde 409
+func TestTaskHandler_UpdateTask_Archived(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		PatchTask(gomock.Any(), 1, gomock.Any()).
+		Return(nil, fmt.Errorf("no se puede modificar la tarea 1: %w", domain.ErrTaskArchived)).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.PUT("/tasks/:id", handler.UpdateTask)
+
+	req, _ := http.NewRequest("PUT", "/tasks/1", bytes.NewBufferString(`{"title":"Nuevo","description":"D","completed":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+// TestTaskHandler_ArchiveCompletedTasks_Success verifica el archivado en lote
+func TestTaskHandler_ArchiveCompletedTasks_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		ArchiveCompletedTasks(gomock.Any(), 30).
+		Return(3, nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTaskRoutes(router, handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/archive", bytes.NewBufferString(`{"older_than_days":30}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, float64(3), response["archived"])
+}
+
+// TestTaskHandler_ArchiveTask_NotCompleted verifica que archivar una tarea pendiente responde 409
+func TestTaskHandler_ArchiveTask_NotCompleted(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		ArchiveTask(gomock.Any(), 2).
+		Return(nil, domain.ErrArchiveNotCompleted).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTaskRoutes(router, handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/2/archive", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
@@ -0,0 +1,99 @@
+package presentation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskHandler_GetAllTasks_AssigneeMe verifica que "me" se resuelve con la cabecera X-User-ID
+func TestTaskHandler_GetAllTasks_AssigneeMe(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		GetTasksByAssignee(gomock.Any(), 5).
+		Return([]*domain.Task{{ID: 1, Assignees: []int{5}}}, nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks", handler.GetAllTasks)
+
+	req, _ := http.NewRequest("GET", "/tasks?assignee=me", nil)
+	req.Header.Set(presentation.CurrentUserHeader, "5")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestTaskHandler_GetAllTasks_AssigneeMeWithoutUser verifica que "me" sin usuario responde 401
+func TestTaskHandler_GetAllTasks_AssigneeMeWithoutUser(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks", handler.GetAllTasks)
+
+	req, _ := http.NewRequest("GET", "/tasks?assignee=me", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// TestTaskHandler_AssignTask_InactiveUser verifica que un usuario inactivo responde 422
+func TestTaskHandler_AssignTask_InactiveUser(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		AssignTask(gomock.Any(), 1, []int{2}).
+		Return(nil, fmt.Errorf("%w: ID 2", domain.ErrAssigneeInactive)).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/tasks/:id/assignees", handler.AssignTask)
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{"user_ids": []int{2}})
+	req, _ := http.NewRequest("POST", "/tasks/1/assignees", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
@@ -0,0 +1,183 @@
+package presentation_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newMultipartUpload construye una petición multipart con el campo "file"
+func newMultipartUpload(t *testing.T, url, fileName, content string) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", fileName)
+	assert.NoError(t, err)
+	_, _ = part.Write([]byte(content))
+	assert.NoError(t, writer.Close())
+
+	req, _ := http.NewRequest("POST", url, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+// TestAttachmentHandler_UploadAttachment_Success verifica que el archivo llega al servicio en streaming
+func TestAttachmentHandler_UploadAttachment_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockAttachmentServiceInterface(ctrl)
+	handler := presentation.NewAttachmentHandler(mockService)
+
+	mockService.EXPECT().
+		UploadAttachment(gomock.Any(), 1, "nota.txt", gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ int, name string, r io.Reader) (*domain.Attachment, error) {
+			content, _ := io.ReadAll(r)
+			assert.Equal(t, "hola", string(content))
+			return &domain.Attachment{ID: 3, TaskID: 1, FileName: name}, nil
+		}).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupAttachmentRoutes(router, handler)
+
+	req := newMultipartUpload(t, "/api/v1/tasks/1/attachments", "nota.txt", "hola")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+// TestAttachmentHandler_UploadAttachment_TooLarge verifica que un archivo grande responde 413
+func TestAttachmentHandler_UploadAttachment_TooLarge(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockAttachmentServiceInterface(ctrl)
+	handler := presentation.NewAttachmentHandler(mockService)
+
+	mockService.EXPECT().
+		UploadAttachment(gomock.Any(), 1, "grande.txt", gomock.Any()).
+		Return(nil, fmt.Errorf("%w: máximo 1 bytes", domain.ErrAttachmentTooLarge)).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupAttachmentRoutes(router, handler)
+
+	req := newMultipartUpload(t, "/api/v1/tasks/1/attachments", "grande.txt", "demasiado")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+// TestFiberAttachmentHandler_UploadAttachment_Streams verifica que con StreamRequestBody un archivo
+// mayor que BodyLimit llega completo al servicio, mientras que un cuerpo JSON de ese tamaño se rechaza
+func TestFiberAttachmentHandler_UploadAttachment_Streams(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockAttachmentServiceInterface(ctrl)
+	content := strings.Repeat("a", 64<<10)
+
+	mockService.EXPECT().
+		UploadAttachment(gomock.Any(), 1, "grande.txt", gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ int, name string, r io.Reader) (*domain.Attachment, error) {
+			received, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, len(content), len(received))
+			return &domain.Attachment{ID: 3, TaskID: 1, FileName: name}, nil
+		}).
+		Times(1)
+
+	app := fiber.New(fiber.Config{
+		DisableStartupMessage:        true,
+		BodyLimit:                    4 << 10,
+		StreamRequestBody:            true,
+		DisablePreParseMultipartForm: true,
+	})
+	app.Use(presentation.NewFiberBodyLimitMiddleware(4 << 10))
+	presentation.SetupAttachmentRoutesFiber(app, presentation.NewFiberAttachmentHandler(mockService))
+	app.Post("/echo", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) })
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = app.Listener(listener) }()
+	defer func() { _ = app.Shutdown() }()
+	baseURL := "http://" + listener.Addr().String()
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	upload := newMultipartUpload(t, baseURL+"/tasks/1/attachments", "grande.txt", content)
+	largeJSON, _ := http.NewRequest("POST", baseURL+"/echo", strings.NewReader(`{"title":"`+content+`"}`))
+	largeJSON.Header.Set("Content-Type", "application/json")
+
+	// Act
+	uploadResp, err := client.Do(upload)
+	require.NoError(t, err)
+	_ = uploadResp.Body.Close()
+	jsonResp, err := client.Do(largeJSON)
+	require.NoError(t, err)
+	_ = jsonResp.Body.Close()
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, uploadResp.StatusCode)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, jsonResp.StatusCode)
+}
+
+// TestAttachmentHandler_DownloadAttachment_Success verifica las cabeceras de descarga
+func TestAttachmentHandler_DownloadAttachment_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockAttachmentServiceInterface(ctrl)
+	handler := presentation.NewAttachmentHandler(mockService)
+
+	attachment := &domain.Attachment{ID: 3, TaskID: 1, FileName: "nota.txt", ContentType: "text/plain; charset=utf-8", Size: 4, SHA256: "abc"}
+	mockService.EXPECT().
+		OpenAttachment(gomock.Any(), 1, 3).
+		Return(attachment, io.NopCloser(bytes.NewReader([]byte("hola"))), nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupAttachmentRoutes(router, handler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks/1/attachments/3", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "hola", w.Body.String())
+	assert.Equal(t, `attachment; filename=nota.txt`, w.Header().Get("Content-Disposition"))
+	assert.Equal(t, `"abc"`, w.Header().Get("ETag"))
+}
@@ -0,0 +1,157 @@
+package presentation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestBoardHandler_CreateBoard_Success verifica que las columnas llegan al servicio en orden
+func TestBoardHandler_CreateBoard_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockBoardServiceInterface(ctrl)
+	handler := presentation.NewBoardHandler(mockService)
+
+	columns := []domain.BoardColumn{
+		{Name: "Haciendo", State: domain.ColumnStatePending, WIPLimit: 3},
+		{Name: "Hecho", State: domain.ColumnStateCompleted},
+	}
+	mockService.EXPECT().
+		CreateBoard(gomock.Any(), "Sprint", domain.SwimlaneAssignee, columns).
+		Return(&domain.Board{ID: 1, Name: "Sprint", Columns: columns}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupBoardRoutes(router, handler)
+
+	body := `{"name":"Sprint","swimlane_by":"assignee","columns":[
+		{"name":"Haciendo","state":"pending","wip_limit":3},{"name":"Hecho","state":"completed"}]}`
+	req, _ := http.NewRequest("POST", "/api/v1/boards", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+// TestBoardHandler_GetBoard_NotFound verifica que un tablero inexistente responde 404
+func TestBoardHandler_GetBoard_NotFound(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockBoardServiceInterface(ctrl)
+	handler := presentation.NewBoardHandler(mockService)
+
+	mockService.EXPECT().GetBoard(gomock.Any(), 9).
+		Return(nil, fmt.Errorf("no se pudo obtener el tablero 9: %w", domain.ErrBoardNotFound))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupBoardRoutes(router, handler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/boards/9", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestBoardHandler_GetBoard_Success verifica que la vista incluye filas y columnas
+func TestBoardHandler_GetBoard_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockBoardServiceInterface(ctrl)
+	handler := presentation.NewBoardHandler(mockService)
+
+	board := &domain.Board{ID: 1, Name: "Sprint", SwimlaneBy: domain.SwimlaneNone, Columns: []domain.BoardColumn{{ID: 10}}}
+	snapshot := domain.BuildBoardSnapshot(board, []domain.BoardCard{{TaskID: 4, ColumnID: 10, Title: "T"}})
+	mockService.EXPECT().GetBoard(gomock.Any(), 1).Return(snapshot, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupBoardRoutes(router, handler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/boards/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response struct {
+		Data domain.BoardSnapshot `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Data.Columns[0].CardCount)
+	assert.Equal(t, 4, response.Data.Swimlanes[0].Columns[0].Cards[0].TaskID)
+}
+
+// TestBoardHandler_MoveCard_WIPLimit verifica que superar el límite WIP responde 409
+func TestBoardHandler_MoveCard_WIPLimit(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockBoardServiceInterface(ctrl)
+	handler := presentation.NewBoardHandler(mockService)
+
+	mockService.EXPECT().MoveCard(gomock.Any(), 1, 5, 10).
+		Return(nil, fmt.Errorf("columna \"Haciendo\" (límite 2): %w", domain.ErrWIPLimitExceeded))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupBoardRoutes(router, handler)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/boards/1/cards/5", bytes.NewBufferString(`{"column_id":10}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+// TestBoardHandler_MoveCard_MissingColumn verifica que column_id es requerido
+func TestBoardHandler_MoveCard_MissingColumn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := presentation.NewBoardHandler(mocks.NewMockBoardServiceInterface(ctrl))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupBoardRoutes(router, handler)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/boards/1/cards/5", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
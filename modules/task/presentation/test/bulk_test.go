@@ -0,0 +1,120 @@
+package presentation_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupBulkRouter crea un router de Gin con las rutas de tareas y el mock del servicio
+func setupBulkRouter(ctrl *gomock.Controller) (*gin.Engine, *mocks.MockTaskServiceInterface) {
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTaskRoutes(router, presentation.NewTaskHandler(mockService))
+	return router, mockService
+}
+
+// bulkRequest crea una petición POST /api/v1/tasks/bulk
+func bulkRequest(body string) *http.Request {
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// TestTaskHandler_BulkTasks_BestEffort verifica el resultado por operación y el 207 si alguna falla
+func TestTaskHandler_BulkTasks_BestEffort(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupBulkRouter(ctrl)
+
+	mockService.EXPECT().BulkTasks(gomock.Any(), domain.BulkBestEffort, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ domain.BulkMode, ops []domain.BulkOperation) ([]*domain.BulkResult, error) {
+			assert.Len(t, ops, 2)
+			assert.True(t, ops[1].Patch.ClearDueDate)
+			assert.Equal(t, "Nuevo", *ops[1].Patch.Title)
+			return []*domain.BulkResult{
+				{Index: 0, Action: domain.BulkCreate, TaskID: 7, Task: &domain.Task{ID: 7, Title: "T"}},
+				{Index: 1, Action: domain.BulkUpdate, TaskID: 3, Err: domain.ErrBulkTaskNotFound},
+			}, nil
+		})
+	body := `{"mode":"best_effort","operations":[{"op":"create","title":"T","description":"D"},
+		{"op":"update","id":3,"patch":{"title":"Nuevo","due_date":null}}]}`
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, bulkRequest(body))
+
+	// Assert
+	assert.Equal(t, http.StatusMultiStatus, w.Code)
+	var response struct {
+		Data struct {
+			Succeeded int                               `json:"succeeded"`
+			Failed    int                               `json:"failed"`
+			Results   []presentation.BulkResultResponse `json:"results"`
+		} `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Data.Succeeded)
+	assert.Equal(t, 1, response.Data.Failed)
+	assert.Equal(t, http.StatusCreated, response.Data.Results[0].Status)
+	assert.Equal(t, http.StatusNotFound, response.Data.Results[1].Status)
+}
+
+// TestTaskHandler_BulkTasks_AtomicFailure verifica que un lote atómico fallido responde con el código
+// de la operación que falló
+func TestTaskHandler_BulkTasks_AtomicFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupBulkRouter(ctrl)
+
+	mockService.EXPECT().BulkTasks(gomock.Any(), domain.BulkAtomic, gomock.Any()).Return([]*domain.BulkResult{
+		{Index: 0, Action: domain.BulkDelete, TaskID: 1, Err: domain.ErrBulkAborted},
+		{Index: 1, Action: domain.BulkComplete, TaskID: 2, Err: domain.ErrTaskBlocked},
+	}, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, bulkRequest(`{"operations":[{"op":"delete","id":1},{"op":"complete","id":2}]}`))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), `"status":424`)
+}
+
+// TestTaskHandler_BulkTasks_InvalidPatch verifica que un patch con campos desconocidos rechaza el lote
+func TestTaskHandler_BulkTasks_InvalidPatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _ := setupBulkRouter(ctrl)
+	// No se espera BulkTasks
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, bulkRequest(`{"operations":[{"op":"update","id":1,"patch":{"owner":2}}]}`))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestTaskHandler_BulkTasks_TooMany verifica que el límite de operaciones responde 400
+func TestTaskHandler_BulkTasks_TooMany(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupBulkRouter(ctrl)
+
+	mockService.EXPECT().BulkTasks(gomock.Any(), domain.BulkAtomic, gomock.Any()).Return(nil, domain.ErrInvalidBulkRequest)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, bulkRequest(`{"operations":[]}`))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,80 @@
+package presentation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestCommentHandler_CreateComment_Success verifica que se crea un comentario
+func TestCommentHandler_CreateComment_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCommentServiceInterface(ctrl)
+	handler := presentation.NewCommentHandler(mockService)
+
+	mockService.EXPECT().
+		AddComment(gomock.Any(), 1, "ana", "hola").
+		Return(&domain.Comment{ID: 5, TaskID: 1, Author: "ana", Body: "hola"}, nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupCommentRoutes(router, handler)
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{"author": "ana", "body": "hola"})
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/1/comments", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+// TestCommentHandler_ListComments_Pagination verifica que se pasan limit y offset y se retorna el total
+func TestCommentHandler_ListComments_Pagination(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCommentServiceInterface(ctrl)
+	handler := presentation.NewCommentHandler(mockService)
+
+	mockService.EXPECT().
+		ListComments(gomock.Any(), 1, 2, 4).
+		Return([]*domain.Comment{{ID: 5, TaskID: 1}}, 7, nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupCommentRoutes(router, handler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks/1/comments?limit=2&offset=4", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(7), response["total"])
+	assert.Equal(t, float64(1), response["count"])
+}
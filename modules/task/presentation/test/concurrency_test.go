@@ -0,0 +1,160 @@
+package presentation_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskHandler_GetTask_ETag verifica que la lectura emite el ETag con la versión de la tarea
+func TestTaskHandler_GetTask_ETag(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().GetTaskByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Version: 4}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks/:id", handler.GetTask)
+
+	req, _ := http.NewRequest("GET", "/tasks/1", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+}
+
+// TestTaskHandler_UpdateTask_IfMatchMismatch verifica que un If-Match obsoleto responde 412
+func TestTaskHandler_UpdateTask_IfMatchMismatch(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		PatchTask(gomock.Any(), 1, gomock.Any()).
+		Return(nil, fmt.Errorf("no se puede modificar: %w", domain.ErrPreconditionFailed))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.PUT("/tasks/:id", handler.UpdateTask)
+
+	req, _ := http.NewRequest("PUT", "/tasks/1", bytes.NewBufferString(`{"title":"Nuevo","description":"D","completed":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"2"`)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
+// TestTaskHandler_DeleteTask_InvalidIfMatch verifica que un If-Match inválido responde 412 sin llamar al servicio
+func TestTaskHandler_DeleteTask_InvalidIfMatch(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.DELETE("/tasks/:id", handler.DeleteTask)
+
+	req, _ := http.NewRequest("DELETE", "/tasks/1", nil)
+	req.Header.Set("If-Match", "abc")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
+// TestFiberTaskHandler_UpdateTask_VersionConflict verifica que una escritura concurrente responde 409 en Fiber
+func TestFiberTaskHandler_UpdateTask_VersionConflict(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewFiberTaskHandler(mockService)
+
+	mockService.EXPECT().
+		PatchTask(gomock.Any(), 1, gomock.Any()).
+		Return(nil, fmt.Errorf("no se pudo actualizar la tarea: %w", domain.ErrVersionConflict))
+
+	app := fiber.New()
+	app.Put("/tasks/:id", handler.UpdateTask)
+
+	req := httptest.NewRequest("PUT", "/tasks/1", bytes.NewBufferString(`{"title":"Nuevo","description":"D","completed":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", `"1"`)
+
+	// Act
+	resp, err := app.Test(req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+}
+
+// TestTaskHandler_DeleteTask_IfMatchList verifica que If-Match acepta una lista de ETags y que los
+// ETags débiles no cumplen la condición porque If-Match exige comparación fuerte
+func TestTaskHandler_DeleteTask_IfMatchList(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	// Solo la lista con un ETag fuerte llega al servicio
+	mockService.EXPECT().DeleteTask(gomock.Any(), 1).Return(nil).Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.DELETE("/tasks/:id", handler.DeleteTask)
+
+	deleteWith := func(ifMatch string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest("DELETE", "/tasks/1", nil)
+		req.Header.Set("If-Match", ifMatch)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	list := deleteWith(`W/"2", "3" , "4"`)
+	weak := deleteWith(`W/"3"`)
+	invalid := deleteWith(`"3", *`)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, list.Code)
+	assert.Equal(t, http.StatusPreconditionFailed, weak.Code)
+	assert.Equal(t, http.StatusPreconditionFailed, invalid.Code)
+}
@@ -0,0 +1,112 @@
+package presentation_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupCustomFieldRouter crea un router de Gin con las rutas de campos personalizados y los mocks de los servicios
+func setupCustomFieldRouter(ctrl *gomock.Controller) (*gin.Engine, *mocks.MockTaskServiceInterface, *mocks.MockCustomFieldServiceInterface) {
+	mockTasks := mocks.NewMockTaskServiceInterface(ctrl)
+	mockFields := mocks.NewMockCustomFieldServiceInterface(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupCustomFieldRoutes(router, presentation.NewCustomFieldHandler(mockTasks, mockFields))
+	router.GET("/api/v1/tasks", presentation.NewTaskHandler(mockTasks).GetAllTasks)
+	return router, mockTasks, mockFields
+}
+
+// TestCustomFieldHandler_CreateField_Conflict verifica que una clave repetida responde 409
+func TestCustomFieldHandler_CreateField_Conflict(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, mockFields := setupCustomFieldRouter(ctrl)
+
+	mockFields.EXPECT().CreateField(gomock.Any(), "size", "Tamaño", domain.CustomFieldNumber, []string(nil)).
+		Return(nil, domain.ErrCustomFieldExists)
+
+	req, _ := http.NewRequest("POST", "/api/v1/custom-fields", bytes.NewBufferString(`{"key":"size","name":"Tamaño","type":"number"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+// TestCustomFieldHandler_SetTaskCustomFields_Success verifica que los valores llegan al servicio con null incluido
+func TestCustomFieldHandler_SetTaskCustomFields_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockTasks, _ := setupCustomFieldRouter(ctrl)
+
+	mockTasks.EXPECT().SetTaskCustomFields(gomock.Any(), 1, map[string]any{"size": 3.0, "priority": nil}).
+		Return(&domain.Task{ID: 1, Version: 4, CustomFields: map[string]any{"size": 3.0}}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/tasks/1/custom-fields", bytes.NewBufferString(`{"values":{"size":3,"priority":null}}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+}
+
+// TestTaskHandler_GetAllTasks_CustomFieldQuery verifica que los filtros cf.<clave> y el orden llegan al servicio
+func TestTaskHandler_GetAllTasks_CustomFieldQuery(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockTasks, _ := setupCustomFieldRouter(ctrl)
+
+	mockTasks.EXPECT().GetAllTasks(gomock.Any(), domain.TaskQueryOptions{
+		CustomFields: []domain.CustomFieldFilter{{Key: "priority", Raw: "alta"}, {Key: "size", Raw: "3"}},
+		SortBy:       &domain.CustomFieldSort{Key: "due", Desc: true},
+	}).Return([]*domain.Task{}, nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks?cf.size=3&cf.priority=alta&sort=-cf.due", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestTaskHandler_GetAllTasks_UnknownCustomField verifica que filtrar por un campo inexistente responde 400
+func TestTaskHandler_GetAllTasks_UnknownCustomField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockTasks, _ := setupCustomFieldRouter(ctrl)
+
+	mockTasks.EXPECT().GetAllTasks(gomock.Any(), gomock.Any()).Return(nil, domain.ErrCustomFieldNotFound)
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks?cf.color=rojo", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Un orden que no es por campo personalizado se rechaza sin llamar al servicio
+	req, _ = http.NewRequest("GET", "/api/v1/tasks?sort=title", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,82 @@
+package presentation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskHandler_AddDependency_Success verifica que se agrega un bloqueador
+func TestTaskHandler_AddDependency_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		AddDependency(gomock.Any(), 2, 1).
+		Return(nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/tasks/:id/dependencies", handler.AddDependency)
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{"blocker_id": 1})
+	req, _ := http.NewRequest("POST", "/tasks/2/dependencies", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+// TestTaskHandler_AddDependency_Cycle verifica que un ciclo responde 409
+func TestTaskHandler_AddDependency_Cycle(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		AddDependency(gomock.Any(), 1, 2).
+		Return(fmt.Errorf("no se puede: %w", domain.ErrDependencyCycle)).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/tasks/:id/dependencies", handler.AddDependency)
+
+	jsonBody, _ := json.Marshal(map[string]interface{}{"blocker_id": 2})
+	req, _ := http.NewRequest("POST", "/tasks/1/dependencies", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+
+	var response map[string]interface{}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, "Error adding dependency", response["error"])
+}
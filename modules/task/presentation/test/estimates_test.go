@@ -0,0 +1,122 @@
+package presentation_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupEstimateRouter crea un router de Gin con las rutas de estimaciones y los mocks de los servicios
+func setupEstimateRouter(ctrl *gomock.Controller) (*gin.Engine, *mocks.MockTaskServiceInterface, *mocks.MockEstimateReportServiceInterface) {
+	mockTasks := mocks.NewMockTaskServiceInterface(ctrl)
+	mockReports := mocks.NewMockEstimateReportServiceInterface(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupEstimateRoutes(router, presentation.NewEstimateHandler(mockTasks, mockReports))
+	return router, mockTasks, mockReports
+}
+
+// TestEstimateHandler_EstimateTask_Success verifica que la estimación llega al servicio
+func TestEstimateHandler_EstimateTask_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockTasks, _ := setupEstimateRouter(ctrl)
+
+	mockTasks.EXPECT().SetTaskEstimate(gomock.Any(), 1, 5, 7.5).
+		Return(&domain.Task{ID: 1, StoryPoints: 5, EstimatedHours: 7.5, RemainingHours: 7.5, Version: 2}, nil)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/tasks/1/estimate", bytes.NewBufferString(`{"story_points":5,"estimated_hours":7.5}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+}
+
+// TestEstimateHandler_EstimateTask_Invalid verifica que una estimación negativa responde 400
+func TestEstimateHandler_EstimateTask_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockTasks, _ := setupEstimateRouter(ctrl)
+
+	mockTasks.EXPECT().SetTaskEstimate(gomock.Any(), 1, -1, 0.0).Return(nil, domain.ErrInvalidEstimate)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/tasks/1/estimate", bytes.NewBufferString(`{"story_points":-1}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestEstimateHandler_UpdateRemainingWork_Required verifica que remaining_hours es obligatorio (0 es válido)
+func TestEstimateHandler_UpdateRemainingWork_Required(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockTasks, _ := setupEstimateRouter(ctrl)
+
+	req, _ := http.NewRequest("PUT", "/api/v1/tasks/1/remaining", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	mockTasks.EXPECT().UpdateRemainingWork(gomock.Any(), 1, 0.0).Return(&domain.Task{ID: 1, Version: 3}, nil)
+	req, _ = http.NewRequest("PUT", "/api/v1/tasks/1/remaining", bytes.NewBufferString(`{"remaining_hours":0}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestEstimateHandler_GetBurndown_BoardFilter verifica el rango inclusivo y el filtro de tablero
+func TestEstimateHandler_GetBurndown_BoardFilter(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, mockReports := setupEstimateRouter(ctrl)
+
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	mockReports.EXPECT().GetBurndown(gomock.Any(), from, to, 3).Return(domain.NewBurndown(from, to, 3, nil), nil)
+
+	req, _ := http.NewRequest("GET", "/api/v1/reports/burndown?from=2026-03-02&to=2026-03-13&board_id=3", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestEstimateHandler_GetVelocity_UnknownBoard verifica que un tablero inexistente responde 404
+func TestEstimateHandler_GetVelocity_UnknownBoard(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _, mockReports := setupEstimateRouter(ctrl)
+
+	mockReports.EXPECT().GetVelocity(gomock.Any(), gomock.Any(), gomock.Any(), 9).Return(nil, domain.ErrBoardNotFound)
+
+	req, _ := http.NewRequest("GET", "/api/v1/reports/velocity?from=2026-03-02&to=2026-03-29&board_id=9", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
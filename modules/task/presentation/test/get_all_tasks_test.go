@@ -46,7 +46,7 @@ func TestTaskHandler_GetAllTasks_Success(t *testing.T) {
 	}
 
 	// Expectativas del mock
-	mockService.EXPECT().GetAllTasks(gomock.Any()).Return(expectedTasks, nil).Times(1)
+	mockService.EXPECT().GetAllTasks(gomock.Any(), domain.TaskQueryOptions{}).Return(expectedTasks, nil).Times(1)
 
 	// Configurar Gin en modo test
 	gin.SetMode(gin.TestMode)
@@ -96,7 +96,7 @@ func TestTaskHandler_GetAllTasks_ServiceError(t *testing.T) {
 
 	// Expectativas del mock
 	mockService.EXPECT().
-		GetAllTasks(gomock.Any()).
+		GetAllTasks(gomock.Any(), domain.TaskQueryOptions{}).
 		Return(nil, serviceError).
 		Times(1)
 
@@ -138,7 +138,7 @@ func TestTaskHandler_GetAllTasks_EmptyList(t *testing.T) {
 
 	// Expectativas del mock
 	mockService.EXPECT().
-		GetAllTasks(gomock.Any()).
+		GetAllTasks(gomock.Any(), domain.TaskQueryOptions{}).
 		Return(emptyTasks, nil).
 		Times(1)
 
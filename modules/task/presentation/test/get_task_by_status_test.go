@@ -47,7 +47,7 @@ func TestTaskHandler_GetTaskByStatus_Success_Completed(t *testing.T) {
 
 	// Expectativas del mock
 	mockService.EXPECT().
-		GetTasksByStatus(gomock.Any(), true).
+		GetTasksByStatus(gomock.Any(), true, domain.TaskQueryOptions{}).
 		Return(expectedTasks, nil).
 		Times(1)
 
@@ -113,7 +113,7 @@ func TestTaskHandler_GetTaskByStatus_Success_NotCompleted(t *testing.T) {
 
 	// Expectativas del mock
 	mockService.EXPECT().
-		GetTasksByStatus(gomock.Any(), false).
+		GetTasksByStatus(gomock.Any(), false, domain.TaskQueryOptions{}).
 		Return(expectedTasks, nil).
 		Times(1)
 
@@ -168,7 +168,7 @@ func TestTaskHandler_GetTaskByStatus_Success_EmptyList(t *testing.T) {
 
 	// Expectativas del mock
 	mockService.EXPECT().
-		GetTasksByStatus(gomock.Any(), true).
+		GetTasksByStatus(gomock.Any(), true, domain.TaskQueryOptions{}).
 		Return(emptyTasks, nil).
 		Times(1)
 
@@ -218,7 +218,7 @@ func TestTaskHandler_GetTaskByStatus_ServiceError(t *testing.T) {
 
 	// Expectativas del mock
 	mockService.EXPECT().
-		GetTasksByStatus(gomock.Any(), true).
+		GetTasksByStatus(gomock.Any(), true, domain.TaskQueryOptions{}).
 		Return(nil, serviceError).
 		Times(1)
 
@@ -333,7 +333,7 @@ func TestTaskHandler_GetTaskByStatus_ValidParameter_Numeric(t *testing.T) {
 
 	// SÍ esperamos llamadas al servicio porque "1" es válido como true
 	mockService.EXPECT().
-		GetTasksByStatus(gomock.Any(), true).
+		GetTasksByStatus(gomock.Any(), true, domain.TaskQueryOptions{}).
 		Return(emptyTasks, nil).
 		Times(1)
 
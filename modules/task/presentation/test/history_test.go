@@ -0,0 +1,120 @@
+package presentation_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskHandler_GetTaskHistory_Success verifica que se lista el historial de la tarea
+func TestTaskHandler_GetTaskHistory_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().GetTaskHistory(gomock.Any(), 1).Return([]*domain.TaskRevision{
+		{ID: 1, TaskID: 1, Action: domain.RevisionCreate, Actor: "ana"},
+	}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks/:id/history", handler.GetTaskHistory)
+
+	req, _ := http.NewRequest("GET", "/tasks/1/history", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"action":"create"`)
+}
+
+// TestTaskHandler_GetTask_AsOf verifica que as_of consulta la tarea en esa fecha
+func TestTaskHandler_GetTask_AsOf(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	at := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	mockService.EXPECT().GetTaskAsOf(gomock.Any(), 1, at).Return(&domain.Task{ID: 1, Title: "Antes"}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks/:id", handler.GetTask)
+
+	req, _ := http.NewRequest("GET", "/tasks/1?as_of=2026-01-05T09:00:00Z", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Antes")
+}
+
+// TestTaskHandler_GetTask_AsOfInvalid verifica que una fecha inválida responde 400
+func TestTaskHandler_GetTask_AsOfInvalid(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/tasks/:id", handler.GetTask)
+
+	req, _ := http.NewRequest("GET", "/tasks/1?as_of=ayer", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestTaskHandler_RevertTask_RevisionNotFound verifica que una revisión inexistente responde 404
+func TestTaskHandler_RevertTask_RevisionNotFound(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().RevertTask(gomock.Any(), 1, 9).
+		Return(nil, fmt.Errorf("no se pudo obtener la revisión 9: %w", domain.ErrRevisionNotFound))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/tasks/:id/history/:revisionId/revert", handler.RevertTask)
+
+	req, _ := http.NewRequest("POST", "/tasks/1/history/9/revert", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
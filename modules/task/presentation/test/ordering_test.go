@@ -0,0 +1,68 @@
+package presentation_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskHandler_MoveTask_Success verifica que las referencias llegan al servicio
+func TestTaskHandler_MoveTask_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().MoveTask(gomock.Any(), 3, 1, 0).Return(&domain.Task{ID: 3, Rank: "h", Version: 2}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/tasks/:id/move", handler.MoveTask)
+
+	req, _ := http.NewRequest("POST", "/tasks/3/move", bytes.NewBufferString(`{"before": 1}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"2"`, w.Header().Get("ETag"))
+}
+
+// TestTaskHandler_MoveTask_InvalidMove verifica que referencias inválidas responden 400
+func TestTaskHandler_MoveTask_InvalidMove(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().MoveTask(gomock.Any(), 3, 0, 0).Return(nil, domain.ErrInvalidMove)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/tasks/:id/move", handler.MoveTask)
+
+	req, _ := http.NewRequest("POST", "/tasks/3/move", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
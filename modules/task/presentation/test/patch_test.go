@@ -0,0 +1,133 @@
+package presentation_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupPatchRouter crea un router de Gin con PATCH /tasks/:id y el mock del servicio
+func setupPatchRouter(ctrl *gomock.Controller) (*gin.Engine, *mocks.MockTaskServiceInterface) {
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.PATCH("/tasks/:id", presentation.NewTaskHandler(mockService).PatchTask)
+	return router, mockService
+}
+
+// patchRequest crea una petición PATCH con el tipo de contenido indicado
+func patchRequest(contentType, body string) *http.Request {
+	req, _ := http.NewRequest("PATCH", "/tasks/1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", contentType)
+	return req
+}
+
+// TestTaskHandler_PatchTask_MergePatchClearsDueDate verifica que null en un merge patch quita la fecha
+func TestTaskHandler_PatchTask_MergePatchClearsDueDate(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupPatchRouter(ctrl)
+
+	due := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	current := &domain.Task{ID: 1, Title: "T", Description: "D", DueDate: &due, Version: 4}
+	mockService.EXPECT().GetTaskByID(gomock.Any(), 1).Return(current, nil)
+	mockService.EXPECT().PatchTask(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ int, patch domain.TaskPatch) (*domain.Task, error) {
+			// Solo cambia la fecha y se fija la versión leída
+			assert.True(t, patch.ClearDueDate)
+			assert.Nil(t, patch.Title)
+			assert.Nil(t, patch.Completed)
+			return &domain.Task{ID: 1, Title: "T", Description: "D", Version: 5}, nil
+		})
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, patchRequest(presentation.MergePatchContentType, `{"due_date":null}`))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"5"`, w.Header().Get("ETag"))
+}
+
+// TestTaskHandler_PatchTask_JSONPatch verifica que las operaciones de JSON Patch se traducen al parche del dominio
+func TestTaskHandler_PatchTask_JSONPatch(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupPatchRouter(ctrl)
+
+	mockService.EXPECT().GetTaskByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	mockService.EXPECT().PatchTask(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, patch domain.TaskPatch) (*domain.Task, error) {
+			assert.Equal(t, "Nuevo", *patch.Title)
+			assert.True(t, *patch.Completed)
+			assert.Nil(t, patch.Description)
+			return &domain.Task{ID: 1, Title: "Nuevo", Description: "D", Completed: true}, nil
+		})
+	body := `[{"op":"test","path":"/title","value":"T"},{"op":"replace","path":"/title","value":"Nuevo"},{"op":"replace","path":"/completed","value":true}]`
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, patchRequest(presentation.JSONPatchContentType, body))
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+// TestTaskHandler_PatchTask_TestFailed verifica que una operación test fallida responde 409 sin guardar
+func TestTaskHandler_PatchTask_TestFailed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupPatchRouter(ctrl)
+
+	mockService.EXPECT().GetTaskByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+	// No se espera PatchTask
+	body := `[{"op":"test","path":"/title","value":"Otro"},{"op":"replace","path":"/title","value":"Nuevo"}]`
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, patchRequest(presentation.JSONPatchContentType, body))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+// TestTaskHandler_PatchTask_InvalidPatches verifica los errores de tipo de contenido y de campos
+func TestTaskHandler_PatchTask_InvalidPatches(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		status      int
+	}{
+		{"tipo de contenido no soportado", "application/json", `{"title":"Nuevo"}`, http.StatusUnsupportedMediaType},
+		{"campo desconocido", presentation.MergePatchContentType, `{"owner_id":3}`, http.StatusBadRequest},
+		{"título quitado", presentation.MergePatchContentType, `{"title":null}`, http.StatusBadRequest},
+		{"operación desconocida", presentation.JSONPatchContentType, `[{"op":"swap","path":"/title"}]`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			router, mockService := setupPatchRouter(ctrl)
+
+			mockService.EXPECT().GetTaskByID(gomock.Any(), 1).Return(&domain.Task{ID: 1, Title: "T", Description: "D"}, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, patchRequest(tt.contentType, tt.body))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
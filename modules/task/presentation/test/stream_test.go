@@ -0,0 +1,178 @@
+package presentation_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupStreamRouter crea un router de Gin con el stream de eventos y el mock del registro
+func setupStreamRouter(ctrl *gomock.Controller, heartbeat time.Duration) (*gin.Engine, *mocks.MockEventStream) {
+	mockStream := mocks.NewMockEventStream(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTaskStreamRoutes(router, presentation.NewTaskStreamHandler(mockStream, heartbeat))
+	return router, mockStream
+}
+
+// streamedTask arma un evento del stream para una tarea con estado y proyecto
+func streamedTask(id uint64, eventType domain.EventType, completed bool, project string) application.StreamedEvent {
+	task := &domain.Task{ID: int(id), Completed: completed, CustomFields: map[string]any{"project": project}}
+	return application.StreamedEvent{ID: id, Event: domain.Event{Type: eventType, TaskID: task.ID, Task: task}}
+}
+
+// closedSubscription arma una suscripción cuyos eventos en vivo ya están encolados y terminan
+func closedSubscription(lastID uint64, backlog []application.StreamedEvent, live ...application.StreamedEvent) *application.EventSubscription {
+	events := make(chan application.StreamedEvent, len(live))
+	for _, streamed := range live {
+		events <- streamed
+	}
+	close(events)
+	return &application.EventSubscription{LastID: lastID, Backlog: backlog, Events: events, Close: func() {}}
+}
+
+// TestTaskStreamHandler_StreamEvents_ResumesAndFilters verifica la reanudación con Last-Event-ID y los filtros
+func TestTaskStreamHandler_StreamEvents_ResumesAndFilters(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockStream := setupStreamRouter(ctrl, time.Minute)
+
+	mockStream.EXPECT().Subscribe(uint64(7)).Return(closedSubscription(8,
+		[]application.StreamedEvent{streamedTask(8, domain.EventTaskCompleted, true, "web")},
+		streamedTask(9, domain.EventTaskCreated, false, "web"),
+		streamedTask(10, domain.EventTaskCompleted, true, "api"),
+		streamedTask(11, domain.EventTaskCompleted, true, "web"),
+	))
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks/events?status=completed&project=web", nil)
+	req.Header.Set(presentation.LastEventIDHeader, "7")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	body := w.Body.String()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Contains(t, body, "retry: 3000\n\n")
+	assert.Contains(t, body, "id: 8\nevent: task.completed\ndata: {\"type\":\"task.completed\",\"task_id\":8,")
+	assert.Contains(t, body, "id: 11\nevent: task.completed\n")
+	assert.NotContains(t, body, "id: 9\nevent")
+	assert.NotContains(t, body, "id: 10\nevent")
+	assert.NotContains(t, body, "event: reset")
+}
+
+// TestTaskStreamHandler_StreamEvents_Reset verifica el aviso cuando el registro ya no tiene los eventos perdidos
+func TestTaskStreamHandler_StreamEvents_Reset(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockStream := setupStreamRouter(ctrl, time.Minute)
+
+	subscription := closedSubscription(40, nil)
+	subscription.Missed = true
+	mockStream.EXPECT().Subscribe(uint64(5)).Return(subscription)
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks/events?last_event_id=5", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "id: 40\nevent: reset\ndata: {}\n\n")
+}
+
+// TestTaskStreamHandler_StreamEvents_Heartbeat verifica el heartbeat con el último ID y el cierre de la suscripción
+func TestTaskStreamHandler_StreamEvents_Heartbeat(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockStream := setupStreamRouter(ctrl, 5*time.Millisecond)
+
+	closed := make(chan struct{})
+	mockStream.EXPECT().Subscribe(uint64(0)).Return(&application.EventSubscription{
+		LastID: 20,
+		Events: make(chan application.StreamedEvent),
+		Close:  func() { close(closed) },
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", "/api/v1/tasks/events", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Contains(t, w.Body.String(), "id: 20\n\n")
+	assert.Contains(t, w.Body.String(), ": heartbeat\nid: 20\n\n")
+	select {
+	case <-closed:
+	default:
+		t.Fatal("la suscripción no se cerró al desconectarse el cliente")
+	}
+}
+
+// TestTaskStreamHandler_StreamEvents_InvalidParams verifica los filtros y el Last-Event-ID inválidos
+func TestTaskStreamHandler_StreamEvents_InvalidParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, _ := setupStreamRouter(ctrl, time.Minute)
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks/events?status=archived", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	req, _ = http.NewRequest("GET", "/api/v1/tasks/events", nil)
+	req.Header.Set(presentation.LastEventIDHeader, "abc")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestFiberTaskStreamHandler_StreamEvents verifica el stream con Fiber antes de la ruta /tasks/:id
+func TestFiberTaskStreamHandler_StreamEvents(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mocks.NewMockEventStream(ctrl)
+
+	app := fiber.New()
+	presentation.SetupTaskStreamRoutesFiber(app, presentation.NewFiberTaskStreamHandler(mockStream, time.Minute))
+	app.Get("/tasks/:id", func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusTeapot) })
+
+	mockStream.EXPECT().Subscribe(uint64(3)).
+		Return(closedSubscription(3, nil, streamedTask(4, domain.EventTaskDeleted, false, "web")))
+
+	req := httptest.NewRequest("GET", "/tasks/events?status=pending", nil)
+	req.Header.Set(presentation.LastEventIDHeader, "3")
+
+	// Act
+	resp, err := app.Test(req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "id: 4\nevent: task.deleted\n")
+}
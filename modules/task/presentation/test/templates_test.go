@@ -0,0 +1,96 @@
+package presentation_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupTemplateRouter crea un router de Gin con las rutas de plantillas y el mock del servicio
+func setupTemplateRouter(ctrl *gomock.Controller) (*gin.Engine, *mocks.MockTemplateServiceInterface) {
+	mockService := mocks.NewMockTemplateServiceInterface(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTemplateRoutes(router, presentation.NewTemplateHandler(mockService))
+	return router, mockService
+}
+
+// TestTemplateHandler_InstantiateTemplate_Success verifica las variables y la fecha de inicio
+func TestTemplateHandler_InstantiateTemplate_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupTemplateRouter(ctrl)
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	mockService.EXPECT().InstantiateTemplate(gomock.Any(), 3, map[string]string{"name": "Ana"}, start).
+		Return([]*domain.Task{{ID: 10}, {ID: 11}}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/templates/3/instantiate",
+		bytes.NewBufferString(`{"variables":{"name":"Ana"},"start_date":"2026-03-02"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"count":2`)
+}
+
+// TestTemplateHandler_InstantiateTemplate_Errors verifica los códigos de variables faltantes y plantilla inexistente
+func TestTemplateHandler_InstantiateTemplate_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupTemplateRouter(ctrl)
+
+	// Sin cuerpo se instancia con la fecha de hoy
+	mockService.EXPECT().InstantiateTemplate(gomock.Any(), 3, map[string]string(nil), gomock.Any()).
+		Return(nil, domain.ErrMissingTemplateVariable)
+	req, _ := http.NewRequest("POST", "/api/v1/templates/3/instantiate", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	mockService.EXPECT().InstantiateTemplate(gomock.Any(), 4, gomock.Any(), gomock.Any()).
+		Return(nil, domain.ErrTemplateNotFound)
+	req, _ = http.NewRequest("POST", "/api/v1/templates/4/instantiate", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// Una fecha inválida no llega al servicio
+	req, _ = http.NewRequest("POST", "/api/v1/templates/4/instantiate", bytes.NewBufferString(`{"start_date":"02/03/2026"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestTemplateHandler_CreateTemplate_Invalid verifica que una plantilla inválida responde 400
+func TestTemplateHandler_CreateTemplate_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupTemplateRouter(ctrl)
+
+	mockService.EXPECT().CreateTemplate(gomock.Any(), "Vacía", "", gomock.Any()).Return(nil, domain.ErrInvalidTemplate)
+
+	req, _ := http.NewRequest("POST", "/api/v1/templates", bytes.NewBufferString(`{"name":"Vacía","tasks":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
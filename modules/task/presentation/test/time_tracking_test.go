@@ -0,0 +1,145 @@
+package presentation_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTimeHandler_StartTimer_AlreadyRunning verifica que un segundo temporizador responde 409
+func TestTimeHandler_StartTimer_AlreadyRunning(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTimeTrackingServiceInterface(ctrl)
+	handler := presentation.NewTimeHandler(mockService)
+
+	mockService.EXPECT().StartTimer(gomock.Any(), 1, 3).Return(nil, domain.ErrTimerAlreadyRunning)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTimeRoutes(router, handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/1/timer", nil)
+	req.Header.Set(presentation.CurrentUserHeader, "3")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+// TestTimeHandler_StopTimer_RequiresUser verifica que sin X-User-ID responde 401
+func TestTimeHandler_StopTimer_RequiresUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := presentation.NewTimeHandler(mocks.NewMockTimeTrackingServiceInterface(ctrl))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTimeRoutes(router, handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/timer/stop", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// TestTimeHandler_LogTime_Success verifica que la duración y la nota llegan al servicio
+func TestTimeHandler_LogTime_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTimeTrackingServiceInterface(ctrl)
+	handler := presentation.NewTimeHandler(mockService)
+
+	mockService.EXPECT().LogTime(gomock.Any(), 1, 3, nil, 90*time.Minute, "reunión").
+		Return(&domain.TimeEntry{ID: 1, TaskID: 1, UserID: 3, DurationSeconds: 5400}, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTimeRoutes(router, handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/1/time-entries", bytes.NewBufferString(`{"duration":"1h30m","note":"reunión"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(presentation.CurrentUserHeader, "3")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+// TestTimeHandler_LogTime_InvalidDuration verifica que una duración inválida responde 400
+func TestTimeHandler_LogTime_InvalidDuration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	handler := presentation.NewTimeHandler(mocks.NewMockTimeTrackingServiceInterface(ctrl))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTimeRoutes(router, handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/1/time-entries", bytes.NewBufferString(`{"duration":"una hora"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(presentation.CurrentUserHeader, "3")
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestTimeHandler_GetTimeReport_InclusiveDay verifica que "to" como fecha incluye ese día
+func TestTimeHandler_GetTimeReport_InclusiveDay(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTimeTrackingServiceInterface(ctrl)
+	handler := presentation.NewTimeHandler(mockService)
+
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	rows := []domain.TimeReportRow{{Key: "7", Seconds: 3600, Entries: 2}}
+	mockService.EXPECT().GetTimeReport(gomock.Any(), from, to, domain.TimeReportByUser).
+		Return(domain.NewTimeReport(from, to, domain.TimeReportByUser, rows), nil)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTimeRoutes(router, handler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/time-entries/report?from=2026-03-01&to=2026-03-31&group_by=user", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response struct {
+		Data domain.TimeReport `json:"data"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, int64(3600), response.Data.TotalSeconds)
+}
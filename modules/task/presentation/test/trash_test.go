@@ -0,0 +1,103 @@
+package presentation_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestTaskHandler_GetTrash_Success verifica el listado de la papelera
+func TestTaskHandler_GetTrash_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		GetTrash(gomock.Any()).
+		Return([]*domain.Task{{ID: 1, Title: "Eliminada"}}, nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTaskRoutes(router, handler)
+
+	req, _ := http.NewRequest("GET", "/api/v1/tasks/trash", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, float64(1), response["count"])
+}
+
+// TestTaskHandler_RestoreTask_NotInTrash verifica que restaurar una tarea fuera de la papelera responde 404
+func TestTaskHandler_RestoreTask_NotInTrash(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		RestoreTask(gomock.Any(), 7).
+		Return(nil, fmt.Errorf("no se pudo restaurar: %w", domain.ErrTaskNotInTrash)).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTaskRoutes(router, handler)
+
+	req, _ := http.NewRequest("POST", "/api/v1/tasks/7/restore", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+// TestTaskHandler_PermanentlyDeleteTask_Success verifica la eliminación definitiva desde la papelera
+func TestTaskHandler_PermanentlyDeleteTask_Success(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockTaskServiceInterface(ctrl)
+	handler := presentation.NewTaskHandler(mockService)
+
+	mockService.EXPECT().
+		PermanentlyDeleteTask(gomock.Any(), 3).
+		Return(nil).
+		Times(1)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupTaskRoutes(router, handler)
+
+	req, _ := http.NewRequest("DELETE", "/api/v1/tasks/trash/3", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+}
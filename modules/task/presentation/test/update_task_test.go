@@ -43,10 +43,19 @@ func TestTaskHandler_UpdateTask_Success(t *testing.T) {
 		UpdatedAt:   time.Now().UTC(),
 	}
 
-	// Expectativas del mock
-	completed := true
+	// Expectativas del mock: PUT reemplaza todos los campos editables
+	title, description, completed := "Tarea Actualizada", "Descripción actualizada", true
+	recurrence, points, hours := "", 0, 0.0
 	mockService.EXPECT().
-		UpdateTask(gomock.Any(), 1, "Tarea Actualizada", "Descripción actualizada", &completed).
+		PatchTask(gomock.Any(), 1, domain.TaskPatch{
+			Title:          &title,
+			Description:    &description,
+			Completed:      &completed,
+			ClearDueDate:   true,
+			Recurrence:     &recurrence,
+			StoryPoints:    &points,
+			EstimatedHours: &hours,
+		}).
 		Return(expectedTask, nil).
 		Times(1)
 
@@ -88,7 +97,7 @@ func TestTaskHandler_UpdateTask_Success(t *testing.T) {
 	assert.Equal(t, true, task["completed"])
 }
 
-// TestTaskHandler_UpdateTask_PartialUpdate verifica actualización parcial (solo algunos campos)
+// TestTaskHandler_UpdateTask_PartialUpdate verifica que PUT rechaza cuerpos parciales (se usa PATCH)
 func TestTaskHandler_UpdateTask_PartialUpdate(t *testing.T) {
 	// Arrange
 	ctrl := gomock.NewController(t)
@@ -97,27 +106,12 @@ func TestTaskHandler_UpdateTask_PartialUpdate(t *testing.T) {
 	mockService := mocks.NewMockTaskServiceInterface(ctrl)
 	handler := presentation.NewTaskHandler(mockService)
 
-	// Solo actualizamos el título
+	// Solo se envía el título: faltan description y completed
 	requestBody := map[string]interface{}{
 		"title": "Solo Título Actualizado",
 	}
 
-	// Tarea que devuelve el servicio
-	expectedTask := &domain.Task{
-		ID:          1,
-		Title:       "Solo Título Actualizado",
-		Description: "Descripción original", // No cambió
-		Completed:   false,                  // No cambió
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
-	}
-
-	// Expectativas del mock - completed será false por defecto
-	completed := false
-	mockService.EXPECT().
-		UpdateTask(gomock.Any(), 1, "Solo Título Actualizado", "", &completed).
-		Return(expectedTask, nil).
-		Times(1)
+	// NO esperamos llamadas al servicio porque falla la validación antes
 
 	// Configurar Gin en modo test
 	gin.SetMode(gin.TestMode)
@@ -134,23 +128,13 @@ func TestTaskHandler_UpdateTask_PartialUpdate(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
 
 	// Verificar el JSON de respuesta
 	var response map[string]interface{}
 	err := json.Unmarshal(w.Body.Bytes(), &response)
 	assert.NoError(t, err)
-
-	// Verificar estructura de respuesta
-	assert.Equal(t, "Task updated successfully", response["message"])
-
-	// Verificar que data existe
-	data, exists := response["data"]
-	assert.True(t, exists)
-
-	// Verificar que el título se actualizó
-	task := data.(map[string]interface{})
-	assert.Equal(t, "Solo Título Actualizado", task["title"])
+	assert.Equal(t, "Invalid request", response["error"])
 }
 
 // TestTaskHandler_UpdateTask_ServiceError verifica el manejo de errores del servicio
@@ -164,15 +148,16 @@ func TestTaskHandler_UpdateTask_ServiceError(t *testing.T) {
 
 	// Datos de entrada
 	requestBody := map[string]interface{}{
-		"title": "Tarea que fallará",
+		"title":       "Tarea que fallará",
+		"description": "Descripción",
+		"completed":   false,
 	}
 
 	serviceError := errors.New("task not found")
 
 	// Expectativas del mock
-	completed := false
 	mockService.EXPECT().
-		UpdateTask(gomock.Any(), 999, "Tarea que fallará", "", &completed).
+		PatchTask(gomock.Any(), 999, gomock.Any()).
 		Return(nil, serviceError).
 		Times(1)
 
@@ -283,7 +268,7 @@ func TestTaskHandler_UpdateTask_InvalidJSON_MalformedJSON(t *testing.T) {
 	assert.NotEmpty(t, response["message"])
 }
 
-// TestTaskHandler_UpdateTask_EmptyJSON verifica manejo de JSON vacío (debería ser válido)
+// TestTaskHandler_UpdateTask_EmptyJSON verifica que un JSON vacío no es un reemplazo válido
 func TestTaskHandler_UpdateTask_EmptyJSON(t *testing.T) {
 	// Arrange
 	ctrl := gomock.NewController(t)
@@ -292,22 +277,7 @@ func TestTaskHandler_UpdateTask_EmptyJSON(t *testing.T) {
 	mockService := mocks.NewMockTaskServiceInterface(ctrl)
 	handler := presentation.NewTaskHandler(mockService)
 
-	// Tarea que devuelve el servicio (sin cambios)
-	expectedTask := &domain.Task{
-		ID:          1,
-		Title:       "Título Original",
-		Description: "Descripción Original",
-		Completed:   false,
-		CreatedAt:   time.Now().UTC(),
-		UpdatedAt:   time.Now().UTC(),
-	}
-
-	// Expectativas del mock - todos los campos vacíos
-	completed := false
-	mockService.EXPECT().
-		UpdateTask(gomock.Any(), 1, "", "", &completed).
-		Return(expectedTask, nil).
-		Times(1)
+	// NO esperamos llamadas al servicio: PUT ya no marca la tarea como pendiente sin que se pida
 
 	// Configurar Gin en modo test
 	gin.SetMode(gin.TestMode)
@@ -323,14 +293,5 @@ func TestTaskHandler_UpdateTask_EmptyJSON(t *testing.T) {
 	router.ServeHTTP(w, req)
 
 	// Assert
-	assert.Equal(t, http.StatusOK, w.Code)
-
-	// Verificar el JSON de respuesta
-	var response map[string]interface{}
-	err := json.Unmarshal(w.Body.Bytes(), &response)
-	assert.NoError(t, err)
-
-	// Verificar estructura de respuesta
-	assert.Equal(t, "Task updated successfully", response["message"])
-	assert.NotNil(t, response["data"])
+	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
@@ -0,0 +1,346 @@
+package presentation
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// TimeHandler maneja las peticiones HTTP de registro de tiempo
+type TimeHandler struct {
+	timeService application.TimeTrackingServiceInterface
+}
+
+// NewTimeHandler crea una nueva instancia del handler de registro de tiempo
+func NewTimeHandler(timeService application.TimeTrackingServiceInterface) *TimeHandler {
+	return &TimeHandler{
+		timeService: timeService,
+	}
+}
+
+// LogTimeRequest representa la estructura de la petición para registrar tiempo manualmente
+type LogTimeRequest struct {
+	Duration  string     `json:"duration" binding:"required"` // Formato de Go: "1h30m", "45m"
+	StartedAt *time.Time `json:"started_at"`
+	Note      string     `json:"note"`
+}
+
+// timeErrorStatus traduce los errores de registro de tiempo a códigos HTTP
+func timeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrTimerAlreadyRunning):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrNoRunningTimer), errors.Is(err, domain.ErrBoardNotFound):
+		return http.StatusNotFound
+	}
+	return archivedErrorStatus(err, http.StatusBadRequest)
+}
+
+// parseLogDuration interpreta la duración de un registro manual
+func parseLogDuration(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < time.Second {
+		return 0, errors.New("duration must be a positive Go duration such as 1h30m")
+	}
+	return duration, nil
+}
+
+// parseReportRange interpreta from y to (RFC 3339 o YYYY-MM-DD); una fecha sin hora en to incluye ese día completo
+func parseReportRange(fromValue, toValue string) (time.Time, time.Time, error) {
+	parse := func(value string, endOfDay bool) (time.Time, error) {
+		if at, err := time.Parse(time.RFC3339, value); err == nil {
+			return at, nil
+		}
+		day, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if endOfDay {
+			day = day.AddDate(0, 0, 1)
+		}
+		return day, nil
+	}
+	from, err := parse(fromValue, false)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("from must be a date (YYYY-MM-DD) or an RFC 3339 timestamp")
+	}
+	to, err := parse(toValue, true)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("to must be a date (YYYY-MM-DD) or an RFC 3339 timestamp")
+	}
+	return from, to, nil
+}
+
+// requireCurrentUser obtiene el usuario de la cabecera X-User-ID; responde el error si falta o no es válido
+func requireCurrentUser(c *gin.Context) (int, bool) {
+	userID, status, err := resolveAssignee("me", c.GetHeader(CurrentUserHeader))
+	if err != nil {
+		c.JSON(status, gin.H{
+			"error":   "Invalid user",
+			"message": err.Error(),
+		})
+		return 0, false
+	}
+	return userID, true
+}
+
+// StartTimer inicia un temporizador del usuario actual sobre la tarea
+// @Summary Inicia un temporizador
+// @Description Un usuario solo puede tener un temporizador en curso (409 si ya tiene uno)
+// @Tags tiempo
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param X-User-ID header int true "Usuario actual"
+// @Success 201 {object} entities.TimeEntry
+// @Failure 409 {object} gin.H
+// @Router /tasks/{id}/timer [post]
+func (h *TimeHandler) StartTimer(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+	userID, ok := requireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.timeService.StartTimer(c.Request.Context(), int(taskID), userID)
+	if err != nil {
+		c.JSON(timeErrorStatus(err), gin.H{
+			"error":   "Error starting timer",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Timer started successfully",
+		"data":    entry,
+	})
+}
+
+// StopTimer detiene el temporizador en curso del usuario actual
+// @Summary Detiene el temporizador
+// @Tags tiempo
+// @Produce json
+// @Param X-User-ID header int true "Usuario actual"
+// @Success 200 {object} entities.TimeEntry
+// @Failure 404 {object} gin.H
+// @Router /timer/stop [post]
+func (h *TimeHandler) StopTimer(c *gin.Context) {
+	userID, ok := requireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	entry, err := h.timeService.StopTimer(c.Request.Context(), userID)
+	if err != nil {
+		c.JSON(timeErrorStatus(err), gin.H{
+			"error":   "Error stopping timer",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Timer stopped successfully",
+		"data":    entry,
+	})
+}
+
+// LogTime registra tiempo manualmente en una tarea
+// @Summary Registra tiempo manual
+// @Tags tiempo
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Param X-User-ID header int true "Usuario actual"
+// @Param entry body LogTimeRequest true "Duración y nota"
+// @Success 201 {object} entities.TimeEntry
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/time-entries [post]
+func (h *TimeHandler) LogTime(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+	userID, ok := requireCurrentUser(c)
+	if !ok {
+		return
+	}
+
+	var req LogTimeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+	duration, err := parseLogDuration(req.Duration)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid duration",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	entry, err := h.timeService.LogTime(c.Request.Context(), int(taskID), userID, req.StartedAt, duration, req.Note)
+	if err != nil {
+		c.JSON(timeErrorStatus(err), gin.H{
+			"error":   "Error logging time",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Time logged successfully",
+		"data":    entry,
+	})
+}
+
+// ListTimeEntries obtiene los registros de tiempo de una tarea y su total
+// @Summary Registros de tiempo de una tarea
+// @Tags tiempo
+// @Produce json
+// @Param id path int true "ID de la tarea"
+// @Success 200 {object} []entities.TimeEntry
+// @Failure 400 {object} gin.H
+// @Router /tasks/{id}/time-entries [get]
+func (h *TimeHandler) ListTimeEntries(c *gin.Context) {
+	taskID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	entries, total, err := h.timeService.ListTimeEntries(c.Request.Context(), int(taskID))
+	if err != nil {
+		c.JSON(timeErrorStatus(err), gin.H{
+			"error":   "Error getting time entries",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Time entries retrieved successfully",
+		"data":          entries,
+		"count":         len(entries),
+		"total_seconds": total,
+	})
+}
+
+// GetBoardTime obtiene el total de tiempo registrado en las tareas de un tablero
+// @Summary Tiempo total de un tablero
+// @Tags tiempo
+// @Produce json
+// @Param id path int true "ID del tablero"
+// @Success 200 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /boards/{id}/time [get]
+func (h *TimeHandler) GetBoardTime(c *gin.Context) {
+	boardID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+		return
+	}
+
+	total, err := h.timeService.GetBoardTime(c.Request.Context(), int(boardID))
+	if err != nil {
+		c.JSON(timeErrorStatus(err), gin.H{
+			"error":   "Error getting board time",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Board time retrieved successfully",
+		"total_seconds": total,
+	})
+}
+
+// GetProjectTime obtiene el total de tiempo registrado en las tareas de un proyecto
+// @Summary Tiempo total de un proyecto
+// @Description Suma el tiempo de las tareas cuyo campo personalizado project tiene ese valor
+// @Tags tiempo
+// @Produce json
+// @Param project path string true "Valor del campo personalizado project"
+// @Success 200 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Router /projects/{project}/time [get]
+func (h *TimeHandler) GetProjectTime(c *gin.Context) {
+	project := c.Param("project")
+	total, err := h.timeService.GetProjectTime(c.Request.Context(), project)
+	if err != nil {
+		c.JSON(timeErrorStatus(err), gin.H{
+			"error":   "Error getting project time",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Project time retrieved successfully",
+		"project":       project,
+		"total_seconds": total,
+	})
+}
+
+// GetTimeReport agrega el tiempo registrado en un rango por día, usuario, tarea o proyecto
+// @Summary Reporte de tiempo
+// @Tags tiempo
+// @Produce json
+// @Param from query string true "Inicio (YYYY-MM-DD o RFC 3339)"
+// @Param to query string true "Fin (YYYY-MM-DD inclusive o RFC 3339 exclusivo)"
+// @Param group_by query string false "day (por defecto), user, task o project"
+// @Success 200 {object} entities.TimeReport
+// @Failure 400 {object} gin.H
+// @Router /time-entries/report [get]
+func (h *TimeHandler) GetTimeReport(c *gin.Context) {
+	from, to, err := parseReportRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid range",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	groupBy := domain.TimeReportGroup(c.DefaultQuery("group_by", string(domain.TimeReportByDay)))
+	report, err := h.timeService.GetTimeReport(c.Request.Context(), from, to, groupBy)
+	if err != nil {
+		c.JSON(timeErrorStatus(err), gin.H{
+			"error":   "Error getting time report",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Time report retrieved successfully",
+		"data":    report,
+	})
+}
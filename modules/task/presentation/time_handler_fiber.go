@@ -0,0 +1,227 @@
+package presentation
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberTimeHandler maneja las peticiones HTTP de registro de tiempo con Fiber
+type FiberTimeHandler struct {
+	timeService application.TimeTrackingServiceInterface
+}
+
+// NewFiberTimeHandler crea una nueva instancia del handler de registro de tiempo con Fiber
+func NewFiberTimeHandler(timeService application.TimeTrackingServiceInterface) *FiberTimeHandler {
+	return &FiberTimeHandler{
+		timeService: timeService,
+	}
+}
+
+// FiberLogTimeRequest representa la estructura de la petición para registrar tiempo manualmente
+type FiberLogTimeRequest struct {
+	Duration  string     `json:"duration"`
+	StartedAt *time.Time `json:"started_at"`
+	Note      string     `json:"note"`
+}
+
+// fiberCurrentUser obtiene el usuario de la cabecera X-User-ID;
+// retorna el código y el cuerpo del error si falta o no es válido
+func fiberCurrentUser(c *fiber.Ctx) (int, int, fiber.Map) {
+	userID, status, err := resolveAssignee("me", c.Get(CurrentUserHeader))
+	if err != nil {
+		return 0, status, fiber.Map{
+			"error":   "Invalid user",
+			"message": err.Error(),
+		}
+	}
+	return userID, fiber.StatusOK, nil
+}
+
+// StartTimer inicia un temporizador del usuario actual sobre la tarea con Fiber
+func (h *FiberTimeHandler) StartTimer(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+	userID, status, invalid := fiberCurrentUser(c)
+	if invalid != nil {
+		return c.Status(status).JSON(invalid)
+	}
+
+	entry, err := h.timeService.StartTimer(c.Context(), int(taskID), userID)
+	if err != nil {
+		return c.Status(timeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error starting timer",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Timer started successfully",
+		"data":    entry,
+	})
+}
+
+// StopTimer detiene el temporizador en curso del usuario actual con Fiber
+func (h *FiberTimeHandler) StopTimer(c *fiber.Ctx) error {
+	userID, status, invalid := fiberCurrentUser(c)
+	if invalid != nil {
+		return c.Status(status).JSON(invalid)
+	}
+
+	entry, err := h.timeService.StopTimer(c.Context(), userID)
+	if err != nil {
+		return c.Status(timeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error stopping timer",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Timer stopped successfully",
+		"data":    entry,
+	})
+}
+
+// LogTime registra tiempo manualmente en una tarea con Fiber
+func (h *FiberTimeHandler) LogTime(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+	userID, status, invalid := fiberCurrentUser(c)
+	if invalid != nil {
+		return c.Status(status).JSON(invalid)
+	}
+
+	var req FiberLogTimeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+	duration, err := parseLogDuration(req.Duration)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid duration",
+			"message": err.Error(),
+		})
+	}
+
+	entry, err := h.timeService.LogTime(c.Context(), int(taskID), userID, req.StartedAt, duration, req.Note)
+	if err != nil {
+		return c.Status(timeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error logging time",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Time logged successfully",
+		"data":    entry,
+	})
+}
+
+// ListTimeEntries obtiene los registros de tiempo de una tarea y su total con Fiber
+func (h *FiberTimeHandler) ListTimeEntries(c *fiber.Ctx) error {
+	taskID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	entries, total, err := h.timeService.ListTimeEntries(c.Context(), int(taskID))
+	if err != nil {
+		return c.Status(timeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting time entries",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":       "Time entries retrieved successfully",
+		"data":          entries,
+		"count":         len(entries),
+		"total_seconds": total,
+	})
+}
+
+// GetBoardTime obtiene el total de tiempo registrado en las tareas de un tablero con Fiber
+func (h *FiberTimeHandler) GetBoardTime(c *fiber.Ctx) error {
+	boardID, err := strconv.ParseUint(c.Params("id"), 10, 32)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "ID must be a positive integer",
+		})
+	}
+
+	total, err := h.timeService.GetBoardTime(c.Context(), int(boardID))
+	if err != nil {
+		return c.Status(timeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting board time",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":       "Board time retrieved successfully",
+		"total_seconds": total,
+	})
+}
+
+// GetProjectTime obtiene el total de tiempo registrado en las tareas de un proyecto con Fiber
+func (h *FiberTimeHandler) GetProjectTime(c *fiber.Ctx) error {
+	project := c.Params("project")
+	total, err := h.timeService.GetProjectTime(c.Context(), project)
+	if err != nil {
+		return c.Status(timeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting project time",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":       "Project time retrieved successfully",
+		"project":       project,
+		"total_seconds": total,
+	})
+}
+
+// GetTimeReport agrega el tiempo registrado en un rango por día, usuario, tarea o proyecto con Fiber
+func (h *FiberTimeHandler) GetTimeReport(c *fiber.Ctx) error {
+	from, to, err := parseReportRange(c.Query("from"), c.Query("to"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid range",
+			"message": err.Error(),
+		})
+	}
+
+	groupBy := domain.TimeReportGroup(c.Query("group_by", string(domain.TimeReportByDay)))
+	report, err := h.timeService.GetTimeReport(c.Context(), from, to, groupBy)
+	if err != nil {
+		return c.Status(timeErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting time report",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Time report retrieved successfully",
+		"data":    report,
+	})
+}
@@ -10,15 +10,33 @@ import (
 
 // UserService maneja los casos de uso relacionados con usuarios
 type UserService struct {
-	userRepo domain.UserRepository
+	userRepo     domain.UserRepository
+	onDeactivate []DeactivationHook
+}
+
+// DeactivationHook se ejecuta después de desactivar un usuario (p. ej. para quitarle sus tareas)
+type DeactivationHook func(ctx context.Context, userID int) error
+
+// UserServiceOption configura dependencias opcionales de UserService
+type UserServiceOption func(*UserService)
+
+// WithDeactivationHook registra una acción a ejecutar cuando se desactiva un usuario
+func WithDeactivationHook(hook DeactivationHook) UserServiceOption {
+	return func(s *UserService) {
+		s.onDeactivate = append(s.onDeactivate, hook)
+	}
 }
 
 // NewUserService crea una nueva instancia de UserService
 
-func NewUserService(userRepo domain.UserRepository) *UserService {
-	return &UserService{
+func NewUserService(userRepo domain.UserRepository, opts ...UserServiceOption) *UserService {
+	s := &UserService{
 		userRepo: userRepo,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateUser crea un nuevo usuario
@@ -169,6 +187,12 @@ func (s *UserService) DeactivateUser(ctx context.Context, id int) error {
 		return fmt.Errorf("no se pudo desactivar el usuario: %w", err)
 	}
 
+	for _, hook := range s.onDeactivate {
+		if err := hook(ctx, id); err != nil {
+			return fmt.Errorf("el usuario se desactivó pero falló una acción posterior: %w", err)
+		}
+	}
+
 	return nil
 }
 
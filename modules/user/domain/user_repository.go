@@ -9,6 +9,7 @@ type UserRepository interface {
 	GetByUsername(ctx context.Context, username string) (*User, error) // Obtiene un usuario por nombre de usuario
 	GetByEmail(ctx context.Context, email string) (*User, error)       // Obtiene un usuario por email
 	GetAll(ctx context.Context) ([]*User, error)                       // Obtiene todos los usuarios
+	GetByIDs(ctx context.Context, ids []int) ([]*User, error)          // Obtiene los usuarios de una lista de IDs
 	Update(ctx context.Context, user *User) (*User, error)             // Actualiza un usuario
 	Delete(ctx context.Context, id int) error                          // Elimina un usuario
 	GetActiveUsers(ctx context.Context) ([]*User, error)               // Obtiene todos los usuarios activos
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/YerkoTenorio/api-go-hexagonal/modules/user/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
 	"gorm.io/gorm"
 )
 
@@ -22,7 +23,7 @@ func NewGormUserRepository(db *gorm.DB) *GormUserRepository {
 func (r *GormUserRepository) Create(ctx context.Context, user *domain.User) (*domain.User, error) {
 	gormUser := r.toGormModel(user)
 
-	result := r.db.WithContext(ctx).Create(gormUser)
+	result := database.GormConn(ctx, r.db).Create(gormUser)
 	if result.Error != nil {
 		return nil, fmt.Errorf("error al crear usuario: %w", result.Error)
 	}
@@ -35,7 +36,7 @@ func (r *GormUserRepository) Create(ctx context.Context, user *domain.User) (*do
 func (r *GormUserRepository) GetByID(ctx context.Context, id int) (*domain.User, error) {
 	var gormUser GormUserModel
 
-	result := r.db.WithContext(ctx).First(&gormUser, id)
+	result := database.GormConn(ctx, r.db).First(&gormUser, id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("usuario con ID %d no encontrado", id)
@@ -51,7 +52,7 @@ func (r *GormUserRepository) GetByID(ctx context.Context, id int) (*domain.User,
 func (r *GormUserRepository) GetByUsername(ctx context.Context, username string) (*domain.User, error) {
 	var gormUser GormUserModel
 
-	result := r.db.WithContext(ctx).First(&gormUser, username)
+	result := database.GormConn(ctx, r.db).First(&gormUser, username)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, fmt.Errorf("usuario con username %s no encontrado", username)
@@ -67,7 +68,7 @@ func (r *GormUserRepository) GetByUsername(ctx context.Context, username string)
 func (r *GormUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var gormUser GormUserModel
 
-	result := r.db.WithContext(ctx).Where("email = ?", email).First(&gormUser)
+	result := database.GormConn(ctx, r.db).Where("email = ?", email).First(&gormUser)
 	if result.Error != nil {
 		if result.Error != nil {
 			if result.Error == gorm.ErrRecordNotFound {
@@ -84,7 +85,26 @@ func (r *GormUserRepository) GetByEmail(ctx context.Context, email string) (*dom
 func (r *GormUserRepository) GetAll(ctx context.Context) ([]*domain.User, error) {
 	var gormUsers []GormUserModel
 
-	result := r.db.WithContext(ctx).Find(&gormUsers)
+	result := database.GormConn(ctx, r.db).Find(&gormUsers)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error al obtener usuarios: %w", result.Error)
+	}
+
+	users := make([]*domain.User, len(gormUsers))
+	for i, gormUser := range gormUsers {
+		users[i] = r.toDomainModel(&gormUser)
+	}
+	return users, nil
+}
+
+// GetByIDs obtiene los usuarios de una lista de IDs en una sola consulta; los IDs que no existen se omiten
+func (r *GormUserRepository) GetByIDs(ctx context.Context, ids []int) ([]*domain.User, error) {
+	if len(ids) == 0 {
+		return []*domain.User{}, nil
+	}
+	var gormUsers []GormUserModel
+
+	result := database.GormConn(ctx, r.db).Where("id IN ?", ids).Find(&gormUsers)
 	if result.Error != nil {
 		return nil, fmt.Errorf("error al obtener usuarios: %w", result.Error)
 	}
@@ -101,7 +121,7 @@ func (r *GormUserRepository) GetAll(ctx context.Context) ([]*domain.User, error)
 func (r *GormUserRepository) GetActiveUsers(ctx context.Context) ([]*domain.User, error) {
 	var gormUsers []GormUserModel
 
-	result := r.db.WithContext(ctx).Where("active = ?", true).Find(&gormUsers)
+	result := database.GormConn(ctx, r.db).Where("active = ?", true).Find(&gormUsers)
 	if result.Error != nil {
 		return nil, fmt.Errorf("error al obtener usuarios activos: %w", result.Error)
 	}
@@ -119,7 +139,7 @@ func (r *GormUserRepository) GetActiveUsers(ctx context.Context) ([]*domain.User
 func (r *GormUserRepository) Update(ctx context.Context, user *domain.User) (*domain.User, error) {
 	gormUser := r.toGormModel(user)
 
-	result := r.db.WithContext(ctx).Save(gormUser)
+	result := database.GormConn(ctx, r.db).Save(gormUser)
 	if result.Error != nil {
 		return nil, fmt.Errorf("error al actualizar usuario: %w", result.Error)
 	}
@@ -130,7 +150,7 @@ func (r *GormUserRepository) Update(ctx context.Context, user *domain.User) (*do
 // Delete elimina un usuario por su ID
 
 func (r *GormUserRepository) Delete(ctx context.Context, id int) error {
-	result := r.db.WithContext(ctx).Delete(&GormUserModel{}, id)
+	result := database.GormConn(ctx, r.db).Delete(&GormUserModel{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("error al eliminar usuario: %w", result.Error)
 	}
@@ -0,0 +1,33 @@
+package application
+
+import (
+	"context"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=../presentation/mocks/mock_webhook_service.go -package=mocks
+
+// WebhookServiceInterface define el contrato que usan los handlers de webhooks
+type WebhookServiceInterface interface {
+	// CreateSubscription crea una suscripción; si secret está vacío se genera uno
+	CreateSubscription(ctx context.Context, url string, events []string, secret string) (*domain.Subscription, error)
+
+	// GetSubscription obtiene una suscripción por su ID
+	GetSubscription(ctx context.Context, id int) (*domain.Subscription, error)
+
+	// ListSubscriptions obtiene todas las suscripciones
+	ListSubscriptions(ctx context.Context) ([]*domain.Subscription, error)
+
+	// UpdateSubscription reemplaza la URL, el filtro de eventos y el estado; un secret vacío conserva el actual
+	UpdateSubscription(ctx context.Context, id int, url string, events []string, active bool, secret string) (*domain.Subscription, error)
+
+	// DeleteSubscription elimina una suscripción junto con su registro de entregas
+	DeleteSubscription(ctx context.Context, id int) error
+
+	// ListDeliveries obtiene las últimas entregas de una suscripción
+	ListDeliveries(ctx context.Context, id int, limit int) ([]*domain.Delivery, error)
+
+	// SendTestEvent envía en el momento un evento de prueba y retorna el resultado
+	SendTestEvent(ctx context.Context, id int) (*domain.Delivery, error)
+}
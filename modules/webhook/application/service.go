@@ -0,0 +1,223 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+)
+
+const (
+	// deliveryLease es cuánto tiempo una entrega reservada no se intenta en otro despachador; debe
+	// superar el timeout del envío
+	deliveryLease = 5 * time.Minute
+	// DefaultDeliveryLogLimit es la cantidad de entregas que se listan si no se indica otra
+	DefaultDeliveryLogLimit = 50
+	// MaxDeliveryLogLimit es la cantidad máxima de entregas que se listan
+	MaxDeliveryLogLimit = 500
+)
+
+// WebhookService maneja las suscripciones de webhooks y la entrega de los eventos de tareas
+type WebhookService struct {
+	repo   domain.Repository
+	sender domain.Sender
+	policy domain.RetryPolicy
+}
+
+// NewWebhookService crea una nueva instancia de WebhookService; las entregas fallidas se reintentan según policy
+func NewWebhookService(repo domain.Repository, sender domain.Sender, policy domain.RetryPolicy) *WebhookService {
+	return &WebhookService{
+		repo:   repo,
+		sender: sender,
+		policy: policy,
+	}
+}
+
+// CreateSubscription crea una suscripción; si secret está vacío se genera uno
+func (s *WebhookService) CreateSubscription(ctx context.Context, url string, events []string, secret string) (*domain.Subscription, error) {
+	subscription, err := domain.NewSubscription(url, events, secret)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.repo.CreateSubscription(ctx, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo crear la suscripción: %w", err)
+	}
+	return created, nil
+}
+
+// GetSubscription obtiene una suscripción por su ID
+func (s *WebhookService) GetSubscription(ctx context.Context, id int) (*domain.Subscription, error) {
+	return s.repo.GetSubscription(ctx, id)
+}
+
+// ListSubscriptions obtiene todas las suscripciones
+func (s *WebhookService) ListSubscriptions(ctx context.Context) ([]*domain.Subscription, error) {
+	subscriptions, err := s.repo.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener las suscripciones: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscription reemplaza la URL, el filtro de eventos y el estado; un secret vacío conserva el actual
+func (s *WebhookService) UpdateSubscription(ctx context.Context, id int, url string, events []string, active bool, secret string) (*domain.Subscription, error) {
+	subscription, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := subscription.Update(url, events, active, secret); err != nil {
+		return nil, err
+	}
+	updated, err := s.repo.UpdateSubscription(ctx, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo actualizar la suscripción: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteSubscription elimina una suscripción junto con su registro de entregas
+func (s *WebhookService) DeleteSubscription(ctx context.Context, id int) error {
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+// ListDeliveries obtiene las últimas entregas de una suscripción; limit 0 usa DefaultDeliveryLogLimit
+func (s *WebhookService) ListDeliveries(ctx context.Context, id int, limit int) ([]*domain.Delivery, error) {
+	if limit <= 0 {
+		limit = DefaultDeliveryLogLimit
+	}
+	if _, err := s.repo.GetSubscription(ctx, id); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.repo.ListDeliveries(ctx, id, min(limit, MaxDeliveryLogLimit))
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron obtener las entregas de la suscripción %d: %w", id, err)
+	}
+	return deliveries, nil
+}
+
+// SendTestEvent envía en el momento un evento webhook.test a la suscripción, aunque esté inactiva,
+// y retorna el resultado. La entrega queda en el registro y no se reintenta
+func (s *WebhookService) SendTestEvent(ctx context.Context, id int) (*domain.Delivery, error) {
+	subscription, err := s.repo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(taskdomain.Event{Type: domain.EventTest, OccurredAt: time.Now().UTC()})
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo armar el evento de prueba: %w", err)
+	}
+	delivery := domain.NewDelivery(subscription.ID, domain.EventTest, payload)
+	if err := s.repo.CreateDeliveries(ctx, []*domain.Delivery{delivery}); err != nil {
+		return nil, fmt.Errorf("no se pudo registrar la entrega de prueba: %w", err)
+	}
+
+	status, sendErr := s.sender.Send(ctx, subscription, delivery)
+	delivery.RecordAttempt(status, sendErr, domain.RetryPolicy{MaxAttempts: 1})
+	if err := s.repo.UpdateDelivery(ctx, delivery); err != nil {
+		return nil, fmt.Errorf("no se pudo registrar el resultado de la entrega de prueba: %w", err)
+	}
+	return delivery, nil
+}
+
+// Publish crea una entrega por cada suscripción activa interesada en cada evento; el despachador
+// las envía después. Sirve como destino del bus o del outbox de eventos de tareas
+func (s *WebhookService) Publish(ctx context.Context, events []taskdomain.Event) error {
+	subscriptions, err := s.repo.ListSubscriptions(ctx)
+	if err != nil {
+		return fmt.Errorf("no se pudieron obtener las suscripciones: %w", err)
+	}
+
+	var deliveries []*domain.Delivery
+	for _, event := range events {
+		var payload []byte
+		for _, subscription := range subscriptions {
+			if !subscription.Matches(string(event.Type)) {
+				continue
+			}
+			if payload == nil {
+				if payload, err = json.Marshal(event); err != nil {
+					return fmt.Errorf("no se pudo serializar el evento %s: %w", event.Type, err)
+				}
+			}
+			deliveries = append(deliveries, domain.NewDelivery(subscription.ID, string(event.Type), payload))
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := s.repo.CreateDeliveries(ctx, deliveries); err != nil {
+		return fmt.Errorf("no se pudieron registrar las entregas de webhooks: %w", err)
+	}
+	return nil
+}
+
+// DeliverDue envía hasta limit entregas cuyo intento ya corresponde y retorna cuántas intentó
+func (s *WebhookService) DeliverDue(ctx context.Context, limit int) (int, error) {
+	deliveries, err := s.repo.ClaimDeliveries(ctx, limit, deliveryLease)
+	if err != nil {
+		return 0, fmt.Errorf("no se pudieron reservar las entregas de webhooks: %w", err)
+	}
+
+	subscriptions := make(map[int]*domain.Subscription)
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = s.repo.GetSubscription(ctx, delivery.SubscriptionID)
+			if err != nil && !errors.Is(err, domain.ErrSubscriptionNotFound) {
+				return 0, fmt.Errorf("no se pudo obtener la suscripción %d: %w", delivery.SubscriptionID, err)
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+		// La suscripción se eliminó después de reservar la entrega, que se eliminó con ella
+		if subscription == nil {
+			continue
+		}
+
+		if subscription.Active {
+			status, sendErr := s.sender.Send(ctx, subscription, delivery)
+			delivery.RecordAttempt(status, sendErr, s.policy)
+			if delivery.Status == domain.DeliveryDead {
+				log.Printf("Webhook: la entrega %d a la suscripción %d agotó %d intentos: %s", delivery.ID, subscription.ID, delivery.Attempts, delivery.LastError)
+			}
+		} else {
+			delivery.Abandon("la suscripción está inactiva")
+		}
+		if err := s.repo.UpdateDelivery(ctx, delivery); err != nil {
+			return 0, fmt.Errorf("no se pudo registrar el resultado de la entrega %d: %w", delivery.ID, err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+// RunDispatcher ejecuta DeliverDue cada interval, en lotes de batchSize, hasta que se cancele el contexto
+func (s *WebhookService) RunDispatcher(ctx context.Context, interval time.Duration, batchSize int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// Mientras los lotes salgan llenos hay más entregas esperando
+		for {
+			sent, err := s.DeliverDue(ctx, batchSize)
+			if err != nil {
+				log.Printf("Error entregando webhooks: %v", err)
+				break
+			}
+			if sent < batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package application_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/application/mocks"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var testPolicy = domain.RetryPolicy{MaxAttempts: 3, BackoffBase: time.Second, BackoffMax: time.Minute}
+
+// TestWebhookService_Publish_FiltersSubscriptions verifica que solo las suscripciones activas e
+// interesadas en el evento reciben una entrega
+func TestWebhookService_Publish_FiltersSubscriptions(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepository(ctrl)
+	service := application.NewWebhookService(mockRepo, mocks.NewMockSender(ctrl), testPolicy)
+
+	mockRepo.EXPECT().ListSubscriptions(gomock.Any()).Return([]*domain.Subscription{
+		{ID: 1, Active: true},
+		{ID: 2, Active: true, Events: []string{"task.completed"}},
+		{ID: 3, Active: false},
+	}, nil)
+	mockRepo.EXPECT().CreateDeliveries(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, deliveries []*domain.Delivery) error {
+			assert.Len(t, deliveries, 3)
+			assert.Equal(t, 1, deliveries[0].SubscriptionID)
+			assert.Equal(t, "task.created", deliveries[0].EventType)
+			assert.Equal(t, 1, deliveries[1].SubscriptionID)
+			assert.Equal(t, 2, deliveries[2].SubscriptionID)
+			assert.Equal(t, "task.completed", deliveries[2].EventType)
+			assert.Equal(t, domain.DeliveryPending, deliveries[2].Status)
+			return nil
+		})
+
+	// Act
+	err := service.Publish(context.Background(), []taskdomain.Event{
+		{Type: taskdomain.EventTaskCreated, TaskID: 7},
+		{Type: taskdomain.EventTaskCompleted, TaskID: 7},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestWebhookService_Publish_NoMatches verifica que sin suscripciones interesadas no se escribe nada
+func TestWebhookService_Publish_NoMatches(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepository(ctrl)
+	service := application.NewWebhookService(mockRepo, mocks.NewMockSender(ctrl), testPolicy)
+
+	mockRepo.EXPECT().ListSubscriptions(gomock.Any()).
+		Return([]*domain.Subscription{{ID: 2, Active: true, Events: []string{"task.completed"}}}, nil)
+
+	// Act
+	err := service.Publish(context.Background(), []taskdomain.Event{{Type: taskdomain.EventTaskCreated, TaskID: 7}})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+// TestWebhookService_DeliverDue_RecordsResults verifica el envío, el reintento y el abandono de
+// entregas de suscripciones inactivas o eliminadas
+func TestWebhookService_DeliverDue_RecordsResults(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepository(ctrl)
+	mockSender := mocks.NewMockSender(ctrl)
+	service := application.NewWebhookService(mockRepo, mockSender, testPolicy)
+
+	ok := &domain.Delivery{ID: 1, SubscriptionID: 1, Status: domain.DeliveryPending}
+	failing := &domain.Delivery{ID: 2, SubscriptionID: 1, Status: domain.DeliveryPending}
+	inactive := &domain.Delivery{ID: 3, SubscriptionID: 2, Status: domain.DeliveryPending}
+	deleted := &domain.Delivery{ID: 4, SubscriptionID: 3, Status: domain.DeliveryPending}
+	active := &domain.Subscription{ID: 1, Active: true}
+
+	mockRepo.EXPECT().ClaimDeliveries(gomock.Any(), 10, gomock.Any()).
+		Return([]*domain.Delivery{ok, failing, inactive, deleted}, nil)
+	mockRepo.EXPECT().GetSubscription(gomock.Any(), 1).Return(active, nil).Times(1)
+	mockRepo.EXPECT().GetSubscription(gomock.Any(), 2).Return(&domain.Subscription{ID: 2}, nil)
+	mockRepo.EXPECT().GetSubscription(gomock.Any(), 3).Return(nil, domain.ErrSubscriptionNotFound)
+	mockSender.EXPECT().Send(gomock.Any(), active, ok).Return(200, nil)
+	mockSender.EXPECT().Send(gomock.Any(), active, failing).Return(503, errors.New("el receptor respondió 503"))
+	mockRepo.EXPECT().UpdateDelivery(gomock.Any(), ok).Return(nil)
+	mockRepo.EXPECT().UpdateDelivery(gomock.Any(), failing).Return(nil)
+	mockRepo.EXPECT().UpdateDelivery(gomock.Any(), inactive).Return(nil)
+
+	// Act
+	sent, err := service.DeliverDue(context.Background(), 10)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 4, sent)
+	assert.Equal(t, domain.DeliverySucceeded, ok.Status)
+	assert.Equal(t, domain.DeliveryRetrying, failing.Status)
+	assert.Equal(t, 503, failing.ResponseStatus)
+	assert.NotNil(t, failing.NextAttemptAt)
+	assert.Equal(t, domain.DeliveryDead, inactive.Status)
+	assert.Zero(t, inactive.Attempts)
+}
+
+// TestWebhookService_ListDeliveries_Limit verifica el límite por defecto y el máximo del registro de entregas
+func TestWebhookService_ListDeliveries_Limit(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepository(ctrl)
+	service := application.NewWebhookService(mockRepo, mocks.NewMockSender(ctrl), testPolicy)
+
+	mockRepo.EXPECT().GetSubscription(gomock.Any(), 1).Return(&domain.Subscription{ID: 1}, nil).Times(2)
+	mockRepo.EXPECT().ListDeliveries(gomock.Any(), 1, application.DefaultDeliveryLogLimit).Return(nil, nil)
+	mockRepo.EXPECT().ListDeliveries(gomock.Any(), 1, application.MaxDeliveryLogLimit).Return(nil, nil)
+	mockRepo.EXPECT().GetSubscription(gomock.Any(), 9).Return(nil, domain.ErrSubscriptionNotFound)
+
+	// Act
+	_, errDefault := service.ListDeliveries(context.Background(), 1, 0)
+	_, errMax := service.ListDeliveries(context.Background(), 1, 10000)
+	_, errMissing := service.ListDeliveries(context.Background(), 9, 10)
+
+	// Assert
+	assert.NoError(t, errDefault)
+	assert.NoError(t, errMax)
+	assert.ErrorIs(t, errMissing, domain.ErrSubscriptionNotFound)
+}
+
+// TestWebhookService_UpdateSubscription_Invalid verifica que una URL inválida no se guarda
+func TestWebhookService_UpdateSubscription_Invalid(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockRepository(ctrl)
+	service := application.NewWebhookService(mockRepo, mocks.NewMockSender(ctrl), testPolicy)
+
+	mockRepo.EXPECT().GetSubscription(gomock.Any(), 1).
+		Return(&domain.Subscription{ID: 1, URL: "https://example.com", Secret: "s", Active: true}, nil)
+
+	// Act
+	_, err := service.UpdateSubscription(context.Background(), 1, "ftp://example.com", nil, true, "")
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrInvalidSubscription)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=repository.go -destination=../application/mocks/mock_repository.go -package=mocks
+
+// Repository define el puerto para persistir las suscripciones de webhooks y sus entregas
+type Repository interface {
+	// CreateSubscription guarda una suscripción nueva y la retorna con su ID
+	CreateSubscription(ctx context.Context, subscription *Subscription) (*Subscription, error)
+	// GetSubscription obtiene una suscripción; ErrSubscriptionNotFound si no existe
+	GetSubscription(ctx context.Context, id int) (*Subscription, error)
+	// ListSubscriptions obtiene todas las suscripciones
+	ListSubscriptions(ctx context.Context) ([]*Subscription, error)
+	// UpdateSubscription guarda los cambios de una suscripción
+	UpdateSubscription(ctx context.Context, subscription *Subscription) (*Subscription, error)
+	// DeleteSubscription elimina una suscripción con sus entregas
+	DeleteSubscription(ctx context.Context, id int) error
+
+	// CreateDeliveries guarda entregas pendientes y les asigna su ID
+	CreateDeliveries(ctx context.Context, deliveries []*Delivery) error
+	// ClaimDeliveries reserva durante lease hasta limit entregas cuyo intento ya corresponde, en orden de ID
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+	// UpdateDelivery guarda el resultado de un intento y libera la reserva
+	UpdateDelivery(ctx context.Context, delivery *Delivery) error
+	// ListDeliveries obtiene las últimas limit entregas de una suscripción, de la más reciente a la más antigua
+	ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*Delivery, error)
+}
@@ -0,0 +1,12 @@
+package domain
+
+import "context"
+
+//go:generate mockgen -source=sender.go -destination=../application/mocks/mock_sender.go -package=mocks
+
+// Sender es el puerto para enviar una entrega firmada al receptor de la suscripción
+type Sender interface {
+	// Send envía la entrega y retorna el código HTTP de la respuesta (0 si no la hubo). Una respuesta
+	// fuera de 2xx es un error
+	Send(ctx context.Context, subscription *Subscription, delivery *Delivery) (int, error)
+}
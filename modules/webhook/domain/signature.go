@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// Cabeceras de cada entrega
+const (
+	// SignatureHeader lleva la firma "sha256=<hex>" del cuerpo
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader lleva el momento del envío en segundos Unix; forma parte de lo firmado
+	TimestampHeader = "X-Webhook-Timestamp"
+	// EventHeader lleva el tipo de evento
+	EventHeader = "X-Webhook-Event"
+	// DeliveryHeader lleva el ID de la entrega; se repite en los reintentos, así el receptor descarta duplicados
+	DeliveryHeader = "X-Webhook-Delivery"
+)
+
+// signaturePrefix identifica el algoritmo de la firma
+const signaturePrefix = "sha256="
+
+// Sign firma el cuerpo con HMAC-SHA256 sobre "<timestamp>.<cuerpo>" y retorna "sha256=<hex>"
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature comprueba la firma de una entrega y que su timestamp (segundos Unix) no se aleje
+// de ahora más que tolerance, para rechazar entregas repetidas por un tercero
+func VerifySignature(secret, timestamp string, body []byte, signature string, tolerance time.Duration) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	sent := time.Unix(seconds, 0)
+	if age := time.Since(sent); age > tolerance || age < -tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, sent, body)), []byte(signature))
+}
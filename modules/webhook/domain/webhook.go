@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+)
+
+var (
+	// ErrSubscriptionNotFound indica que la suscripción no existe
+	ErrSubscriptionNotFound = errors.New("la suscripción de webhook no existe")
+	// ErrInvalidSubscription indica que la URL o el filtro de eventos de la suscripción no son válidos
+	ErrInvalidSubscription = errors.New("suscripción de webhook inválida")
+)
+
+// EventTest es el tipo del evento de prueba que se envía a pedido
+const EventTest = "webhook.test"
+
+// Estados de una entrega
+const (
+	// DeliveryPending es una entrega que todavía no se intentó
+	DeliveryPending = "pending"
+	// DeliveryRetrying es una entrega que falló y espera su próximo intento
+	DeliveryRetrying = "retrying"
+	// DeliverySucceeded es una entrega que el receptor aceptó con un código 2xx
+	DeliverySucceeded = "succeeded"
+	// DeliveryDead es una entrega que agotó los reintentos o que ya no puede entregarse
+	DeliveryDead = "dead"
+)
+
+// maxErrorLength limita el largo del error guardado en el registro de entregas
+const maxErrorLength = 500
+
+// EventTypes son los tipos de evento a los que puede suscribirse un webhook
+var EventTypes = []string{
+	string(taskdomain.EventTaskCreated),
+	string(taskdomain.EventTaskUpdated),
+	string(taskdomain.EventTaskCompleted),
+	string(taskdomain.EventTaskReopened),
+	string(taskdomain.EventTaskDeleted),
+}
+
+// Subscription es un receptor de webhooks. Sin Events recibe todos los tipos de evento
+type Subscription struct {
+	ID     int      `json:"id"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	// Secret firma las entregas; no se expone al listar las suscripciones
+	Secret    string    `json:"-"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewSubscription crea una suscripción activa; si secret está vacío se genera uno aleatorio
+func NewSubscription(rawURL string, events []string, secret string) (*Subscription, error) {
+	now := time.Now().UTC()
+	s := &Subscription{Active: true, CreatedAt: now, UpdatedAt: now}
+	if err := s.Update(rawURL, events, true, secret); err != nil {
+		return nil, err
+	}
+	if s.Secret == "" {
+		s.Secret = newSecret()
+	}
+	return s, nil
+}
+
+// Update reemplaza la URL, el filtro de eventos y el estado; un secret vacío conserva el actual
+func (s *Subscription) Update(rawURL string, events []string, active bool, secret string) error {
+	rawURL = strings.TrimSpace(rawURL)
+	if err := validateURL(rawURL); err != nil {
+		return err
+	}
+	normalized, err := normalizeEvents(events)
+	if err != nil {
+		return err
+	}
+
+	s.URL = rawURL
+	s.Events = normalized
+	s.Active = active
+	if secret = strings.TrimSpace(secret); secret != "" {
+		s.Secret = secret
+	}
+	s.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Matches indica si la suscripción recibe los eventos del tipo indicado
+func (s *Subscription) Matches(eventType string) bool {
+	return s.Active && (len(s.Events) == 0 || slices.Contains(s.Events, eventType))
+}
+
+// validateURL exige una URL absoluta http o https
+func validateURL(rawURL string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: la URL debe ser absoluta y usar http o https", ErrInvalidSubscription)
+	}
+	return nil
+}
+
+// normalizeEvents valida el filtro de eventos y lo retorna ordenado y sin repetidos
+func normalizeEvents(events []string) ([]string, error) {
+	normalized := make([]string, 0, len(events))
+	for _, event := range events {
+		event = strings.TrimSpace(event)
+		if !slices.Contains(EventTypes, event) {
+			return nil, fmt.Errorf("%w: tipo de evento desconocido %q", ErrInvalidSubscription, event)
+		}
+		normalized = append(normalized, event)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// newSecret genera un secreto aleatorio de 32 bytes en hexadecimal
+func newSecret() string {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	return hex.EncodeToString(secret)
+}
+
+// Delivery es el envío de un evento a una suscripción y su resultado; forma el registro de entregas
+type Delivery struct {
+	ID             int             `json:"id"`
+	SubscriptionID int             `json:"subscription_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	// NextAttemptAt es cuándo se intenta la entrega; nil cuando ya terminó
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// ResponseStatus es el código HTTP del último intento; 0 si no hubo respuesta
+	ResponseStatus int        `json:"response_status,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+}
+
+// NewDelivery crea una entrega pendiente del evento para la suscripción
+func NewDelivery(subscriptionID int, eventType string, payload []byte) *Delivery {
+	now := time.Now().UTC()
+	return &Delivery{
+		SubscriptionID: subscriptionID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  &now,
+		CreatedAt:      now,
+	}
+}
+
+// RetryPolicy define cuántas veces se intenta una entrega y cuánto se espera entre intentos
+type RetryPolicy struct {
+	MaxAttempts int
+	// BackoffBase es la espera antes del primer reintento; se duplica en cada intento hasta BackoffMax
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+// Backoff retorna la espera después del intento número attempts
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	wait := p.BackoffBase
+	for i := 1; i < attempts && wait < p.BackoffMax; i++ {
+		wait *= 2
+	}
+	return min(wait, p.BackoffMax)
+}
+
+// RecordAttempt registra el resultado de un intento. Un error programa un reintento según la política
+// o, si se agotaron los intentos, deja la entrega como dead
+func (d *Delivery) RecordAttempt(responseStatus int, err error, policy RetryPolicy) {
+	now := time.Now().UTC()
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	if err == nil {
+		d.Status = DeliverySucceeded
+		d.LastError = ""
+		d.NextAttemptAt = nil
+		d.DeliveredAt = &now
+		return
+	}
+
+	d.LastError = truncateError(err.Error())
+	if d.Attempts >= policy.MaxAttempts {
+		d.Status = DeliveryDead
+		d.NextAttemptAt = nil
+		return
+	}
+	next := now.Add(policy.Backoff(d.Attempts))
+	d.Status = DeliveryRetrying
+	d.NextAttemptAt = &next
+}
+
+// Abandon deja la entrega como dead sin intentarla, por ejemplo si la suscripción se desactivó
+func (d *Delivery) Abandon(reason string) {
+	d.Status = DeliveryDead
+	d.LastError = truncateError(reason)
+	d.NextAttemptAt = nil
+}
+
+// truncateError acota el mensaje de error guardado
+func truncateError(message string) string {
+	if len(message) <= maxErrorLength {
+		return message
+	}
+	return strings.ToValidUTF8(message[:maxErrorLength], "")
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewSubscription verifica la validación, la normalización del filtro y el secreto generado
+func TestNewSubscription(t *testing.T) {
+	subscription, err := NewSubscription(" https://example.com/hook ", []string{"task.deleted", "task.created", "task.created"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/hook", subscription.URL)
+	assert.Equal(t, []string{"task.created", "task.deleted"}, subscription.Events)
+	assert.True(t, subscription.Active)
+	assert.Len(t, subscription.Secret, 64)
+
+	other, err := NewSubscription("http://localhost:9000", nil, "mi-secreto")
+	assert.NoError(t, err)
+	assert.Equal(t, "mi-secreto", other.Secret)
+	assert.Empty(t, other.Events)
+
+	for _, invalid := range []string{"", "example.com/hook", "ftp://example.com", "https://"} {
+		_, err := NewSubscription(invalid, nil, "")
+		assert.ErrorIs(t, err, ErrInvalidSubscription, invalid)
+	}
+	_, err = NewSubscription("https://example.com", []string{"task.archived"}, "")
+	assert.ErrorIs(t, err, ErrInvalidSubscription)
+}
+
+// TestSubscription_Matches verifica el filtro de eventos y las suscripciones inactivas
+func TestSubscription_Matches(t *testing.T) {
+	all := &Subscription{Active: true}
+	filtered := &Subscription{Active: true, Events: []string{"task.completed"}}
+	inactive := &Subscription{Active: false}
+
+	assert.True(t, all.Matches("task.created"))
+	assert.True(t, filtered.Matches("task.completed"))
+	assert.False(t, filtered.Matches("task.created"))
+	assert.False(t, inactive.Matches("task.created"))
+}
+
+// TestDelivery_RecordAttempt verifica los reintentos con backoff y el paso a dead
+func TestDelivery_RecordAttempt(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BackoffBase: time.Minute, BackoffMax: 90 * time.Second}
+	delivery := NewDelivery(1, "task.created", []byte(`{}`))
+
+	delivery.RecordAttempt(500, errors.New("el receptor respondió 500"), policy)
+	assert.Equal(t, DeliveryRetrying, delivery.Status)
+	assert.Equal(t, 500, delivery.ResponseStatus)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), *delivery.NextAttemptAt, 5*time.Second)
+
+	delivery.RecordAttempt(0, errors.New("conexión rechazada"), policy)
+	assert.WithinDuration(t, time.Now().Add(90*time.Second), *delivery.NextAttemptAt, 5*time.Second)
+
+	delivery.RecordAttempt(0, errors.New("conexión rechazada"), policy)
+	assert.Equal(t, DeliveryDead, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Nil(t, delivery.NextAttemptAt)
+
+	ok := NewDelivery(1, "task.created", []byte(`{}`))
+	ok.RecordAttempt(204, nil, policy)
+	assert.Equal(t, DeliverySucceeded, ok.Status)
+	assert.NotNil(t, ok.DeliveredAt)
+	assert.Nil(t, ok.NextAttemptAt)
+}
+
+// TestSignAndVerify verifica la firma sobre timestamp y cuerpo y la tolerancia del timestamp
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"type":"task.created"}`)
+	now := time.Now()
+	signature := Sign("secreto", now, body)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	assert.Regexp(t, `^sha256=[0-9a-f]{64}$`, signature)
+	assert.True(t, VerifySignature("secreto", timestamp, body, signature, time.Minute))
+	assert.False(t, VerifySignature("otro", timestamp, body, signature, time.Minute))
+	assert.False(t, VerifySignature("secreto", timestamp, []byte(`{}`), signature, time.Minute))
+	assert.False(t, VerifySignature("secreto", strconv.FormatInt(now.Unix()+1, 10), body, signature, time.Minute))
+
+	old := now.Add(-time.Hour)
+	assert.False(t, VerifySignature("secreto", strconv.FormatInt(old.Unix(), 10), body, Sign("secreto", old, body), time.Minute))
+}
@@ -0,0 +1,293 @@
+package infrastructure
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+)
+
+// deliveryColumns son las columnas de una entrega en el orden que espera scanDelivery
+const deliveryColumns = `id, subscription_id, event_type, payload, status, attempts, next_attempt_at, response_status,
+	last_error, created_at, delivered_at`
+
+// claimDeliveriesQuery reserva por lease las entregas cuyo intento ya corresponde. %s es la cláusula
+// de bloqueo del motor: PostgreSQL salta las filas que otro despachador está reservando
+const claimDeliveriesQuery = `UPDATE webhook_deliveries SET locked_until = ?
+	WHERE id IN (
+		SELECT id FROM webhook_deliveries
+		WHERE status IN ('pending', 'retrying') AND next_attempt_at <= ? AND (locked_until IS NULL OR locked_until <= ?)
+		ORDER BY id
+		LIMIT ?%s
+	)
+	RETURNING ` + deliveryColumns
+
+// encodeEvents serializa el filtro de eventos de una suscripción
+func encodeEvents(events []string) (string, error) {
+	if events == nil {
+		events = []string{}
+	}
+	data, err := json.Marshal(events)
+	if err != nil {
+		return "", fmt.Errorf("error serializando eventos de la suscripción: %w", err)
+	}
+	return string(data), nil
+}
+
+// decodeEvents lee el filtro de eventos de una suscripción
+func decodeEvents(raw string) ([]string, error) {
+	events := []string{}
+	if err := json.Unmarshal([]byte(raw), &events); err != nil {
+		return nil, fmt.Errorf("error leyendo eventos de la suscripción: %w", err)
+	}
+	return events, nil
+}
+
+// rowScanner es la parte común de *sql.Row y *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanSubscription lee una suscripción con las columnas id, url, events, secret, active, created_at, updated_at
+func scanSubscription(row rowScanner) (*domain.Subscription, error) {
+	subscription := &domain.Subscription{}
+	var events string
+	if err := row.Scan(&subscription.ID, &subscription.URL, &events, &subscription.Secret, &subscription.Active,
+		&subscription.CreatedAt, &subscription.UpdatedAt); err != nil {
+		return nil, err
+	}
+	var err error
+	if subscription.Events, err = decodeEvents(events); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// scanDelivery lee una entrega con deliveryColumns
+func scanDelivery(row rowScanner) (*domain.Delivery, error) {
+	delivery := &domain.Delivery{}
+	var payload string
+	var nextAttemptAt, deliveredAt sql.NullTime
+	if err := row.Scan(&delivery.ID, &delivery.SubscriptionID, &delivery.EventType, &payload, &delivery.Status,
+		&delivery.Attempts, &nextAttemptAt, &delivery.ResponseStatus, &delivery.LastError, &delivery.CreatedAt, &deliveredAt); err != nil {
+		return nil, err
+	}
+	delivery.Payload = json.RawMessage(payload)
+	if nextAttemptAt.Valid {
+		delivery.NextAttemptAt = &nextAttemptAt.Time
+	}
+	if deliveredAt.Valid {
+		delivery.DeliveredAt = &deliveredAt.Time
+	}
+	return delivery, nil
+}
+
+// SQLiteRepository implementa Repository usando SQLite
+type SQLiteRepository struct {
+	db *database.SQLiteDB
+}
+
+// NewSQLiteRepository crea una nueva instancia del repositorio de webhooks
+func NewSQLiteRepository(db *database.SQLiteDB) domain.Repository {
+	return &SQLiteRepository{
+		db: db,
+	}
+}
+
+// CreateSubscription guarda una suscripción nueva
+func (r *SQLiteRepository) CreateSubscription(ctx context.Context, subscription *domain.Subscription) (*domain.Subscription, error) {
+	events, err := encodeEvents(subscription.Events)
+	if err != nil {
+		return nil, err
+	}
+	query := `INSERT INTO webhook_subscriptions (url, events, secret, active, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, subscription.URL, events, subscription.Secret, subscription.Active,
+		subscription.CreatedAt, subscription.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("error creando suscripción de webhook: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo ID de la suscripción: %w", err)
+	}
+
+	created := *subscription
+	created.ID = int(id)
+	return &created, nil
+}
+
+// GetSubscription obtiene una suscripción por su ID
+func (r *SQLiteRepository) GetSubscription(ctx context.Context, id int) (*domain.Subscription, error) {
+	query := `SELECT id, url, events, secret, active, created_at, updated_at FROM webhook_subscriptions WHERE id = ?`
+	subscription, err := scanSubscription(r.db.Conn(ctx).QueryRowContext(ctx, query, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %d", domain.ErrSubscriptionNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo suscripción de webhook: %w", err)
+	}
+	return subscription, nil
+}
+
+// ListSubscriptions obtiene todas las suscripciones ordenadas por ID
+func (r *SQLiteRepository) ListSubscriptions(ctx context.Context) ([]*domain.Subscription, error) {
+	query := `SELECT id, url, events, secret, active, created_at, updated_at FROM webhook_subscriptions ORDER BY id`
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("error listando suscripciones de webhook: %w", err)
+	}
+	defer rows.Close()
+
+	subscriptions := []*domain.Subscription{}
+	for rows.Next() {
+		subscription, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando suscripción de webhook: %w", err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando suscripciones de webhook: %w", err)
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscription guarda los cambios de una suscripción
+func (r *SQLiteRepository) UpdateSubscription(ctx context.Context, subscription *domain.Subscription) (*domain.Subscription, error) {
+	events, err := encodeEvents(subscription.Events)
+	if err != nil {
+		return nil, err
+	}
+	query := `UPDATE webhook_subscriptions SET url = ?, events = ?, secret = ?, active = ?, updated_at = ? WHERE id = ?`
+	result, err := r.db.Conn(ctx).ExecContext(ctx, query, subscription.URL, events, subscription.Secret, subscription.Active,
+		subscription.UpdatedAt, subscription.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error actualizando suscripción de webhook: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo filas afectadas: %w", err)
+	}
+	if rows == 0 {
+		return nil, fmt.Errorf("%w: %d", domain.ErrSubscriptionNotFound, subscription.ID)
+	}
+	return subscription, nil
+}
+
+// DeleteSubscription elimina una suscripción con sus entregas en una transacción
+func (r *SQLiteRepository) DeleteSubscription(ctx context.Context, id int) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM webhook_deliveries WHERE subscription_id = ?`, id); err != nil {
+		return fmt.Errorf("error eliminando entregas de la suscripción: %w", err)
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("error eliminando suscripción de webhook: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error obteniendo filas afectadas: %w", err)
+	}
+	if rows == 0 {
+		return fmt.Errorf("%w: %d", domain.ErrSubscriptionNotFound, id)
+	}
+	return tx.Commit()
+}
+
+// CreateDeliveries guarda entregas pendientes y les asigna su ID
+func (r *SQLiteRepository) CreateDeliveries(ctx context.Context, deliveries []*domain.Delivery) error {
+	tx, err := r.db.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `INSERT INTO webhook_deliveries (subscription_id, event_type, payload, status, attempts, next_attempt_at, created_at)
+		VALUES (?, ?, ?, ?, 0, ?, ?)`
+	for _, delivery := range deliveries {
+		result, err := tx.ExecContext(ctx, query, delivery.SubscriptionID, delivery.EventType, string(delivery.Payload),
+			delivery.Status, delivery.NextAttemptAt, delivery.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("error creando entrega de webhook: %w", err)
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return fmt.Errorf("error obteniendo ID de la entrega: %w", err)
+		}
+		delivery.ID = int(id)
+	}
+	return tx.Commit()
+}
+
+// ClaimDeliveries reserva por lease las entregas cuyo intento ya corresponde
+func (r *SQLiteRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*domain.Delivery, error) {
+	now := time.Now().UTC()
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, fmt.Sprintf(claimDeliveriesQuery, ""), now.Add(lease), now, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error reservando entregas de webhook: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*domain.Delivery
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando entrega de webhook: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando entregas de webhook: %w", err)
+	}
+
+	// RETURNING no garantiza el orden de las filas
+	slices.SortFunc(deliveries, func(a, b *domain.Delivery) int { return cmp.Compare(a.ID, b.ID) })
+	return deliveries, nil
+}
+
+// UpdateDelivery guarda el resultado de un intento y libera la reserva
+func (r *SQLiteRepository) UpdateDelivery(ctx context.Context, delivery *domain.Delivery) error {
+	query := `UPDATE webhook_deliveries SET status = ?, attempts = ?, next_attempt_at = ?, locked_until = NULL,
+		response_status = ?, last_error = ?, delivered_at = ? WHERE id = ?`
+	_, err := r.db.Conn(ctx).ExecContext(ctx, query, delivery.Status, delivery.Attempts, delivery.NextAttemptAt,
+		delivery.ResponseStatus, delivery.LastError, delivery.DeliveredAt, delivery.ID)
+	if err != nil {
+		return fmt.Errorf("error actualizando entrega de webhook: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries obtiene las últimas entregas de una suscripción
+func (r *SQLiteRepository) ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*domain.Delivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE subscription_id = ? ORDER BY id DESC LIMIT ?`
+	rows, err := r.db.Conn(ctx).QueryContext(ctx, query, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error listando entregas de webhook: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*domain.Delivery{}
+	for rows.Next() {
+		delivery, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error escaneando entrega de webhook: %w", err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterando entregas de webhook: %w", err)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,254 @@
+package infrastructure
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"gorm.io/gorm"
+)
+
+// GormSubscriptionModel es el modelo de GORM para la tabla webhook_subscriptions (PostgreSQL)
+type GormSubscriptionModel struct {
+	ID        int       `gorm:"primaryKey;autoIncrement"`
+	URL       string    `gorm:"type:text;not null"`
+	Events    string    `gorm:"type:text;not null;default:'[]'"`
+	Secret    string    `gorm:"not null"`
+	Active    bool      `gorm:"not null;default:true"`
+	CreatedAt time.Time `gorm:"not null"`
+	UpdatedAt time.Time `gorm:"not null"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormSubscriptionModel) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (m *GormSubscriptionModel) ToDomain() (*domain.Subscription, error) {
+	events, err := decodeEvents(m.Events)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Subscription{
+		ID:        m.ID,
+		URL:       m.URL,
+		Events:    events,
+		Secret:    m.Secret,
+		Active:    m.Active,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}, nil
+}
+
+// GormDeliveryModel es el modelo de GORM para la tabla webhook_deliveries (PostgreSQL)
+type GormDeliveryModel struct {
+	ID             int        `gorm:"primaryKey;autoIncrement"`
+	SubscriptionID int        `gorm:"not null;index"`
+	EventType      string     `gorm:"not null"`
+	Payload        string     `gorm:"type:text;not null"`
+	Status         string     `gorm:"not null"`
+	Attempts       int        `gorm:"not null;default:0"`
+	NextAttemptAt  *time.Time `gorm:"null"`
+	LockedUntil    *time.Time `gorm:"null"`
+	ResponseStatus int        `gorm:"not null;default:0"`
+	LastError      string     `gorm:"not null;default:''"`
+	CreatedAt      time.Time  `gorm:"not null"`
+	DeliveredAt    *time.Time `gorm:"null"`
+}
+
+// TableName especifica el nombre de la tabla
+func (GormDeliveryModel) TableName() string {
+	return "webhook_deliveries"
+}
+
+// ToDomain convierte el modelo GORM a entidad de dominio
+func (m *GormDeliveryModel) ToDomain() *domain.Delivery {
+	return &domain.Delivery{
+		ID:             m.ID,
+		SubscriptionID: m.SubscriptionID,
+		EventType:      m.EventType,
+		Payload:        json.RawMessage(m.Payload),
+		Status:         m.Status,
+		Attempts:       m.Attempts,
+		NextAttemptAt:  m.NextAttemptAt,
+		ResponseStatus: m.ResponseStatus,
+		LastError:      m.LastError,
+		CreatedAt:      m.CreatedAt,
+		DeliveredAt:    m.DeliveredAt,
+	}
+}
+
+// GormRepository implementa Repository usando GORM
+type GormRepository struct {
+	db *gorm.DB
+}
+
+// NewGormRepository crea una nueva instancia del repositorio de webhooks con GORM
+func NewGormRepository(db *gorm.DB) domain.Repository {
+	return &GormRepository{
+		db: db,
+	}
+}
+
+// CreateSubscription guarda una suscripción nueva
+func (r *GormRepository) CreateSubscription(ctx context.Context, subscription *domain.Subscription) (*domain.Subscription, error) {
+	events, err := encodeEvents(subscription.Events)
+	if err != nil {
+		return nil, err
+	}
+	model := GormSubscriptionModel{
+		URL:       subscription.URL,
+		Events:    events,
+		Secret:    subscription.Secret,
+		Active:    subscription.Active,
+		CreatedAt: subscription.CreatedAt,
+		UpdatedAt: subscription.UpdatedAt,
+	}
+	if err := database.GormConn(ctx, r.db).Create(&model).Error; err != nil {
+		return nil, fmt.Errorf("error creando suscripción de webhook con GORM: %w", err)
+	}
+	return model.ToDomain()
+}
+
+// GetSubscription obtiene una suscripción por su ID
+func (r *GormRepository) GetSubscription(ctx context.Context, id int) (*domain.Subscription, error) {
+	var model GormSubscriptionModel
+	err := database.GormConn(ctx, r.db).First(&model, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %d", domain.ErrSubscriptionNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo suscripción de webhook con GORM: %w", err)
+	}
+	return model.ToDomain()
+}
+
+// ListSubscriptions obtiene todas las suscripciones ordenadas por ID
+func (r *GormRepository) ListSubscriptions(ctx context.Context) ([]*domain.Subscription, error) {
+	var models []GormSubscriptionModel
+	if err := database.GormConn(ctx, r.db).Order("id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("error listando suscripciones de webhook con GORM: %w", err)
+	}
+
+	subscriptions := make([]*domain.Subscription, 0, len(models))
+	for i := range models {
+		subscription, err := models[i].ToDomain()
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+// UpdateSubscription guarda los cambios de una suscripción
+func (r *GormRepository) UpdateSubscription(ctx context.Context, subscription *domain.Subscription) (*domain.Subscription, error) {
+	events, err := encodeEvents(subscription.Events)
+	if err != nil {
+		return nil, err
+	}
+	result := database.GormConn(ctx, r.db).Model(&GormSubscriptionModel{}).Where("id = ?", subscription.ID).Updates(map[string]any{
+		"url": subscription.URL, "events": events, "secret": subscription.Secret, "active": subscription.Active, "updated_at": subscription.UpdatedAt,
+	})
+	if result.Error != nil {
+		return nil, fmt.Errorf("error actualizando suscripción de webhook con GORM: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("%w: %d", domain.ErrSubscriptionNotFound, subscription.ID)
+	}
+	return subscription, nil
+}
+
+// DeleteSubscription elimina una suscripción con sus entregas en una transacción
+func (r *GormRepository) DeleteSubscription(ctx context.Context, id int) error {
+	return database.GormConn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("subscription_id = ?", id).Delete(&GormDeliveryModel{}).Error; err != nil {
+			return fmt.Errorf("error eliminando entregas de la suscripción con GORM: %w", err)
+		}
+		result := tx.Delete(&GormSubscriptionModel{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("error eliminando suscripción de webhook con GORM: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %d", domain.ErrSubscriptionNotFound, id)
+		}
+		return nil
+	})
+}
+
+// CreateDeliveries guarda entregas pendientes y les asigna su ID
+func (r *GormRepository) CreateDeliveries(ctx context.Context, deliveries []*domain.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	models := make([]GormDeliveryModel, len(deliveries))
+	for i, delivery := range deliveries {
+		models[i] = GormDeliveryModel{
+			SubscriptionID: delivery.SubscriptionID,
+			EventType:      delivery.EventType,
+			Payload:        string(delivery.Payload),
+			Status:         delivery.Status,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			CreatedAt:      delivery.CreatedAt,
+		}
+	}
+	if err := database.GormConn(ctx, r.db).Create(&models).Error; err != nil {
+		return fmt.Errorf("error creando entregas de webhook con GORM: %w", err)
+	}
+	for i := range models {
+		deliveries[i].ID = models[i].ID
+	}
+	return nil
+}
+
+// ClaimDeliveries reserva por lease las entregas cuyo intento ya corresponde; con SKIP LOCKED dos
+// despachadores no compiten por las mismas filas
+func (r *GormRepository) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*domain.Delivery, error) {
+	now := time.Now().UTC()
+	var models []GormDeliveryModel
+	query := fmt.Sprintf(claimDeliveriesQuery, " FOR UPDATE SKIP LOCKED")
+	if err := database.GormConn(ctx, r.db).Raw(query, now.Add(lease), now, now, limit).Scan(&models).Error; err != nil {
+		return nil, fmt.Errorf("error reservando entregas de webhook con GORM: %w", err)
+	}
+
+	deliveries := make([]*domain.Delivery, len(models))
+	for i := range models {
+		deliveries[i] = models[i].ToDomain()
+	}
+	slices.SortFunc(deliveries, func(a, b *domain.Delivery) int { return cmp.Compare(a.ID, b.ID) })
+	return deliveries, nil
+}
+
+// UpdateDelivery guarda el resultado de un intento y libera la reserva
+func (r *GormRepository) UpdateDelivery(ctx context.Context, delivery *domain.Delivery) error {
+	err := database.GormConn(ctx, r.db).Model(&GormDeliveryModel{}).Where("id = ?", delivery.ID).Updates(map[string]any{
+		"status": delivery.Status, "attempts": delivery.Attempts, "next_attempt_at": delivery.NextAttemptAt, "locked_until": nil,
+		"response_status": delivery.ResponseStatus, "last_error": delivery.LastError, "delivered_at": delivery.DeliveredAt,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("error actualizando entrega de webhook con GORM: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries obtiene las últimas entregas de una suscripción
+func (r *GormRepository) ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*domain.Delivery, error) {
+	var models []GormDeliveryModel
+	err := database.GormConn(ctx, r.db).Where("subscription_id = ?", subscriptionID).Order("id DESC").Limit(limit).Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("error listando entregas de webhook con GORM: %w", err)
+	}
+
+	deliveries := make([]*domain.Delivery, len(models))
+	for i := range models {
+		deliveries[i] = models[i].ToDomain()
+	}
+	return deliveries, nil
+}
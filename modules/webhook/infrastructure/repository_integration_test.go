@@ -0,0 +1,109 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/config"
+	"github.com/YerkoTenorio/api-go-hexagonal/shared/database"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSQLiteDB(t *testing.T) *database.SQLiteDB {
+	t.Helper()
+	dbPath := filepath.Join(os.TempDir(), fmt.Sprintf("webhook_test_%d.db", time.Now().UnixNano()))
+	cfg := &config.Config{Database: config.DatabaseConfig{Path: dbPath}}
+	sqliteDB, err := database.NewSQLiteDB(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = sqliteDB.Close()
+		_ = os.Remove(dbPath)
+	})
+	return sqliteDB
+}
+
+func TestSQLiteRepository_SubscriptionCRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+
+	subscription, err := domain.NewSubscription("https://example.com/hook", []string{"task.created"}, "secreto")
+	require.NoError(t, err)
+	created, err := repo.CreateSubscription(ctx, subscription)
+	require.NoError(t, err)
+	require.NotZero(t, created.ID)
+
+	found, err := repo.GetSubscription(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com/hook", found.URL)
+	require.Equal(t, []string{"task.created"}, found.Events)
+	require.Equal(t, "secreto", found.Secret)
+	require.True(t, found.Active)
+
+	require.NoError(t, found.Update("https://example.com/otro", nil, false, ""))
+	_, err = repo.UpdateSubscription(ctx, found)
+	require.NoError(t, err)
+
+	subscriptions, err := repo.ListSubscriptions(ctx)
+	require.NoError(t, err)
+	require.Len(t, subscriptions, 1)
+	require.Equal(t, "https://example.com/otro", subscriptions[0].URL)
+	require.Empty(t, subscriptions[0].Events)
+	require.False(t, subscriptions[0].Active)
+	require.Equal(t, "secreto", subscriptions[0].Secret)
+
+	require.NoError(t, repo.CreateDeliveries(ctx, []*domain.Delivery{domain.NewDelivery(created.ID, "task.created", []byte(`{}`))}))
+	require.NoError(t, repo.DeleteSubscription(ctx, created.ID))
+	_, err = repo.GetSubscription(ctx, created.ID)
+	require.ErrorIs(t, err, domain.ErrSubscriptionNotFound)
+	require.ErrorIs(t, repo.DeleteSubscription(ctx, created.ID), domain.ErrSubscriptionNotFound)
+
+	// Las entregas se eliminan con la suscripción
+	deliveries, err := repo.ListDeliveries(ctx, created.ID, 10)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
+
+func TestSQLiteRepository_ClaimDeliveries(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+
+	subscription, err := domain.NewSubscription("https://example.com/hook", nil, "")
+	require.NoError(t, err)
+	subscription, err = repo.CreateSubscription(ctx, subscription)
+	require.NoError(t, err)
+
+	first := domain.NewDelivery(subscription.ID, "task.created", []byte(`{"type":"task.created"}`))
+	later := domain.NewDelivery(subscription.ID, "task.updated", []byte(`{}`))
+	future := time.Now().UTC().Add(time.Hour)
+	later.NextAttemptAt = &future
+	require.NoError(t, repo.CreateDeliveries(ctx, []*domain.Delivery{first, later}))
+	require.NotZero(t, first.ID)
+
+	// Solo se reserva la entrega cuyo intento ya corresponde, y no se reserva dos veces
+	claimed, err := repo.ClaimDeliveries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	require.Equal(t, first.ID, claimed[0].ID)
+	require.JSONEq(t, `{"type":"task.created"}`, string(claimed[0].Payload))
+	claimed, err = repo.ClaimDeliveries(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, claimed)
+
+	// Al guardar el resultado se libera la reserva
+	first.RecordAttempt(204, nil, domain.RetryPolicy{MaxAttempts: 3})
+	require.NoError(t, repo.UpdateDelivery(ctx, first))
+
+	deliveries, err := repo.ListDeliveries(ctx, subscription.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, later.ID, deliveries[0].ID)
+	require.Equal(t, domain.DeliverySucceeded, deliveries[1].Status)
+	require.Equal(t, 204, deliveries[1].ResponseStatus)
+	require.NotNil(t, deliveries[1].DeliveredAt)
+	require.Nil(t, deliveries[1].NextAttemptAt)
+}
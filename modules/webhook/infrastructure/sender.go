@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+)
+
+// maxResponseBody es cuánto de la respuesta del receptor se lee para reutilizar la conexión
+const maxResponseBody = 64 << 10
+
+// HTTPSender implementa Sender con un POST firmado a la URL de la suscripción
+type HTTPSender struct {
+	client *http.Client
+}
+
+// NewHTTPSender crea un emisor cuyas peticiones se cancelan después de timeout. Las redirecciones no
+// se siguen: un 3xx cuenta como fallo
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send envía la entrega firmada con el secreto de la suscripción
+func (s *HTTPSender) Send(ctx context.Context, subscription *domain.Subscription, delivery *domain.Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("error armando la petición: %w", err)
+	}
+
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "task-webhooks/1.0")
+	req.Header.Set(domain.EventHeader, delivery.EventType)
+	req.Header.Set(domain.DeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(domain.TimestampHeader, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(domain.SignatureHeader, domain.Sign(subscription.Secret, now, delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error enviando el webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("el receptor respondió %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,208 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	taskdomain "github.com/YerkoTenorio/api-go-hexagonal/modules/task/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	"github.com/stretchr/testify/require"
+)
+
+// receivedWebhook es una petición recibida por el receptor de prueba
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+// testReceiver es un receptor de webhooks local que responde con el código de status
+type testReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	status   int
+	received []receivedWebhook
+}
+
+func newTestReceiver(t *testing.T, status int) *testReceiver {
+	t.Helper()
+	receiver := &testReceiver{status: status}
+	receiver.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		receiver.mu.Lock()
+		defer receiver.mu.Unlock()
+		receiver.received = append(receiver.received, receivedWebhook{header: r.Header.Clone(), body: body})
+		w.WriteHeader(receiver.status)
+	}))
+	t.Cleanup(receiver.Close)
+	return receiver
+}
+
+func (r *testReceiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *testReceiver) requests() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.received...)
+}
+
+func TestHTTPSender_SignsDelivery(t *testing.T) {
+	receiver := newTestReceiver(t, http.StatusNoContent)
+	sender := NewHTTPSender(5 * time.Second)
+	subscription := &domain.Subscription{ID: 1, URL: receiver.URL, Secret: "secreto", Active: true}
+	delivery := domain.NewDelivery(1, "task.created", []byte(`{"type":"task.created","task_id":4}`))
+	delivery.ID = 9
+
+	status, err := sender.Send(context.Background(), subscription, delivery)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, status)
+
+	requests := receiver.requests()
+	require.Len(t, requests, 1)
+	header := requests[0].header
+	require.Equal(t, "application/json", header.Get("Content-Type"))
+	require.Equal(t, "task.created", header.Get(domain.EventHeader))
+	require.Equal(t, "9", header.Get(domain.DeliveryHeader))
+	require.Equal(t, string(delivery.Payload), string(requests[0].body))
+	require.True(t, domain.VerifySignature("secreto", header.Get(domain.TimestampHeader), requests[0].body,
+		header.Get(domain.SignatureHeader), time.Minute))
+}
+
+func TestHTTPSender_FailsOnNon2xx(t *testing.T) {
+	sender := NewHTTPSender(5 * time.Second)
+	delivery := domain.NewDelivery(1, "task.created", []byte(`{}`))
+
+	failing := newTestReceiver(t, http.StatusServiceUnavailable)
+	status, err := sender.Send(context.Background(), &domain.Subscription{URL: failing.URL}, delivery)
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+
+	// Las redirecciones no se siguen
+	redirect := httptest.NewServer(http.RedirectHandler(failing.URL, http.StatusFound))
+	defer redirect.Close()
+	status, err = sender.Send(context.Background(), &domain.Subscription{URL: redirect.URL}, delivery)
+	require.Error(t, err)
+	require.Equal(t, http.StatusFound, status)
+	require.Len(t, failing.requests(), 1)
+}
+
+func TestWebhookService_DeliversTaskEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+	service := application.NewWebhookService(repo, NewHTTPSender(5*time.Second), domain.RetryPolicy{MaxAttempts: 3, BackoffBase: time.Minute, BackoffMax: time.Minute})
+
+	receiver := newTestReceiver(t, http.StatusOK)
+	all, err := service.CreateSubscription(ctx, receiver.URL, nil, "")
+	require.NoError(t, err)
+	completedOnly, err := service.CreateSubscription(ctx, receiver.URL+"/completed", []string{"task.completed"}, "")
+	require.NoError(t, err)
+
+	task := &taskdomain.Task{ID: 4, Title: "T"}
+	require.NoError(t, service.Publish(ctx, []taskdomain.Event{
+		{Type: taskdomain.EventTaskCreated, TaskID: 4, Task: task, Actor: "ana"},
+		{Type: taskdomain.EventTaskCompleted, TaskID: 4, Task: task, Actor: "ana"},
+	}))
+
+	sent, err := service.DeliverDue(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 3, sent)
+
+	requests := receiver.requests()
+	require.Len(t, requests, 3)
+	for _, request := range requests {
+		var event taskdomain.Event
+		require.NoError(t, json.Unmarshal(request.body, &event))
+		require.Equal(t, 4, event.TaskID)
+		require.Equal(t, "ana", event.Actor)
+		require.Equal(t, string(event.Type), request.header.Get(domain.EventHeader))
+	}
+
+	deliveries, err := service.ListDeliveries(ctx, all.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	deliveries, err = service.ListDeliveries(ctx, completedOnly.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, "task.completed", deliveries[0].EventType)
+	require.Equal(t, domain.DeliverySucceeded, deliveries[0].Status)
+}
+
+func TestWebhookService_RetriesUntilDead(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+	service := application.NewWebhookService(repo, NewHTTPSender(5*time.Second),
+		domain.RetryPolicy{MaxAttempts: 3, BackoffBase: time.Millisecond, BackoffMax: time.Millisecond})
+
+	receiver := newTestReceiver(t, http.StatusInternalServerError)
+	subscription, err := service.CreateSubscription(ctx, receiver.URL, nil, "")
+	require.NoError(t, err)
+	require.NoError(t, service.Publish(ctx, []taskdomain.Event{{Type: taskdomain.EventTaskDeleted, TaskID: 2}}))
+
+	for range 3 {
+		time.Sleep(5 * time.Millisecond)
+		_, err := service.DeliverDue(ctx, 10)
+		require.NoError(t, err)
+	}
+
+	// Los reintentos repiten el mismo ID de entrega
+	requests := receiver.requests()
+	require.Len(t, requests, 3)
+	require.Equal(t, requests[0].header.Get(domain.DeliveryHeader), requests[2].header.Get(domain.DeliveryHeader))
+
+	deliveries, err := service.ListDeliveries(ctx, subscription.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, domain.DeliveryDead, deliveries[0].Status)
+	require.Equal(t, 3, deliveries[0].Attempts)
+	require.Equal(t, http.StatusInternalServerError, deliveries[0].ResponseStatus)
+
+	// Una entrega dead no se vuelve a intentar
+	receiver.setStatus(http.StatusOK)
+	time.Sleep(5 * time.Millisecond)
+	sent, err := service.DeliverDue(ctx, 10)
+	require.NoError(t, err)
+	require.Zero(t, sent)
+}
+
+func TestWebhookService_SendTestEvent(t *testing.T) {
+	ctx := context.Background()
+	repo := NewSQLiteRepository(newTestSQLiteDB(t))
+	service := application.NewWebhookService(repo, NewHTTPSender(5*time.Second), domain.RetryPolicy{MaxAttempts: 3, BackoffBase: time.Minute, BackoffMax: time.Minute})
+
+	receiver := newTestReceiver(t, http.StatusAccepted)
+	subscription, err := service.CreateSubscription(ctx, receiver.URL, []string{"task.created"}, "secreto")
+	require.NoError(t, err)
+
+	delivery, err := service.SendTestEvent(ctx, subscription.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.DeliverySucceeded, delivery.Status)
+	require.Equal(t, http.StatusAccepted, delivery.ResponseStatus)
+
+	requests := receiver.requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, domain.EventTest, requests[0].header.Get(domain.EventHeader))
+	require.Equal(t, strconv.Itoa(delivery.ID), requests[0].header.Get(domain.DeliveryHeader))
+	require.True(t, domain.VerifySignature("secreto", requests[0].header.Get(domain.TimestampHeader), requests[0].body,
+		requests[0].header.Get(domain.SignatureHeader), time.Minute))
+
+	// Un fallo en la prueba queda registrado y no se reintenta
+	receiver.setStatus(http.StatusNotFound)
+	delivery, err = service.SendTestEvent(ctx, subscription.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.DeliveryDead, delivery.Status)
+	require.Equal(t, http.StatusNotFound, delivery.ResponseStatus)
+	sent, err := service.DeliverDue(ctx, 10)
+	require.NoError(t, err)
+	require.Zero(t, sent)
+}
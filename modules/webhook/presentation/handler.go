@@ -0,0 +1,323 @@
+package presentation
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/application"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookHandler maneja las peticiones HTTP de suscripciones de webhooks
+type WebhookHandler struct {
+	webhookService application.WebhookServiceInterface
+}
+
+// NewWebhookHandler crea una nueva instancia del handler de webhooks
+func NewWebhookHandler(webhookService application.WebhookServiceInterface) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// CreateSubscriptionRequest representa la estructura de la petición para crear una suscripción
+type CreateSubscriptionRequest struct {
+	URL    string   `json:"url" binding:"required"`
+	Events []string `json:"events"` // vacío recibe todos los eventos
+	Secret string   `json:"secret"` // se genera uno si se omite
+}
+
+// UpdateSubscriptionRequest representa la estructura de la petición para actualizar una suscripción
+type UpdateSubscriptionRequest struct {
+	URL    string   `json:"url" binding:"required"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"` // true si se omite
+	Secret string   `json:"secret"` // se conserva el actual si se omite
+}
+
+// webhookErrorStatus traduce los errores de webhooks a códigos HTTP
+func webhookErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrSubscriptionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrInvalidSubscription):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// parseSubscriptionID interpreta el ID de suscripción de la ruta
+func parseSubscriptionID(value string) (int, error) {
+	id, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || id == 0 {
+		return 0, errors.New("ID must be a positive integer")
+	}
+	return int(id), nil
+}
+
+// parseDeliveryLimit interpreta el parámetro limit del registro de entregas (0 si se omite)
+func parseDeliveryLimit(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || limit == 0 {
+		return 0, errors.New("limit must be a positive integer")
+	}
+	return int(limit), nil
+}
+
+// activeOrDefault retorna el estado pedido, o activo si se omitió
+func activeOrDefault(active *bool) bool {
+	return active == nil || *active
+}
+
+// CreateSubscription crea una suscripción de webhook
+// @Summary Crea una suscripción de webhook
+// @Description El secreto solo se devuelve en esta respuesta; las entregas se firman con HMAC-SHA256
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param subscription body CreateSubscriptionRequest true "Suscripción"
+// @Success 201 {object} gin.H
+// @Failure 400 {object} gin.H
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateSubscription(c *gin.Context) {
+	var req CreateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(c.Request.Context(), req.URL, req.Events, req.Secret)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{
+			"error":   "Error creating webhook",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Webhook created successfully",
+		"data":    subscription,
+		"secret":  subscription.Secret,
+	})
+}
+
+// ListSubscriptions obtiene todas las suscripciones de webhooks
+// @Summary Lista las suscripciones de webhooks
+// @Tags webhooks
+// @Produce json
+// @Success 200 {object} []entities.Subscription
+// @Failure 500 {object} gin.H
+// @Router /webhooks [get]
+func (h *WebhookHandler) ListSubscriptions(c *gin.Context) {
+	subscriptions, err := h.webhookService.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":   "Error getting webhooks",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhooks retrieved successfully",
+		"data":    subscriptions,
+		"count":   len(subscriptions),
+	})
+}
+
+// GetSubscription obtiene una suscripción de webhook por su ID
+// @Summary Obtiene una suscripción de webhook
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "ID de la suscripción"
+// @Success 200 {object} entities.Subscription
+// @Failure 404 {object} gin.H
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetSubscription(c *gin.Context) {
+	id, err := parseSubscriptionID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	subscription, err := h.webhookService.GetSubscription(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{
+			"error":   "Error getting webhook",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook retrieved successfully",
+		"data":    subscription,
+	})
+}
+
+// UpdateSubscription actualiza una suscripción de webhook
+// @Summary Actualiza una suscripción de webhook
+// @Description Reemplaza la URL y el filtro de eventos; un secreto vacío conserva el actual
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path int true "ID de la suscripción"
+// @Param subscription body UpdateSubscriptionRequest true "Suscripción"
+// @Success 200 {object} entities.Subscription
+// @Failure 400 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) UpdateSubscription(c *gin.Context) {
+	id, err := parseSubscriptionID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	var req UpdateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	subscription, err := h.webhookService.UpdateSubscription(c.Request.Context(), id, req.URL, req.Events, activeOrDefault(req.Active), req.Secret)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{
+			"error":   "Error updating webhook",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook updated successfully",
+		"data":    subscription,
+	})
+}
+
+// DeleteSubscription elimina una suscripción de webhook
+// @Summary Elimina una suscripción de webhook
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "ID de la suscripción"
+// @Success 200 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteSubscription(c *gin.Context) {
+	id, err := parseSubscriptionID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	if err := h.webhookService.DeleteSubscription(c.Request.Context(), id); err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{
+			"error":   "Error deleting webhook",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Webhook deleted successfully",
+	})
+}
+
+// ListDeliveries obtiene el registro de entregas de una suscripción
+// @Summary Lista las entregas de una suscripción
+// @Description Las más recientes primero; limit por defecto 50, máximo 500
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "ID de la suscripción"
+// @Param limit query int false "Cantidad de entregas"
+// @Success 200 {object} []entities.Delivery
+// @Failure 404 {object} gin.H
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	id, err := parseSubscriptionID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+	limit, err := parseDeliveryLimit(c.Query("limit"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid limit",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(c.Request.Context(), id, limit)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{
+			"error":   "Error getting deliveries",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Deliveries retrieved successfully",
+		"data":    deliveries,
+		"count":   len(deliveries),
+	})
+}
+
+// SendTestEvent envía un evento de prueba a una suscripción
+// @Summary Envía un evento de prueba
+// @Description Envía webhook.test en el momento y retorna la entrega; un fallo del receptor no se reintenta
+// @Tags webhooks
+// @Produce json
+// @Param id path int true "ID de la suscripción"
+// @Success 200 {object} entities.Delivery
+// @Failure 404 {object} gin.H
+// @Router /webhooks/{id}/test [post]
+func (h *WebhookHandler) SendTestEvent(c *gin.Context) {
+	id, err := parseSubscriptionID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	delivery, err := h.webhookService.SendTestEvent(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(webhookErrorStatus(err), gin.H{
+			"error":   "Error sending test event",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Test event sent",
+		"data":    delivery,
+	})
+}
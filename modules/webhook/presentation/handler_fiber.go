@@ -0,0 +1,209 @@
+package presentation
+
+import (
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/application"
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberWebhookHandler maneja las peticiones HTTP de suscripciones de webhooks con Fiber
+type FiberWebhookHandler struct {
+	webhookService application.WebhookServiceInterface
+}
+
+// NewFiberWebhookHandler crea una nueva instancia del handler de webhooks con Fiber
+func NewFiberWebhookHandler(webhookService application.WebhookServiceInterface) *FiberWebhookHandler {
+	return &FiberWebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// FiberCreateSubscriptionRequest representa la estructura de la petición para crear una suscripción
+type FiberCreateSubscriptionRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+}
+
+// FiberUpdateSubscriptionRequest representa la estructura de la petición para actualizar una suscripción
+type FiberUpdateSubscriptionRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Active *bool    `json:"active"`
+	Secret string   `json:"secret"`
+}
+
+// CreateSubscription crea una suscripción de webhook con Fiber
+func (h *FiberWebhookHandler) CreateSubscription(c *fiber.Ctx) error {
+	var req FiberCreateSubscriptionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(c.Context(), req.URL, req.Events, req.Secret)
+	if err != nil {
+		return c.Status(webhookErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error creating webhook",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Webhook created successfully",
+		"data":    subscription,
+		"secret":  subscription.Secret,
+	})
+}
+
+// ListSubscriptions obtiene todas las suscripciones de webhooks con Fiber
+func (h *FiberWebhookHandler) ListSubscriptions(c *fiber.Ctx) error {
+	subscriptions, err := h.webhookService.ListSubscriptions(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "Error getting webhooks",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Webhooks retrieved successfully",
+		"data":    subscriptions,
+		"count":   len(subscriptions),
+	})
+}
+
+// GetSubscription obtiene una suscripción de webhook por su ID con Fiber
+func (h *FiberWebhookHandler) GetSubscription(c *fiber.Ctx) error {
+	id, err := parseSubscriptionID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+
+	subscription, err := h.webhookService.GetSubscription(c.Context(), id)
+	if err != nil {
+		return c.Status(webhookErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting webhook",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Webhook retrieved successfully",
+		"data":    subscription,
+	})
+}
+
+// UpdateSubscription actualiza una suscripción de webhook con Fiber
+func (h *FiberWebhookHandler) UpdateSubscription(c *fiber.Ctx) error {
+	id, err := parseSubscriptionID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+
+	var req FiberUpdateSubscriptionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	}
+
+	subscription, err := h.webhookService.UpdateSubscription(c.Context(), id, req.URL, req.Events, activeOrDefault(req.Active), req.Secret)
+	if err != nil {
+		return c.Status(webhookErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error updating webhook",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Webhook updated successfully",
+		"data":    subscription,
+	})
+}
+
+// DeleteSubscription elimina una suscripción de webhook con Fiber
+func (h *FiberWebhookHandler) DeleteSubscription(c *fiber.Ctx) error {
+	id, err := parseSubscriptionID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+
+	if err := h.webhookService.DeleteSubscription(c.Context(), id); err != nil {
+		return c.Status(webhookErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error deleting webhook",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Webhook deleted successfully",
+	})
+}
+
+// ListDeliveries obtiene el registro de entregas de una suscripción con Fiber
+func (h *FiberWebhookHandler) ListDeliveries(c *fiber.Ctx) error {
+	id, err := parseSubscriptionID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+	limit, err := parseDeliveryLimit(c.Query("limit"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid limit",
+			"message": err.Error(),
+		})
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(c.Context(), id, limit)
+	if err != nil {
+		return c.Status(webhookErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error getting deliveries",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Deliveries retrieved successfully",
+		"data":    deliveries,
+		"count":   len(deliveries),
+	})
+}
+
+// SendTestEvent envía un evento de prueba a una suscripción con Fiber
+func (h *FiberWebhookHandler) SendTestEvent(c *fiber.Ctx) error {
+	id, err := parseSubscriptionID(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": err.Error(),
+		})
+	}
+
+	delivery, err := h.webhookService.SendTestEvent(c.Context(), id)
+	if err != nil {
+		return c.Status(webhookErrorStatus(err)).JSON(fiber.Map{
+			"error":   "Error sending test event",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Test event sent",
+		"data":    delivery,
+	})
+}
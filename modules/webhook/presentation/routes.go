@@ -0,0 +1,32 @@
+package presentation
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// SetupWebhookRoutes configura las rutas de suscripciones de webhooks
+func SetupWebhookRoutes(router *gin.Engine, webhookHandler *WebhookHandler) {
+	webhookGroup := router.Group("/api/v1/webhooks")
+	{
+		// POST /api/v1/webhooks - Crear una suscripción
+		webhookGroup.POST("", webhookHandler.CreateSubscription)
+
+		// GET /api/v1/webhooks - Listar las suscripciones
+		webhookGroup.GET("", webhookHandler.ListSubscriptions)
+
+		// GET /api/v1/webhooks/:id - Obtener una suscripción
+		webhookGroup.GET("/:id", webhookHandler.GetSubscription)
+
+		// PUT /api/v1/webhooks/:id - Actualizar una suscripción
+		webhookGroup.PUT("/:id", webhookHandler.UpdateSubscription)
+
+		// DELETE /api/v1/webhooks/:id - Eliminar una suscripción y sus entregas
+		webhookGroup.DELETE("/:id", webhookHandler.DeleteSubscription)
+
+		// GET /api/v1/webhooks/:id/deliveries - Registro de entregas
+		webhookGroup.GET("/:id/deliveries", webhookHandler.ListDeliveries)
+
+		// POST /api/v1/webhooks/:id/test - Enviar un evento de prueba
+		webhookGroup.POST("/:id/test", webhookHandler.SendTestEvent)
+	}
+}
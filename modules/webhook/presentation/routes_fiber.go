@@ -0,0 +1,18 @@
+package presentation
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupWebhookRoutesFiber configura las rutas de suscripciones de webhooks para Fiber
+func SetupWebhookRoutesFiber(app *fiber.App, handler *FiberWebhookHandler) {
+	webhooks := app.Group("/webhooks")
+
+	webhooks.Post("/", handler.CreateSubscription)
+	webhooks.Get("/", handler.ListSubscriptions)
+	webhooks.Get("/:id", handler.GetSubscription)
+	webhooks.Put("/:id", handler.UpdateSubscription)
+	webhooks.Delete("/:id", handler.DeleteSubscription)
+	webhooks.Get("/:id/deliveries", handler.ListDeliveries)
+	webhooks.Post("/:id/test", handler.SendTestEvent)
+}
@@ -0,0 +1,153 @@
+package presentation_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/domain"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/presentation"
+	"github.com/YerkoTenorio/api-go-hexagonal/modules/webhook/presentation/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// setupWebhookRouter crea un router de Gin con las rutas de webhooks y el mock del servicio
+func setupWebhookRouter(ctrl *gomock.Controller) (*gin.Engine, *mocks.MockWebhookServiceInterface) {
+	mockService := mocks.NewMockWebhookServiceInterface(ctrl)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	presentation.SetupWebhookRoutes(router, presentation.NewWebhookHandler(mockService))
+	return router, mockService
+}
+
+// TestWebhookHandler_CreateSubscription_ReturnsSecret verifica que el secreto solo se devuelve al crear
+func TestWebhookHandler_CreateSubscription_ReturnsSecret(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupWebhookRouter(ctrl)
+
+	subscription := &domain.Subscription{ID: 1, URL: "https://example.com/hook", Events: []string{"task.created"}, Secret: "s3cr3t", Active: true}
+	mockService.EXPECT().CreateSubscription(gomock.Any(), "https://example.com/hook", []string{"task.created"}, "").
+		Return(subscription, nil)
+	mockService.EXPECT().GetSubscription(gomock.Any(), 1).Return(subscription, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/webhooks",
+		bytes.NewBufferString(`{"url":"https://example.com/hook","events":["task.created"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+	getReq, _ := http.NewRequest("GET", "/api/v1/webhooks/1", nil)
+	getW := httptest.NewRecorder()
+	router.ServeHTTP(getW, getReq)
+
+	// Assert
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"secret":"s3cr3t"`)
+	assert.Equal(t, http.StatusOK, getW.Code)
+	assert.NotContains(t, getW.Body.String(), "s3cr3t")
+}
+
+// TestWebhookHandler_Errors verifica los códigos de suscripción inválida, inexistente e ID inválido
+func TestWebhookHandler_Errors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupWebhookRouter(ctrl)
+
+	mockService.EXPECT().CreateSubscription(gomock.Any(), "ftp://example.com", gomock.Any(), gomock.Any()).
+		Return(nil, domain.ErrInvalidSubscription)
+	req, _ := http.NewRequest("POST", "/api/v1/webhooks", bytes.NewBufferString(`{"url":"ftp://example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	mockService.EXPECT().DeleteSubscription(gomock.Any(), 5).Return(domain.ErrSubscriptionNotFound)
+	req, _ = http.NewRequest("DELETE", "/api/v1/webhooks/5", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req, _ = http.NewRequest("POST", "/api/v1/webhooks/abc/test", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestWebhookHandler_UpdateSubscription_DefaultsActive verifica que active se asume true si se omite
+func TestWebhookHandler_UpdateSubscription_DefaultsActive(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupWebhookRouter(ctrl)
+
+	mockService.EXPECT().UpdateSubscription(gomock.Any(), 2, "https://example.com", []string(nil), true, "").
+		Return(&domain.Subscription{ID: 2, Active: true}, nil)
+	mockService.EXPECT().UpdateSubscription(gomock.Any(), 2, "https://example.com", []string(nil), false, "nuevo").
+		Return(&domain.Subscription{ID: 2}, nil)
+
+	// Act
+	req, _ := http.NewRequest("PUT", "/api/v1/webhooks/2", bytes.NewBufferString(`{"url":"https://example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	req, _ = http.NewRequest("PUT", "/api/v1/webhooks/2",
+		bytes.NewBufferString(`{"url":"https://example.com","active":false,"secret":"nuevo"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w2 := httptest.NewRecorder()
+	router.ServeHTTP(w2, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, http.StatusOK, w2.Code)
+}
+
+// TestWebhookHandler_ListDeliveries verifica el límite del registro de entregas
+func TestWebhookHandler_ListDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupWebhookRouter(ctrl)
+
+	mockService.EXPECT().ListDeliveries(gomock.Any(), 3, 20).
+		Return([]*domain.Delivery{{ID: 8, SubscriptionID: 3, Status: domain.DeliveryDead}}, nil)
+	req, _ := http.NewRequest("GET", "/api/v1/webhooks/3/deliveries?limit=20", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"count":1`)
+	assert.Contains(t, w.Body.String(), `"status":"dead"`)
+
+	req, _ = http.NewRequest("GET", "/api/v1/webhooks/3/deliveries?limit=0", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+// TestWebhookHandler_SendTestEvent verifica que se devuelve el resultado de la entrega de prueba
+func TestWebhookHandler_SendTestEvent(t *testing.T) {
+	// Arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	router, mockService := setupWebhookRouter(ctrl)
+
+	mockService.EXPECT().SendTestEvent(gomock.Any(), 4).Return(&domain.Delivery{
+		ID: 12, SubscriptionID: 4, EventType: domain.EventTest, Status: domain.DeliverySucceeded, Attempts: 1, ResponseStatus: 204,
+	}, nil)
+
+	req, _ := http.NewRequest("POST", "/api/v1/webhooks/4/test", nil)
+	w := httptest.NewRecorder()
+
+	// Act
+	router.ServeHTTP(w, req)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"event_type":"webhook.test"`)
+	assert.Contains(t, w.Body.String(), `"response_status":204`)
+}
@@ -4,16 +4,25 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 // Config contiene toda la configuración de la aplicación
 type Config struct {
-    Database DatabaseConfig
-    Server   ServerConfig
-    App      AppConfig
-    Log      LogConfig
+    Database    DatabaseConfig
+    Server      ServerConfig
+    App         AppConfig
+    Log         LogConfig
+    Task        TaskConfig
+    Attachments AttachmentConfig
+    Idempotency IdempotencyConfig
+    Outbox      OutboxConfig
+    Webhook     WebhookConfig
+    Realtime    RealtimeConfig
+    GraphQL     GraphQLConfig
 }
 
 // DatabaseConfig configuración de la base de datos
@@ -40,6 +49,116 @@ type LogConfig struct {
 	Level string
 }
 
+// TaskConfig configuración de las reglas de negocio de tareas
+type TaskConfig struct {
+	// EnforceBlockers impide completar tareas con bloqueadores abiertos
+	EnforceBlockers bool
+	// CommentsOnDelete define qué hacer con los comentarios al eliminar una tarea: cascade | archive
+	CommentsOnDelete string
+	// UnassignOnDeactivate quita las tareas asignadas a un usuario cuando se desactiva
+	UnassignOnDeactivate bool
+	// TrashRetention es el tiempo que una tarea eliminada permanece en la papelera antes de purgarse
+	TrashRetention time.Duration
+	// TrashPurgeInterval es cada cuánto se ejecuta la purga de la papelera
+	TrashPurgeInterval time.Duration
+	// AutoArchiveDays archiva las tareas completadas hace más de estos días; 0 desactiva el archivado automático
+	AutoArchiveDays int
+	// AutoArchiveInterval es cada cuánto se ejecuta el archivado automático
+	AutoArchiveInterval time.Duration
+	// EventWorkers es la cantidad de workers que entregan los eventos de tareas; 0 los entrega de forma síncrona
+	EventWorkers int
+	// EventBuffer es la capacidad de la cola de eventos de cada worker
+	EventBuffer int
+	// StreamLogSize es la cantidad de eventos recientes que guarda el stream para reanudar con Last-Event-ID
+	StreamLogSize int
+	// StreamHeartbeat es cada cuánto se envía un heartbeat a los clientes del stream sin eventos
+	StreamHeartbeat time.Duration
+}
+
+// AttachmentConfig configuración de archivos adjuntos y su almacenamiento
+type AttachmentConfig struct {
+	// MaxSize es el tamaño máximo de un archivo en bytes
+	MaxSize int
+	// AllowedTypes son los tipos MIME permitidos; admite comodines como image/*
+	AllowedTypes []string
+	// Storage define dónde se guardan los archivos: local | s3
+	Storage string
+	// Dir es el directorio del almacenamiento local
+	Dir string
+	// S3Endpoint, S3Bucket, S3Region, S3AccessKey y S3SecretKey configuran un servicio compatible con S3
+	S3Endpoint  string
+	S3Bucket    string
+	S3Region    string
+	S3AccessKey string
+	S3SecretKey string
+}
+
+// IdempotencyConfig configuración de las claves de idempotencia
+type IdempotencyConfig struct {
+	// TTL es el tiempo que se guarda la respuesta de una petición con Idempotency-Key
+	TTL time.Duration
+	// PurgeInterval es cada cuánto se eliminan las claves vencidas
+	PurgeInterval time.Duration
+}
+
+// OutboxConfig configuración del outbox de eventos de tareas
+type OutboxConfig struct {
+	// Enabled guarda los eventos en el outbox en la misma transacción que cada cambio
+	Enabled bool
+	// PollInterval es cada cuánto el relay busca entradas pendientes
+	PollInterval time.Duration
+	// BatchSize es la cantidad máxima de entradas que el relay reserva por lote
+	BatchSize int
+	// Lease es cuánto tiempo una entrada reservada no se entrega en otro relay
+	Lease time.Duration
+	// MaxAttempts es la cantidad de intentos antes de marcar una entrada como agotada
+	MaxAttempts int
+	// BackoffBase y BackoffMax acotan la espera entre reintentos, que se duplica en cada intento
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// Retention es cuánto se conservan las entradas entregadas; 0 las conserva siempre
+	Retention time.Duration
+}
+
+// WebhookConfig configuración de la entrega de webhooks salientes
+type WebhookConfig struct {
+	// Timeout es el tiempo máximo de cada petición al receptor
+	Timeout time.Duration
+	// MaxAttempts es la cantidad de intentos antes de marcar una entrega como dead
+	MaxAttempts int
+	// BackoffBase y BackoffMax acotan la espera entre reintentos, que se duplica en cada intento
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// DispatchInterval es cada cuánto se buscan entregas pendientes
+	DispatchInterval time.Duration
+	// BatchSize es la cantidad máxima de entregas que se reservan por lote
+	BatchSize int
+}
+
+// RealtimeConfig configuración del socket de edición colaborativa
+type RealtimeConfig struct {
+	// TicketSecret firma los tickets de conexión; vacío genera uno por proceso (no sirve con varias instancias)
+	TicketSecret string
+	// TicketTTL es cuánto vale un ticket de conexión
+	TicketTTL time.Duration
+	// SendBuffer es la cantidad de mensajes pendientes de un cliente antes de desconectarlo por lento
+	SendBuffer int
+	// MaxSubscriptions es la cantidad máxima de tareas suscritas por conexión; 0 no limita
+	MaxSubscriptions int
+	// AllowedOrigins son los orígenes permitidos además del propio host (por ejemplo *.example.com)
+	AllowedOrigins []string
+}
+
+// GraphQLConfig configuración del endpoint GraphQL
+type GraphQLConfig struct {
+	// MaxDepth es la cantidad máxima de niveles de campos anidados; 0 no limita
+	MaxDepth int
+	// MaxComplexity es el costo estimado máximo de una operación; 0 no limita
+	MaxComplexity int
+	// ListFactor es la cantidad de elementos que se estima para cada lista al calcular el costo
+	ListFactor int
+}
+
 // LoadConfig carga la configuración desde variables de entorno
 func LoadConfig() (*Config, error) {
 	// Cargar el archivo .env si existe
@@ -65,6 +184,64 @@ func LoadConfig() (*Config, error) {
 		Log: LogConfig{
 			Level: getEnv("LOG_LEVEL", "info"),
 		},
+		Task: TaskConfig{
+			EnforceBlockers:      getEnvAsBool("TASK_ENFORCE_BLOCKERS", false),
+			CommentsOnDelete:     getEnv("TASK_COMMENTS_ON_DELETE", "cascade"),
+			UnassignOnDeactivate: getEnvAsBool("TASK_UNASSIGN_ON_DEACTIVATE", true),
+			TrashRetention:       getEnvAsDuration("TASK_TRASH_RETENTION", 30*24*time.Hour),
+			TrashPurgeInterval:   getEnvAsDuration("TASK_TRASH_PURGE_INTERVAL", time.Hour),
+			AutoArchiveDays:      getEnvAsInt("TASK_AUTO_ARCHIVE_DAYS", 0),
+			AutoArchiveInterval:  getEnvAsDuration("TASK_AUTO_ARCHIVE_INTERVAL", time.Hour),
+			EventWorkers:         getEnvAsInt("TASK_EVENT_WORKERS", 4),
+			EventBuffer:          getEnvAsInt("TASK_EVENT_BUFFER", 256),
+			StreamLogSize:        getEnvAsInt("TASK_STREAM_LOG_SIZE", 1000),
+			StreamHeartbeat:      getEnvAsDuration("TASK_STREAM_HEARTBEAT", 15*time.Second),
+		},
+		Attachments: AttachmentConfig{
+			MaxSize:      getEnvAsInt("ATTACHMENTS_MAX_SIZE", 10<<20),
+			AllowedTypes: getEnvAsList("ATTACHMENTS_ALLOWED_TYPES", []string{"image/*", "application/pdf", "text/plain"}),
+			Storage:      getEnv("ATTACHMENTS_STORAGE", "local"),
+			Dir:          getEnv("ATTACHMENTS_DIR", "./data/attachments"),
+			S3Endpoint:   getEnv("S3_ENDPOINT", ""),
+			S3Bucket:     getEnv("S3_BUCKET", ""),
+			S3Region:     getEnv("S3_REGION", "us-east-1"),
+			S3AccessKey:  getEnv("S3_ACCESS_KEY", ""),
+			S3SecretKey:  getEnv("S3_SECRET_KEY", ""),
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:           getEnvAsDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			PurgeInterval: getEnvAsDuration("IDEMPOTENCY_PURGE_INTERVAL", time.Hour),
+		},
+		Outbox: OutboxConfig{
+			Enabled:      getEnvAsBool("OUTBOX_ENABLED", false),
+			PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+			Lease:        getEnvAsDuration("OUTBOX_LEASE", 30*time.Second),
+			MaxAttempts:  getEnvAsInt("OUTBOX_MAX_ATTEMPTS", 10),
+			BackoffBase:  getEnvAsDuration("OUTBOX_BACKOFF_BASE", time.Second),
+			BackoffMax:   getEnvAsDuration("OUTBOX_BACKOFF_MAX", 5*time.Minute),
+			Retention:    getEnvAsDuration("OUTBOX_RETENTION", 7*24*time.Hour),
+		},
+		Webhook: WebhookConfig{
+			Timeout:          getEnvAsDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:      getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			BackoffBase:      getEnvAsDuration("WEBHOOK_BACKOFF_BASE", 30*time.Second),
+			BackoffMax:       getEnvAsDuration("WEBHOOK_BACKOFF_MAX", time.Hour),
+			DispatchInterval: getEnvAsDuration("WEBHOOK_DISPATCH_INTERVAL", 5*time.Second),
+			BatchSize:        getEnvAsInt("WEBHOOK_BATCH_SIZE", 50),
+		},
+		Realtime: RealtimeConfig{
+			TicketSecret:     getEnv("REALTIME_TICKET_SECRET", ""),
+			TicketTTL:        getEnvAsDuration("REALTIME_TICKET_TTL", time.Minute),
+			SendBuffer:       getEnvAsInt("REALTIME_SEND_BUFFER", 64),
+			MaxSubscriptions: getEnvAsInt("REALTIME_MAX_SUBSCRIPTIONS", 100),
+			AllowedOrigins:   getEnvAsList("REALTIME_ALLOWED_ORIGINS", nil),
+		},
+		GraphQL: GraphQLConfig{
+			MaxDepth:      getEnvAsInt("GRAPHQL_MAX_DEPTH", 8),
+			MaxComplexity: getEnvAsInt("GRAPHQL_MAX_COMPLEXITY", 1000),
+			ListFactor:    getEnvAsInt("GRAPHQL_LIST_FACTOR", 10),
+		},
 	}
 
 	// Validar configuración crítica
@@ -103,6 +280,31 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+// getEnvAsDuration obtiene una variable de entorno como duración (p. ej. "720h")
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsList obtiene una variable de entorno como lista separada por comas
+func getEnvAsList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // validate valida que la configuración sea correcta
 func (c *Config) validate() error {
     // Validar que exista configuración de base de datos: local (Path) o remota (URL)
@@ -119,6 +321,78 @@ func (c *Config) validate() error {
 		return fmt.Errorf("SERVER_PORT debe ser un número válido: %s", c.Server.Port)
 	}
 
+	if c.Task.CommentsOnDelete != "cascade" && c.Task.CommentsOnDelete != "archive" {
+		return fmt.Errorf("TASK_COMMENTS_ON_DELETE debe ser cascade o archive: %s", c.Task.CommentsOnDelete)
+	}
+
+	if c.Task.TrashRetention < 0 || c.Task.TrashPurgeInterval <= 0 {
+		return fmt.Errorf("TASK_TRASH_RETENTION no puede ser negativo y TASK_TRASH_PURGE_INTERVAL debe ser mayor que cero")
+	}
+
+	if c.Task.AutoArchiveDays < 0 || c.Task.AutoArchiveInterval <= 0 {
+		return fmt.Errorf("TASK_AUTO_ARCHIVE_DAYS no puede ser negativo y TASK_AUTO_ARCHIVE_INTERVAL debe ser mayor que cero")
+	}
+
+	if c.Task.EventWorkers < 0 || c.Task.EventBuffer < 0 {
+		return fmt.Errorf("TASK_EVENT_WORKERS y TASK_EVENT_BUFFER no pueden ser negativos")
+	}
+	if c.Task.StreamLogSize <= 0 || c.Task.StreamHeartbeat <= 0 {
+		return fmt.Errorf("TASK_STREAM_LOG_SIZE y TASK_STREAM_HEARTBEAT deben ser mayores que cero")
+	}
+
+	if c.Attachments.MaxSize <= 0 {
+		return fmt.Errorf("ATTACHMENTS_MAX_SIZE debe ser mayor que cero: %d", c.Attachments.MaxSize)
+	}
+
+	if c.Idempotency.TTL <= 0 || c.Idempotency.PurgeInterval <= 0 {
+		return fmt.Errorf("IDEMPOTENCY_TTL e IDEMPOTENCY_PURGE_INTERVAL deben ser mayores que cero")
+	}
+
+	if c.Outbox.PollInterval <= 0 || c.Outbox.BatchSize <= 0 || c.Outbox.Lease <= 0 || c.Outbox.MaxAttempts <= 0 {
+		return fmt.Errorf("OUTBOX_POLL_INTERVAL, OUTBOX_BATCH_SIZE, OUTBOX_LEASE y OUTBOX_MAX_ATTEMPTS deben ser mayores que cero")
+	}
+	if c.Outbox.BackoffBase <= 0 || c.Outbox.BackoffMax < c.Outbox.BackoffBase || c.Outbox.Retention < 0 {
+		return fmt.Errorf("OUTBOX_BACKOFF_BASE debe ser mayor que cero y no mayor que OUTBOX_BACKOFF_MAX, y OUTBOX_RETENTION no puede ser negativo")
+	}
+
+	// Una entrega reservada no se reintenta en otro despachador durante 5 minutos
+	if c.Webhook.Timeout <= 0 || c.Webhook.Timeout >= 5*time.Minute {
+		return fmt.Errorf("WEBHOOK_TIMEOUT debe ser mayor que cero y menor que 5m: %s", c.Webhook.Timeout)
+	}
+	if c.Webhook.MaxAttempts <= 0 || c.Webhook.DispatchInterval <= 0 || c.Webhook.BatchSize <= 0 {
+		return fmt.Errorf("WEBHOOK_MAX_ATTEMPTS, WEBHOOK_DISPATCH_INTERVAL y WEBHOOK_BATCH_SIZE deben ser mayores que cero")
+	}
+	if c.Webhook.BackoffBase <= 0 || c.Webhook.BackoffMax < c.Webhook.BackoffBase {
+		return fmt.Errorf("WEBHOOK_BACKOFF_BASE debe ser mayor que cero y no mayor que WEBHOOK_BACKOFF_MAX")
+	}
+
+	if c.Realtime.TicketTTL <= 0 || c.Realtime.SendBuffer <= 0 {
+		return fmt.Errorf("REALTIME_TICKET_TTL y REALTIME_SEND_BUFFER deben ser mayores que cero")
+	}
+	if c.Realtime.MaxSubscriptions < 0 {
+		return fmt.Errorf("REALTIME_MAX_SUBSCRIPTIONS no puede ser negativo: %d", c.Realtime.MaxSubscriptions)
+	}
+
+	if c.GraphQL.MaxDepth < 0 || c.GraphQL.MaxComplexity < 0 {
+		return fmt.Errorf("GRAPHQL_MAX_DEPTH y GRAPHQL_MAX_COMPLEXITY no pueden ser negativos")
+	}
+	if c.GraphQL.ListFactor <= 0 {
+		return fmt.Errorf("GRAPHQL_LIST_FACTOR debe ser mayor que cero: %d", c.GraphQL.ListFactor)
+	}
+
+	switch c.Attachments.Storage {
+	case "local":
+		if c.Attachments.Dir == "" {
+			return fmt.Errorf("ATTACHMENTS_DIR no puede estar vacío con almacenamiento local")
+		}
+	case "s3":
+		if c.Attachments.S3Endpoint == "" || c.Attachments.S3Bucket == "" {
+			return fmt.Errorf("S3_ENDPOINT y S3_BUCKET son requeridos con almacenamiento s3")
+		}
+	default:
+		return fmt.Errorf("ATTACHMENTS_STORAGE debe ser local o s3: %s", c.Attachments.Storage)
+	}
+
     return nil
 }
 
@@ -71,6 +71,305 @@ func (g *GormDB) autoMigrate() error {
 		return fmt.Errorf("error creando tabla tasks con GORM: %w", err)
 	}
 
+	createDependenciesSQL := `
+	CREATE TABLE IF NOT EXISTS task_dependencies (
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		blocker_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (task_id, blocker_id)
+	);
+	`
+
+	if err := g.DB.Exec(createDependenciesSQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla task_dependencies con GORM: %w", err)
+	}
+
+	// Los comentarios no tienen clave foránea: la política de borrado puede archivarlos
+	createCommentsSQL := `
+	CREATE TABLE IF NOT EXISTS task_comments (
+		id SERIAL PRIMARY KEY,
+		task_id INTEGER NOT NULL,
+		author VARCHAR(255) NOT NULL,
+		body TEXT NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		edited_at TIMESTAMP NULL,
+		archived_at TIMESTAMP NULL
+	);
+	CREATE INDEX IF NOT EXISTS idx_task_comments_task_id ON task_comments (task_id, created_at);
+	CREATE TABLE IF NOT EXISTS task_comment_revisions (
+		id SERIAL PRIMARY KEY,
+		comment_id INTEGER NOT NULL REFERENCES task_comments(id) ON DELETE CASCADE,
+		body TEXT NOT NULL,
+		edited_at TIMESTAMP NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS idx_task_comment_revisions_comment_id ON task_comment_revisions (comment_id);
+	`
+
+	if err := g.DB.Exec(createCommentsSQL).Error; err != nil {
+		return fmt.Errorf("error creando tablas de comentarios con GORM: %w", err)
+	}
+
+	// Metadatos de adjuntos; el contenido vive en el BlobStore bajo storage_key
+	createAttachmentsSQL := `
+	CREATE TABLE IF NOT EXISTS task_attachments (
+		id SERIAL PRIMARY KEY,
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		file_name VARCHAR(255) NOT NULL,
+		content_type VARCHAR(255) NOT NULL,
+		size BIGINT NOT NULL,
+		sha256 VARCHAR(64) NOT NULL,
+		storage_key VARCHAR(255) NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE INDEX IF NOT EXISTS idx_task_attachments_task_id ON task_attachments (task_id);
+	CREATE INDEX IF NOT EXISTS idx_task_attachments_storage_key ON task_attachments (storage_key);
+	-- Las tablas creadas antes no tenían la clave foránea: se quitan los adjuntos de tareas que ya
+	-- no existen y se agrega para que se eliminen con la tarea
+	DO $$
+	BEGIN
+		IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'task_attachments_task_id_fkey') THEN
+			DELETE FROM task_attachments WHERE task_id NOT IN (SELECT id FROM tasks);
+			ALTER TABLE task_attachments ADD CONSTRAINT task_attachments_task_id_fkey
+				FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE;
+		END IF;
+	END $$;
+	`
+
+	if err := g.DB.Exec(createAttachmentsSQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla de adjuntos con GORM: %w", err)
+	}
+
+	// Usuarios (módulo user) y responsables de cada tarea
+	createAssigneesSQL := `
+	CREATE TABLE IF NOT EXISTS users (
+		id SERIAL PRIMARY KEY,
+		username VARCHAR(255) NOT NULL UNIQUE,
+		email VARCHAR(255) NOT NULL UNIQUE,
+		password TEXT NOT NULL,
+		first_name VARCHAR(255) NOT NULL,
+		last_name VARCHAR(255) NOT NULL,
+		active BOOLEAN NOT NULL DEFAULT TRUE,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE TABLE IF NOT EXISTS task_assignees (
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (task_id, user_id)
+	);
+	CREATE INDEX IF NOT EXISTS idx_task_assignees_user_id ON task_assignees (user_id, task_id);
+	`
+
+	if err := g.DB.Exec(createAssigneesSQL).Error; err != nil {
+		return fmt.Errorf("error creando tablas de usuarios y asignaciones con GORM: %w", err)
+	}
+
+	// Historial de cambios: una revisión por escritura, con las diferencias y la tarea resultante
+	createRevisionsSQL := `
+	CREATE TABLE IF NOT EXISTS task_revisions (
+		id SERIAL PRIMARY KEY,
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		action VARCHAR(32) NOT NULL,
+		actor VARCHAR(255) NOT NULL DEFAULT '',
+		changes JSONB NOT NULL,
+		snapshot JSONB NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE INDEX IF NOT EXISTS idx_task_revisions_task_id ON task_revisions (task_id, created_at);
+	`
+
+	if err := g.DB.Exec(createRevisionsSQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla task_revisions con GORM: %w", err)
+	}
+
+	// Tableros kanban: columnas ordenadas por posición y una tarjeta por tarea en cada tablero
+	createBoardsSQL := `
+	CREATE TABLE IF NOT EXISTS boards (
+		id SERIAL PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		swimlane_by VARCHAR(32) NOT NULL DEFAULT 'none',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE TABLE IF NOT EXISTS board_columns (
+		id SERIAL PRIMARY KEY,
+		board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		name VARCHAR(255) NOT NULL,
+		position INTEGER NOT NULL,
+		state VARCHAR(32) NOT NULL,
+		wip_limit INTEGER NOT NULL DEFAULT 0
+	);
+	CREATE INDEX IF NOT EXISTS idx_board_columns_board_id ON board_columns (board_id, position);
+	CREATE TABLE IF NOT EXISTS board_cards (
+		board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		column_id INTEGER NOT NULL REFERENCES board_columns(id) ON DELETE CASCADE,
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		rank TEXT NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (board_id, task_id)
+	);
+	CREATE INDEX IF NOT EXISTS idx_board_cards_column_id ON board_cards (column_id, rank);
+	`
+
+	if err := g.DB.Exec(createBoardsSQL).Error; err != nil {
+		return fmt.Errorf("error creando tablas de tableros con GORM: %w", err)
+	}
+
+	// Registros de tiempo; el índice único parcial garantiza un solo temporizador en curso por usuario
+	createTimeEntriesSQL := `
+	CREATE TABLE IF NOT EXISTS time_entries (
+		id SERIAL PRIMARY KEY,
+		task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+		user_id INTEGER NOT NULL,
+		started_at TIMESTAMP NOT NULL,
+		ended_at TIMESTAMP NULL,
+		duration_seconds BIGINT NOT NULL DEFAULT 0,
+		note TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE INDEX IF NOT EXISTS idx_time_entries_task_id ON time_entries (task_id, started_at);
+	CREATE INDEX IF NOT EXISTS idx_time_entries_started_at ON time_entries (started_at);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries (user_id) WHERE ended_at IS NULL;
+	`
+
+	if err := g.DB.Exec(createTimeEntriesSQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla time_entries con GORM: %w", err)
+	}
+
+	// Definiciones de campos personalizados; los valores se guardan en la columna JSONB tasks.custom_fields
+	createCustomFieldsSQL := `
+	CREATE TABLE IF NOT EXISTS custom_fields (
+		id SERIAL PRIMARY KEY,
+		key VARCHAR(50) NOT NULL UNIQUE,
+		name VARCHAR(255) NOT NULL,
+		type VARCHAR(20) NOT NULL,
+		options TEXT NOT NULL DEFAULT '[]',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	`
+
+	if err := g.DB.Exec(createCustomFieldsSQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla custom_fields con GORM: %w", err)
+	}
+
+	// Plantillas de tareas; el árbol de tareas se guarda como JSONB
+	createTemplatesSQL := `
+	CREATE TABLE IF NOT EXISTS task_templates (
+		id SERIAL PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		tasks JSONB NOT NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	`
+
+	if err := g.DB.Exec(createTemplatesSQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla task_templates con GORM: %w", err)
+	}
+
+	// Respuestas guardadas por clave de idempotencia; status 0 indica una petición en curso
+	createIdempotencySQL := `
+	CREATE TABLE IF NOT EXISTS idempotency_keys (
+		key VARCHAR(512) PRIMARY KEY,
+		fingerprint VARCHAR(64) NOT NULL,
+		status INTEGER NOT NULL DEFAULT 0,
+		content_type VARCHAR(255) NOT NULL DEFAULT '',
+		body BYTEA NULL,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		expires_at TIMESTAMP NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+	`
+
+	if err := g.DB.Exec(createIdempotencySQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla idempotency_keys con GORM: %w", err)
+	}
+
+	// Outbox de eventos de tareas: se escribe en la transacción del cambio y lo vacía el relay
+	createOutboxSQL := `
+	CREATE TABLE IF NOT EXISTS outbox (
+		id BIGSERIAL PRIMARY KEY,
+		event_type VARCHAR(64) NOT NULL,
+		task_id INTEGER NOT NULL,
+		payload TEXT NOT NULL,
+		status VARCHAR(16) NOT NULL DEFAULT 'pending',
+		attempts INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMP NOT NULL,
+		locked_until TIMESTAMP NULL,
+		last_error TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		processed_at TIMESTAMP NULL
+	);
+	CREATE INDEX IF NOT EXISTS idx_outbox_status_next_attempt ON outbox (status, next_attempt_at);
+	CREATE INDEX IF NOT EXISTS idx_outbox_task_id ON outbox (task_id, id);
+	`
+
+	if err := g.DB.Exec(createOutboxSQL).Error; err != nil {
+		return fmt.Errorf("error creando tabla outbox con GORM: %w", err)
+	}
+
+	// Suscripciones de webhooks y su registro de entregas, que también es la cola de reintentos
+	createWebhookSQL := `
+	CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+		id SERIAL PRIMARY KEY,
+		url TEXT NOT NULL,
+		events TEXT NOT NULL DEFAULT '[]',
+		secret VARCHAR(255) NOT NULL,
+		active BOOLEAN NOT NULL DEFAULT TRUE,
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+	);
+	CREATE TABLE IF NOT EXISTS webhook_deliveries (
+		id SERIAL PRIMARY KEY,
+		subscription_id INTEGER NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+		event_type VARCHAR(64) NOT NULL,
+		payload TEXT NOT NULL,
+		status VARCHAR(16) NOT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at TIMESTAMP NULL,
+		locked_until TIMESTAMP NULL,
+		response_status INTEGER NOT NULL DEFAULT 0,
+		last_error TEXT NOT NULL DEFAULT '',
+		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		delivered_at TIMESTAMP NULL
+	);
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_status_next_attempt ON webhook_deliveries (status, next_attempt_at);
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id, id);
+	`
+
+	if err := g.DB.Exec(createWebhookSQL).Error; err != nil {
+		return fmt.Errorf("error creando tablas de webhooks con GORM: %w", err)
+	}
+
+	// Columnas agregadas después de la versión inicial de la tabla tasks
+	alterTasksSQL := `
+	ALTER TABLE tasks
+		ADD COLUMN IF NOT EXISTS due_date TIMESTAMP NULL,
+		ADD COLUMN IF NOT EXISTS recurrence TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS occurrence INTEGER NOT NULL DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL,
+		ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP NULL,
+		ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP NULL,
+		ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1,
+		ADD COLUMN IF NOT EXISTS rank TEXT NOT NULL DEFAULT '',
+		ADD COLUMN IF NOT EXISTS story_points INTEGER NOT NULL DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS estimated_hours DOUBLE PRECISION NOT NULL DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS remaining_hours DOUBLE PRECISION NOT NULL DEFAULT 0,
+		ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+	CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks (deleted_at);
+	CREATE INDEX IF NOT EXISTS idx_tasks_rank ON tasks (rank, id);
+	CREATE INDEX IF NOT EXISTS idx_tasks_completed_at ON tasks (completed_at);
+	CREATE INDEX IF NOT EXISTS idx_tasks_custom_fields ON tasks USING GIN (custom_fields);
+	`
+
+	if err := g.DB.Exec(alterTasksSQL).Error; err != nil {
+		return fmt.Errorf("error actualizando columnas de tasks con GORM: %w", err)
+	}
+
 	fmt.Println("[GORM] Auto-migración completada")
 	return nil
 }
@@ -101,6 +101,321 @@ func (s *SQLiteDB) createTables() error {
 		return fmt.Errorf("error creando tabla tasks: %w", err)
 	}
 
+	createDependenciesTable := `
+	CREATE TABLE IF NOT EXISTS task_dependencies (
+	   task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+	   blocker_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+	   created_at DATETIME NOT NULL,
+	   PRIMARY KEY (task_id, blocker_id)
+	   );`
+
+	if _, err := s.DB.Exec(createDependenciesTable); err != nil {
+		return fmt.Errorf("error creando tabla task_dependencies: %w", err)
+	}
+
+	// Los comentarios no tienen clave foránea: la política de borrado puede archivarlos
+	createCommentsTables := `
+	CREATE TABLE IF NOT EXISTS task_comments (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   task_id INTEGER NOT NULL,
+	   author TEXT NOT NULL,
+	   body TEXT NOT NULL,
+	   created_at DATETIME NOT NULL,
+	   updated_at DATETIME NOT NULL,
+	   edited_at DATETIME NULL,
+	   archived_at DATETIME NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_task_comments_task_id ON task_comments (task_id, created_at);
+	CREATE TABLE IF NOT EXISTS task_comment_revisions (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   comment_id INTEGER NOT NULL,
+	   body TEXT NOT NULL,
+	   edited_at DATETIME NOT NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_task_comment_revisions_comment_id ON task_comment_revisions (comment_id);`
+
+	if _, err := s.DB.Exec(createCommentsTables); err != nil {
+		return fmt.Errorf("error creando tablas de comentarios: %w", err)
+	}
+
+	// Metadatos de adjuntos; el contenido vive en el BlobStore bajo storage_key
+	createAttachmentsTable := `
+	CREATE TABLE IF NOT EXISTS task_attachments (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   task_id INTEGER NOT NULL,
+	   file_name TEXT NOT NULL,
+	   content_type TEXT NOT NULL,
+	   size INTEGER NOT NULL,
+	   sha256 TEXT NOT NULL,
+	   storage_key TEXT NOT NULL,
+	   created_at DATETIME NOT NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_task_attachments_task_id ON task_attachments (task_id);
+	CREATE INDEX IF NOT EXISTS idx_task_attachments_storage_key ON task_attachments (storage_key);`
+
+	if _, err := s.DB.Exec(createAttachmentsTable); err != nil {
+		return fmt.Errorf("error creando tabla de adjuntos: %w", err)
+	}
+
+	// Responsables de cada tarea; el índice por user_id resuelve "mis tareas"
+	createAssigneesTable := `
+	CREATE TABLE IF NOT EXISTS task_assignees (
+	   task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+	   user_id INTEGER NOT NULL,
+	   created_at DATETIME NOT NULL,
+	   PRIMARY KEY (task_id, user_id)
+	   );
+	CREATE INDEX IF NOT EXISTS idx_task_assignees_user_id ON task_assignees (user_id, task_id);`
+
+	if _, err := s.DB.Exec(createAssigneesTable); err != nil {
+		return fmt.Errorf("error creando tabla task_assignees: %w", err)
+	}
+
+	// Historial de cambios: una revisión por escritura, con las diferencias y la tarea resultante
+	createRevisionsTable := `
+	CREATE TABLE IF NOT EXISTS task_revisions (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+	   action TEXT NOT NULL,
+	   actor TEXT NOT NULL DEFAULT '',
+	   changes TEXT NOT NULL,
+	   snapshot TEXT NOT NULL,
+	   created_at DATETIME NOT NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_task_revisions_task_id ON task_revisions (task_id, created_at);`
+
+	if _, err := s.DB.Exec(createRevisionsTable); err != nil {
+		return fmt.Errorf("error creando tabla task_revisions: %w", err)
+	}
+
+	// Tableros kanban: columnas ordenadas por posición y una tarjeta por tarea en cada tablero
+	createBoardsTables := `
+	CREATE TABLE IF NOT EXISTS boards (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   name TEXT NOT NULL,
+	   swimlane_by TEXT NOT NULL DEFAULT 'none',
+	   created_at DATETIME NOT NULL,
+	   updated_at DATETIME NOT NULL
+	   );
+	CREATE TABLE IF NOT EXISTS board_columns (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+	   name TEXT NOT NULL,
+	   position INTEGER NOT NULL,
+	   state TEXT NOT NULL,
+	   wip_limit INTEGER NOT NULL DEFAULT 0
+	   );
+	CREATE INDEX IF NOT EXISTS idx_board_columns_board_id ON board_columns (board_id, position);
+	CREATE TABLE IF NOT EXISTS board_cards (
+	   board_id INTEGER NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+	   column_id INTEGER NOT NULL REFERENCES board_columns(id) ON DELETE CASCADE,
+	   task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+	   rank TEXT NOT NULL,
+	   created_at DATETIME NOT NULL,
+	   updated_at DATETIME NOT NULL,
+	   PRIMARY KEY (board_id, task_id)
+	   );
+	CREATE INDEX IF NOT EXISTS idx_board_cards_column_id ON board_cards (column_id, rank);`
+
+	if _, err := s.DB.Exec(createBoardsTables); err != nil {
+		return fmt.Errorf("error creando tablas de tableros: %w", err)
+	}
+
+	// Registros de tiempo; el índice único parcial garantiza un solo temporizador en curso por usuario
+	createTimeEntriesTable := `
+	CREATE TABLE IF NOT EXISTS time_entries (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+	   user_id INTEGER NOT NULL,
+	   started_at DATETIME NOT NULL,
+	   ended_at DATETIME NULL,
+	   duration_seconds INTEGER NOT NULL DEFAULT 0,
+	   note TEXT NOT NULL DEFAULT '',
+	   created_at DATETIME NOT NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_time_entries_task_id ON time_entries (task_id, started_at);
+	CREATE INDEX IF NOT EXISTS idx_time_entries_started_at ON time_entries (started_at);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_time_entries_running ON time_entries (user_id) WHERE ended_at IS NULL;`
+
+	if _, err := s.DB.Exec(createTimeEntriesTable); err != nil {
+		return fmt.Errorf("error creando tabla time_entries: %w", err)
+	}
+
+	// Campos personalizados: definiciones y valores por tarea en filas (EAV); los numéricos y los
+	// usuarios van en value_number y el resto en value_text para filtrar y ordenar por tipo
+	createCustomFieldsTables := `
+	CREATE TABLE IF NOT EXISTS custom_fields (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   key TEXT NOT NULL UNIQUE,
+	   name TEXT NOT NULL,
+	   type TEXT NOT NULL,
+	   options TEXT NOT NULL DEFAULT '[]',
+	   created_at DATETIME NOT NULL
+	   );
+	CREATE TABLE IF NOT EXISTS task_custom_values (
+	   task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+	   field_id INTEGER NOT NULL REFERENCES custom_fields(id) ON DELETE CASCADE,
+	   value_text TEXT NULL,
+	   value_number REAL NULL,
+	   PRIMARY KEY (task_id, field_id)
+	   );
+	CREATE INDEX IF NOT EXISTS idx_task_custom_values_text ON task_custom_values (field_id, value_text);
+	CREATE INDEX IF NOT EXISTS idx_task_custom_values_number ON task_custom_values (field_id, value_number);`
+
+	if _, err := s.DB.Exec(createCustomFieldsTables); err != nil {
+		return fmt.Errorf("error creando tablas de campos personalizados: %w", err)
+	}
+
+	// Plantillas de tareas; el árbol de tareas se guarda como JSON
+	createTemplatesTable := `
+	CREATE TABLE IF NOT EXISTS task_templates (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   name TEXT NOT NULL,
+	   description TEXT NOT NULL DEFAULT '',
+	   tasks TEXT NOT NULL,
+	   created_at DATETIME NOT NULL,
+	   updated_at DATETIME NOT NULL
+	   );`
+
+	if _, err := s.DB.Exec(createTemplatesTable); err != nil {
+		return fmt.Errorf("error creando tabla task_templates: %w", err)
+	}
+
+	// Respuestas guardadas por clave de idempotencia; status 0 indica una petición en curso
+	createIdempotencyTable := `
+	CREATE TABLE IF NOT EXISTS idempotency_keys (
+	   key TEXT PRIMARY KEY,
+	   fingerprint TEXT NOT NULL,
+	   status INTEGER NOT NULL DEFAULT 0,
+	   content_type TEXT NOT NULL DEFAULT '',
+	   body BLOB NULL,
+	   created_at DATETIME NOT NULL,
+	   expires_at DATETIME NOT NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);`
+
+	if _, err := s.DB.Exec(createIdempotencyTable); err != nil {
+		return fmt.Errorf("error creando tabla idempotency_keys: %w", err)
+	}
+
+	// Outbox de eventos de tareas: se escribe en la transacción del cambio y lo vacía el relay
+	createOutboxTable := `
+	CREATE TABLE IF NOT EXISTS outbox (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   event_type TEXT NOT NULL,
+	   task_id INTEGER NOT NULL,
+	   payload TEXT NOT NULL,
+	   status TEXT NOT NULL DEFAULT 'pending',
+	   attempts INTEGER NOT NULL DEFAULT 0,
+	   next_attempt_at DATETIME NOT NULL,
+	   locked_until DATETIME NULL,
+	   last_error TEXT NOT NULL DEFAULT '',
+	   created_at DATETIME NOT NULL,
+	   processed_at DATETIME NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_outbox_status_next_attempt ON outbox (status, next_attempt_at);
+	CREATE INDEX IF NOT EXISTS idx_outbox_task_id ON outbox (task_id, id);`
+
+	if _, err := s.DB.Exec(createOutboxTable); err != nil {
+		return fmt.Errorf("error creando tabla outbox: %w", err)
+	}
+
+	// Suscripciones de webhooks y su registro de entregas, que también es la cola de reintentos
+	createWebhookTables := `
+	CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   url TEXT NOT NULL,
+	   events TEXT NOT NULL DEFAULT '[]',
+	   secret TEXT NOT NULL,
+	   active BOOLEAN NOT NULL DEFAULT 1,
+	   created_at DATETIME NOT NULL,
+	   updated_at DATETIME NOT NULL
+	   );
+	CREATE TABLE IF NOT EXISTS webhook_deliveries (
+	   id INTEGER PRIMARY KEY AUTOINCREMENT,
+	   subscription_id INTEGER NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+	   event_type TEXT NOT NULL,
+	   payload TEXT NOT NULL,
+	   status TEXT NOT NULL,
+	   attempts INTEGER NOT NULL DEFAULT 0,
+	   next_attempt_at DATETIME NULL,
+	   locked_until DATETIME NULL,
+	   response_status INTEGER NOT NULL DEFAULT 0,
+	   last_error TEXT NOT NULL DEFAULT '',
+	   created_at DATETIME NOT NULL,
+	   delivered_at DATETIME NULL
+	   );
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_status_next_attempt ON webhook_deliveries (status, next_attempt_at);
+	CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id, id);`
+
+	if _, err := s.DB.Exec(createWebhookTables); err != nil {
+		return fmt.Errorf("error creando tablas de webhooks: %w", err)
+	}
+
+	// Columnas agregadas después de la versión inicial de la tabla tasks
+	taskColumns := []struct{ name, definition string }{
+		{"due_date", "DATETIME NULL"},
+		{"recurrence", "TEXT NOT NULL DEFAULT ''"},
+		{"occurrence", "INTEGER NOT NULL DEFAULT 0"},
+		{"deleted_at", "DATETIME NULL"},
+		{"completed_at", "DATETIME NULL"},
+		{"archived_at", "DATETIME NULL"},
+		{"version", "INTEGER NOT NULL DEFAULT 1"},
+		{"rank", "TEXT NOT NULL DEFAULT ''"},
+		{"story_points", "INTEGER NOT NULL DEFAULT 0"},
+		{"estimated_hours", "REAL NOT NULL DEFAULT 0"},
+		{"remaining_hours", "REAL NOT NULL DEFAULT 0"},
+	}
+	for _, col := range taskColumns {
+		if err := s.addColumnIfMissing("tasks", col.name, col.definition); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.DB.Exec(`CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks (deleted_at)`); err != nil {
+		return fmt.Errorf("error creando índice de tareas eliminadas: %w", err)
+	}
+	if _, err := s.DB.Exec(`CREATE INDEX IF NOT EXISTS idx_tasks_rank ON tasks (rank, id)`); err != nil {
+		return fmt.Errorf("error creando índice de orden de tareas: %w", err)
+	}
+	if _, err := s.DB.Exec(`CREATE INDEX IF NOT EXISTS idx_tasks_completed_at ON tasks (completed_at)`); err != nil {
+		return fmt.Errorf("error creando índice de tareas completadas: %w", err)
+	}
+
+	return nil
+}
+
+// addColumnIfMissing agrega una columna si la tabla aún no la tiene (SQLite no soporta ADD COLUMN IF NOT EXISTS)
+func (s *SQLiteDB) addColumnIfMissing(table, column, definition string) error {
+	rows, err := s.DB.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return fmt.Errorf("error leyendo columnas de %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			colType    string
+			notNull    int
+			defaultV   sql.NullString
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultV, &primaryKey); err != nil {
+			return fmt.Errorf("error escaneando columnas de %s: %w", table, err)
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterando columnas de %s: %w", table, err)
+	}
+
+	if _, err := s.DB.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition)); err != nil {
+		return fmt.Errorf("error agregando columna %s.%s: %w", table, column, err)
+	}
 	return nil
 }
 
@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+
+	"gorm.io/gorm"
+)
+
+// SQLExecutor es la parte común de *sql.DB y *sql.Tx que usan los repositorios
+type SQLExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// sqlTxKey es la clave de contexto de la transacción de SQLite en curso
+type sqlTxKey struct{}
+
+// gormTxKey es la clave de contexto de la transacción de GORM en curso
+type gormTxKey struct{}
+
+// savepointSeq numera los savepoints de las transacciones anidadas
+var savepointSeq atomic.Int64
+
+// Tx es una transacción de SQLite. Si se inició dentro de la transacción del contexto usa un
+// savepoint: Rollback deshace solo sus cambios y Commit los deja en manos de la transacción externa
+type Tx struct {
+	*sql.Tx
+	ctx       context.Context
+	savepoint string
+	done      bool
+}
+
+// Commit confirma la transacción, o libera el savepoint si está anidada
+func (t *Tx) Commit() error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	if t.savepoint == "" {
+		return t.Tx.Commit()
+	}
+	_, err := t.Tx.ExecContext(t.ctx, "RELEASE SAVEPOINT "+t.savepoint)
+	return err
+}
+
+// Rollback revierte la transacción, o solo los cambios desde el savepoint si está anidada.
+// Después de Commit no hace nada, así que puede usarse con defer
+func (t *Tx) Rollback() error {
+	if t.done {
+		return sql.ErrTxDone
+	}
+	t.done = true
+	if t.savepoint == "" {
+		return t.Tx.Rollback()
+	}
+	if _, err := t.Tx.ExecContext(t.ctx, "ROLLBACK TO SAVEPOINT "+t.savepoint); err != nil {
+		return err
+	}
+	_, err := t.Tx.ExecContext(t.ctx, "RELEASE SAVEPOINT "+t.savepoint)
+	return err
+}
+
+// Conn retorna la transacción del contexto si hay una en curso o, si no, la conexión
+func (s *SQLiteDB) Conn(ctx context.Context) SQLExecutor {
+	if tx, ok := ctx.Value(sqlTxKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return s.DB
+}
+
+// BeginTx inicia una transacción, o un savepoint dentro de la transacción del contexto
+func (s *SQLiteDB) BeginTx(ctx context.Context) (*Tx, error) {
+	if tx, ok := ctx.Value(sqlTxKey{}).(*sql.Tx); ok {
+		savepoint := fmt.Sprintf("sp_%d", savepointSeq.Add(1))
+		if _, err := tx.ExecContext(ctx, "SAVEPOINT "+savepoint); err != nil {
+			return nil, err
+		}
+		return &Tx{Tx: tx, ctx: ctx, savepoint: savepoint}, nil
+	}
+
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{Tx: tx, ctx: ctx}, nil
+}
+
+// SQLiteTxManager ejecuta operaciones de varios repositorios SQLite en una sola transacción
+type SQLiteTxManager struct {
+	db *SQLiteDB
+}
+
+// NewSQLiteTxManager crea un administrador de transacciones para SQLite
+func NewSQLiteTxManager(db *SQLiteDB) *SQLiteTxManager {
+	return &SQLiteTxManager{db: db}
+}
+
+// WithinTx ejecuta fn en una transacción que viaja en el contexto; los repositorios que reciben
+// ese contexto se unen a ella. Si fn retorna error se revierte todo. Dentro de otra transacción
+// fn se une a la existente
+func (m *SQLiteTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(sqlTxKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := m.db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error iniciando transacción: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, sqlTxKey{}, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("error confirmando transacción: %w", err)
+	}
+	return nil
+}
+
+// GormConn retorna la transacción de GORM del contexto si hay una en curso o, si no, db; siempre con ctx
+func GormConn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(gormTxKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// GormTxManager ejecuta operaciones de varios repositorios GORM en una sola transacción
+type GormTxManager struct {
+	db *gorm.DB
+}
+
+// NewGormTxManager crea un administrador de transacciones para GORM
+func NewGormTxManager(db *gorm.DB) *GormTxManager {
+	return &GormTxManager{db: db}
+}
+
+// WithinTx ejecuta fn en una transacción que viaja en el contexto; los repositorios que reciben
+// ese contexto se unen a ella. Si fn retorna error se revierte todo. Dentro de otra transacción
+// fn se une a la existente
+func (m *GormTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(gormTxKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, gormTxKey{}, tx))
+	})
+}